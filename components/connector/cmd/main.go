@@ -15,10 +15,14 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/config"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/metrics"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/vrischmann/envconfig"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
@@ -54,28 +58,46 @@ func main() {
 	go certsLoader.Run(ctx)
 	go revokedCertsLoader.Run(ctx)
 
+	revokedCertsPruner := revocation.NewExpiredCertificatesPruner(internalComponents.RevokedCertsRepository, cfg.RevocationPruneInterval)
+	go revokedCertsPruner.Run(ctx)
+
+	issuedCertsCollector := metrics.NewIssuedCertificatesCollector()
+	prometheus.MustRegister(issuedCertsCollector)
+
+	expiryMonitor := inventory.NewExpiryMonitor(internalComponents.IssuedCertificatesRepository, issuedCertsCollector, cfg.IssuedCertificatesExpiryWarningWindow, cfg.IssuedCertificatesExpiryCheckInterval)
+	go expiryMonitor.Run(ctx)
+
+	issuedCertsPruner := inventory.NewExpiredCertificatesPruner(internalComponents.IssuedCertificatesRepository, cfg.IssuedCertificatesPruneInterval)
+	go issuedCertsPruner.Run(ctx)
+
 	certificateResolver := api.NewCertificateResolver(
 		internalComponents.Authenticator,
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.CSRKeyAlgorithm,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.InventoryService,
+		internalComponents.CAProvider)
 
 	authContextMiddleware := authentication.NewAuthenticationContextMiddleware()
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, internalComponents.CertificateStatusService, correlation.AttachCorrelationIDToContext(), log.RequestLogger(), authContextMiddleware.PropagateAuthentication)
 	exitOnError(err, "Failed configuring external graphQL handler")
 
 	hydratorServer, err := config.PrepareHydratorServer(cfg, internalComponents.CSRSubjectConsts, internalComponents.ExternalIssuerSubjectConsts, internalComponents.RevokedCertsRepository, correlation.AttachCorrelationIDToContext(), log.RequestLogger())
 	exitOnError(err, "Failed configuring hydrator handler")
 
+	metricsServer := config.PrepareMetricsServer(cfg)
+
 	wg := &sync.WaitGroup{}
-	wg.Add(2)
+	wg.Add(3)
 
 	go startServer(ctx, externalGqlServer, wg)
 	go startServer(ctx, hydratorServer, wg)
+	go startServer(ctx, metricsServer, wg)
 
 	wg.Wait()
 }
@@ -7,6 +7,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
 	"github.com/kyma-incubator/compass/components/connector/internal/namespacedname"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/secrets"
@@ -19,51 +21,87 @@ type Components struct {
 	Authenticator authentication.Authenticator
 
 	CertificateService     certificates.Service
+	CAProvider             certificates.CAProvider
 	RevokedCertsRepository revocation.RevokedCertificatesRepository
 
+	InventoryService             inventory.Service
+	IssuedCertificatesRepository inventory.IssuedCertificatesRepository
+	CertificateStatusService     certstatus.Service
+
 	ExternalIssuerSubjectConsts certificates.ExternalIssuerSubjectConsts
 	CSRSubjectConsts            certificates.CSRSubjectConsts
+	CSRKeyAlgorithm             string
 }
 
 func InitInternalComponents(cfg Config, k8sClientSet kubernetes.Interface, directorGCLI tokens.GraphQLClient) (Components, certificates.Loader, revocation.Loader) {
 	caSecret := namespacedname.Parse(cfg.CASecret.Name)
+	previousCASecrets := make([]types.NamespacedName, 0, len(cfg.CASecret.PreviousNames))
+	previousCASecretNames := make([]string, 0, len(cfg.CASecret.PreviousNames))
+	for _, name := range cfg.CASecret.PreviousNames {
+		previousCASecret := namespacedname.Parse(name)
+		previousCASecrets = append(previousCASecrets, previousCASecret)
+		previousCASecretNames = append(previousCASecretNames, previousCASecret.Name)
+	}
 	rootCASecret := namespacedname.Parse(cfg.RootCASecret.Name)
 
+	issuedCertsConfigMap := namespacedname.Parse(cfg.IssuedCertificatesConfigMapName)
+	issuedCertsRepository := newIssuedCertsRepository(k8sClientSet, issuedCertsConfigMap, cfg.IssuedCertificatesShards)
+	inventoryService := inventory.NewService(issuedCertsRepository)
+
 	certsCache := certificates.NewCertificateCache()
+	certUtil := certificates.NewCertificateUtility(cfg.CertificateValidityTime, cfg.CRLDistributionPointURL, cfg.OCSPServerURL, cfg.CSRAllowedKeyAlgorithms)
+	caProvider := certificates.NewCAProvider(certsCache, certUtil, caSecret.Name, previousCASecretNames, cfg.CASecret.CertificateKey, cfg.CASecret.KeyKey)
 	certsService := certificates.NewCertificateService(
 		certsCache,
-		certificates.NewCertificateUtility(cfg.CertificateValidityTime),
-		caSecret.Name,
+		certUtil,
+		caProvider,
+		inventoryService,
 		rootCASecret.Name,
-		cfg.CASecret.CertificateKey,
-		cfg.CASecret.KeyKey,
 		cfg.RootCASecret.CertificateKey,
 	)
-	certsLoader := certificates.NewCertificateLoader(certsCache, newSecretsRepository(k8sClientSet), caSecret, rootCASecret)
+	certsLoader := certificates.NewCertificateLoader(certsCache, newSecretsRepository(k8sClientSet), caSecret, previousCASecrets, rootCASecret)
 
-	revokedCertsCache := revocation.NewCache()
-	revokedCertsConfigMap := namespacedname.Parse(cfg.RevocationConfigMapName)
-	revokedCertsRepository := newRevokedCertsRepository(k8sClientSet, revokedCertsConfigMap, revokedCertsCache)
-	revokedCertsLoader := revocation.NewRevokedCertificatesLoader(revokedCertsCache,
-		k8sClientSet.CoreV1().ConfigMaps(revokedCertsConfigMap.Namespace),
-		revokedCertsConfigMap.Name,
-		time.Second,
-	)
+	revokedCertsRepository, revokedCertsLoader := newRevokedCertsRepository(cfg, k8sClientSet)
+	certStatusService := certstatus.NewService(caProvider, issuedCertsRepository, revokedCertsRepository, cfg.CertificateStatusValidityTime)
 
 	return Components{
-		Authenticator:               authentication.NewAuthenticator(),
-		TokenService:                tokens.NewTokenService(directorGCLI),
-		CertificateService:          certsService,
-		RevokedCertsRepository:      revokedCertsRepository,
-		CSRSubjectConsts:            newCSRSubjectConsts(cfg),
-		ExternalIssuerSubjectConsts: newExternalIssuerSubjectConsts(cfg),
+		Authenticator:                authentication.NewAuthenticator(),
+		TokenService:                 tokens.NewTokenService(directorGCLI),
+		CertificateService:           certsService,
+		CAProvider:                   caProvider,
+		RevokedCertsRepository:       revokedCertsRepository,
+		InventoryService:             inventoryService,
+		IssuedCertificatesRepository: issuedCertsRepository,
+		CertificateStatusService:     certStatusService,
+		CSRSubjectConsts:             newCSRSubjectConsts(cfg),
+		CSRKeyAlgorithm:              newCSRKeyAlgorithm(cfg),
+		ExternalIssuerSubjectConsts:  newExternalIssuerSubjectConsts(cfg),
 	}, certsLoader, revokedCertsLoader
 }
 
-func newRevokedCertsRepository(k8sClientSet kubernetes.Interface, revokedCertsConfigMap types.NamespacedName, revokedCertsCache revocation.Cache) revocation.RevokedCertificatesRepository {
+func newRevokedCertsRepository(cfg Config, k8sClientSet kubernetes.Interface) (revocation.RevokedCertificatesRepository, revocation.Loader) {
+	revokedCertsCache := revocation.NewCache()
+
+	if cfg.RevocationStorage == revocation.StorageSecretShards {
+		revokedCertsSecret := namespacedname.Parse(cfg.RevocationSecretName)
+		si := k8sClientSet.CoreV1().Secrets(revokedCertsSecret.Namespace)
+		shardNames := revocation.ShardNames(revokedCertsSecret.Name, cfg.RevocationSecretShards)
+
+		return revocation.NewSecretShardsRepository(si, revokedCertsSecret.Name, cfg.RevocationSecretShards, revokedCertsCache),
+			revocation.NewRevokedCertificatesShardsLoader(revokedCertsCache, si, shardNames, time.Second)
+	}
+
+	revokedCertsConfigMap := namespacedname.Parse(cfg.RevocationConfigMapName)
 	cmi := k8sClientSet.CoreV1().ConfigMaps(revokedCertsConfigMap.Namespace)
 
-	return revocation.NewRepository(cmi, revokedCertsConfigMap.Name, revokedCertsCache)
+	return revocation.NewRepository(cmi, revokedCertsConfigMap.Name, revokedCertsCache),
+		revocation.NewRevokedCertificatesLoader(revokedCertsCache, cmi, revokedCertsConfigMap.Name, time.Second)
+}
+
+func newIssuedCertsRepository(k8sClientSet kubernetes.Interface, issuedCertsConfigMap types.NamespacedName, shards int) inventory.IssuedCertificatesRepository {
+	cmi := k8sClientSet.CoreV1().ConfigMaps(issuedCertsConfigMap.Namespace)
+
+	return inventory.NewRepository(cmi, issuedCertsConfigMap.Name, shards)
 }
 
 func newSecretsRepository(k8sClientSet kubernetes.Interface) secrets.Repository {
@@ -74,6 +112,14 @@ func newSecretsRepository(k8sClientSet kubernetes.Interface) secrets.Repository
 	})
 }
 
+// newCSRKeyAlgorithm returns the key algorithm advertised to the clients, which is the first allowed one or rsa2048 if any key algorithm is allowed
+func newCSRKeyAlgorithm(config Config) string {
+	if len(config.CSRAllowedKeyAlgorithms) == 0 {
+		return "rsa2048"
+	}
+	return config.CSRAllowedKeyAlgorithms[0]
+}
+
 func newCSRSubjectConsts(config Config) certificates.CSRSubjectConsts {
 	return certificates.CSRSubjectConsts{
 		Country:            config.CSRSubject.Country,
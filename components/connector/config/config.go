@@ -30,19 +30,41 @@ type Config struct {
 		Organization              string `envconfig:"default=Org"`
 		OrganizationalUnitPattern string `envconfig:"default=OrgUnit"`
 	}
+	// CSRAllowedKeyAlgorithms are the key algorithms allowed in CSRs, the first one is advertised to the clients
+	CSRAllowedKeyAlgorithms []string      `envconfig:"default=rsa2048;rsa3072;rsa4096;ecdsa-p256;ecdsa-p384;ed25519"`
 	CertificateValidityTime time.Duration `envconfig:"default=2160h"`
 	CASecret                struct {
 		Name           string `envconfig:"default=kyma-integration/connector-service-app-ca"`
 		CertificateKey string `envconfig:"default=ca.crt"`
 		KeyKey         string `envconfig:"default=ca.key"`
+		// PreviousNames are the secrets with the previous generations of the CA, which stay trusted until they expire
+		PreviousNames []string `envconfig:"optional"`
 	}
 	RootCASecret struct {
 		Name           string `envconfig:"optional"`
 		CertificateKey string `envconfig:"optional"`
 	}
 
-	CertificateDataHeader   string `envconfig:"default=Certificate-Data"`
-	RevocationConfigMapName string `envconfig:"default=compass-system/revocations-Config"`
+	CertificateDataHeader   string        `envconfig:"default=Certificate-Data"`
+	RevocationConfigMapName string        `envconfig:"default=compass-system/revocations-Config"`
+	RevocationPruneInterval time.Duration `envconfig:"default=1h"`
+	// RevocationStorage is either configmap, which stores the revocation list in the RevocationConfigMapName config map,
+	// or secret-shards, which shards the revocation list across RevocationSecretShards secrets named <RevocationSecretName>-<shard>
+	RevocationStorage      string `envconfig:"default=configmap"`
+	RevocationSecretName   string `envconfig:"default=compass-system/revocations"`
+	RevocationSecretShards int    `envconfig:"default=16"`
+
+	IssuedCertificatesConfigMapName       string        `envconfig:"default=compass-system/issued-certificates-config"`
+	IssuedCertificatesShards              int           `envconfig:"default=16"`
+	IssuedCertificatesExpiryWarningWindow time.Duration `envconfig:"default=720h"`
+	IssuedCertificatesExpiryCheckInterval time.Duration `envconfig:"default=5m"`
+	IssuedCertificatesPruneInterval       time.Duration `envconfig:"default=1h"`
+
+	MetricsAddress string `envconfig:"default=127.0.0.1:3001"`
+
+	CRLDistributionPointURL       string        `envconfig:"default=https://compass-gateway.kyma.local/connector/v1/crl"`
+	OCSPServerURL                 string        `envconfig:"default=https://compass-gateway.kyma.local/connector/v1/ocsp"`
+	CertificateStatusValidityTime time.Duration `envconfig:"default=1h"`
 
 	DirectorURL                    string `envconfig:"default=127.0.0.1:3003"`
 	CertificateSecuredConnectorURL string `envconfig:"default=https://compass-gateway-mtls.kyma.local"`
@@ -64,10 +86,14 @@ func (c *Config) String() string {
 		"CSRSubjectCountry: %s, CSRSubjectOrganization: %s, CSRSubjectOrganizationalUnit: %s, "+
 		"CSRSubjectLocality: %s, CSRSubjectProvince: %s, "+
 		"ExternalIssuerSubjectCountry: %s, ExternalIssuerSubjectOrganization: %s, ExternalIssuerSubjectOrganizationalUnitPattern: %s,"+
-		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, "+
+		"CSRAllowedKeyAlgorithms: %v, "+
+		"CertificateValidityTime: %s, CASecretName: %s, CASecretCertificateKey: %s, CASecretKeyKey: %s, CASecretPreviousNames: %v, "+
 		"RootCASecretName: %s, RootCASecretCertificateKey: %s, CertificateDataHeader: %s, "+
 		"CertificateSecuredConnectorURL: %s, "+
-		"RevocationConfigMapName: %s, "+
+		"RevocationConfigMapName: %s, RevocationPruneInterval: %s, RevocationStorage: %s, RevocationSecretName: %s, RevocationSecretShards: %d, "+
+		"IssuedCertificatesConfigMapName: %s, IssuedCertificatesShards: %d, IssuedCertificatesExpiryWarningWindow: %s, IssuedCertificatesExpiryCheckInterval: %s, IssuedCertificatesPruneInterval: %s, "+
+		"MetricsAddress: %s, "+
+		"CRLDistributionPointURL: %s, OCSPServerURL: %s, CertificateStatusValidityTime: %s, "+
 		"DirectorURL: %s "+
 		"KubernetesClientPollInteval: %s, KubernetesClientPollTimeout: %s"+
 		"OneTimeTokenURL: %s, HTTPClienttimeout: %s, SubjectConsumerMappingConfig: %s",
@@ -75,10 +101,14 @@ func (c *Config) String() string {
 		c.CSRSubject.Country, c.CSRSubject.Organization, c.CSRSubject.OrganizationalUnit,
 		c.CSRSubject.Locality, c.CSRSubject.Province,
 		c.ExternalIssuerSubject.Country, c.ExternalIssuerSubject.Organization, c.ExternalIssuerSubject.OrganizationalUnitPattern,
-		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey,
+		c.CSRAllowedKeyAlgorithms,
+		c.CertificateValidityTime, c.CASecret.Name, c.CASecret.CertificateKey, c.CASecret.KeyKey, c.CASecret.PreviousNames,
 		c.RootCASecret.Name, c.RootCASecret.CertificateKey, c.CertificateDataHeader,
 		c.CertificateSecuredConnectorURL,
-		c.RevocationConfigMapName,
+		c.RevocationConfigMapName, c.RevocationPruneInterval, c.RevocationStorage, c.RevocationSecretName, c.RevocationSecretShards,
+		c.IssuedCertificatesConfigMapName, c.IssuedCertificatesShards, c.IssuedCertificatesExpiryWarningWindow, c.IssuedCertificatesExpiryCheckInterval, c.IssuedCertificatesPruneInterval,
+		c.MetricsAddress,
+		c.CRLDistributionPointURL, c.OCSPServerURL, c.CertificateStatusValidityTime,
 		c.DirectorURL,
 		c.KubernetesClient.PollInteval, c.KubernetesClient.PollTimeout,
 		c.OneTimeTokenURL, c.HTTPClientTimeout, c.SubjectConsumerMappingConfig)
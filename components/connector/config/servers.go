@@ -1,12 +1,14 @@
 package config
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/99designs/gqlgen/handler"
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/connector/internal/api"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
 	"github.com/kyma-incubator/compass/components/connector/internal/healthz"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/subject"
@@ -14,9 +16,10 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/pkg/oathkeeper"
 	"github.com/kyma-incubator/compass/components/director/pkg/cert"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
+func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolver, certStatusService certstatus.Service, middlewares ...mux.MiddlewareFunc) (*http.Server, error) {
 	gqlInternalCfg := externalschema.Config{
 		Resolvers: &api.ExternalResolver{CertificateResolver: certResolver},
 	}
@@ -28,6 +31,12 @@ func PrepareExternalGraphQLServer(cfg Config, certResolver api.CertificateResolv
 	externalRouter.HandleFunc(cfg.APIEndpoint, handler.GraphQL(externalExecutableSchema))
 	externalRouter.HandleFunc("/healthz", healthz.NewHTTPHandler())
 
+	certStatusHandler := certstatus.NewHandler(certStatusService)
+	externalRouter.HandleFunc("/v1/crl", certStatusHandler.CRL).Methods(http.MethodGet)
+	externalRouter.HandleFunc(fmt.Sprintf("/v1/crl/{%s}", certstatus.CASerialNumberVar), certStatusHandler.CRL).Methods(http.MethodGet)
+	externalRouter.HandleFunc("/v1/ocsp", certStatusHandler.OCSP).Methods(http.MethodPost)
+	externalRouter.PathPrefix("/v1/ocsp/").HandlerFunc(certStatusHandler.OCSP).Methods(http.MethodGet)
+
 	externalRouter.Use(middlewares...)
 
 	handlerWithTimeout, err := timeouthandler.WithTimeout(externalRouter, cfg.ServerTimeout)
@@ -76,3 +85,14 @@ func PrepareHydratorServer(cfg Config, CSRSubjectConsts certificates.CSRSubjectC
 		ReadHeaderTimeout: cfg.ServerTimeout,
 	}, nil
 }
+
+func PrepareMetricsServer(cfg Config) *http.Server {
+	router := http.NewServeMux()
+	router.Handle("/metrics", promhttp.Handler())
+
+	return &http.Server{
+		Addr:              cfg.MetricsAddress,
+		Handler:           router,
+		ReadHeaderTimeout: cfg.ServerTimeout,
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/authentication"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
 	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
 	"github.com/kyma-incubator/compass/components/connector/internal/tokens"
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
@@ -19,6 +20,9 @@ type CertificateResolver interface {
 	SignCertificateSigningRequest(ctx context.Context, csr string) (*externalschema.CertificationResult, error)
 	RevokeCertificate(ctx context.Context) (bool, error)
 	Configuration(ctx context.Context) (*externalschema.Configuration, error)
+	IssuedCertificates(ctx context.Context) ([]*externalschema.IssuedCertificate, error)
+	IssuedCertificate(ctx context.Context, serialNumber string) (*externalschema.IssuedCertificate, error)
+	CertificateAuthorities(ctx context.Context) ([]*externalschema.CertificateAuthority, error)
 }
 
 type certificateResolver struct {
@@ -26,9 +30,12 @@ type certificateResolver struct {
 	tokenService                   tokens.Service
 	certificatesService            certificates.Service
 	csrSubjectConsts               certificates.CSRSubjectConsts
+	csrKeyAlgorithm                string
 	directorURL                    string
 	certificateSecuredConnectorURL string
 	revokedCertsRepository         revocation.RevokedCertificatesRepository
+	inventoryService               inventory.Service
+	caProvider                     certificates.CAProvider
 }
 
 func NewCertificateResolver(
@@ -36,17 +43,23 @@ func NewCertificateResolver(
 	tokenService tokens.Service,
 	certificatesService certificates.Service,
 	csrSubjectConsts certificates.CSRSubjectConsts,
+	csrKeyAlgorithm string,
 	directorURL string,
 	certificateSecuredConnectorURL string,
-	revokedCertsRepository revocation.RevokedCertificatesRepository) CertificateResolver {
+	revokedCertsRepository revocation.RevokedCertificatesRepository,
+	inventoryService inventory.Service,
+	caProvider certificates.CAProvider) CertificateResolver {
 	return &certificateResolver{
 		authenticator:                  authenticator,
 		tokenService:                   tokenService,
 		certificatesService:            certificatesService,
 		csrSubjectConsts:               csrSubjectConsts,
+		csrKeyAlgorithm:                csrKeyAlgorithm,
 		directorURL:                    directorURL,
 		certificateSecuredConnectorURL: certificateSecuredConnectorURL,
 		revokedCertsRepository:         revokedCertsRepository,
+		inventoryService:               inventoryService,
+		caProvider:                     caProvider,
 	}
 }
 
@@ -74,7 +87,7 @@ func (r *certificateResolver) Configuration(ctx context.Context) (*externalschem
 
 	csrInfo := &externalschema.CertificateSigningRequestInfo{
 		Subject:      r.csrSubjectConsts.ToString(clientId),
-		KeyAlgorithm: "rsa2048",
+		KeyAlgorithm: r.csrKeyAlgorithm,
 	}
 
 	log.C(ctx).Infof("Configuration for client with id %s successfully fetched.", clientId)
@@ -111,7 +124,15 @@ func (r *certificateResolver) SignCertificateSigningRequest(ctx context.Context,
 		CSRSubjectConsts: r.csrSubjectConsts,
 	}
 
-	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject)
+	consumer := certificates.Consumer{ID: clientId}
+	if consumerType, err := authentication.GetStringFromContext(ctx, authentication.ConsumerType); err == nil {
+		consumer.Type = consumerType
+	}
+	if tenant, err := authentication.GetStringFromContext(ctx, authentication.TenantKey); err == nil {
+		consumer.Tenant = tenant
+	}
+
+	encodedCertificates, err := r.certificatesService.SignCSR(ctx, rawCSR, subject, consumer)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while signing the CSR with Common Name %s of client with id %s: %v", subject.CommonName, clientId, err)
 		return nil, errors.Wrap(err, "Error while signing Certificate Signing Request")
@@ -134,8 +155,18 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 
 	log.C(ctx).Infof("Revoking certificate for client with id %s", clientId)
 
+	log.C(ctx).Debugf("Marking certificate of client with id %s as revoked in issued certificates inventory", clientId)
+	issuedCert, appErr := r.inventoryService.Revoke(ctx, certificateHash)
+	if appErr != nil {
+		if appErr.Code() != apperrors.CodeNotFound {
+			log.C(ctx).WithError(appErr).Errorf("Failed to mark certificate of client with id %s as revoked in issued certificates inventory: %v", clientId, appErr)
+			return false, errors.Wrap(appErr, "Failed to mark certificate as revoked in issued certificates inventory")
+		}
+		log.C(ctx).Warnf("Certificate of client with id %s is not recorded in issued certificates inventory, it will not be published in CRL nor pruned from revocation list", clientId)
+	}
+
 	log.C(ctx).Debugf("Inserting certificate hash of client with id %s to revocation list", clientId)
-	err = r.revokedCertsRepository.Insert(ctx, certificateHash)
+	err = r.revokedCertsRepository.Insert(ctx, certificateHash, issuedCert.NotAfter)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Failed to add certificate hash of client with id %s to revocation list: %v", clientId, err)
 		return false, errors.Wrap(err, "Failed to add hash to revocation list")
@@ -145,6 +176,79 @@ func (r *certificateResolver) RevokeCertificate(ctx context.Context) (bool, erro
 	return true, nil
 }
 
+func (r *certificateResolver) IssuedCertificates(ctx context.Context) ([]*externalschema.IssuedCertificate, error) {
+	log.C(ctx).Debug("Authenticating the call for listing issued certificates.")
+
+	clientId, err := r.authenticator.Authenticate(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed authentication while listing issued certificates: %v", err)
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Listing issued certificates for client with id %s", clientId)
+	issuedCerts, err := r.inventoryService.List(ctx, clientId)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while listing issued certificates for client with id %s: %v", clientId, err)
+		return nil, errors.Wrap(err, "Failed to list issued certificates")
+	}
+
+	result := make([]*externalschema.IssuedCertificate, 0, len(issuedCerts))
+	for _, crt := range issuedCerts {
+		gqlCrt := inventory.ToGraphQL(crt)
+		result = append(result, &gqlCrt)
+	}
+
+	return result, nil
+}
+
+func (r *certificateResolver) IssuedCertificate(ctx context.Context, serialNumber string) (*externalschema.IssuedCertificate, error) {
+	log.C(ctx).Debug("Authenticating the call for fetching issued certificate.")
+
+	clientId, err := r.authenticator.Authenticate(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed authentication while fetching issued certificate: %v", err)
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Fetching issued certificate with serial number %s for client with id %s", serialNumber, clientId)
+	crt, appErr := r.inventoryService.Get(ctx, clientId, serialNumber)
+	if appErr != nil {
+		if appErr.Code() == apperrors.CodeNotFound {
+			return nil, nil
+		}
+		log.C(ctx).WithError(appErr).Errorf("Error occurred while fetching issued certificate with serial number %s for client with id %s: %v", serialNumber, clientId, appErr)
+		return nil, errors.Wrap(appErr, "Failed to fetch issued certificate")
+	}
+
+	gqlCrt := inventory.ToGraphQL(crt)
+	return &gqlCrt, nil
+}
+
+func (r *certificateResolver) CertificateAuthorities(ctx context.Context) ([]*externalschema.CertificateAuthority, error) {
+	log.C(ctx).Debug("Authenticating the call for listing certificate authorities.")
+
+	clientId, err := r.authenticator.Authenticate(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed authentication while listing certificate authorities: %v", err)
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Listing certificate authorities for client with id %s", clientId)
+	cas, appErr := r.caProvider.CAs()
+	if appErr != nil {
+		log.C(ctx).WithError(appErr).Errorf("Error occurred while listing certificate authorities for client with id %s: %v", clientId, appErr)
+		return nil, errors.Wrap(appErr, "Failed to list certificate authorities")
+	}
+
+	result := make([]*externalschema.CertificateAuthority, 0, len(cas))
+	for _, ca := range cas {
+		gqlCA := certificates.ToCertificateAuthority(ca)
+		result = append(result, &gqlCA)
+	}
+
+	return result, nil
+}
+
 func decodeStringFromBase64(string string) ([]byte, apperrors.AppError) {
 	bytes, err := base64.StdEncoding.DecodeString(string)
 	if err != nil {
@@ -2,8 +2,12 @@ package api
 
 import (
 	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
@@ -13,8 +17,11 @@ import (
 	authenticationMocks "github.com/kyma-incubator/compass/components/connector/internal/authentication/mocks"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	inventoryMocks "github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
 	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
 	tokensMocks "github.com/kyma-incubator/compass/components/connector/internal/tokens/automock"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -23,6 +30,7 @@ const (
 	clientId        = "clientId"
 	certificateHash = "somehash"
 	token           = "abcd-efgh"
+	keyAlgorithm    = "ecdsa-p256"
 )
 
 var (
@@ -61,9 +69,9 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(encodedChain, nil)
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificates.Consumer{ID: clientId}).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		certificationResult, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -76,6 +84,30 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
+	t.Run("should sign client certificate for consumer from context", func(t *testing.T) {
+		// given
+		ctx := context.WithValue(context.Background(), authentication.ConsumerType, "Application")
+		ctx = context.WithValue(ctx, authentication.TenantKey, "tenant")
+		consumer := certificates.Consumer{ID: clientId, Type: "Application", Tenant: "tenant"}
+
+		tokenService := &tokensMocks.Service{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+
+		certService := &certificatesMocks.Service{}
+		certService.On("SignCSR", ctx, decodedCSR, subject, consumer).Return(certificates.EncodedCertificateChain{}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
+
+		// when
+		_, err := certificateResolver.SignCertificateSigningRequest(ctx, CSR)
+
+		// then
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, certService)
+	})
+
 	t.Run("should return error when unauthenticated call", func(t *testing.T) {
 		// given
 		certChainBase64 := "certChainBase64"
@@ -96,7 +128,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -126,7 +158,7 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		certService := &certificatesMocks.Service{}
 		certService.On("SignCSR", decodedCSR, subject).Return(encodedChain, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), "not base 64 csr")
@@ -144,9 +176,9 @@ func TestCertificateResolver_SignCertificateSigningRequest(t *testing.T) {
 		authenticator.On("Authenticate", context.TODO()).Return(clientId, nil)
 
 		certService := &certificatesMocks.Service{}
-		certService.On("SignCSR", mock.Anything, decodedCSR, subject).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
+		certService.On("SignCSR", mock.Anything, decodedCSR, subject, certificates.Consumer{ID: clientId}).Return(certificates.EncodedCertificateChain{}, apperrors.Internal("error"))
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, certService, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		_, err := certificateResolver.SignCertificateSigningRequest(context.TODO(), CSR)
@@ -166,9 +198,12 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
-		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(nil)
+		notAfter := time.Now().Add(time.Hour)
+		revokedCertsRepository.On("Insert", ctx, certificateHash, notAfter).Return(nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Revoke", ctx, certificateHash).Return(inventory.IssuedCertificate{Hash: certificateHash, NotAfter: notAfter}, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, inventoryService, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -176,6 +211,50 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		// then
 		require.NoError(t, err)
 		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, inventoryService)
+	})
+
+	t.Run("should revoke certificate not recorded in inventory", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Insert", ctx, certificateHash, time.Time{}).Return(nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Revoke", ctx, certificateHash).Return(inventory.IssuedCertificate{}, apperrors.NotFound("not found"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, inventoryService, nil)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, true, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, inventoryService)
+	})
+
+	t.Run("should return error if failed to mark cert as revoked in inventory", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Revoke", ctx, certificateHash).Return(inventory.IssuedCertificate{}, apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, inventoryService, nil)
+
+		// when
+		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, inventoryService)
 	})
 
 	t.Run("should return error if failed to verify certificate", func(t *testing.T) {
@@ -183,9 +262,8 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return("", "", errors.Errorf("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
-		revokedCertsRepository.On("Insert", certificateHash).Return(nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -202,9 +280,11 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		authenticator := &authenticationMocks.Authenticator{}
 		authenticator.On("AuthenticateCertificate", context.Background()).Return(clientId, certificateHash, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
-		revokedCertsRepository.On("Insert", ctx, certificateHash).Return(errors.Errorf("error"))
+		revokedCertsRepository.On("Insert", ctx, certificateHash, time.Time{}).Return(errors.Errorf("error"))
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Revoke", ctx, certificateHash).Return(inventory.IssuedCertificate{Hash: certificateHash}, nil)
 
-		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, inventoryService, nil)
 
 		// when
 		revocationResult, err := certificateResolver.RevokeCertificate(context.Background())
@@ -212,6 +292,7 @@ func TestCertificateResolver_RevokeCertificate(t *testing.T) {
 		// then
 		require.Error(t, err)
 		assert.Equal(t, false, revocationResult)
+		mock.AssertExpectationsForObjects(t, revokedCertsRepository, inventoryService)
 	})
 }
 
@@ -228,7 +309,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService.On("GetToken", mock.Anything, subject.CommonName, "Application").Return(token, nil)
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -239,7 +320,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		assert.Equal(t, &directorURL, configurationResult.ManagementPlaneInfo.DirectorURL)
 		assert.Equal(t, &certSecuredConnectorURL, configurationResult.ManagementPlaneInfo.CertificateSecuredConnectorURL)
 		assert.Equal(t, expectedSubject(subject.CSRSubjectConsts, subject.CommonName), configurationResult.CertificateSigningRequestInfo.Subject)
-		assert.Equal(t, "rsa2048", configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
+		assert.Equal(t, keyAlgorithm, configurationResult.CertificateSigningRequestInfo.KeyAlgorithm)
 		mock.AssertExpectationsForObjects(t, tokenService, authenticator)
 	})
 
@@ -254,7 +335,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService.On("GetToken", mock.Anything, subject.CommonName, "Application").Return("", apperrors.Internal("error"))
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -275,7 +356,7 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 		tokenService := &tokensMocks.Service{}
 		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
 
-		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, directorURL, certSecuredConnectorURL, revokedCertsRepository)
+		certificateResolver := NewCertificateResolver(authenticator, tokenService, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, revokedCertsRepository, nil, nil)
 
 		// when
 		configurationResult, err := certificateResolver.Configuration(ctx)
@@ -288,6 +369,228 @@ func TestCertificateResolver_Configuration(t *testing.T) {
 
 }
 
+func TestCertificateResolver_IssuedCertificates(t *testing.T) {
+	issuedCert := inventory.IssuedCertificate{
+		SerialNumber: "1f",
+		Subject:      "CN=clientId",
+		ConsumerID:   clientId,
+		ConsumerType: "Application",
+		NotBefore:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+		Hash:         certificateHash,
+	}
+
+	t.Run("should return certificates issued for client", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("List", ctx, clientId).Return([]inventory.IssuedCertificate{issuedCert}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		issuedCerts, err := certificateResolver.IssuedCertificates(ctx)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, issuedCerts, 1)
+		assert.Equal(t, "1f", issuedCerts[0].SerialNumber)
+		assert.Equal(t, "Application", *issuedCerts[0].ConsumerType)
+		assert.Nil(t, issuedCerts[0].Tenant)
+		assert.Equal(t, "2022-04-01T00:00:00Z", issuedCerts[0].NotAfter)
+		assert.Equal(t, certificateHash, issuedCerts[0].Sha256Hash)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+
+	t.Run("should return error when failed to list issued certificates", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("List", ctx, clientId).Return(nil, apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		_, err := certificateResolver.IssuedCertificates(ctx)
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+
+	t.Run("should return error when failed to authenticate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return("", apperrors.Forbidden("Error"))
+		inventoryService := &inventoryMocks.Service{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		_, err := certificateResolver.IssuedCertificates(ctx)
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+}
+
+func TestCertificateResolver_IssuedCertificate(t *testing.T) {
+	t.Run("should return certificate issued for client", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Get", ctx, clientId, "1f").Return(inventory.IssuedCertificate{SerialNumber: "1f", ConsumerID: clientId}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		issuedCert, err := certificateResolver.IssuedCertificate(ctx, "1f")
+
+		// then
+		require.NoError(t, err)
+		require.NotNil(t, issuedCert)
+		assert.Equal(t, "1f", issuedCert.SerialNumber)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+
+	t.Run("should return nil when certificate not found", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Get", ctx, clientId, "1f").Return(inventory.IssuedCertificate{}, apperrors.NotFound("not found"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		issuedCert, err := certificateResolver.IssuedCertificate(ctx, "1f")
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, issuedCert)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+
+	t.Run("should return error when failed to get issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		inventoryService := &inventoryMocks.Service{}
+		inventoryService.On("Get", ctx, clientId, "1f").Return(inventory.IssuedCertificate{}, apperrors.Internal("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, inventoryService, nil)
+
+		// when
+		_, err := certificateResolver.IssuedCertificate(ctx, "1f")
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, inventoryService)
+	})
+}
+
 func expectedSubject(c certificates.CSRSubjectConsts, commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", c.Organization, c.OrganizationalUnit, c.Locality, c.Province, c.Country, commonName)
 }
+
+func TestCertificateResolver_CertificateAuthorities(t *testing.T) {
+	activeCA := certificates.CA{
+		Certificate: &x509.Certificate{
+			SerialNumber: big.NewInt(0x2a),
+			Subject:      pkix.Name{CommonName: "Connector CA"},
+			NotBefore:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			NotAfter:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		Active: true,
+	}
+	previousCA := certificates.CA{
+		Certificate: &x509.Certificate{
+			SerialNumber: big.NewInt(0x1a),
+			Subject:      pkix.Name{CommonName: "Connector CA"},
+			NotBefore:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			NotAfter:     time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	t.Run("should return certificate authorities", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return([]certificates.CA{activeCA, previousCA}, nil)
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, nil, caProvider)
+
+		// when
+		cas, err := certificateResolver.CertificateAuthorities(ctx)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, cas, 2)
+		assert.Equal(t, externalschema.CertificateAuthority{
+			SerialNumber: "2a",
+			Subject:      "CN=Connector CA",
+			NotBefore:    "2022-01-01T00:00:00Z",
+			NotAfter:     "2024-01-01T00:00:00Z",
+			Active:       true,
+		}, *cas[0])
+		assert.Equal(t, "1a", cas[1].SerialNumber)
+		assert.False(t, cas[1].Active)
+		mock.AssertExpectationsForObjects(t, authenticator, caProvider)
+	})
+
+	t.Run("should return error when failed to load certificate authorities", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return(clientId, nil)
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(nil, apperrors.NotFound("error"))
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, nil, caProvider)
+
+		// when
+		_, err := certificateResolver.CertificateAuthorities(ctx)
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, caProvider)
+	})
+
+	t.Run("should return error when failed to authenticate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		authenticator := &authenticationMocks.Authenticator{}
+		authenticator.On("Authenticate", ctx).Return("", apperrors.Forbidden("Error"))
+		caProvider := &certificatesMocks.CAProvider{}
+
+		certificateResolver := NewCertificateResolver(authenticator, nil, nil, subject.CSRSubjectConsts, keyAlgorithm, directorURL, certSecuredConnectorURL, nil, nil, caProvider)
+
+		// when
+		_, err := certificateResolver.CertificateAuthorities(ctx)
+
+		// then
+		require.Error(t, err)
+		mock.AssertExpectationsForObjects(t, authenticator, caProvider)
+	})
+}
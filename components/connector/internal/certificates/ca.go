@@ -0,0 +1,96 @@
+package certificates
+
+import (
+	"crypto"
+	"crypto/x509"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// CA is a generation of the certificate authority which signs the client certificates
+type CA struct {
+	Certificate *x509.Certificate
+	Key         crypto.Signer
+	// Active is true for the generation which signs new client certificates
+	Active bool
+}
+
+//go:generate mockery --name=CAProvider
+type CAProvider interface {
+	// CA returns the certificate and the private key of the active CA which signs new client certificates
+	CA() (*x509.Certificate, crypto.Signer, apperrors.AppError)
+	// CAs returns the active CA followed by the previous generations which are not expired yet. Previous generations
+	// which cannot be loaded are skipped, so that they do not prevent the active CA from signing new certificates
+	CAs() ([]CA, apperrors.AppError)
+}
+
+type caProvider struct {
+	certsCache                Cache
+	certUtil                  CertificateUtility
+	caCertSecretName          string
+	previousCACertSecretNames []string
+	caCertSecretKey           string
+	caKeySecretKey            string
+}
+
+func NewCAProvider(certsCache Cache, certUtil CertificateUtility, caCertSecretName string, previousCACertSecretNames []string, caCertSecretKey, caKeySecretKey string) CAProvider {
+	return &caProvider{
+		certsCache:                certsCache,
+		certUtil:                  certUtil,
+		caCertSecretName:          caCertSecretName,
+		previousCACertSecretNames: previousCACertSecretNames,
+		caCertSecretKey:           caCertSecretKey,
+		caKeySecretKey:            caKeySecretKey,
+	}
+}
+
+func (p *caProvider) CA() (*x509.Certificate, crypto.Signer, apperrors.AppError) {
+	return p.loadCA(p.caCertSecretName)
+}
+
+func (p *caProvider) CAs() ([]CA, apperrors.AppError) {
+	caCrt, caKey, err := p.loadCA(p.caCertSecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	cas := []CA{{Certificate: caCrt, Key: caKey, Active: true}}
+
+	now := time.Now()
+	for _, secretName := range p.previousCACertSecretNames {
+		caCrt, caKey, err := p.loadCA(secretName)
+		if err != nil {
+			log.D().WithError(err).Errorf("Failed to load previous CA from secret %s, it is not trusted: %v", secretName, err)
+			continue
+		}
+
+		if now.After(caCrt.NotAfter) {
+			continue
+		}
+
+		cas = append(cas, CA{Certificate: caCrt, Key: caKey})
+	}
+
+	return cas, nil
+}
+
+func (p *caProvider) loadCA(secretName string) (*x509.Certificate, crypto.Signer, apperrors.AppError) {
+	secretData, err := p.certsCache.Get(secretName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caCrt, err := p.certUtil.LoadCert(secretData[p.caCertSecretKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caKey, err := p.certUtil.LoadKey(secretData[p.caKeySecretKey])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return caCrt, caKey, nil
+}
@@ -1,33 +1,70 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 )
 
+// serialNumberLimit bounds the randomly generated serial numbers to 128 bits, so that each issued certificate can be identified by its serial number
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
 //go:generate mockery --name=CertificateUtility
 type CertificateUtility interface {
 	LoadCert(encodedData []byte) (*x509.Certificate, apperrors.AppError)
-	LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError)
+	// LoadKey loads RSA, ECDSA or Ed25519 private key
+	LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError)
 	LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError)
 	CheckCSRValues(csr *x509.CertificateRequest, subject CSRSubject) apperrors.AppError
-	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError)
+	SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer) ([]byte, apperrors.AppError)
 	AddCertificateHeaderAndFooter(crtRaw []byte) []byte
 }
 
 type certificateUtility struct {
 	certificateValidityTime time.Duration
+	crlDistributionPointURL string
+	ocspServerURL           string
+	allowedKeyAlgorithms    map[string]bool
 }
 
-func NewCertificateUtility(certificateValidityTime time.Duration) CertificateUtility {
+// NewCertificateUtility creates the utility which signs client certificates valid for the certificateValidityTime.
+// The CRL distribution point and OCSP server URLs are embedded in the signed certificates if they are not empty.
+// CSRs are accepted only with public keys of the allowed key algorithms, as named by KeyAlgorithm, or with any key if none are allowed explicitly.
+func NewCertificateUtility(certificateValidityTime time.Duration, crlDistributionPointURL, ocspServerURL string, allowedKeyAlgorithms []string) CertificateUtility {
+	allowed := make(map[string]bool, len(allowedKeyAlgorithms))
+	for _, keyAlgorithm := range allowedKeyAlgorithms {
+		allowed[keyAlgorithm] = true
+	}
+
 	return &certificateUtility{
 		certificateValidityTime: certificateValidityTime,
+		crlDistributionPointURL: crlDistributionPointURL,
+		ocspServerURL:           ocspServerURL,
+		allowedKeyAlgorithms:    allowed,
+	}
+}
+
+// KeyAlgorithm returns the name of the type and size of the public key, eg.: rsa2048, ecdsa-p256 or ed25519
+func KeyAlgorithm(publicKey interface{}) (string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return fmt.Sprintf("rsa%d", key.N.BitLen()), nil
+	case *ecdsa.PublicKey:
+		return "ecdsa-" + strings.ToLower(strings.ReplaceAll(key.Curve.Params().Name, "-", "")), nil
+	case ed25519.PublicKey:
+		return "ed25519", nil
+	default:
+		return "", fmt.Errorf("unsupported public key type %T", publicKey)
 	}
 }
 
@@ -45,7 +82,7 @@ func (cu *certificateUtility) LoadCert(encodedData []byte) (*x509.Certificate, a
 	return caCRT, nil
 }
 
-func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, apperrors.AppError) {
+func (cu *certificateUtility) LoadKey(encodedData []byte) (crypto.Signer, apperrors.AppError) {
 	pemBlock, _ := pem.Decode(encodedData)
 	if pemBlock == nil {
 		return nil, apperrors.Internal("Error while decoding pem block.")
@@ -55,12 +92,21 @@ func (cu *certificateUtility) LoadKey(encodedData []byte) (*rsa.PrivateKey, appe
 		return caPrivateKey, nil
 	}
 
+	if caPrivateKey, err := x509.ParseECPrivateKey(pemBlock.Bytes); err == nil {
+		return caPrivateKey, nil
+	}
+
 	caPrivateKey, err := x509.ParsePKCS8PrivateKey(pemBlock.Bytes)
 	if err != nil {
 		return nil, apperrors.Internal("Error while parsing private key: %s", err)
 	}
 
-	return caPrivateKey.(*rsa.PrivateKey), nil
+	switch key := caPrivateKey.(type) {
+	case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
+		return key.(crypto.Signer), nil
+	default:
+		return nil, apperrors.Internal("Unsupported private key type %T", caPrivateKey)
+	}
 }
 
 func (cu *certificateUtility) LoadCSR(encodedData []byte) (*x509.CertificateRequest, apperrors.AppError) {
@@ -116,11 +162,26 @@ func (cu *certificateUtility) CheckCSRValues(csr *x509.CertificateRequest, subje
 	} else if csr.Subject.Province[0] != subject.Province {
 		return apperrors.WrongInput("CSR: Invalid province provided.")
 	}
+
+	if len(cu.allowedKeyAlgorithms) > 0 {
+		keyAlgorithm, err := KeyAlgorithm(csr.PublicKey)
+		if err != nil {
+			return apperrors.WrongInput("CSR: Invalid public key provided: %s.", err)
+		}
+		if !cu.allowedKeyAlgorithms[keyAlgorithm] {
+			return apperrors.WrongInput("CSR: Key algorithm %s is not allowed.", keyAlgorithm)
+		}
+	}
 	return nil
 }
 
-func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey *rsa.PrivateKey) ([]byte, apperrors.AppError) {
-	clientCRTTemplate := cu.prepareCRTTemplate(csr)
+func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.CertificateRequest, caKey crypto.Signer) ([]byte, apperrors.AppError) {
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, apperrors.Internal("Error while generating serial number: %s", err)
+	}
+
+	clientCRTTemplate := cu.prepareCRTTemplate(csr, serialNumber, caCrt)
 
 	clientCrtRaw, err := x509.CreateCertificate(rand.Reader, &clientCRTTemplate, caCrt, csr.PublicKey, caKey)
 	if err != nil {
@@ -130,17 +191,26 @@ func (cu *certificateUtility) SignCSR(caCrt *x509.Certificate, csr *x509.Certifi
 	return clientCrtRaw, nil
 }
 
-func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest) x509.Certificate {
-	return x509.Certificate{
-		SignatureAlgorithm: csr.SignatureAlgorithm,
-
-		SerialNumber: big.NewInt(2),
+func (cu *certificateUtility) prepareCRTTemplate(csr *x509.CertificateRequest, serialNumber *big.Int, caCrt *x509.Certificate) x509.Certificate {
+	// The signature algorithm is left empty to be derived from the CA key, which may be of a different type than the client key
+	template := x509.Certificate{
+		SerialNumber: serialNumber,
 		Subject:      csr.Subject,
 		NotBefore:    time.Now(),
 		NotAfter:     time.Now().Add(cu.certificateValidityTime),
 		KeyUsage:     x509.KeyUsageDigitalSignature,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 	}
+
+	// Every CA generation publishes its own CRL, so the distribution point points to the CRL of the signing CA
+	if cu.crlDistributionPointURL != "" {
+		template.CRLDistributionPoints = []string{fmt.Sprintf("%s/%s", strings.TrimSuffix(cu.crlDistributionPointURL, "/"), caCrt.SerialNumber.Text(16))}
+	}
+	if cu.ocspServerURL != "" {
+		template.OCSPServer = []string{cu.ocspServerURL}
+	}
+
+	return template
 }
 
 func (cu *certificateUtility) AddCertificateHeaderAndFooter(crtRaw []byte) []byte {
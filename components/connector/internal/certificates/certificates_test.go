@@ -1,10 +1,16 @@
 package certificates
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
+	"math/big"
 	"testing"
 	"time"
 
@@ -229,7 +235,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should load cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedCert)
@@ -248,7 +254,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail decoding cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert([]byte("invalid data"))
@@ -261,7 +267,7 @@ func TestCertificateUtility_LoadCert(t *testing.T) {
 
 	t.Run("should fail parsing cert", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadCert(encodedInvalidCert)
@@ -277,7 +283,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load RSA key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedRSAKey)
@@ -289,7 +295,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should load key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		key, err := certificateUtility.LoadKey(encodedKey)
@@ -299,9 +305,43 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 		assert.NotNil(t, key)
 	})
 
+	t.Run("should load ECDSA key", func(t *testing.T) {
+		// given
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		rawKey, err := x509.MarshalECPrivateKey(ecdsaKey)
+		require.NoError(t, err)
+
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
+
+		// when
+		key, appErr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: rawKey}))
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, ecdsaKey, key)
+	})
+
+	t.Run("should load Ed25519 key", func(t *testing.T) {
+		// given
+		_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		rawKey, err := x509.MarshalPKCS8PrivateKey(ed25519Key)
+		require.NoError(t, err)
+
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
+
+		// when
+		key, appErr := certificateUtility.LoadKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: rawKey}))
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, ed25519Key, key)
+	})
+
 	t.Run("should fail decoding key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadKey([]byte("invalid data"))
@@ -314,7 +354,7 @@ func TestCertificateUtility_LoadKey(t *testing.T) {
 
 	t.Run("should fail parsing key", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadKey(encodedInvalidKey)
@@ -330,7 +370,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should load CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		key, err := certificateUtility.LoadCSR([]byte(CSR))
@@ -342,7 +382,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail decoding CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte("aW52YWxpZCBkYXRh"))
@@ -355,7 +395,7 @@ func TestCertificateUtility_LoadCSR(t *testing.T) {
 
 	t.Run("should fail parsing CSR", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		crt, err := certificateUtility.LoadCSR([]byte(invalidCSR))
@@ -393,7 +433,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -414,7 +454,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -438,7 +478,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -462,7 +502,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -487,7 +527,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -511,7 +551,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -535,7 +575,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -559,7 +599,7 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 			},
 		}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		err := certificateUtility.CheckCSRValues(csr, csrSubject)
@@ -569,13 +609,69 @@ func TestCertificateUtility_CheckCSRValues(t *testing.T) {
 		assert.Equal(t, apperrors.CodeWrongInput, err.Code())
 		assert.Contains(t, err.Error(), "CSR: Invalid province provided.")
 	})
+
+	t.Run("should accept CSR with allowed key algorithm", func(t *testing.T) {
+		// given
+		csrSubject := CSRSubject{
+			CommonName: "cname",
+			CSRSubjectConsts: CSRSubjectConsts{
+				Country:            "country",
+				Organization:       "organization",
+				OrganizationalUnit: "organizationalUnit",
+				Locality:           "locality",
+				Province:           "province",
+			},
+		}
+
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		ecdsaCSR := *csr
+		ecdsaCSR.PublicKey = &ecdsaKey.PublicKey
+
+		certificateUtility := NewCertificateUtility(validityTime, "", "", []string{"rsa4096", "ecdsa-p256"})
+
+		// when
+		appErr := certificateUtility.CheckCSRValues(&ecdsaCSR, csrSubject)
+
+		// then
+		require.NoError(t, appErr)
+	})
+
+	t.Run("should fail when key algorithm is not allowed", func(t *testing.T) {
+		// given
+		csrSubject := CSRSubject{
+			CommonName: "cname",
+			CSRSubjectConsts: CSRSubjectConsts{
+				Country:            "country",
+				Organization:       "organization",
+				OrganizationalUnit: "organizationalUnit",
+				Locality:           "locality",
+				Province:           "province",
+			},
+		}
+
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		rsaCSR := *csr
+		rsaCSR.PublicKey = &rsaKey.PublicKey
+
+		certificateUtility := NewCertificateUtility(validityTime, "", "", []string{"rsa4096", "ecdsa-p256"})
+
+		// when
+		appErr := certificateUtility.CheckCSRValues(&rsaCSR, csrSubject)
+
+		// then
+		require.Error(t, appErr)
+		assert.Equal(t, apperrors.CodeWrongInput, appErr.Code())
+		assert.Contains(t, appErr.Error(), "CSR: Key algorithm rsa2048 is not allowed.")
+	})
 }
 
 func TestCertificateUtility_SignCSR(t *testing.T) {
 
 	t.Run("should sign client certificate", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
 
 		// when
@@ -590,6 +686,60 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 
 		certificateValidityTime := calculateValidityTime(decodedCrt)
 		assert.Equal(t, validityTime, certificateValidityTime)
+		assert.Empty(t, decodedCrt.CRLDistributionPoints)
+		assert.Empty(t, decodedCrt.OCSPServer)
+	})
+
+	t.Run("should sign client certificate with revocation endpoints", func(t *testing.T) {
+		// given
+		crlURL := "https://compass-gateway.kyma.local/connector/v1/crl"
+		ocspURL := "https://compass-gateway.kyma.local/connector/v1/ocsp"
+
+		certificateUtility := NewCertificateUtility(validityTime, crlURL, ocspURL, nil)
+		caCrt, csr, key := prepareCrtAndKey(certificateUtility)
+
+		// when
+		rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, key)
+
+		//then
+		require.NoError(t, apperr)
+
+		decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+		require.NoError(t, err)
+		assert.Equal(t, []string{crlURL + "/" + caCrt.SerialNumber.Text(16)}, decodedCrt.CRLDistributionPoints)
+		assert.Equal(t, []string{ocspURL}, decodedCrt.OCSPServer)
+	})
+
+	t.Run("should sign client certificate with CA and client keys of different types", func(t *testing.T) {
+		keys := map[string]crypto.Signer{
+			"rsa2048":    fixRSAKey(t),
+			"ecdsa-p256": fixECDSAKey(t, elliptic.P256()),
+			"ecdsa-p384": fixECDSAKey(t, elliptic.P384()),
+			"ed25519":    fixEd25519Key(t),
+		}
+
+		for caKeyAlgorithm, caKey := range keys {
+			for clientKeyAlgorithm, clientKey := range keys {
+				t.Run(caKeyAlgorithm+" CA and "+clientKeyAlgorithm+" client", func(t *testing.T) {
+					// given
+					caCrt := fixCACertificate(t, caKey)
+					csr := fixCSR(t, clientKey)
+
+					certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
+
+					// when
+					rawClientCRT, apperr := certificateUtility.SignCSR(caCrt, csr, caKey)
+
+					// then
+					require.NoError(t, apperr)
+
+					decodedCrt, err := x509.ParseCertificate(rawClientCRT)
+					require.NoError(t, err)
+					require.NoError(t, decodedCrt.CheckSignatureFrom(caCrt))
+					assert.Equal(t, clientKey.Public(), decodedCrt.PublicKey)
+				})
+			}
+		}
 	})
 
 	t.Run("should return when failed to create certificate", func(t *testing.T) {
@@ -598,7 +748,7 @@ func TestCertificateUtility_SignCSR(t *testing.T) {
 		csr := &x509.CertificateRequest{}
 		key := &rsa.PrivateKey{}
 
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 
 		// when
 		rawClientCRT, err := certificateUtility.SignCSR(caCrt, csr, key)
@@ -615,7 +765,7 @@ func TestCertificateUtility_AddCertificateHeaderAndFooter(t *testing.T) {
 
 	t.Run("should add certificate header and footer", func(t *testing.T) {
 		// given
-		certificateUtility := NewCertificateUtility(validityTime)
+		certificateUtility := NewCertificateUtility(validityTime, "", "", nil)
 		certificate, apperr := certificateUtility.LoadCert([]byte(cert))
 		require.NoError(t, apperr)
 
@@ -645,7 +795,7 @@ func calculateValidityTime(certificate *x509.Certificate) time.Duration {
 	return difference
 }
 
-func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, *rsa.PrivateKey) {
+func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate, *x509.CertificateRequest, crypto.Signer) {
 	caCrt, err := certificateUtility.LoadCert(encodedCert)
 	if err != nil {
 	}
@@ -657,3 +807,81 @@ func prepareCrtAndKey(certificateUtility CertificateUtility) (*x509.Certificate,
 	}
 	return caCrt, csr, key
 }
+
+func TestKeyAlgorithm(t *testing.T) {
+	testCases := []struct {
+		Name                 string
+		PublicKey            interface{}
+		ExpectedKeyAlgorithm string
+	}{
+		{Name: "RSA 2048", PublicKey: fixRSAKey(t).Public(), ExpectedKeyAlgorithm: "rsa2048"},
+		{Name: "ECDSA P-256", PublicKey: fixECDSAKey(t, elliptic.P256()).Public(), ExpectedKeyAlgorithm: "ecdsa-p256"},
+		{Name: "ECDSA P-384", PublicKey: fixECDSAKey(t, elliptic.P384()).Public(), ExpectedKeyAlgorithm: "ecdsa-p384"},
+		{Name: "Ed25519", PublicKey: fixEd25519Key(t).Public(), ExpectedKeyAlgorithm: "ed25519"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// when
+			keyAlgorithm, err := KeyAlgorithm(testCase.PublicKey)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedKeyAlgorithm, keyAlgorithm)
+		})
+	}
+
+	t.Run("should fail for unsupported key", func(t *testing.T) {
+		// when
+		_, err := KeyAlgorithm("not a key")
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func fixRSAKey(t *testing.T) crypto.Signer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func fixECDSAKey(t *testing.T, curve elliptic.Curve) crypto.Signer {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func fixEd25519Key(t *testing.T) crypto.Signer {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func fixCACertificate(t *testing.T, key crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Connector CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return crt
+}
+
+func fixCSR(t *testing.T, key crypto.Signer) *x509.CertificateRequest {
+	raw, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: pkix.Name{CommonName: "cname"}}, key)
+	require.NoError(t, err)
+
+	csr, err := x509.ParseCertificateRequest(raw)
+	require.NoError(t, err)
+	return csr
+}
@@ -18,21 +18,24 @@ type Loader interface {
 }
 
 type certLoader struct {
-	certsCache        Cache
-	secretsRepository secrets.Repository
-	caCertSecret      types.NamespacedName
-	rootCACertSecret  types.NamespacedName
+	certsCache            Cache
+	secretsRepository     secrets.Repository
+	caCertSecret          types.NamespacedName
+	previousCACertSecrets []types.NamespacedName
+	rootCACertSecret      types.NamespacedName
 }
 
 func NewCertificateLoader(certsCache Cache,
 	secretsRepository secrets.Repository,
 	caCertSecret types.NamespacedName,
+	previousCACertSecrets []types.NamespacedName,
 	rootCACertSecretName types.NamespacedName) Loader {
 	return &certLoader{
-		certsCache:        certsCache,
-		secretsRepository: secretsRepository,
-		caCertSecret:      caCertSecret,
-		rootCACertSecret:  rootCACertSecretName,
+		certsCache:            certsCache,
+		secretsRepository:     secretsRepository,
+		caCertSecret:          caCertSecret,
+		previousCACertSecrets: previousCACertSecrets,
+		rootCACertSecret:      rootCACertSecretName,
 	}
 }
 
@@ -48,6 +51,9 @@ func (cl *certLoader) Run(ctx context.Context) {
 		if cl.caCertSecret.Name != "" {
 			cl.loadSecretToCache(ctx, cl.caCertSecret)
 		}
+		for _, secret := range cl.previousCACertSecrets {
+			cl.loadSecretToCache(ctx, secret)
+		}
 		if cl.rootCACertSecret.Name != "" {
 			cl.loadSecretToCache(ctx, cl.rootCACertSecret)
 		}
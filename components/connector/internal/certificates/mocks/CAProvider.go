@@ -0,0 +1,78 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	mock "github.com/stretchr/testify/mock"
+
+	crypto "crypto"
+
+	x509 "crypto/x509"
+)
+
+// CAProvider is an autogenerated mock type for the CAProvider type
+type CAProvider struct {
+	mock.Mock
+}
+
+// CA provides a mock function with given fields:
+func (_m *CAProvider) CA() (*x509.Certificate, crypto.Signer, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 *x509.Certificate
+	if rf, ok := ret.Get(0).(func() *x509.Certificate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*x509.Certificate)
+		}
+	}
+
+	var r1 crypto.Signer
+	if rf, ok := ret.Get(1).(func() crypto.Signer); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(crypto.Signer)
+		}
+	}
+
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(2).(func() apperrors.AppError); ok {
+		r2 = rf()
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// CAs provides a mock function with given fields:
+func (_m *CAProvider) CAs() ([]certificates.CA, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []certificates.CA
+	if rf, ok := ret.Get(0).(func() []certificates.CA); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]certificates.CA)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
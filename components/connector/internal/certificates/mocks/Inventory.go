@@ -0,0 +1,35 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	x509 "crypto/x509"
+)
+
+// Inventory is an autogenerated mock type for the Inventory type
+type Inventory struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, rawCertificate, caCertificate, consumer
+func (_m *Inventory) Record(ctx context.Context, rawCertificate []byte, caCertificate *x509.Certificate, consumer certificates.Consumer) apperrors.AppError {
+	ret := _m.Called(ctx, rawCertificate, caCertificate, consumer)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, *x509.Certificate, certificates.Consumer) apperrors.AppError); ok {
+		r0 = rf(ctx, rawCertificate, caCertificate, consumer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
@@ -16,20 +16,20 @@ type Service struct {
 	mock.Mock
 }
 
-// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject
-func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject) (certificates.EncodedCertificateChain, apperrors.AppError) {
-	ret := _m.Called(ctx, encodedCSR, subject)
+// SignCSR provides a mock function with given fields: ctx, encodedCSR, subject, consumer
+func (_m *Service) SignCSR(ctx context.Context, encodedCSR []byte, subject certificates.CSRSubject, consumer certificates.Consumer) (certificates.EncodedCertificateChain, apperrors.AppError) {
+	ret := _m.Called(ctx, encodedCSR, subject, consumer)
 
 	var r0 certificates.EncodedCertificateChain
-	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject) certificates.EncodedCertificateChain); ok {
-		r0 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, certificates.CSRSubject, certificates.Consumer) certificates.EncodedCertificateChain); ok {
+		r0 = rf(ctx, encodedCSR, subject, consumer)
 	} else {
 		r0 = ret.Get(0).(certificates.EncodedCertificateChain)
 	}
 
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject) apperrors.AppError); ok {
-		r1 = rf(ctx, encodedCSR, subject)
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, certificates.CSRSubject, certificates.Consumer) apperrors.AppError); ok {
+		r1 = rf(ctx, encodedCSR, subject, consumer)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
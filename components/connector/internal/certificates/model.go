@@ -2,6 +2,7 @@ package certificates
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
 )
@@ -23,6 +24,13 @@ func (s CSRSubjectConsts) ToString(commonName string) string {
 	return fmt.Sprintf("O=%s,OU=%s,L=%s,ST=%s,C=%s,CN=%s", s.Organization, s.OrganizationalUnit, s.Locality, s.Province, s.Country, commonName)
 }
 
+// Consumer identifies the client for which a certificate is issued.
+type Consumer struct {
+	ID     string
+	Type   string
+	Tenant string
+}
+
 type EncodedCertificateChain struct {
 	CertificateChain  string
 	ClientCertificate string
@@ -37,6 +45,16 @@ func ToCertificationResult(encodedChain EncodedCertificateChain) externalschema.
 	}
 }
 
+func ToCertificateAuthority(ca CA) externalschema.CertificateAuthority {
+	return externalschema.CertificateAuthority{
+		SerialNumber: ca.Certificate.SerialNumber.Text(16),
+		Subject:      ca.Certificate.Subject.String(),
+		NotBefore:    ca.Certificate.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:     ca.Certificate.NotAfter.UTC().Format(time.RFC3339),
+		Active:       ca.Active,
+	}
+}
+
 type ExternalIssuerSubjectConsts struct {
 	Country                   string
 	Organization              string
@@ -13,16 +13,21 @@ import (
 //go:generate mockery --name=Service
 type Service interface {
 	// SignCSR takes encoded CSR, validates subject and generates Certificate based on CA stored in secret
-	// returns base64 encoded certificate chain
-	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError)
+	// records the issued Certificate for the consumer and returns base64 encoded certificate chain
+	SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, consumer Consumer) (EncodedCertificateChain, apperrors.AppError)
+}
+
+//go:generate mockery --name=Inventory
+type Inventory interface {
+	// Record stores the metadata of the issued Certificate together with the CA which signed it
+	Record(ctx context.Context, rawCertificate []byte, caCertificate *x509.Certificate, consumer Consumer) apperrors.AppError
 }
 
 type certificateService struct {
 	certsCache           Cache
 	certUtil             CertificateUtility
-	caCertSecretName     string
-	caCertSecretKey      string
-	caKeySecretKey       string
+	caProvider           CAProvider
+	inventory            Inventory
 	rootCACertSecretName string
 	rootCACertSecretKey  string
 }
@@ -30,21 +35,21 @@ type certificateService struct {
 func NewCertificateService(
 	certsCache Cache,
 	certUtil CertificateUtility,
-	caCertSecretName, rootCACertSecretName string,
-	caCertSecretKey, caKeySecretKey, rootCACertSecretKey string) Service {
+	caProvider CAProvider,
+	inventory Inventory,
+	rootCACertSecretName, rootCACertSecretKey string) Service {
 
 	return &certificateService{
 		certsCache:           certsCache,
 		certUtil:             certUtil,
-		caCertSecretName:     caCertSecretName,
-		caCertSecretKey:      caCertSecretKey,
-		caKeySecretKey:       caKeySecretKey,
+		caProvider:           caProvider,
+		inventory:            inventory,
 		rootCACertSecretName: rootCACertSecretName,
 		rootCACertSecretKey:  rootCACertSecretKey,
 	}
 }
 
-func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject) (EncodedCertificateChain, apperrors.AppError) {
+func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, subject CSRSubject, consumer Consumer) (EncodedCertificateChain, apperrors.AppError) {
 	csr, err := svc.certUtil.LoadCSR(encodedCSR)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CSR with Common Name %s: %v", subject.CommonName, err)
@@ -59,43 +64,40 @@ func (svc *certificateService) SignCSR(ctx context.Context, encodedCSR []byte, s
 	}
 	log.C(ctx).Debugf("Successfully checked the values of the CSR with Common Name %s", subject.CommonName)
 
-	encodedCertChain, err := svc.signCSR(csr)
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
-	log.C(ctx).Debugf("Successfully signed CSR with Common Name %s", subject.CommonName)
-
-	return encodedCertChain, nil
-}
-
-func (svc *certificateService) signCSR(csr *x509.CertificateRequest) (EncodedCertificateChain, apperrors.AppError) {
-	secretData, err := svc.certsCache.Get(svc.caCertSecretName)
+	cas, err := svc.caProvider.CAs()
 	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while loading the CA: %v", err)
 		return EncodedCertificateChain{}, err
 	}
+	activeCA := cas[0]
 
-	caCrt, err := svc.certUtil.LoadCert(secretData[svc.caCertSecretKey])
+	signedCrt, err := svc.certUtil.SignCSR(activeCA.Certificate, csr, activeCA.Key)
 	if err != nil {
 		return EncodedCertificateChain{}, err
 	}
+	log.C(ctx).Debugf("Successfully signed CSR with Common Name %s by CA with serial number %s", subject.CommonName, activeCA.Certificate.SerialNumber.Text(16))
 
-	caKey, err := svc.certUtil.LoadKey(secretData[svc.caKeySecretKey])
-	if err != nil {
-		return EncodedCertificateChain{}, err
-	}
-
-	signedCrt, err := svc.certUtil.SignCSR(caCrt, csr, caKey)
-	if err != nil {
-		return EncodedCertificateChain{}, err
+	// the certificate is issued even if it cannot be recorded, as the inventory is not needed to use the certificate
+	if err := svc.inventory.Record(ctx, signedCrt, activeCA.Certificate, consumer); err != nil {
+		log.C(ctx).WithError(err).Errorf("Error occurred while recording the certificate with Common Name %s, it will not be listed in the inventory: %v", subject.CommonName, err)
+	} else {
+		log.C(ctx).Debugf("Successfully recorded certificate with Common Name %s", subject.CommonName)
 	}
 
-	return svc.encodeCertificates(caCrt.Raw, signedCrt)
+	return svc.encodeCertificates(cas, signedCrt)
 }
 
-func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
-	caCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawCaCertificate)
+// encodeCertificates returns the chain of the client certificate and the active CA, and the trust bundle with all CA
+// generations, so that clients keep trusting the certificates signed by the previous CA while it is being rotated
+func (svc *certificateService) encodeCertificates(cas []CA, rawClientCertificate []byte) (EncodedCertificateChain, apperrors.AppError) {
+	caCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(cas[0].Certificate.Raw)
 	signedCrtBytes := svc.certUtil.AddCertificateHeaderAndFooter(rawClientCertificate)
 
+	trustedCACrtBytes := append([]byte{}, caCrtBytes...)
+	for _, ca := range cas[1:] {
+		trustedCACrtBytes = append(trustedCACrtBytes, svc.certUtil.AddCertificateHeaderAndFooter(ca.Certificate.Raw)...)
+	}
+
 	if svc.rootCACertSecretName != "" && svc.rootCACertSecretKey != "" {
 		rootCABytes, err := svc.loadRootCACert()
 		if err != nil {
@@ -103,11 +105,12 @@ func (svc *certificateService) encodeCertificates(rawCaCertificate, rawClientCer
 		}
 
 		caCrtBytes = append(caCrtBytes, rootCABytes...)
+		trustedCACrtBytes = append(trustedCACrtBytes, rootCABytes...)
 	}
 
 	certChain := append(signedCrtBytes, caCrtBytes...)
 
-	return encodeCertificateBase64(certChain, signedCrtBytes, caCrtBytes), nil
+	return encodeCertificateBase64(certChain, signedCrtBytes, trustedCACrtBytes), nil
 }
 
 func (svc *certificateService) loadRootCACert() ([]byte, apperrors.AppError) {
@@ -6,12 +6,14 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
 	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
 
 	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -59,6 +61,12 @@ var (
 	caCRTBytes     = []byte("caCRTBytes")
 	certChain      = append(clientCRTBytes, caCRTBytes...)
 
+	consumer = certificates.Consumer{
+		ID:     appName,
+		Type:   "Application",
+		Tenant: "tenant",
+	}
+
 	subjectValues = certificates.CSRSubject{
 		CommonName: appName,
 		CSRSubjectConsts: certificates.CSRSubjectConsts{
@@ -87,17 +95,19 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
+		inventory := &certificatesMocks.Inventory{}
+		inventory.On("Record", context.TODO(), clientCRT, caCrt, consumer).Return(nil)
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.NoError(t, apperr)
@@ -111,7 +121,7 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, certChain, decodedChain)
 
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should create certificate with additional root certificate", func(t *testing.T) {
@@ -133,17 +143,19 @@ func TestCertificateService_SignCSR(t *testing.T) {
 			On("AddCertificateHeaderAndFooter", rootCACrt.Raw).Return(rootCACrtBytes)
 		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
 
+		inventory := &certificatesMocks.Inventory{}
+		inventory.On("Record", context.TODO(), clientCRT, caCrt, consumer).Return(nil)
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			rootCASecretName,
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.NoError(t, apperr)
@@ -157,7 +169,102 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, certChain, decodedChain)
 
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
+	})
+
+	t.Run("should create certificate with trust bundle containing previous CA", func(t *testing.T) {
+		// given
+		previousCASecretName := "previous-ca"
+		expiredCASecretName := "expired-ca"
+
+		activeCACrt := &x509.Certificate{Raw: []byte("activeCA"), NotAfter: time.Now().Add(time.Hour)}
+		previousCACrt := &x509.Certificate{Raw: []byte("previousCA"), NotAfter: time.Now().Add(time.Hour)}
+		expiredCACrt := &x509.Certificate{Raw: []byte("expiredCA"), NotAfter: time.Now().Add(-time.Hour)}
+		previousCACrtBytes := []byte("previousCACRTBytes")
+
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+		cache.Put(previousCASecretName, map[string][]byte{caCertificateSecretKey: []byte("previousCA"), caKeySecretKey: []byte("previousCAKey")})
+		cache.Put(expiredCASecretName, map[string][]byte{caCertificateSecretKey: []byte("expiredCA"), caKeySecretKey: []byte("expiredCAKey")})
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(activeCACrt, nil)
+		certUtils.On("LoadCert", []byte("previousCA")).Return(previousCACrt, nil)
+		certUtils.On("LoadCert", []byte("expiredCA")).Return(expiredCACrt, nil)
+		certUtils.On("LoadKey", mock.Anything).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("SignCSR", activeCACrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", activeCACrt.Raw).Return(caCRTBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", previousCACrt.Raw).Return(previousCACrtBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		inventory := &certificatesMocks.Inventory{}
+		inventory.On("Record", context.TODO(), clientCRT, activeCACrt, consumer).Return(nil)
+
+		caProvider := certificates.NewCAProvider(cache, certUtils, authSecretName, []string{previousCASecretName, expiredCASecretName}, caCertificateSecretKey, caKeySecretKey)
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			caProvider,
+			inventory,
+			"",
+			rootCACertificateSecretKey)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedChain, err := decodeBase64(encodedCertChain.CertificateChain)
+		require.NoError(t, err)
+		assert.Equal(t, certChain, decodedChain)
+
+		decodedCACrt, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, append(append([]byte{}, caCRTBytes...), previousCACrtBytes...), decodedCACrt)
+
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
+		certUtils.AssertNotCalled(t, "AddCertificateHeaderAndFooter", expiredCACrt.Raw)
+	})
+
+	t.Run("should create certificate when failed to load previous CA", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		inventory := &certificatesMocks.Inventory{}
+		inventory.On("Record", context.TODO(), clientCRT, caCrt, consumer).Return(nil)
+
+		caProvider := certificates.NewCAProvider(cache, certUtils, authSecretName, []string{"previous-ca"}, caCertificateSecretKey, caKeySecretKey)
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			caProvider,
+			inventory,
+			"",
+			rootCACertificateSecretKey)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedCACrt, err := decodeBase64(encodedCertChain.CaCertificate)
+		require.NoError(t, err)
+		assert.Equal(t, caCRTBytes, decodedCACrt)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return Not Found error when secret not found", func(t *testing.T) {
@@ -167,23 +274,24 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Equal(t, apperrors.CodeNotFound, err.Code())
 		assert.Empty(t, encodedChain)
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return error when couldn't load csr", func(t *testing.T) {
@@ -193,23 +301,24 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils := &certificatesMocks.CertificateUtility{}
 		certUtils.On("LoadCSR", rawCSR).Return(nil, apperrors.Internal("error"))
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return error when subject check failed", func(t *testing.T) {
@@ -220,23 +329,24 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(apperrors.Forbidden("error"))
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeForbidden, err.Code())
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return error when couldn't load cert", func(t *testing.T) {
@@ -249,23 +359,24 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
 		certUtils.On("LoadCert", caCrtEncoded).Return(nil, apperrors.Internal("error"))
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return error when couldn't load key", func(t *testing.T) {
@@ -279,23 +390,24 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
 		certUtils.On("LoadKey", caKeyEncoded).Return(nil, apperrors.Internal("error"))
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 
 	t.Run("should return error when failed to sign CSR", func(t *testing.T) {
@@ -310,23 +422,61 @@ func TestCertificateService_SignCSR(t *testing.T) {
 		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
 		certUtils.On("SignCSR", caCrt, csr, caKey).Return(nil, apperrors.Internal("error"))
 
+		inventory := &certificatesMocks.Inventory{}
+
 		certificatesService := certificates.NewCertificateService(
 			cache,
 			certUtils,
-			authSecretName,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
 			"",
-			caCertificateSecretKey,
-			caKeySecretKey,
 			rootCACertificateSecretKey)
 
 		// when
-		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues)
+		encodedChain, err := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
 
 		// then
 		require.Error(t, err)
 		assert.Empty(t, encodedChain)
 		assert.Equal(t, apperrors.CodeInternal, err.Code())
-		certUtils.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
+	})
+
+	t.Run("should create certificate when failed to record issued certificate", func(t *testing.T) {
+		// given
+		cache := certificates.NewCertificateCache()
+		cache.Put(authSecretName, certsSecretData)
+
+		certUtils := &certificatesMocks.CertificateUtility{}
+		certUtils.On("LoadCert", caCrtEncoded).Return(caCrt, nil)
+		certUtils.On("LoadKey", caKeyEncoded).Return(caKey, nil)
+		certUtils.On("LoadCSR", rawCSR).Return(csr, nil)
+		certUtils.On("CheckCSRValues", csr, subjectValues).Return(nil)
+		certUtils.On("SignCSR", caCrt, csr, caKey).Return(clientCRT, nil)
+		certUtils.On("AddCertificateHeaderAndFooter", caCrt.Raw).Return(caCRTBytes)
+		certUtils.On("AddCertificateHeaderAndFooter", clientCRT).Return(clientCRTBytes)
+
+		inventory := &certificatesMocks.Inventory{}
+		inventory.On("Record", context.TODO(), clientCRT, caCrt, consumer).Return(apperrors.Internal("error"))
+
+		certificatesService := certificates.NewCertificateService(
+			cache,
+			certUtils,
+			certificates.NewCAProvider(cache, certUtils, authSecretName, nil, caCertificateSecretKey, caKeySecretKey),
+			inventory,
+			"",
+			rootCACertificateSecretKey)
+
+		// when
+		encodedCertChain, apperr := certificatesService.SignCSR(context.TODO(), rawCSR, subjectValues, consumer)
+
+		// then
+		require.NoError(t, apperr)
+
+		decodedChain, err := decodeBase64(encodedCertChain.CertificateChain)
+		require.NoError(t, err)
+		assert.Equal(t, certChain, decodedChain)
+		mock.AssertExpectationsForObjects(t, certUtils, inventory)
 	})
 }
 
@@ -0,0 +1,114 @@
+package certstatus
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	crlContentType          = "application/pkix-crl"
+	ocspResponseContentType = "application/ocsp-response"
+
+	// ocspPathSegment precedes the base64 encoded OCSP request in the path of GET requests
+	ocspPathSegment = "/ocsp/"
+
+	// CASerialNumberVar is the path variable with the hex encoded serial number of the CA generation whose CRL is requested
+	CASerialNumberVar = "caSerialNumber"
+
+	// maxOCSPRequestSize limits the size of OCSP requests, which contain the status request of a single certificate
+	maxOCSPRequestSize = 10 * 1024
+)
+
+type Handler struct {
+	service Service
+}
+
+func NewHandler(service Service) *Handler {
+	return &Handler{
+		service: service,
+	}
+}
+
+// CRL responds with the DER encoded certificate revocation list of the CA generation with the serial number from the
+// CASerialNumberVar path variable, or of the active CA if the variable is not set.
+func (h *Handler) CRL(writer http.ResponseWriter, req *http.Request) {
+	crl, err := h.service.CRL(req.Context(), mux.Vars(req)[CASerialNumberVar])
+	if err != nil {
+		if err.Code() == apperrors.CodeNotFound {
+			log.C(req.Context()).WithError(err).Infof("Failed to find CA of requested CRL: %v", err)
+			http.Error(writer, "CA not found", http.StatusNotFound)
+			return
+		}
+		log.C(req.Context()).WithError(err).Errorf("Failed to create CRL: %v", err)
+		http.Error(writer, "failed to create CRL", http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", crlContentType)
+	h.write(writer, req, crl)
+}
+
+// OCSP responds to OCSP requests sent either in the body of POST requests, or base64 encoded in the path of GET requests, as defined by RFC 6960.
+// Errors are reported with OCSP error responses, which are sent with status 200.
+func (h *Handler) OCSP(writer http.ResponseWriter, req *http.Request) {
+	rawRequest, err := h.readOCSPRequest(req)
+	if err != nil {
+		log.C(req.Context()).WithError(err).Infof("Failed to read OCSP request: %v", err)
+		h.writeOCSPResponse(writer, req, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	resp, appErr := h.service.OCSP(req.Context(), rawRequest)
+	if appErr != nil {
+		if appErr.Code() == apperrors.CodeBadRequest {
+			log.C(req.Context()).WithError(appErr).Infof("Received malformed OCSP request: %v", appErr)
+			h.writeOCSPResponse(writer, req, ocsp.MalformedRequestErrorResponse)
+			return
+		}
+		log.C(req.Context()).WithError(appErr).Errorf("Failed to create OCSP response: %v", appErr)
+		h.writeOCSPResponse(writer, req, ocsp.InternalErrorErrorResponse)
+		return
+	}
+
+	h.writeOCSPResponse(writer, req, resp)
+}
+
+func (h *Handler) readOCSPRequest(req *http.Request) ([]byte, error) {
+	if req.Method == http.MethodPost {
+		return ioutil.ReadAll(http.MaxBytesReader(nil, req.Body, maxOCSPRequestSize))
+	}
+
+	path := req.URL.EscapedPath()
+	index := strings.Index(path, ocspPathSegment)
+	if index < 0 {
+		return nil, errors.New("OCSP request not found in path")
+	}
+
+	encoded, err := url.PathUnescape(path[index+len(ocspPathSegment):])
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+func (h *Handler) writeOCSPResponse(writer http.ResponseWriter, req *http.Request, resp []byte) {
+	writer.Header().Set("Content-Type", ocspResponseContentType)
+	h.write(writer, req, resp)
+}
+
+func (h *Handler) write(writer http.ResponseWriter, req *http.Request, data []byte) {
+	writer.WriteHeader(http.StatusOK)
+	if _, err := writer.Write(data); err != nil {
+		log.C(req.Context()).WithError(err).Error("An error has occurred while writing to response body")
+	}
+}
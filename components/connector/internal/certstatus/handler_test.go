@@ -0,0 +1,179 @@
+package certstatus_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/ocsp"
+)
+
+func TestHandler_CRL(t *testing.T) {
+	t.Run("should respond with CRL", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("CRL", mock.Anything, "").Return([]byte("crl"), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/crl", nil)
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).CRL(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/pkix-crl", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "crl", recorder.Body.String())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with CRL of CA with serial number from path", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("CRL", mock.Anything, "1f").Return([]byte("crl"), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/crl/1f", nil)
+		req = mux.SetURLVars(req, map[string]string{certstatus.CASerialNumberVar: "1f"})
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).CRL(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "crl", recorder.Body.String())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with Not Found when CA is not trusted", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("CRL", mock.Anything, "ff").Return(nil, apperrors.NotFound("error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/crl/ff", nil)
+		req = mux.SetURLVars(req, map[string]string{certstatus.CASerialNumberVar: "ff"})
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).CRL(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with Internal Server Error when failed to create CRL", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("CRL", mock.Anything, "").Return(nil, apperrors.Internal("error"))
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/crl", nil)
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).CRL(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		service.AssertExpectations(t)
+	})
+}
+
+func TestHandler_OCSP(t *testing.T) {
+	rawRequest := []byte{0x30, 0xff, 0xfb}
+	encodedRequest := url.PathEscape(base64.StdEncoding.EncodeToString(rawRequest))
+
+	t.Run("should respond to OCSP request sent with POST", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("OCSP", mock.Anything, rawRequest).Return([]byte("response"), nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/ocsp", bytes.NewReader(rawRequest))
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).OCSP(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/ocsp-response", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "response", recorder.Body.String())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond to OCSP request sent with GET", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("OCSP", mock.Anything, rawRequest).Return([]byte("response"), nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/ocsp/"+encodedRequest, nil)
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).OCSP(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "response", recorder.Body.String())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with malformed request error when request is not base64 encoded", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+
+		req := httptest.NewRequest(http.MethodGet, "/v1/ocsp/not-base64!", nil)
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).OCSP(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, ocsp.MalformedRequestErrorResponse, recorder.Body.Bytes())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with malformed request error when request is invalid", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("OCSP", mock.Anything, rawRequest).Return(nil, apperrors.BadRequest("error"))
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/ocsp", bytes.NewReader(rawRequest))
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).OCSP(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, ocsp.MalformedRequestErrorResponse, recorder.Body.Bytes())
+		service.AssertExpectations(t)
+	})
+
+	t.Run("should respond with internal error when failed to create response", func(t *testing.T) {
+		// given
+		service := &mocks.Service{}
+		service.On("OCSP", mock.Anything, rawRequest).Return(nil, apperrors.Internal("error"))
+
+		req := httptest.NewRequest(http.MethodPost, "/v1/ocsp", bytes.NewReader(rawRequest))
+		recorder := httptest.NewRecorder()
+
+		// when
+		certstatus.NewHandler(service).OCSP(recorder, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, ocsp.InternalErrorErrorResponse, recorder.Body.Bytes())
+		service.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,66 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// CRL provides a mock function with given fields: ctx, caSerialNumber
+func (_m *Service) CRL(ctx context.Context, caSerialNumber string) ([]byte, apperrors.AppError) {
+	ret := _m.Called(ctx, caSerialNumber)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, caSerialNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, caSerialNumber)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// OCSP provides a mock function with given fields: ctx, rawRequest
+func (_m *Service) OCSP(ctx context.Context, rawRequest []byte) ([]byte, apperrors.AppError) {
+	ret := _m.Called(ctx, rawRequest)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) []byte); ok {
+		r0 = rf(ctx, rawRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) apperrors.AppError); ok {
+		r1 = rf(ctx, rawRequest)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
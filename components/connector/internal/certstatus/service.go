@@ -0,0 +1,218 @@
+package certstatus
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation"
+	"golang.org/x/crypto/ocsp"
+)
+
+//go:generate mockery --name=Service
+type Service interface {
+	// CRL returns the DER encoded certificate revocation list of the certificates issued by the Connector CA generation
+	// with the hex encoded serial number, or by the active CA if the serial number is empty. The CRL is signed by the same CA.
+	CRL(ctx context.Context, caSerialNumber string) ([]byte, apperrors.AppError)
+	// OCSP returns the DER encoded OCSP response for the DER encoded OCSP request, signed by the CA generation which issued the certificate
+	OCSP(ctx context.Context, rawRequest []byte) ([]byte, apperrors.AppError)
+}
+
+type service struct {
+	caProvider             certificates.CAProvider
+	repository             inventory.IssuedCertificatesRepository
+	revokedCertsRepository revocation.RevokedCertificatesRepository
+	validityPeriod         time.Duration
+}
+
+// NewService creates the service which publishes the revocation status of the certificates recorded in the inventory,
+// signed by the Connector CA. Certificates are revoked if they are marked as revoked in the inventory or if their hashes
+// are on the revocation list. The published status is valid for the validity period.
+func NewService(caProvider certificates.CAProvider, repository inventory.IssuedCertificatesRepository, revokedCertsRepository revocation.RevokedCertificatesRepository, validityPeriod time.Duration) Service {
+	return &service{
+		caProvider:             caProvider,
+		repository:             repository,
+		revokedCertsRepository: revokedCertsRepository,
+		validityPeriod:         validityPeriod,
+	}
+}
+
+func (s *service) CRL(ctx context.Context, caSerialNumber string) ([]byte, apperrors.AppError) {
+	cas, appErr := s.caProvider.CAs()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var issuer *certificates.CA
+	for i := range cas {
+		if caSerialNumber == "" && cas[i].Active || caSerialNumber != "" && cas[i].Certificate.SerialNumber.Text(16) == caSerialNumber {
+			issuer = &cas[i]
+			break
+		}
+	}
+	if issuer == nil {
+		return nil, apperrors.NotFound("CA with serial number %s is not trusted", caSerialNumber)
+	}
+
+	issuedCerts, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, apperrors.Internal("Error while listing issued certificates: %s", err)
+	}
+
+	now := time.Now().UTC()
+	caSerialNumber = issuer.Certificate.SerialNumber.Text(16)
+	revokedCerts := make([]pkix.RevokedCertificate, 0)
+	for _, crt := range issuedCerts {
+		// Expired certificates are rejected by their validity period, so they are not listed to keep the CRL small
+		if crt.NotAfter.Before(now) || !signedBy(crt, caSerialNumber) {
+			continue
+		}
+
+		revokedAt := s.revokedAt(crt, now)
+		if revokedAt == nil {
+			continue
+		}
+
+		serialNumber, ok := new(big.Int).SetString(crt.SerialNumber, 16)
+		if !ok {
+			return nil, apperrors.Internal("Invalid serial number %s of issued certificate", crt.SerialNumber)
+		}
+
+		revokedCerts = append(revokedCerts, pkix.RevokedCertificate{
+			SerialNumber:   serialNumber,
+			RevocationTime: *revokedAt,
+		})
+	}
+
+	template := &x509.RevocationList{
+		// The CRL is created on each request, so the creation time is used as the monotonically increasing CRL number
+		Number:              big.NewInt(now.UnixNano()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(s.validityPeriod),
+		RevokedCertificates: revokedCerts,
+	}
+
+	crl, err := x509.CreateRevocationList(rand.Reader, template, issuer.Certificate, issuer.Key)
+	if err != nil {
+		return nil, apperrors.Internal("Error while creating CRL: %s", err)
+	}
+
+	return crl, nil
+}
+
+func (s *service) OCSP(ctx context.Context, rawRequest []byte) ([]byte, apperrors.AppError) {
+	req, err := ocsp.ParseRequest(rawRequest)
+	if err != nil {
+		return nil, apperrors.BadRequest("Error while parsing OCSP request: %s", err)
+	}
+
+	cas, appErr := s.caProvider.CAs()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var issuer *certificates.CA
+	for i := range cas {
+		issued, err := isIssuedBy(req, cas[i].Certificate)
+		if err != nil {
+			return nil, apperrors.Internal("Error while checking issuer of OCSP request: %s", err)
+		}
+		if issued {
+			issuer = &cas[i]
+			break
+		}
+	}
+	if issuer == nil {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+
+	issuedCerts, err := s.repository.List(ctx)
+	if err != nil {
+		return nil, apperrors.Internal("Error while listing issued certificates: %s", err)
+	}
+
+	now := time.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(s.validityPeriod),
+		IssuerHash:   req.HashAlgorithm,
+	}
+
+	serialNumber := req.SerialNumber.Text(16)
+	caSerialNumber := issuer.Certificate.SerialNumber.Text(16)
+	for _, crt := range issuedCerts {
+		if crt.SerialNumber != serialNumber || !signedBy(crt, caSerialNumber) {
+			continue
+		}
+
+		template.Status = ocsp.Good
+		if revokedAt := s.revokedAt(crt, now); revokedAt != nil {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = *revokedAt
+			template.RevocationReason = ocsp.Unspecified
+		}
+		break
+	}
+
+	resp, err := ocsp.CreateResponse(issuer.Certificate, issuer.Certificate, template, issuer.Key)
+	if err != nil {
+		return nil, apperrors.Internal("Error while creating OCSP response: %s", err)
+	}
+
+	return resp, nil
+}
+
+// revokedAt returns the time when the issued certificate was revoked, or nil if it is not revoked. Certificates which are
+// only on the revocation list, e.g. because they were missing from the inventory when they were revoked, have no recorded
+// revocation time, so they are reported as revoked at the given time.
+func (s *service) revokedAt(crt inventory.IssuedCertificate, now time.Time) *time.Time {
+	if crt.RevokedAt != nil {
+		return crt.RevokedAt
+	}
+	if crt.Hash != "" && s.revokedCertsRepository.Contains(crt.Hash) {
+		return &now
+	}
+	return nil
+}
+
+// signedBy checks whether the issued certificate was signed by the CA with the serial number. Certificates recorded
+// without the CA serial number are attributed to every CA.
+func signedBy(crt inventory.IssuedCertificate, caSerialNumber string) bool {
+	return crt.CASerialNumber == "" || crt.CASerialNumber == caSerialNumber
+}
+
+// isIssuedBy checks whether the OCSP request asks for the status of a certificate issued by the CA,
+// by comparing the hashes of the issuer name and public key.
+func isIssuedBy(req *ocsp.Request, caCrt *x509.Certificate) (bool, error) {
+	if !req.HashAlgorithm.Available() {
+		return false, nil
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCrt.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(hash(req.HashAlgorithm, caCrt.RawSubject), req.IssuerNameHash) &&
+		bytes.Equal(hash(req.HashAlgorithm, publicKeyInfo.PublicKey.RightAlign()), req.IssuerKeyHash), nil
+}
+
+func hash(algorithm crypto.Hash, data []byte) []byte {
+	h := algorithm.New()
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,388 @@
+package certstatus_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	certificatesMocks "github.com/kyma-incubator/compass/components/connector/internal/certificates/mocks"
+	"github.com/kyma-incubator/compass/components/connector/internal/certstatus"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	inventoryMocks "github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	revocationMocks "github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+const validityPeriod = time.Hour
+
+func TestService_CRL(t *testing.T) {
+	ctx := context.Background()
+	caCrt, caKey := fixCA(t)
+	previousCACrt, previousCAKey := fixCA(t)
+	cas := []certificates.CA{
+		{Certificate: caCrt, Key: caKey, Active: true},
+		{Certificate: previousCACrt, Key: previousCAKey},
+	}
+
+	revokedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	issuedCerts := []inventory.IssuedCertificate{
+		{SerialNumber: "1f", NotAfter: time.Now().Add(time.Hour), RevokedAt: &revokedAt},
+		{SerialNumber: "2f", NotAfter: time.Now().Add(time.Hour)},
+		{SerialNumber: "3f", NotAfter: time.Now().Add(-time.Minute), RevokedAt: &revokedAt},
+		{SerialNumber: "4f", NotAfter: time.Now().Add(time.Hour), RevokedAt: &revokedAt, CASerialNumber: previousCACrt.SerialNumber.Text(16)},
+	}
+
+	t.Run("should return CRL of revoked certificates issued by active CA which are not expired", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(cas, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return(issuedCerts, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		rawCRL, err := service.CRL(ctx, "")
+
+		// then
+		require.NoError(t, err)
+
+		crl, parseErr := x509.ParseDERCRL(rawCRL)
+		require.NoError(t, parseErr)
+		require.NoError(t, caCrt.CheckCRLSignature(crl))
+		require.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+		assert.Equal(t, big.NewInt(0x1f), crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+		assert.True(t, revokedAt.Equal(crl.TBSCertList.RevokedCertificates[0].RevocationTime))
+		assert.WithinDuration(t, time.Now().Add(validityPeriod), crl.TBSCertList.NextUpdate, time.Minute)
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return CRL with certificates revoked only on the revocation list", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(cas, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{
+			{SerialNumber: "5f", NotAfter: time.Now().Add(time.Hour), Hash: "revoked-hash"},
+			{SerialNumber: "6f", NotAfter: time.Now().Add(time.Hour), Hash: "other-hash"},
+		}, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", "revoked-hash").Return(true).Once()
+		revokedCertsRepository.On("Contains", "other-hash").Return(false).Once()
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		rawCRL, err := service.CRL(ctx, "")
+
+		// then
+		require.NoError(t, err)
+
+		crl, parseErr := x509.ParseDERCRL(rawCRL)
+		require.NoError(t, parseErr)
+		require.Len(t, crl.TBSCertList.RevokedCertificates, 1)
+		assert.Equal(t, big.NewInt(0x5f), crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+		assert.WithinDuration(t, time.Now(), crl.TBSCertList.RevokedCertificates[0].RevocationTime, time.Minute)
+		mock.AssertExpectationsForObjects(t, caProvider, repository, revokedCertsRepository)
+	})
+
+	t.Run("should return CRL of previous CA signed with its own key", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(cas, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return(issuedCerts, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		rawCRL, err := service.CRL(ctx, previousCACrt.SerialNumber.Text(16))
+
+		// then
+		require.NoError(t, err)
+
+		crl, parseErr := x509.ParseDERCRL(rawCRL)
+		require.NoError(t, parseErr)
+		require.NoError(t, previousCACrt.CheckCRLSignature(crl))
+		require.Len(t, crl.TBSCertList.RevokedCertificates, 2)
+		assert.Equal(t, big.NewInt(0x1f), crl.TBSCertList.RevokedCertificates[0].SerialNumber)
+		assert.Equal(t, big.NewInt(0x4f), crl.TBSCertList.RevokedCertificates[1].SerialNumber)
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return Not Found error for CA which is not trusted", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(cas, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		_, err := service.CRL(ctx, "ff")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return error when CA is not available", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(nil, apperrors.NotFound("error"))
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		_, err := service.CRL(ctx, "")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return error when failed to list issued certificates", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return(cas, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return(nil, errors.New("error"))
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		_, err := service.CRL(ctx, "")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+}
+
+func TestService_OCSP(t *testing.T) {
+	ctx := context.Background()
+	caCrt, caKey := fixCA(t)
+	clientCrt := fixClientCertificate(t, caCrt, caKey, 0x1f)
+
+	revokedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	testCases := []struct {
+		Name              string
+		IssuedCerts       []inventory.IssuedCertificate
+		ExpectedStatus    int
+		ExpectedRevokedAt time.Time
+	}{
+		{
+			Name:           "Good status of issued certificate",
+			IssuedCerts:    []inventory.IssuedCertificate{{SerialNumber: "1f"}},
+			ExpectedStatus: ocsp.Good,
+		},
+		{
+			Name:              "Revoked status of revoked certificate",
+			IssuedCerts:       []inventory.IssuedCertificate{{SerialNumber: "1f", RevokedAt: &revokedAt}},
+			ExpectedStatus:    ocsp.Revoked,
+			ExpectedRevokedAt: revokedAt,
+		},
+		{
+			Name:           "Unknown status of certificate not recorded in inventory",
+			IssuedCerts:    []inventory.IssuedCertificate{{SerialNumber: "2f"}},
+			ExpectedStatus: ocsp.Unknown,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// given
+			caProvider := &certificatesMocks.CAProvider{}
+			caProvider.On("CAs").Return([]certificates.CA{{Certificate: caCrt, Key: caKey, Active: true}}, nil)
+			repository := &inventoryMocks.IssuedCertificatesRepository{}
+			repository.On("List", ctx).Return(testCase.IssuedCerts, nil)
+			revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+			service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+			rawRequest, err := ocsp.CreateRequest(clientCrt, caCrt, nil)
+			require.NoError(t, err)
+
+			// when
+			rawResponse, appErr := service.OCSP(ctx, rawRequest)
+
+			// then
+			require.NoError(t, appErr)
+
+			resp, err := ocsp.ParseResponseForCert(rawResponse, clientCrt, caCrt)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedStatus, resp.Status)
+			assert.True(t, testCase.ExpectedRevokedAt.Equal(resp.RevokedAt))
+			mock.AssertExpectationsForObjects(t, caProvider, repository)
+		})
+	}
+
+	t.Run("should respond with Revoked status for certificate revoked only on the revocation list", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return([]certificates.CA{{Certificate: caCrt, Key: caKey, Active: true}}, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{{SerialNumber: "1f", Hash: "revoked-hash"}}, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+		revokedCertsRepository.On("Contains", "revoked-hash").Return(true).Once()
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		rawRequest, err := ocsp.CreateRequest(clientCrt, caCrt, nil)
+		require.NoError(t, err)
+
+		// when
+		rawResponse, appErr := service.OCSP(ctx, rawRequest)
+
+		// then
+		require.NoError(t, appErr)
+
+		resp, err := ocsp.ParseResponseForCert(rawResponse, clientCrt, caCrt)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Revoked, resp.Status)
+		assert.WithinDuration(t, time.Now(), resp.RevokedAt, time.Minute)
+		mock.AssertExpectationsForObjects(t, caProvider, repository, revokedCertsRepository)
+	})
+
+	t.Run("should respond with status of certificate issued by previous CA", func(t *testing.T) {
+		// given
+		previousCACrt, previousCAKey := fixCA(t)
+		previousClientCrt := fixClientCertificate(t, previousCACrt, previousCAKey, 0x1f)
+
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return([]certificates.CA{
+			{Certificate: caCrt, Key: caKey, Active: true},
+			{Certificate: previousCACrt, Key: previousCAKey},
+		}, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{
+			{SerialNumber: "1f", CASerialNumber: caCrt.SerialNumber.Text(16)},
+			{SerialNumber: "1f", CASerialNumber: previousCACrt.SerialNumber.Text(16), RevokedAt: &revokedAt},
+		}, nil)
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		rawRequest, err := ocsp.CreateRequest(previousClientCrt, previousCACrt, nil)
+		require.NoError(t, err)
+
+		// when
+		rawResponse, appErr := service.OCSP(ctx, rawRequest)
+
+		// then
+		require.NoError(t, appErr)
+
+		resp, err := ocsp.ParseResponseForCert(rawResponse, previousClientCrt, previousCACrt)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Revoked, resp.Status)
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return unauthorized response for certificate issued by other CA", func(t *testing.T) {
+		// given
+		otherCACrt, otherCAKey := fixCA(t)
+		otherClientCrt := fixClientCertificate(t, otherCACrt, otherCAKey, 0x1f)
+
+		caProvider := &certificatesMocks.CAProvider{}
+		caProvider.On("CAs").Return([]certificates.CA{{Certificate: caCrt, Key: caKey, Active: true}}, nil)
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		rawRequest, err := ocsp.CreateRequest(otherClientCrt, otherCACrt, nil)
+		require.NoError(t, err)
+
+		// when
+		rawResponse, appErr := service.OCSP(ctx, rawRequest)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, ocsp.UnauthorizedErrorResponse, rawResponse)
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+
+	t.Run("should return Bad Request error for malformed request", func(t *testing.T) {
+		// given
+		caProvider := &certificatesMocks.CAProvider{}
+		repository := &inventoryMocks.IssuedCertificatesRepository{}
+		revokedCertsRepository := &revocationMocks.RevokedCertificatesRepository{}
+
+		service := certstatus.NewService(caProvider, repository, revokedCertsRepository, validityPeriod)
+
+		// when
+		_, err := service.OCSP(ctx, []byte("not a request"))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		mock.AssertExpectationsForObjects(t, caProvider, repository)
+	})
+}
+
+func fixCA(t *testing.T) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: "Connector CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return crt, key
+}
+
+func fixClientCertificate(t *testing.T, caCrt *x509.Certificate, caKey *rsa.PrivateKey, serialNumber int64) *x509.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serialNumber),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	raw, err := x509.CreateCertificate(rand.Reader, template, caCrt, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	crt, err := x509.ParseCertificate(raw)
+	require.NoError(t, err)
+	return crt
+}
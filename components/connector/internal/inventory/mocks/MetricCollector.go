@@ -0,0 +1,15 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// MetricCollector is an autogenerated mock type for the MetricCollector type
+type MetricCollector struct {
+	mock.Mock
+}
+
+// SetIssuedCertificates provides a mock function with given fields: valid, expiring, expired
+func (_m *MetricCollector) SetIssuedCertificates(valid int, expiring int, expired int) {
+	_m.Called(valid, expiring, expired)
+}
@@ -0,0 +1,108 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	certificates "github.com/kyma-incubator/compass/components/connector/internal/certificates"
+
+	context "context"
+
+	inventory "github.com/kyma-incubator/compass/components/connector/internal/inventory"
+
+	mock "github.com/stretchr/testify/mock"
+
+	x509 "crypto/x509"
+)
+
+// Service is an autogenerated mock type for the Service type
+type Service struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, consumerID, serialNumber
+func (_m *Service) Get(ctx context.Context, consumerID string, serialNumber string) (inventory.IssuedCertificate, apperrors.AppError) {
+	ret := _m.Called(ctx, consumerID, serialNumber)
+
+	var r0 inventory.IssuedCertificate
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) inventory.IssuedCertificate); ok {
+		r0 = rf(ctx, consumerID, serialNumber)
+	} else {
+		r0 = ret.Get(0).(inventory.IssuedCertificate)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, consumerID, serialNumber)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, consumerID
+func (_m *Service) List(ctx context.Context, consumerID string) ([]inventory.IssuedCertificate, apperrors.AppError) {
+	ret := _m.Called(ctx, consumerID)
+
+	var r0 []inventory.IssuedCertificate
+	if rf, ok := ret.Get(0).(func(context.Context, string) []inventory.IssuedCertificate); ok {
+		r0 = rf(ctx, consumerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]inventory.IssuedCertificate)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, consumerID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// Record provides a mock function with given fields: ctx, rawCertificate, caCertificate, consumer
+func (_m *Service) Record(ctx context.Context, rawCertificate []byte, caCertificate *x509.Certificate, consumer certificates.Consumer) apperrors.AppError {
+	ret := _m.Called(ctx, rawCertificate, caCertificate, consumer)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, *x509.Certificate, certificates.Consumer) apperrors.AppError); ok {
+		r0 = rf(ctx, rawCertificate, caCertificate, consumer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// Revoke provides a mock function with given fields: ctx, hash
+func (_m *Service) Revoke(ctx context.Context, hash string) (inventory.IssuedCertificate, apperrors.AppError) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 inventory.IssuedCertificate
+	if rf, ok := ret.Get(0).(func(context.Context, string) inventory.IssuedCertificate); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(inventory.IssuedCertificate)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,74 @@
+package inventory
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/pkg/graphql/externalschema"
+)
+
+type IssuedCertificate struct {
+	SerialNumber string    `json:"serialNumber"`
+	Subject      string    `json:"subject"`
+	ConsumerID   string    `json:"consumerID"`
+	ConsumerType string    `json:"consumerType"`
+	Tenant       string    `json:"tenant"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	Hash         string    `json:"hash"`
+	// CASerialNumber is the serial number of the CA which signed the certificate, empty for certificates recorded before CA rotation was supported
+	CASerialNumber string `json:"caSerialNumber,omitempty"`
+	// RevokedAt is the time when the certificate was revoked, or nil if it is not revoked
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// NewIssuedCertificate creates the metadata of the certificate issued for the consumer and signed by the CA. Hash is the hex
+// encoded SHA256 hash of the DER encoded certificate, the same as the one used to revoke certificates.
+func NewIssuedCertificate(crt, caCrt *x509.Certificate, consumer certificates.Consumer) IssuedCertificate {
+	hash := sha256.Sum256(crt.Raw)
+
+	return IssuedCertificate{
+		SerialNumber:   crt.SerialNumber.Text(16),
+		Subject:        crt.Subject.String(),
+		ConsumerID:     consumer.ID,
+		ConsumerType:   consumer.Type,
+		Tenant:         consumer.Tenant,
+		NotBefore:      crt.NotBefore.UTC(),
+		NotAfter:       crt.NotAfter.UTC(),
+		Hash:           hex.EncodeToString(hash[:]),
+		CASerialNumber: caCrt.SerialNumber.Text(16),
+	}
+}
+
+func ToGraphQL(crt IssuedCertificate) externalschema.IssuedCertificate {
+	return externalschema.IssuedCertificate{
+		SerialNumber:   crt.SerialNumber,
+		Subject:        crt.Subject,
+		ConsumerID:     crt.ConsumerID,
+		ConsumerType:   optionalString(crt.ConsumerType),
+		Tenant:         optionalString(crt.Tenant),
+		NotBefore:      crt.NotBefore.Format(time.RFC3339),
+		NotAfter:       crt.NotAfter.Format(time.RFC3339),
+		Sha256Hash:     crt.Hash,
+		RevokedAt:      optionalTime(crt.RevokedAt),
+		CaSerialNumber: optionalString(crt.CASerialNumber),
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func optionalTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	formatted := value.Format(time.RFC3339)
+	return &formatted
+}
@@ -0,0 +1,83 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+const expiryMonitorCorrelationID = "issued-certificates-expiry-monitor"
+
+//go:generate mockery --name=MetricCollector
+type MetricCollector interface {
+	SetIssuedCertificates(valid, expiring, expired int)
+}
+
+type Monitor interface {
+	Run(ctx context.Context)
+}
+
+type expiryMonitor struct {
+	repository    IssuedCertificatesRepository
+	collector     MetricCollector
+	warningWindow time.Duration
+	interval      time.Duration
+}
+
+// NewExpiryMonitor creates a monitor which periodically reports the number of issued certificates which are valid,
+// which expire within the warning window, and which are already expired.
+func NewExpiryMonitor(repository IssuedCertificatesRepository, collector MetricCollector, warningWindow, interval time.Duration) Monitor {
+	return &expiryMonitor{
+		repository:    repository,
+		collector:     collector,
+		warningWindow: warningWindow,
+		interval:      interval,
+	}
+}
+
+func (m *expiryMonitor) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, expiryMonitorCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.report(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping issued certificates expiry monitor...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *expiryMonitor) report(ctx context.Context) {
+	issuedCerts, err := m.repository.List(ctx)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Could not list issued certificates: %v", err)
+		return
+	}
+
+	now := time.Now()
+	valid, expiring, expired := 0, 0, 0
+	for _, crt := range issuedCerts {
+		switch {
+		case !crt.NotAfter.After(now):
+			expired++
+		case crt.NotAfter.Before(now.Add(m.warningWindow)):
+			expiring++
+		default:
+			valid++
+		}
+	}
+
+	if expiring > 0 {
+		log.C(ctx).Warnf("%d issued certificates expire within %s", expiring, m.warningWindow)
+	}
+	m.collector.SetIssuedCertificates(valid, expiring, expired)
+}
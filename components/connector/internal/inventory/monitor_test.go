@@ -0,0 +1,64 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiryMonitor_Run(t *testing.T) {
+	t.Run("should report issued certificates by expiry state", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		now := time.Now()
+		issuedCerts := []inventory.IssuedCertificate{
+			{SerialNumber: "1", NotAfter: now.Add(-time.Hour)},
+			{SerialNumber: "2", NotAfter: now.Add(24 * time.Hour)},
+			{SerialNumber: "3", NotAfter: now.Add(48 * time.Hour)},
+			{SerialNumber: "4", NotAfter: now.Add(90 * 24 * time.Hour)},
+		}
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", mock.Anything).Return(issuedCerts, nil).Once()
+		collector := &mocks.MetricCollector{}
+		collector.On("SetIssuedCertificates", 1, 2, 1).Run(func(args mock.Arguments) {
+			cancel()
+		}).Once()
+
+		monitor := inventory.NewExpiryMonitor(repository, collector, 30*24*time.Hour, time.Hour)
+
+		// when
+		monitor.Run(ctx)
+
+		// then
+		mock.AssertExpectationsForObjects(t, repository, collector)
+	})
+
+	t.Run("should not report when failed to list issued certificates", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", mock.Anything).Return(nil, errors.New("error")).Run(func(args mock.Arguments) {
+			cancel()
+		}).Once()
+		collector := &mocks.MetricCollector{}
+
+		monitor := inventory.NewExpiryMonitor(repository, collector, 30*24*time.Hour, time.Hour)
+
+		// when
+		monitor.Run(ctx)
+
+		// then
+		mock.AssertExpectationsForObjects(t, repository, collector)
+		collector.AssertNotCalled(t, "SetIssuedCertificates", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,59 @@
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+const issuedCertificatesPrunerCorrelationID = "issued-certificates-pruner"
+
+type Pruner interface {
+	Run(ctx context.Context)
+}
+
+type expiredCertificatesPruner struct {
+	repository IssuedCertificatesRepository
+	interval   time.Duration
+}
+
+// NewExpiredCertificatesPruner creates a pruner which periodically removes the entries of expired certificates from the inventory.
+func NewExpiredCertificatesPruner(repository IssuedCertificatesRepository, interval time.Duration) Pruner {
+	return &expiredCertificatesPruner{
+		repository: repository,
+		interval:   interval,
+	}
+}
+
+func (p *expiredCertificatesPruner) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, issuedCertificatesPrunerCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.prune(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping issued certificates pruner...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *expiredCertificatesPruner) prune(ctx context.Context) {
+	deleted, err := p.repository.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to remove expired certificates from inventory: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.C(ctx).Infof("Removed %d expired certificates from inventory", deleted)
+	}
+}
@@ -0,0 +1,53 @@
+package inventory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExpiredCertificatesPruner_Run(t *testing.T) {
+	t.Run("should delete expired certificates", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("time.Time")).Return(1, nil).Run(func(args mock.Arguments) {
+			cancel()
+		}).Once()
+
+		pruner := inventory.NewExpiredCertificatesPruner(repository, time.Hour)
+
+		// when
+		pruner.Run(ctx)
+
+		// then
+		mock.AssertExpectationsForObjects(t, repository)
+	})
+
+	t.Run("should retry after interval when failed to delete expired certificates", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("time.Time")).Return(0, errors.New("error")).Once()
+		repository.On("DeleteExpired", mock.Anything, mock.AnythingOfType("time.Time")).Return(0, nil).Run(func(args mock.Arguments) {
+			cancel()
+		}).Once()
+
+		pruner := inventory.NewExpiredCertificatesPruner(repository, time.Millisecond)
+
+		// when
+		pruner.Run(ctx)
+
+		// then
+		mock.AssertExpectationsForObjects(t, repository)
+	})
+}
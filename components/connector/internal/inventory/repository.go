@@ -0,0 +1,181 @@
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+//go:generate mockery --name=Manager
+type Manager interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions) (*v1.ConfigMap, error)
+	Update(ctx context.Context, configMap *v1.ConfigMap, opts metav1.UpdateOptions) (*v1.ConfigMap, error)
+}
+
+//go:generate mockery --name=IssuedCertificatesRepository
+type IssuedCertificatesRepository interface {
+	Insert(ctx context.Context, crt IssuedCertificate) error
+	// List returns the certificates issued for all consumers
+	List(ctx context.Context) ([]IssuedCertificate, error)
+	// ListByConsumer returns the certificates issued for the consumer
+	ListByConsumer(ctx context.Context, consumerID string) ([]IssuedCertificate, error)
+	// DeleteExpired removes the certificates which expired before the given time and returns their number
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// issuedCertificatesRepository stores the issued certificates in config maps, keyed by their serial numbers. The certificates
+// are sharded by their consumers across the given number of config maps named <configMapName>-<shard>, so that none of
+// the config maps exceeds the size limit and the certificates of a consumer are loaded from a single config map
+type issuedCertificatesRepository struct {
+	configMapManager Manager
+	configMapName    string
+	shards           int
+}
+
+func NewRepository(configMapManager Manager, configMapName string, shards int) IssuedCertificatesRepository {
+	return &issuedCertificatesRepository{
+		configMapManager: configMapManager,
+		configMapName:    configMapName,
+		shards:           shards,
+	}
+}
+
+func (r *issuedCertificatesRepository) Insert(ctx context.Context, crt IssuedCertificate) error {
+	data, err := json.Marshal(crt)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling issued certificate")
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(ctx, r.shardName(crt.ConsumerID), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[crt.SerialNumber] = string(data)
+
+		_, err = r.configMapManager.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *issuedCertificatesRepository) List(ctx context.Context) ([]IssuedCertificate, error) {
+	issuedCerts := make([]IssuedCertificate, 0)
+	for shard := 0; shard < r.shards; shard++ {
+		shardCerts, err := r.load(ctx, fmt.Sprintf("%s-%d", r.configMapName, shard))
+		if err != nil {
+			return nil, err
+		}
+		issuedCerts = append(issuedCerts, shardCerts...)
+	}
+
+	sortByIssueTime(issuedCerts)
+	return issuedCerts, nil
+}
+
+func (r *issuedCertificatesRepository) ListByConsumer(ctx context.Context, consumerID string) ([]IssuedCertificate, error) {
+	shardCerts, err := r.load(ctx, r.shardName(consumerID))
+	if err != nil {
+		return nil, err
+	}
+
+	issuedCerts := make([]IssuedCertificate, 0)
+	for _, crt := range shardCerts {
+		if crt.ConsumerID == consumerID {
+			issuedCerts = append(issuedCerts, crt)
+		}
+	}
+
+	sortByIssueTime(issuedCerts)
+	return issuedCerts, nil
+}
+
+func (r *issuedCertificatesRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	deleted := 0
+	for shard := 0; shard < r.shards; shard++ {
+		shardDeleted, err := r.deleteExpired(ctx, fmt.Sprintf("%s-%d", r.configMapName, shard), now)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += shardDeleted
+	}
+
+	return deleted, nil
+}
+
+func (r *issuedCertificatesRepository) deleteExpired(ctx context.Context, configMapName string, now time.Time) (int, error) {
+	deleted := 0
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		deleted = 0
+
+		configMap, err := r.configMapManager.Get(ctx, configMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		for serialNumber, data := range configMap.Data {
+			var crt IssuedCertificate
+			if err := json.Unmarshal([]byte(data), &crt); err != nil {
+				return errors.Wrapf(err, "while unmarshalling issued certificate with serial number %s", serialNumber)
+			}
+			if crt.NotAfter.Before(now) {
+				delete(configMap.Data, serialNumber)
+				deleted++
+			}
+		}
+
+		if deleted == 0 {
+			return nil
+		}
+
+		_, err = r.configMapManager.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func (r *issuedCertificatesRepository) load(ctx context.Context, configMapName string) ([]IssuedCertificate, error) {
+	configMap, err := r.configMapManager.Get(ctx, configMapName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	issuedCerts := make([]IssuedCertificate, 0, len(configMap.Data))
+	for serialNumber, data := range configMap.Data {
+		var crt IssuedCertificate
+		if err := json.Unmarshal([]byte(data), &crt); err != nil {
+			return nil, errors.Wrapf(err, "while unmarshalling issued certificate with serial number %s", serialNumber)
+		}
+		issuedCerts = append(issuedCerts, crt)
+	}
+
+	return issuedCerts, nil
+}
+
+// shardName returns the name of the config map which stores the certificates issued for the consumer
+func (r *issuedCertificatesRepository) shardName(consumerID string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(consumerID))
+	return fmt.Sprintf("%s-%d", r.configMapName, h.Sum32()%uint32(r.shards))
+}
+
+func sortByIssueTime(issuedCerts []IssuedCertificate) {
+	sort.Slice(issuedCerts, func(i, j int) bool {
+		return issuedCerts[i].NotBefore.Before(issuedCerts[j].NotBefore)
+	})
+}
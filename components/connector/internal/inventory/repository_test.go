@@ -0,0 +1,256 @@
+package inventory_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	configMapName = "issued-certificates"
+	shardName     = "issued-certificates-0"
+)
+
+func TestIssuedCertificatesRepository_Insert(t *testing.T) {
+	t.Run("should insert issued certificate to the config map of the consumer shard", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		issuedCert := fixIssuedCertificate("1f", "app", fixTime(0))
+		data, err := json.Marshal(issuedCert)
+		require.NoError(t, err)
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(&v1.ConfigMap{Data: nil}, nil)
+		configMapManager.On("Update", ctx, &v1.ConfigMap{
+			Data: map[string]string{
+				"1f": string(data),
+			}}, metav1.UpdateOptions{}).Return(&v1.ConfigMap{}, nil)
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		err = repository.Insert(ctx, issuedCert)
+
+		// then
+		require.NoError(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get config map", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(nil, errors.New("error"))
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		err := repository.Insert(ctx, fixIssuedCertificate("1f", "app", fixTime(0)))
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to update config map", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(&v1.ConfigMap{}, nil)
+		configMapManager.On("Update", ctx, mock.Anything, metav1.UpdateOptions{}).Return(nil, errors.New("error"))
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		err := repository.Insert(ctx, fixIssuedCertificate("1f", "app", fixTime(0)))
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+func TestIssuedCertificatesRepository_List(t *testing.T) {
+	t.Run("should return issued certificates ordered by issue time", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		older := fixIssuedCertificate("1f", "app", fixTime(0))
+		newer := fixIssuedCertificate("2f", "app", fixTime(1))
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"2f": fixIssuedCertificateJSON(t, newer),
+				"1f": fixIssuedCertificateJSON(t, older),
+			}}, nil)
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		issuedCerts, err := repository.List(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []inventory.IssuedCertificate{older, newer}, issuedCerts)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when config map contains invalid data", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"1f": "not json",
+			}}, nil)
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		_, err := repository.List(ctx)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "serial number 1f")
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+func TestIssuedCertificatesRepository_ShardsByConsumer(t *testing.T) {
+	t.Run("should list certificates of consumer from the shard they are inserted to", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		appCert := fixIssuedCertificate("1f", "app", fixTime(0))
+		otherCert := fixIssuedCertificate("2f", "other-app", fixTime(0))
+
+		var insertedTo string
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, mock.AnythingOfType("string"), metav1.GetOptions{}).Run(func(args mock.Arguments) {
+			insertedTo = args.String(1)
+		}).Return(&v1.ConfigMap{}, nil).Once()
+		configMapManager.On("Update", ctx, mock.Anything, metav1.UpdateOptions{}).Return(&v1.ConfigMap{}, nil).Once()
+		configMapManager.On("Get", ctx, mock.MatchedBy(func(name string) bool {
+			return name == insertedTo
+		}), metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"1f": fixIssuedCertificateJSON(t, appCert),
+				"2f": fixIssuedCertificateJSON(t, otherCert),
+			}}, nil).Once()
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 16)
+
+		// when
+		err := repository.Insert(ctx, appCert)
+		require.NoError(t, err)
+		issuedCerts, err := repository.ListByConsumer(ctx, "app")
+
+		// then
+		require.NoError(t, err)
+		assert.Regexp(t, "^issued-certificates-([0-9]|1[0-5])$", insertedTo)
+		assert.Equal(t, []inventory.IssuedCertificate{appCert}, issuedCerts)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should list certificates of all consumers from all shards", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		older := fixIssuedCertificate("1f", "app", fixTime(0))
+		newer := fixIssuedCertificate("2f", "other-app", fixTime(1))
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, "issued-certificates-0", metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"2f": fixIssuedCertificateJSON(t, newer),
+			}}, nil).Once()
+		configMapManager.On("Get", ctx, "issued-certificates-1", metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"1f": fixIssuedCertificateJSON(t, older),
+			}}, nil).Once()
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 2)
+
+		// when
+		issuedCerts, err := repository.List(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []inventory.IssuedCertificate{older, newer}, issuedCerts)
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+func TestIssuedCertificatesRepository_DeleteExpired(t *testing.T) {
+	t.Run("should delete expired certificates from all shards", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		expired := fixIssuedCertificate("1f", "app", fixTime(0))
+		valid := fixIssuedCertificate("2f", "app", fixTime(10))
+		now := expired.NotAfter.Add(time.Hour)
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, "issued-certificates-0", metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"1f": fixIssuedCertificateJSON(t, expired),
+				"2f": fixIssuedCertificateJSON(t, valid),
+			}}, nil).Once()
+		configMapManager.On("Update", ctx, &v1.ConfigMap{
+			Data: map[string]string{
+				"2f": fixIssuedCertificateJSON(t, valid),
+			}}, metav1.UpdateOptions{}).Return(&v1.ConfigMap{}, nil).Once()
+		configMapManager.On("Get", ctx, "issued-certificates-1", metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"2f": fixIssuedCertificateJSON(t, valid),
+			}}, nil).Once()
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 2)
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		configMapManager.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to update config map", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		expired := fixIssuedCertificate("1f", "app", fixTime(0))
+
+		configMapManager := &mocks.Manager{}
+		configMapManager.On("Get", ctx, shardName, metav1.GetOptions{}).Return(&v1.ConfigMap{
+			Data: map[string]string{
+				"1f": fixIssuedCertificateJSON(t, expired),
+			}}, nil).Once()
+		configMapManager.On("Update", ctx, mock.Anything, metav1.UpdateOptions{}).Return(nil, errors.New("error")).Once()
+
+		repository := inventory.NewRepository(configMapManager, configMapName, 1)
+
+		// when
+		_, err := repository.DeleteExpired(ctx, expired.NotAfter.Add(time.Hour))
+
+		// then
+		require.Error(t, err)
+		configMapManager.AssertExpectations(t)
+	})
+}
+
+func fixIssuedCertificateJSON(t *testing.T, crt inventory.IssuedCertificate) string {
+	data, err := json.Marshal(crt)
+	require.NoError(t, err)
+	return string(data)
+}
@@ -0,0 +1,93 @@
+package inventory
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+)
+
+//go:generate mockery --name=Service
+type Service interface {
+	Record(ctx context.Context, rawCertificate []byte, caCertificate *x509.Certificate, consumer certificates.Consumer) apperrors.AppError
+	// List returns the certificates issued for the consumer
+	List(ctx context.Context, consumerID string) ([]IssuedCertificate, apperrors.AppError)
+	// Get returns the certificate with the serial number issued for the consumer
+	Get(ctx context.Context, consumerID, serialNumber string) (IssuedCertificate, apperrors.AppError)
+	// Revoke marks the issued certificate with the SHA256 hash as revoked and returns it
+	Revoke(ctx context.Context, hash string) (IssuedCertificate, apperrors.AppError)
+}
+
+type service struct {
+	repository IssuedCertificatesRepository
+}
+
+func NewService(repository IssuedCertificatesRepository) Service {
+	return &service{
+		repository: repository,
+	}
+}
+
+func (s *service) Record(ctx context.Context, rawCertificate []byte, caCertificate *x509.Certificate, consumer certificates.Consumer) apperrors.AppError {
+	crt, err := x509.ParseCertificate(rawCertificate)
+	if err != nil {
+		return apperrors.Internal("Error while parsing issued certificate: %s", err)
+	}
+
+	if err := s.repository.Insert(ctx, NewIssuedCertificate(crt, caCertificate, consumer)); err != nil {
+		return apperrors.Internal("Error while storing issued certificate: %s", err)
+	}
+
+	return nil
+}
+
+func (s *service) List(ctx context.Context, consumerID string) ([]IssuedCertificate, apperrors.AppError) {
+	consumerCerts, err := s.repository.ListByConsumer(ctx, consumerID)
+	if err != nil {
+		return nil, apperrors.Internal("Error while listing issued certificates: %s", err)
+	}
+
+	return consumerCerts, nil
+}
+
+func (s *service) Get(ctx context.Context, consumerID, serialNumber string) (IssuedCertificate, apperrors.AppError) {
+	consumerCerts, err := s.List(ctx, consumerID)
+	if err != nil {
+		return IssuedCertificate{}, err
+	}
+
+	for _, crt := range consumerCerts {
+		if crt.SerialNumber == serialNumber {
+			return crt, nil
+		}
+	}
+
+	return IssuedCertificate{}, apperrors.NotFound("Issued certificate with serial number %s not found", serialNumber)
+}
+
+func (s *service) Revoke(ctx context.Context, hash string) (IssuedCertificate, apperrors.AppError) {
+	issuedCerts, err := s.repository.List(ctx)
+	if err != nil {
+		return IssuedCertificate{}, apperrors.Internal("Error while listing issued certificates: %s", err)
+	}
+
+	for _, crt := range issuedCerts {
+		if crt.Hash != hash {
+			continue
+		}
+		if crt.RevokedAt != nil {
+			return crt, nil
+		}
+
+		revokedAt := time.Now().UTC()
+		crt.RevokedAt = &revokedAt
+		if err := s.repository.Insert(ctx, crt); err != nil {
+			return IssuedCertificate{}, apperrors.Internal("Error while storing revoked certificate: %s", err)
+		}
+		return crt, nil
+	}
+
+	return IssuedCertificate{}, apperrors.NotFound("Issued certificate with hash %s not found", hash)
+}
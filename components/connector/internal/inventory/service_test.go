@@ -0,0 +1,280 @@
+package inventory_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/apperrors"
+	"github.com/kyma-incubator/compass/components/connector/internal/certificates"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory"
+	"github.com/kyma-incubator/compass/components/connector/internal/inventory/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Record(t *testing.T) {
+	t.Run("should store metadata of issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		rawCert := fixRawCertificate(t)
+		hash := sha256.Sum256(rawCert)
+		consumer := certificates.Consumer{ID: "app", Type: "Application", Tenant: "tenant"}
+
+		expected := inventory.IssuedCertificate{
+			SerialNumber:   "1f",
+			Subject:        "CN=app",
+			ConsumerID:     "app",
+			ConsumerType:   "Application",
+			Tenant:         "tenant",
+			NotBefore:      fixTime(0),
+			NotAfter:       fixTime(90),
+			Hash:           hex.EncodeToString(hash[:]),
+			CASerialNumber: "a",
+		}
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("Insert", ctx, expected).Return(nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		err := service.Record(ctx, rawCert, &x509.Certificate{SerialNumber: big.NewInt(10)}, consumer)
+
+		// then
+		require.NoError(t, err)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when certificate is invalid", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		service := inventory.NewService(repository)
+
+		// when
+		err := service.Record(context.Background(), []byte("not a certificate"), &x509.Certificate{SerialNumber: big.NewInt(10)}, certificates.Consumer{ID: "app"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to store issued certificate", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("Insert", ctx, mock.AnythingOfType("inventory.IssuedCertificate")).Return(errors.New("error"))
+
+		service := inventory.NewService(repository)
+
+		// when
+		err := service.Record(ctx, fixRawCertificate(t), &x509.Certificate{SerialNumber: big.NewInt(10)}, certificates.Consumer{ID: "app"})
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_List(t *testing.T) {
+	t.Run("should return certificates issued for consumer", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		appCert := fixIssuedCertificate("1f", "app", fixTime(0))
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListByConsumer", ctx, "app").Return([]inventory.IssuedCertificate{appCert}, nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		issuedCerts, err := service.List(ctx, "app")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []inventory.IssuedCertificate{appCert}, issuedCerts)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to list issued certificates", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListByConsumer", ctx, "app").Return(nil, errors.New("error"))
+
+		service := inventory.NewService(repository)
+
+		// when
+		_, err := service.List(ctx, "app")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
+
+func TestService_Get(t *testing.T) {
+	ctx := context.Background()
+	appCert := fixIssuedCertificate("1f", "app", fixTime(0))
+
+	t.Run("should return certificate issued for consumer", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListByConsumer", ctx, "app").Return([]inventory.IssuedCertificate{appCert}, nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		issuedCert, err := service.Get(ctx, "app", "1f")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, appCert, issuedCert)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return Not Found error for certificate issued for other consumer", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("ListByConsumer", ctx, "app").Return([]inventory.IssuedCertificate{appCert}, nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		_, err := service.Get(ctx, "app", "2f")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
+
+func fixRawCertificate(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(31),
+		Subject:      pkix.Name{CommonName: "app"},
+		NotBefore:    fixTime(0),
+		NotAfter:     fixTime(90),
+	}
+
+	rawCert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return rawCert
+}
+
+func fixIssuedCertificate(serialNumber, consumerID string, notBefore time.Time) inventory.IssuedCertificate {
+	return inventory.IssuedCertificate{
+		SerialNumber: serialNumber,
+		Subject:      "CN=" + consumerID,
+		ConsumerID:   consumerID,
+		ConsumerType: "Application",
+		Tenant:       "tenant",
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+		Hash:         "hash",
+	}
+}
+
+func fixTime(days int) time.Time {
+	return time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC).Add(time.Duration(days) * 24 * time.Hour)
+}
+
+func TestService_Revoke(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should mark issued certificate as revoked", func(t *testing.T) {
+		// given
+		appCert := fixIssuedCertificate("1f", "app", fixTime(0))
+		otherCert := fixIssuedCertificate("2f", "other-app", fixTime(0))
+		otherCert.Hash = "other-hash"
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{otherCert, appCert}, nil)
+		repository.On("Insert", ctx, mock.MatchedBy(func(crt inventory.IssuedCertificate) bool {
+			return crt.SerialNumber == "1f" && crt.RevokedAt != nil
+		})).Return(nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		crt, err := service.Revoke(ctx, "hash")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "1f", crt.SerialNumber)
+		require.NotNil(t, crt.RevokedAt)
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should not change revocation time of revoked certificate", func(t *testing.T) {
+		// given
+		revokedAt := fixTime(1)
+		appCert := fixIssuedCertificate("1f", "app", fixTime(0))
+		appCert.RevokedAt = &revokedAt
+
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{appCert}, nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		crt, err := service.Revoke(ctx, "hash")
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &revokedAt, crt.RevokedAt)
+		repository.AssertExpectations(t)
+		repository.AssertNotCalled(t, "Insert", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return Not Found error for unknown hash", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{fixIssuedCertificate("1f", "app", fixTime(0))}, nil)
+
+		service := inventory.NewService(repository)
+
+		// when
+		_, err := service.Revoke(ctx, "unknown-hash")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeNotFound, err.Code())
+		repository.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to store revoked certificate", func(t *testing.T) {
+		// given
+		repository := &mocks.IssuedCertificatesRepository{}
+		repository.On("List", ctx).Return([]inventory.IssuedCertificate{fixIssuedCertificate("1f", "app", fixTime(0))}, nil)
+		repository.On("Insert", ctx, mock.AnythingOfType("inventory.IssuedCertificate")).Return(errors.New("error"))
+
+		service := inventory.NewService(repository)
+
+		// when
+		_, err := service.Revoke(ctx, "hash")
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+		repository.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	validState    = "valid"
+	expiringState = "expiring"
+	expiredState  = "expired"
+)
+
+type IssuedCertificatesCollector struct {
+	issuedCertificates *prometheus.GaugeVec
+}
+
+func NewIssuedCertificatesCollector() *IssuedCertificatesCollector {
+	return &IssuedCertificatesCollector{
+		issuedCertificates: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "connector",
+			Name:      "issued_certificates",
+			Help:      "number of issued client certificates by expiry state",
+		}, []string{"state"}),
+	}
+}
+
+func (c *IssuedCertificatesCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.issuedCertificates.Describe(ch)
+}
+
+func (c *IssuedCertificatesCollector) Collect(ch chan<- prometheus.Metric) {
+	c.issuedCertificates.Collect(ch)
+}
+
+func (c *IssuedCertificatesCollector) SetIssuedCertificates(valid, expiring, expired int) {
+	c.issuedCertificates.WithLabelValues(validState).Set(float64(valid))
+	c.issuedCertificates.WithLabelValues(expiringState).Set(float64(expiring))
+	c.issuedCertificates.WithLabelValues(expiredState).Set(float64(expired))
+}
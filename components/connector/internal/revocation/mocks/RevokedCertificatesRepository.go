@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // RevokedCertificatesRepository is an autogenerated mock type for the RevokedCertificatesRepository type
@@ -27,13 +29,34 @@ func (_m *RevokedCertificatesRepository) Contains(hash string) bool {
 	return r0
 }
 
-// Insert provides a mock function with given fields: ctx, hash
-func (_m *RevokedCertificatesRepository) Insert(ctx context.Context, hash string) error {
-	ret := _m.Called(ctx, hash)
+// DeleteExpired provides a mock function with given fields: ctx, now
+func (_m *RevokedCertificatesRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Insert provides a mock function with given fields: ctx, hash, notAfter
+func (_m *RevokedCertificatesRepository) Insert(ctx context.Context, hash string, notAfter time.Time) error {
+	ret := _m.Called(ctx, hash, notAfter)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, hash)
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, hash, notAfter)
 	} else {
 		r0 = ret.Error(0)
 	}
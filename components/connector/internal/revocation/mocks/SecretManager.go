@@ -0,0 +1,89 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	watch "k8s.io/apimachinery/pkg/watch"
+)
+
+// SecretManager is an autogenerated mock type for the SecretManager type
+type SecretManager struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, name, options
+func (_m *SecretManager) Get(ctx context.Context, name string, options v1.GetOptions) (*corev1.Secret, error) {
+	ret := _m.Called(ctx, name, options)
+
+	var r0 *corev1.Secret
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) *corev1.Secret); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, v1.GetOptions) error); ok {
+		r1 = rf(ctx, name, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, secret, opts
+func (_m *SecretManager) Update(ctx context.Context, secret *corev1.Secret, opts v1.UpdateOptions) (*corev1.Secret, error) {
+	ret := _m.Called(ctx, secret, opts)
+
+	var r0 *corev1.Secret
+	if rf, ok := ret.Get(0).(func(context.Context, *corev1.Secret, v1.UpdateOptions) *corev1.Secret); ok {
+		r0 = rf(ctx, secret, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*corev1.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *corev1.Secret, v1.UpdateOptions) error); ok {
+		r1 = rf(ctx, secret, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Watch provides a mock function with given fields: ctx, opts
+func (_m *SecretManager) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 watch.Interface
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) watch.Interface); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(watch.Interface)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, v1.ListOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,59 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+const revocationListPrunerCorrelationID = "revocation-list-pruner"
+
+type Pruner interface {
+	Run(ctx context.Context)
+}
+
+type expiredCertificatesPruner struct {
+	repository RevokedCertificatesRepository
+	interval   time.Duration
+}
+
+// NewExpiredCertificatesPruner creates a pruner which periodically removes the entries of expired certificates from the revocation list.
+func NewExpiredCertificatesPruner(repository RevokedCertificatesRepository, interval time.Duration) Pruner {
+	return &expiredCertificatesPruner{
+		repository: repository,
+		interval:   interval,
+	}
+}
+
+func (p *expiredCertificatesPruner) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, revocationListPrunerCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.prune(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Info("Context cancelled, stopping revocation list pruner...")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *expiredCertificatesPruner) prune(ctx context.Context) {
+	deleted, err := p.repository.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("Failed to remove expired certificates from revocation list: %v", err)
+		return
+	}
+
+	if deleted > 0 {
+		log.C(ctx).Infof("Removed %d expired certificates from revocation list", deleted)
+	}
+}
@@ -2,6 +2,7 @@ package revocation
 
 import (
 	"context"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,49 +18,56 @@ type Manager interface {
 }
 
 //go:generate mockery --name=RevokedCertificatesRepository
+
+// RevokedCertificatesRepository stores the hashes of revoked certificates together with their expiry time,
+// so that entries of expired certificates, which are rejected anyway, can be dropped from the storage
 type RevokedCertificatesRepository interface {
-	Insert(ctx context.Context, hash string) error
+	// Insert adds the certificate hash to the revocation list. Zero notAfter means that the expiry time of the certificate is unknown and the entry is never pruned
+	Insert(ctx context.Context, hash string, notAfter time.Time) error
 	Contains(hash string) bool
+	// DeleteExpired removes the entries of certificates which expired before the given time and returns their number
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
 }
 
-type revokedCertifiatesRepository struct {
+// configMapRepository stores the revoked certificates in a config map, keyed by their hashes. The values are the
+// expiry times of the certificates in RFC3339 format, or the hashes themselves if the expiry time is unknown
+type configMapRepository struct {
 	configMapManager  Manager
 	configMapName     string
 	revokedCertsCache Cache
 }
 
 func NewRepository(configMapManager Manager, configMapName string, revokedCertsCache Cache) RevokedCertificatesRepository {
-	return &revokedCertifiatesRepository{
+	return &configMapRepository{
 		configMapManager:  configMapManager,
 		configMapName:     configMapName,
 		revokedCertsCache: revokedCertsCache,
 	}
 }
 
-func (r *revokedCertifiatesRepository) Insert(ctx context.Context, hash string) error {
-	configMap, err := r.configMapManager.Get(ctx, r.configMapName, metav1.GetOptions{})
-	if err != nil {
-		return err
+func (r *configMapRepository) Insert(ctx context.Context, hash string, notAfter time.Time) error {
+	value := hash
+	if !notAfter.IsZero() {
+		value = notAfter.UTC().Format(time.RFC3339)
 	}
 
-	revokedCerts := configMap.Data
-	if revokedCerts == nil {
-		revokedCerts = map[string]string{}
-	}
-	revokedCerts[hash] = hash
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		configMap, err := r.configMapManager.Get(ctx, r.configMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
 
-	updatedConfigMap := configMap
-	updatedConfigMap.Data = revokedCerts
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[hash] = value
 
-	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		_, err = r.configMapManager.Update(ctx, updatedConfigMap, metav1.UpdateOptions{})
+		_, err = r.configMapManager.Update(ctx, configMap, metav1.UpdateOptions{})
 		return err
 	})
-
-	return err
 }
 
-func (r *revokedCertifiatesRepository) Contains(hash string) bool {
+func (r *configMapRepository) Contains(hash string) bool {
 	configMap := r.revokedCertsCache.Get()
 
 	found := false
@@ -69,3 +77,39 @@ func (r *revokedCertifiatesRepository) Contains(hash string) bool {
 
 	return found
 }
+
+func (r *configMapRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	deleted := 0
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		deleted = 0
+
+		configMap, err := r.configMapManager.Get(ctx, r.configMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		for hash, value := range configMap.Data {
+			notAfter, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				// entries without expiry time, e.g. added before the expiry time was recorded, are kept
+				continue
+			}
+			if notAfter.Before(now) {
+				delete(configMap.Data, hash)
+				deleted++
+			}
+		}
+
+		if deleted == 0 {
+			return nil
+		}
+
+		_, err = r.configMapManager.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
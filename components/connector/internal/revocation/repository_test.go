@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -79,7 +80,7 @@ func TestRevokedCertificatesRepository(t *testing.T) {
 		repository := NewRepository(configListManagerMock, configMapName, cache)
 
 		// when
-		err := repository.Insert(ctx, someHash)
+		err := repository.Insert(ctx, someHash, time.Time{})
 		require.NoError(t, err)
 
 		// then
@@ -107,10 +108,118 @@ func TestRevokedCertificatesRepository(t *testing.T) {
 		repository := NewRepository(configListManagerMock, configMapName, cache)
 
 		// when
-		err := repository.Insert(ctx, someHash)
+		err := repository.Insert(ctx, someHash, time.Time{})
 		require.Error(t, err)
 
 		// then
 		configListManagerMock.AssertExpectations(t)
 	})
+
+	t.Run("should insert value with expiry time to the list", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		cache := NewCache()
+		someHash := "someHash"
+		notAfter := time.Date(2022, 2, 4, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+		configListManagerMock := &mocks.Manager{}
+
+		configListManagerMock.On("Get", ctx, configMapName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				Data: map[string]string{"otherHash": "otherHash"},
+			}, nil)
+
+		configListManagerMock.On("Update", ctx, &v1.ConfigMap{
+			Data: map[string]string{
+				"otherHash": "otherHash",
+				someHash:    "2022-02-04T11:00:00Z",
+			}}, metav1.UpdateOptions{}).Return(&v1.ConfigMap{}, nil)
+
+		repository := NewRepository(configListManagerMock, configMapName, cache)
+
+		// when
+		err := repository.Insert(ctx, someHash, notAfter)
+		require.NoError(t, err)
+
+		// then
+		configListManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should delete expired values from the list", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		now := time.Date(2022, 2, 4, 12, 0, 0, 0, time.UTC)
+
+		configListManagerMock := &mocks.Manager{}
+		configListManagerMock.On("Get", ctx, configMapName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				Data: map[string]string{
+					"expiredHash": "2022-02-04T11:59:59Z",
+					"validHash":   "2022-02-04T12:00:01Z",
+					"legacyHash":  "legacyHash",
+				},
+			}, nil)
+
+		configListManagerMock.On("Update", ctx, &v1.ConfigMap{
+			Data: map[string]string{
+				"validHash":  "2022-02-04T12:00:01Z",
+				"legacyHash": "legacyHash",
+			}}, metav1.UpdateOptions{}).Return(&v1.ConfigMap{}, nil)
+
+		repository := NewRepository(configListManagerMock, configMapName, NewCache())
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		configListManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should not update config map if there are no expired values", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		now := time.Date(2022, 2, 4, 12, 0, 0, 0, time.UTC)
+
+		configListManagerMock := &mocks.Manager{}
+		configListManagerMock.On("Get", ctx, configMapName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				Data: map[string]string{"validHash": "2022-02-04T12:00:01Z"},
+			}, nil)
+
+		repository := NewRepository(configListManagerMock, configMapName, NewCache())
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+		configListManagerMock.AssertExpectations(t)
+		configListManagerMock.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when failed to delete expired values", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		now := time.Date(2022, 2, 4, 12, 0, 0, 0, time.UTC)
+
+		configListManagerMock := &mocks.Manager{}
+		configListManagerMock.On("Get", ctx, configMapName, mock.AnythingOfType("v1.GetOptions")).Return(
+			&v1.ConfigMap{
+				Data: map[string]string{"expiredHash": "2022-02-04T11:59:59Z"},
+			}, nil)
+		configListManagerMock.On("Update", ctx, mock.AnythingOfType("*v1.ConfigMap"), metav1.UpdateOptions{}).Return(nil, errors.New("some error"))
+
+		repository := NewRepository(configListManagerMock, configMapName, NewCache())
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, now)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, 0, deleted)
+		configListManagerMock.AssertExpectations(t)
+	})
 }
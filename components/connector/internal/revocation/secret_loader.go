@@ -0,0 +1,138 @@
+package revocation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type revokedCertificatesShardsLoader struct {
+	revokedCertsCache Cache
+	secretManager     SecretManager
+	secretNames       []string
+	reconnectInterval time.Duration
+
+	mutex  sync.Mutex
+	shards map[string]map[string]string
+}
+
+// NewRevokedCertificatesShardsLoader creates a loader which watches every secret shard of the revocation list and
+// puts the entries of all shards into the cache
+func NewRevokedCertificatesShardsLoader(revokedCertsCache Cache,
+	secretManager SecretManager,
+	secretNames []string,
+	reconnectInterval time.Duration,
+) Loader {
+	return &revokedCertificatesShardsLoader{
+		revokedCertsCache: revokedCertsCache,
+		secretManager:     secretManager,
+		secretNames:       secretNames,
+		reconnectInterval: reconnectInterval,
+		shards:            make(map[string]map[string]string, len(secretNames)),
+	}
+}
+
+func (rl *revokedCertificatesShardsLoader) Run(ctx context.Context) {
+	entry := log.C(ctx)
+	entry = entry.WithField(log.FieldRequestID, revocationListLoaderCorrelationID)
+	ctx = log.ContextWithLogger(ctx, entry)
+
+	wg := &sync.WaitGroup{}
+	for _, name := range rl.secretNames {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			rl.startKubeWatch(ctx, name)
+		}(name)
+	}
+	wg.Wait()
+}
+
+func (rl *revokedCertificatesShardsLoader) startKubeWatch(ctx context.Context, secretName string) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.C(ctx).Infof("Context cancelled, stopping revocation list secret %s watcher...", secretName)
+			return
+		default:
+		}
+		log.C(ctx).Infof("Starting watcher for revocation list secret %s changes...", secretName)
+		watcher, err := rl.secretManager.Watch(ctx, metav1.ListOptions{
+			FieldSelector: "metadata.name=" + secretName,
+			Watch:         true,
+		})
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("Could not initialize watcher. Sleep for %s and try again... %v", rl.reconnectInterval.String(), err)
+			time.Sleep(rl.reconnectInterval)
+			continue
+		}
+		log.C(ctx).Infof("Waiting for revocation list secret %s events...", secretName)
+
+		rl.processEvents(ctx, secretName, watcher.ResultChan())
+
+		// Cleanup any allocated resources
+		watcher.Stop()
+		time.Sleep(rl.reconnectInterval)
+	}
+}
+
+func (rl *revokedCertificatesShardsLoader) processEvents(ctx context.Context, secretName string, events <-chan watch.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			switch ev.Type {
+			case watch.Added:
+				fallthrough
+			case watch.Modified:
+				log.C(ctx).Infof("Revocation list secret %s updated", secretName)
+				secret, ok := ev.Object.(*v1.Secret)
+				if !ok {
+					log.C(ctx).Error("Unexpected error: object is not secret. Try again")
+					continue
+				}
+				data := make(map[string]string, len(secret.Data))
+				for hash, value := range secret.Data {
+					data[hash] = string(value)
+				}
+				rl.putShard(secretName, data)
+			case watch.Deleted:
+				log.C(ctx).Infof("Revocation list secret %s deleted", secretName)
+				rl.putShard(secretName, nil)
+			case watch.Error:
+				log.C(ctx).Errorf("Error event is received, stop revocation list secret %s watcher and try again...", secretName)
+				return
+			}
+		}
+	}
+}
+
+// putShard replaces the entries of the shard and puts the entries of all shards into the cache
+func (rl *revokedCertificatesShardsLoader) putShard(secretName string, data map[string]string) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	if data == nil {
+		delete(rl.shards, secretName)
+	} else {
+		rl.shards[secretName] = data
+	}
+
+	merged := make(map[string]string)
+	for _, shard := range rl.shards {
+		for hash, value := range shard {
+			merged[hash] = value
+		}
+	}
+	rl.revokedCertsCache.Put(merged)
+}
@@ -0,0 +1,118 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func prepShards(ctx context.Context, shardNames ...string) (Cache, map[string]*testWatch, *mocks.SecretManager) {
+	cache := NewCache()
+	watchers := make(map[string]*testWatch, len(shardNames))
+	secretManagerMock := &mocks.SecretManager{}
+	for _, name := range shardNames {
+		watcher := &testWatch{
+			events: make(chan watch.Event, 100),
+		}
+		watchers[name] = watcher
+		secretManagerMock.
+			On("Watch", mock.Anything, metav1.ListOptions{FieldSelector: "metadata.name=" + name, Watch: true}).
+			Return(watcher, nil).
+			Once()
+	}
+	loader := NewRevokedCertificatesShardsLoader(cache, secretManagerMock, shardNames, time.Millisecond)
+
+	go loader.Run(ctx)
+	return cache, watchers, secretManagerMock
+}
+
+func Test_revokedCertificatesShardsLoader(t *testing.T) {
+	t.Run("should load entries of all shards", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watchers, managerMock := prepShards(ctx, "revocations-0", "revocations-1")
+
+		// when
+		watchers["revocations-0"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.Secret{Data: map[string][]byte{"hash0": []byte("hash0")}},
+		})
+		watchers["revocations-1"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.Secret{Data: map[string][]byte{"hash1": []byte("hash1")}},
+		})
+
+		// then
+		assert.Eventually(t, func() bool {
+			return cache.Get()["hash0"] == "hash0" && cache.Get()["hash1"] == "hash1"
+		}, time.Second*2, time.Millisecond*100)
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should replace entries of modified shard and drop entries of deleted shard", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watchers, managerMock := prepShards(ctx, "revocations-0", "revocations-1")
+
+		watchers["revocations-0"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.Secret{Data: map[string][]byte{"hash0": []byte("hash0")}},
+		})
+		watchers["revocations-1"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.Secret{Data: map[string][]byte{"hash1": []byte("hash1")}},
+		})
+		assert.Eventually(t, func() bool {
+			return len(cache.Get()) == 2
+		}, time.Second*2, time.Millisecond*100)
+
+		// when
+		watchers["revocations-0"].putEvent(watch.Event{
+			Type:   watch.Modified,
+			Object: &v1.Secret{Data: map[string][]byte{"modified": []byte("modified")}},
+		})
+		watchers["revocations-1"].putEvent(watch.Event{
+			Type: watch.Deleted,
+		})
+
+		// then
+		assert.Eventually(t, func() bool {
+			data := cache.Get()
+			return len(data) == 1 && data["modified"] == "modified"
+		}, time.Second*2, time.Millisecond*100)
+		managerMock.AssertExpectations(t)
+	})
+
+	t.Run("should not load shard if event object is not secret", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		cache, watchers, managerMock := prepShards(ctx, "revocations-0")
+
+		// when
+		watchers["revocations-0"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.ConfigMap{Data: map[string]string{"hash": "hash"}},
+		})
+		watchers["revocations-0"].putEvent(watch.Event{
+			Type:   watch.Added,
+			Object: &v1.Secret{Data: map[string][]byte{"other": []byte("other")}},
+		})
+
+		// then
+		assert.Eventually(t, func() bool {
+			return cache.Get()["other"] == "other"
+		}, time.Second*2, time.Millisecond*100)
+		assert.Empty(t, cache.Get()["hash"])
+		managerMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,137 @@
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// StorageConfigMap stores the revocation list in a single config map
+	StorageConfigMap = "configmap"
+	// StorageSecretShards stores the revocation list sharded by the certificate hashes across multiple secrets
+	StorageSecretShards = "secret-shards"
+)
+
+//go:generate mockery --name=SecretManager
+type SecretManager interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions) (*v1.Secret, error)
+	Update(ctx context.Context, secret *v1.Secret, opts metav1.UpdateOptions) (*v1.Secret, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+}
+
+// secretShardsRepository stores the revoked certificates in secrets named <secretName>-<shard>, keyed by their hashes.
+// The values are the same as in the config map storage. Sharding keeps every secret below the size limit of a
+// Kubernetes object, and inserting a certificate updates only the secret of its shard
+type secretShardsRepository struct {
+	secretManager     SecretManager
+	secretName        string
+	shards            int
+	revokedCertsCache Cache
+}
+
+func NewSecretShardsRepository(secretManager SecretManager, secretName string, shards int, revokedCertsCache Cache) RevokedCertificatesRepository {
+	return &secretShardsRepository{
+		secretManager:     secretManager,
+		secretName:        secretName,
+		shards:            shards,
+		revokedCertsCache: revokedCertsCache,
+	}
+}
+
+// ShardNames returns the names of the secrets which store the revocation list
+func ShardNames(secretName string, shards int) []string {
+	names := make([]string, 0, shards)
+	for shard := 0; shard < shards; shard++ {
+		names = append(names, fmt.Sprintf("%s-%d", secretName, shard))
+	}
+	return names
+}
+
+func (r *secretShardsRepository) Insert(ctx context.Context, hash string, notAfter time.Time) error {
+	value := hash
+	if !notAfter.IsZero() {
+		value = notAfter.UTC().Format(time.RFC3339)
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		secret, err := r.secretManager.Get(ctx, r.shardName(hash), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[hash] = []byte(value)
+
+		_, err = r.secretManager.Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func (r *secretShardsRepository) Contains(hash string) bool {
+	_, found := r.revokedCertsCache.Get()[hash]
+	return found
+}
+
+func (r *secretShardsRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	deleted := 0
+	for _, name := range ShardNames(r.secretName, r.shards) {
+		shardDeleted, err := r.deleteExpired(ctx, name, now)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += shardDeleted
+	}
+
+	return deleted, nil
+}
+
+func (r *secretShardsRepository) deleteExpired(ctx context.Context, secretName string, now time.Time) (int, error) {
+	deleted := 0
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		deleted = 0
+
+		secret, err := r.secretManager.Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		for hash, value := range secret.Data {
+			notAfter, err := time.Parse(time.RFC3339, string(value))
+			if err != nil {
+				// entries without expiry time are kept, the same as in the config map storage
+				continue
+			}
+			if notAfter.Before(now) {
+				delete(secret.Data, hash)
+				deleted++
+			}
+		}
+
+		if deleted == 0 {
+			return nil
+		}
+
+		_, err = r.secretManager.Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+func (r *secretShardsRepository) shardName(hash string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(hash))
+	return fmt.Sprintf("%s-%d", r.secretName, h.Sum32()%uint32(r.shards))
+}
@@ -0,0 +1,154 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/connector/internal/revocation/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSecretShardsRepository(t *testing.T) {
+	secretName := "revocations"
+
+	t.Run("should return true if value is present in any shard", func(t *testing.T) {
+		// given
+		cache := NewCache()
+		cache.Put(map[string]string{"someHash": "someHash"})
+		secretManagerMock := &mocks.SecretManager{}
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 4, cache)
+
+		// when
+		isPresent := repository.Contains("someHash")
+
+		// then
+		assert.True(t, isPresent)
+		assert.False(t, repository.Contains("otherHash"))
+		secretManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should insert value with expiry time to the secret of its shard", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		notAfter := time.Date(2022, 2, 4, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+		var insertedTo string
+		secretManagerMock := &mocks.SecretManager{}
+		secretManagerMock.On("Get", ctx, mock.AnythingOfType("string"), metav1.GetOptions{}).Run(func(args mock.Arguments) {
+			insertedTo = args.String(1)
+		}).Return(&v1.Secret{
+			Data: map[string][]byte{"otherHash": []byte("otherHash")},
+		}, nil).Once()
+		secretManagerMock.On("Update", ctx, &v1.Secret{
+			Data: map[string][]byte{
+				"otherHash": []byte("otherHash"),
+				"someHash":  []byte("2022-02-04T11:00:00Z"),
+			}}, metav1.UpdateOptions{}).Return(&v1.Secret{}, nil).Once()
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 4, NewCache())
+
+		// when
+		err := repository.Insert(ctx, "someHash", notAfter)
+
+		// then
+		require.NoError(t, err)
+		assert.Contains(t, ShardNames(secretName, 4), insertedTo)
+		secretManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should insert values with the same hash to the same shard", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		insertedTo := make([]string, 0, 2)
+		secretManagerMock := &mocks.SecretManager{}
+		secretManagerMock.On("Get", ctx, mock.AnythingOfType("string"), metav1.GetOptions{}).Run(func(args mock.Arguments) {
+			insertedTo = append(insertedTo, args.String(1))
+		}).Return(&v1.Secret{}, nil).Twice()
+		secretManagerMock.On("Update", ctx, mock.Anything, metav1.UpdateOptions{}).Return(&v1.Secret{}, nil).Twice()
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 16, NewCache())
+
+		// when
+		require.NoError(t, repository.Insert(ctx, "someHash", time.Time{}))
+		require.NoError(t, repository.Insert(ctx, "someHash", time.Time{}))
+
+		// then
+		require.Len(t, insertedTo, 2)
+		assert.Equal(t, insertedTo[0], insertedTo[1])
+		secretManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to update secret", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		secretManagerMock := &mocks.SecretManager{}
+		secretManagerMock.On("Get", ctx, "revocations-0", metav1.GetOptions{}).Return(&v1.Secret{}, nil).Once()
+		secretManagerMock.On("Update", ctx, mock.Anything, metav1.UpdateOptions{}).Return(nil, errors.New("some error")).Once()
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 1, NewCache())
+
+		// when
+		err := repository.Insert(ctx, "someHash", time.Time{})
+
+		// then
+		require.Error(t, err)
+		secretManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should delete expired values from all shards", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+		now := time.Date(2022, 2, 4, 12, 0, 0, 0, time.UTC)
+
+		secretManagerMock := &mocks.SecretManager{}
+		secretManagerMock.On("Get", ctx, "revocations-0", metav1.GetOptions{}).Return(&v1.Secret{
+			Data: map[string][]byte{
+				"expiredHash": []byte("2022-02-04T11:59:59Z"),
+				"legacyHash":  []byte("legacyHash"),
+			}}, nil).Once()
+		secretManagerMock.On("Update", ctx, &v1.Secret{
+			Data: map[string][]byte{
+				"legacyHash": []byte("legacyHash"),
+			}}, metav1.UpdateOptions{}).Return(&v1.Secret{}, nil).Once()
+		secretManagerMock.On("Get", ctx, "revocations-1", metav1.GetOptions{}).Return(&v1.Secret{
+			Data: map[string][]byte{
+				"validHash": []byte("2022-02-04T12:00:01Z"),
+			}}, nil).Once()
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 2, NewCache())
+
+		// when
+		deleted, err := repository.DeleteExpired(ctx, now)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		secretManagerMock.AssertExpectations(t)
+	})
+
+	t.Run("should return error when failed to get secret", func(t *testing.T) {
+		// given
+		ctx := context.Background()
+
+		secretManagerMock := &mocks.SecretManager{}
+		secretManagerMock.On("Get", ctx, "revocations-0", metav1.GetOptions{}).Return(nil, errors.New("some error")).Once()
+
+		repository := NewSecretShardsRepository(secretManagerMock, secretName, 2, NewCache())
+
+		// when
+		_, err := repository.DeleteExpired(ctx, time.Now())
+
+		// then
+		require.Error(t, err)
+		secretManagerMock.AssertExpectations(t)
+	})
+}
@@ -35,6 +35,8 @@ const (
 	testConfigMapName = "test-secret"
 	oneTimeTokenURL   = "http://director.com"
 	clientID          = "abcd-efgh"
+
+	testIssuedCertsConfigMapName = "test-issued-certificates"
 )
 
 var (
@@ -47,6 +49,10 @@ func TestMain(m *testing.M) {
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
 	err = os.Setenv("APP_REVOCATION_CONFIG_MAP_NAME", testConfigMapName)
 	exitOnError(err, "Error setting APP_CA_SECRET_NAME env")
+	err = os.Setenv("APP_ISSUED_CERTIFICATES_CONFIG_MAP_NAME", testIssuedCertsConfigMapName)
+	exitOnError(err, "Error setting APP_ISSUED_CERTIFICATES_CONFIG_MAP_NAME env")
+	err = os.Setenv("APP_ISSUED_CERTIFICATES_SHARDS", "1")
+	exitOnError(err, "Error setting APP_ISSUED_CERTIFICATES_SHARDS env")
 	err = os.Setenv("APP_ONE_TIME_TOKEN_URL", oneTimeTokenURL)
 	exitOnError(err, "Error setting APP_ONE_TIME_TOKEN_URL env")
 
@@ -72,6 +78,9 @@ func TestMain(m *testing.M) {
 			Data:       nil,
 			BinaryData: nil,
 		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: testIssuedCertsConfigMapName + "-0", Namespace: "default"},
+		},
 	)
 
 	directorGCLI := &gcliMocks.GraphQLClient{}
@@ -89,9 +98,12 @@ func TestMain(m *testing.M) {
 		internalComponents.TokenService,
 		internalComponents.CertificateService,
 		internalComponents.CSRSubjectConsts,
+		internalComponents.CSRKeyAlgorithm,
 		cfg.DirectorURL,
 		cfg.CertificateSecuredConnectorURL,
-		internalComponents.RevokedCertsRepository)
+		internalComponents.RevokedCertsRepository,
+		internalComponents.InventoryService,
+		internalComponents.CAProvider)
 
 	authContextTestMiddleware := func(handler http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -120,7 +132,7 @@ func TestMain(m *testing.M) {
 		})
 	}
 
-	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, authContextTestMiddleware)
+	externalGqlServer, err := config.PrepareExternalGraphQLServer(cfg, certificateResolver, internalComponents.CertificateStatusService, authContextTestMiddleware)
 	exitOnError(err, "Error configuring external graphQL handler")
 
 	externalGqlServer.TLSConfig = &tls.Config{ClientAuth: tls.RequestClientCert}
@@ -61,4 +61,7 @@ func Test_Clientset(t *testing.T) {
 	revocationCM, err := k8sClientSet.CoreV1().ConfigMaps("default").Get(ctx, testConfigMapName, v1.GetOptions{})
 	require.NoError(t, err)
 	assert.Len(t, revocationCM.Data, 1)
+	issuedCertsCM, err := k8sClientSet.CoreV1().ConfigMaps("default").Get(ctx, testIssuedCertsConfigMapName+"-0", v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Len(t, issuedCertsCM.Data, 2)
 }
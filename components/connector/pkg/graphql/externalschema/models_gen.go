@@ -2,6 +2,14 @@
 
 package externalschema
 
+type CertificateAuthority struct {
+	SerialNumber string `json:"serialNumber"`
+	Subject      string `json:"subject"`
+	NotBefore    string `json:"notBefore"`
+	NotAfter     string `json:"notAfter"`
+	Active       bool   `json:"active"`
+}
+
 type CertificateSigningRequestInfo struct {
 	Subject      string `json:"subject"`
 	KeyAlgorithm string `json:"keyAlgorithm"`
@@ -19,6 +27,19 @@ type Configuration struct {
 	ManagementPlaneInfo           *ManagementPlaneInfo           `json:"managementPlaneInfo"`
 }
 
+type IssuedCertificate struct {
+	SerialNumber   string  `json:"serialNumber"`
+	Subject        string  `json:"subject"`
+	ConsumerID     string  `json:"consumerID"`
+	ConsumerType   *string `json:"consumerType"`
+	Tenant         *string `json:"tenant"`
+	NotBefore      string  `json:"notBefore"`
+	NotAfter       string  `json:"notAfter"`
+	Sha256Hash     string  `json:"sha256Hash"`
+	RevokedAt      *string `json:"revokedAt"`
+	CaSerialNumber *string `json:"caSerialNumber"`
+}
+
 type ManagementPlaneInfo struct {
 	DirectorURL                    *string `json:"directorURL"`
 	CertificateSecuredConnectorURL *string `json:"certificateSecuredConnectorURL"`
@@ -42,6 +42,14 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CertificateAuthority struct {
+		Active       func(childComplexity int) int
+		NotAfter     func(childComplexity int) int
+		NotBefore    func(childComplexity int) int
+		SerialNumber func(childComplexity int) int
+		Subject      func(childComplexity int) int
+	}
+
 	CertificateSigningRequestInfo struct {
 		KeyAlgorithm func(childComplexity int) int
 		Subject      func(childComplexity int) int
@@ -59,6 +67,19 @@ type ComplexityRoot struct {
 		Token                         func(childComplexity int) int
 	}
 
+	IssuedCertificate struct {
+		CaSerialNumber func(childComplexity int) int
+		ConsumerID     func(childComplexity int) int
+		ConsumerType   func(childComplexity int) int
+		NotAfter       func(childComplexity int) int
+		NotBefore      func(childComplexity int) int
+		RevokedAt      func(childComplexity int) int
+		SerialNumber   func(childComplexity int) int
+		Sha256Hash     func(childComplexity int) int
+		Subject        func(childComplexity int) int
+		Tenant         func(childComplexity int) int
+	}
+
 	ManagementPlaneInfo struct {
 		CertificateSecuredConnectorURL func(childComplexity int) int
 		DirectorURL                    func(childComplexity int) int
@@ -70,7 +91,10 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Configuration func(childComplexity int) int
+		CertificateAuthorities func(childComplexity int) int
+		Configuration          func(childComplexity int) int
+		IssuedCertificate      func(childComplexity int, serialNumber string) int
+		IssuedCertificates     func(childComplexity int) int
 	}
 
 	Token struct {
@@ -84,6 +108,9 @@ type MutationResolver interface {
 }
 type QueryResolver interface {
 	Configuration(ctx context.Context) (*Configuration, error)
+	IssuedCertificates(ctx context.Context) ([]*IssuedCertificate, error)
+	IssuedCertificate(ctx context.Context, serialNumber string) (*IssuedCertificate, error)
+	CertificateAuthorities(ctx context.Context) ([]*CertificateAuthority, error)
 }
 
 type executableSchema struct {
@@ -101,6 +128,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CertificateAuthority.active":
+		if e.complexity.CertificateAuthority.Active == nil {
+			break
+		}
+
+		return e.complexity.CertificateAuthority.Active(childComplexity), true
+
+	case "CertificateAuthority.notAfter":
+		if e.complexity.CertificateAuthority.NotAfter == nil {
+			break
+		}
+
+		return e.complexity.CertificateAuthority.NotAfter(childComplexity), true
+
+	case "CertificateAuthority.notBefore":
+		if e.complexity.CertificateAuthority.NotBefore == nil {
+			break
+		}
+
+		return e.complexity.CertificateAuthority.NotBefore(childComplexity), true
+
+	case "CertificateAuthority.serialNumber":
+		if e.complexity.CertificateAuthority.SerialNumber == nil {
+			break
+		}
+
+		return e.complexity.CertificateAuthority.SerialNumber(childComplexity), true
+
+	case "CertificateAuthority.subject":
+		if e.complexity.CertificateAuthority.Subject == nil {
+			break
+		}
+
+		return e.complexity.CertificateAuthority.Subject(childComplexity), true
+
 	case "CertificateSigningRequestInfo.keyAlgorithm":
 		if e.complexity.CertificateSigningRequestInfo.KeyAlgorithm == nil {
 			break
@@ -157,6 +219,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Configuration.Token(childComplexity), true
 
+	case "IssuedCertificate.caSerialNumber":
+		if e.complexity.IssuedCertificate.CaSerialNumber == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.CaSerialNumber(childComplexity), true
+
+	case "IssuedCertificate.consumerID":
+		if e.complexity.IssuedCertificate.ConsumerID == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.ConsumerID(childComplexity), true
+
+	case "IssuedCertificate.consumerType":
+		if e.complexity.IssuedCertificate.ConsumerType == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.ConsumerType(childComplexity), true
+
+	case "IssuedCertificate.notAfter":
+		if e.complexity.IssuedCertificate.NotAfter == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.NotAfter(childComplexity), true
+
+	case "IssuedCertificate.notBefore":
+		if e.complexity.IssuedCertificate.NotBefore == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.NotBefore(childComplexity), true
+
+	case "IssuedCertificate.revokedAt":
+		if e.complexity.IssuedCertificate.RevokedAt == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.RevokedAt(childComplexity), true
+
+	case "IssuedCertificate.serialNumber":
+		if e.complexity.IssuedCertificate.SerialNumber == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.SerialNumber(childComplexity), true
+
+	case "IssuedCertificate.sha256Hash":
+		if e.complexity.IssuedCertificate.Sha256Hash == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.Sha256Hash(childComplexity), true
+
+	case "IssuedCertificate.subject":
+		if e.complexity.IssuedCertificate.Subject == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.Subject(childComplexity), true
+
+	case "IssuedCertificate.tenant":
+		if e.complexity.IssuedCertificate.Tenant == nil {
+			break
+		}
+
+		return e.complexity.IssuedCertificate.Tenant(childComplexity), true
+
 	case "ManagementPlaneInfo.certificateSecuredConnectorURL":
 		if e.complexity.ManagementPlaneInfo.CertificateSecuredConnectorURL == nil {
 			break
@@ -190,6 +322,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.SignCertificateSigningRequest(childComplexity, args["csr"].(string)), true
 
+	case "Query.certificateAuthorities":
+		if e.complexity.Query.CertificateAuthorities == nil {
+			break
+		}
+
+		return e.complexity.Query.CertificateAuthorities(childComplexity), true
+
 	case "Query.configuration":
 		if e.complexity.Query.Configuration == nil {
 			break
@@ -197,6 +336,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Configuration(childComplexity), true
 
+	case "Query.issuedCertificate":
+		if e.complexity.Query.IssuedCertificate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_issuedCertificate_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.IssuedCertificate(childComplexity, args["serialNumber"].(string)), true
+
+	case "Query.issuedCertificates":
+		if e.complexity.Query.IssuedCertificates == nil {
+			break
+		}
+
+		return e.complexity.Query.IssuedCertificates(childComplexity), true
+
 	case "Token.token":
 		if e.complexity.Token.Token == nil {
 			break
@@ -299,11 +457,43 @@ type CertificateSigningRequestInfo {
     keyAlgorithm: String! # eg.: rsa2048
 }
 
+# IssuedCertificate
+type IssuedCertificate {
+    serialNumber: String! # eg.: "5d1c0e3f6a1b4e8f9c2d7a6b3e0f1c4d"
+    subject: String! # eg.: "CN={ID},OU=Test,O=Test,L=Blacksburg,ST=Virginia,C=US"
+    consumerID: String!
+    consumerType: String # eg.: "Application"
+    tenant: String
+    notBefore: String! # eg.: "2022-01-04T12:00:00Z"
+    notAfter: String! # eg.: "2022-04-04T12:00:00Z"
+    sha256Hash: String!
+    revokedAt: String # eg.: "2022-02-04T12:00:00Z"
+    caSerialNumber: String # eg.: "3a7f"
+}
+
+# CertificateAuthority
+type CertificateAuthority {
+    serialNumber: String! # eg.: "3a7f"
+    subject: String! # eg.: "CN=Connector CA,O=Org"
+    notBefore: String! # eg.: "2022-01-01T00:00:00Z"
+    notAfter: String! # eg.: "2024-01-01T00:00:00Z"
+    active: Boolean!
+}
+
 type Query {
     # Client-Certificates
 
     """returns configuration information like subject that should be placed in the signing request or Director URL"""
     configuration: Configuration!
+
+    """returns certificates issued for the client"""
+    issuedCertificates: [IssuedCertificate!]!
+
+    """returns certificate with given serial number issued for the client"""
+    issuedCertificate(serialNumber: String!): IssuedCertificate
+
+    """returns generations of the CA trusted by the Connector, the active one signs new certificates"""
+    certificateAuthorities: [CertificateAuthority!]!
 }
 
 type Mutation {
@@ -349,6 +539,20 @@ func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_issuedCertificate_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["serialNumber"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["serialNumber"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -385,6 +589,176 @@ func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArg
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _CertificateAuthority_serialNumber(ctx context.Context, field graphql.CollectedField, obj *CertificateAuthority) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateAuthority",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SerialNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateAuthority_subject(ctx context.Context, field graphql.CollectedField, obj *CertificateAuthority) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateAuthority",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateAuthority_notBefore(ctx context.Context, field graphql.CollectedField, obj *CertificateAuthority) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateAuthority",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NotBefore, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateAuthority_notAfter(ctx context.Context, field graphql.CollectedField, obj *CertificateAuthority) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateAuthority",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NotAfter, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _CertificateAuthority_active(ctx context.Context, field graphql.CollectedField, obj *CertificateAuthority) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "CertificateAuthority",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Active, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _CertificateSigningRequestInfo_subject(ctx context.Context, field graphql.CollectedField, obj *CertificateSigningRequestInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -648,7 +1022,335 @@ func (ec *executionContext) _Configuration_managementPlaneInfo(ctx context.Conte
 	return ec.marshalOManagementPlaneInfo2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐManagementPlaneInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ManagementPlaneInfo_directorURL(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _IssuedCertificate_serialNumber(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SerialNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_subject(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Subject, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_consumerID(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConsumerID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_consumerType(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ConsumerType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_tenant(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Tenant, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_notBefore(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NotBefore, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_notAfter(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NotAfter, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_sha256Hash(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sha256Hash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_revokedAt(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RevokedAt, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _IssuedCertificate_caSerialNumber(ctx context.Context, field graphql.CollectedField, obj *IssuedCertificate) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "IssuedCertificate",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CaSerialNumber, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManagementPlaneInfo_directorURL(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -674,12 +1376,118 @@ func (ec *executionContext) _ManagementPlaneInfo_directorURL(ctx context.Context
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ManagementPlaneInfo_certificateSecuredConnectorURL(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ManagementPlaneInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.CertificateSecuredConnectorURL, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_signCertificateSigningRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_signCertificateSigningRequest_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().SignCertificateSigningRequest(rctx, args["csr"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*CertificationResult)
+	fc.Result = res
+	return ec.marshalNCertificationResult2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificationResult(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Mutation_revokeCertificate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().RevokeCertificate(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ManagementPlaneInfo_certificateSecuredConnectorURL(ctx context.Context, field graphql.CollectedField, obj *ManagementPlaneInfo) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_configuration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -687,30 +1495,33 @@ func (ec *executionContext) _ManagementPlaneInfo_certificateSecuredConnectorURL(
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ManagementPlaneInfo",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CertificateSecuredConnectorURL, nil
+		return ec.resolvers.Query().Configuration(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*Configuration)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐConfiguration(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_signCertificateSigningRequest(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_issuedCertificates(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -718,23 +1529,16 @@ func (ec *executionContext) _Mutation_signCertificateSigningRequest(ctx context.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Mutation_signCertificateSigningRequest_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().SignCertificateSigningRequest(rctx, args["csr"].(string))
+		return ec.resolvers.Query().IssuedCertificates(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -746,12 +1550,12 @@ func (ec *executionContext) _Mutation_signCertificateSigningRequest(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*CertificationResult)
+	res := resTmp.([]*IssuedCertificate)
 	fc.Result = res
-	return ec.marshalNCertificationResult2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificationResult(ctx, field.Selections, res)
+	return ec.marshalNIssuedCertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificateᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Mutation_revokeCertificate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_issuedCertificate(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -759,33 +1563,37 @@ func (ec *executionContext) _Mutation_revokeCertificate(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Mutation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_issuedCertificate_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RevokeCertificate(rctx)
+		return ec.resolvers.Query().IssuedCertificate(rctx, args["serialNumber"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*IssuedCertificate)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_configuration(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_certificateAuthorities(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -802,7 +1610,7 @@ func (ec *executionContext) _Query_configuration(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Configuration(rctx)
+		return ec.resolvers.Query().CertificateAuthorities(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -814,9 +1622,9 @@ func (ec *executionContext) _Query_configuration(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*Configuration)
+	res := resTmp.([]*CertificateAuthority)
 	fc.Result = res
-	return ec.marshalNConfiguration2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐConfiguration(ctx, field.Selections, res)
+	return ec.marshalNCertificateAuthority2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateAuthorityᚄ(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
@@ -1985,6 +2793,53 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 
 // region    **************************** object.gotpl ****************************
 
+var certificateAuthorityImplementors = []string{"CertificateAuthority"}
+
+func (ec *executionContext) _CertificateAuthority(ctx context.Context, sel ast.SelectionSet, obj *CertificateAuthority) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, certificateAuthorityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CertificateAuthority")
+		case "serialNumber":
+			out.Values[i] = ec._CertificateAuthority_serialNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subject":
+			out.Values[i] = ec._CertificateAuthority_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "notBefore":
+			out.Values[i] = ec._CertificateAuthority_notBefore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "notAfter":
+			out.Values[i] = ec._CertificateAuthority_notAfter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "active":
+			out.Values[i] = ec._CertificateAuthority_active(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var certificateSigningRequestInfoImplementors = []string{"CertificateSigningRequestInfo"}
 
 func (ec *executionContext) _CertificateSigningRequestInfo(ctx context.Context, sel ast.SelectionSet, obj *CertificateSigningRequestInfo) graphql.Marshaler {
@@ -2082,6 +2937,66 @@ func (ec *executionContext) _Configuration(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var issuedCertificateImplementors = []string{"IssuedCertificate"}
+
+func (ec *executionContext) _IssuedCertificate(ctx context.Context, sel ast.SelectionSet, obj *IssuedCertificate) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, issuedCertificateImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IssuedCertificate")
+		case "serialNumber":
+			out.Values[i] = ec._IssuedCertificate_serialNumber(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "subject":
+			out.Values[i] = ec._IssuedCertificate_subject(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "consumerID":
+			out.Values[i] = ec._IssuedCertificate_consumerID(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "consumerType":
+			out.Values[i] = ec._IssuedCertificate_consumerType(ctx, field, obj)
+		case "tenant":
+			out.Values[i] = ec._IssuedCertificate_tenant(ctx, field, obj)
+		case "notBefore":
+			out.Values[i] = ec._IssuedCertificate_notBefore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "notAfter":
+			out.Values[i] = ec._IssuedCertificate_notAfter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sha256Hash":
+			out.Values[i] = ec._IssuedCertificate_sha256Hash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "revokedAt":
+			out.Values[i] = ec._IssuedCertificate_revokedAt(ctx, field, obj)
+		case "caSerialNumber":
+			out.Values[i] = ec._IssuedCertificate_caSerialNumber(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var managementPlaneInfoImplementors = []string{"ManagementPlaneInfo"}
 
 func (ec *executionContext) _ManagementPlaneInfo(ctx context.Context, sel ast.SelectionSet, obj *ManagementPlaneInfo) graphql.Marshaler {
@@ -2173,6 +3088,45 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				}
 				return res
 			})
+		case "issuedCertificates":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_issuedCertificates(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
+		case "issuedCertificate":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_issuedCertificate(ctx, field)
+				return res
+			})
+		case "certificateAuthorities":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_certificateAuthorities(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&invalids, 1)
+				}
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -2474,6 +3428,57 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCertificateAuthority2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateAuthority(ctx context.Context, sel ast.SelectionSet, v CertificateAuthority) graphql.Marshaler {
+	return ec._CertificateAuthority(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCertificateAuthority2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateAuthorityᚄ(ctx context.Context, sel ast.SelectionSet, v []*CertificateAuthority) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCertificateAuthority2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateAuthority(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNCertificateAuthority2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificateAuthority(ctx context.Context, sel ast.SelectionSet, v *CertificateAuthority) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CertificateAuthority(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNCertificationResult2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐCertificationResult(ctx context.Context, sel ast.SelectionSet, v CertificationResult) graphql.Marshaler {
 	return ec._CertificationResult(ctx, sel, &v)
 }
@@ -2502,6 +3507,57 @@ func (ec *executionContext) marshalNConfiguration2ᚖgithubᚗcomᚋkymaᚑincub
 	return ec._Configuration(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNIssuedCertificate2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v IssuedCertificate) graphql.Marshaler {
+	return ec._IssuedCertificate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNIssuedCertificate2ᚕᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificateᚄ(ctx context.Context, sel ast.SelectionSet, v []*IssuedCertificate) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v *IssuedCertificate) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._IssuedCertificate(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -2776,6 +3832,17 @@ func (ec *executionContext) marshalOCertificateSigningRequestInfo2ᚖgithubᚗco
 	return ec._CertificateSigningRequestInfo(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOIssuedCertificate2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v IssuedCertificate) graphql.Marshaler {
+	return ec._IssuedCertificate(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOIssuedCertificate2ᚖgithubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐIssuedCertificate(ctx context.Context, sel ast.SelectionSet, v *IssuedCertificate) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._IssuedCertificate(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOManagementPlaneInfo2githubᚗcomᚋkymaᚑincubatorᚋcompassᚋcomponentsᚋconnectorᚋpkgᚋgraphqlᚋexternalschemaᚐManagementPlaneInfo(ctx context.Context, sel ast.SelectionSet, v ManagementPlaneInfo) graphql.Marshaler {
 	return ec._ManagementPlaneInfo(ctx, sel, &v)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
@@ -37,10 +38,13 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	operationdomain "github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/schema"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
@@ -51,6 +55,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/metrics"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/oathkeeper"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
 	"github.com/kyma-incubator/compass/components/director/internal/packagetobundles"
 	panichandler "github.com/kyma-incubator/compass/components/director/internal/panic_handler"
 	"github.com/kyma-incubator/compass/components/director/internal/runtimemapping"
@@ -77,6 +82,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/scope"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	directorTime "github.com/kyma-incubator/compass/components/director/pkg/time"
+	pkgwebhook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
 	"github.com/kyma-incubator/compass/components/operations-controller/client"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -98,13 +104,16 @@ type config struct {
 	ServerTimeout time.Duration `envconfig:"default=110s"`
 
 	Database                      persistence.DatabaseConfig
-	APIEndpoint                   string `envconfig:"default=/graphql"`
-	TenantMappingEndpoint         string `envconfig:"default=/tenant-mapping"`
-	RuntimeMappingEndpoint        string `envconfig:"default=/runtime-mapping"`
-	AuthenticationMappingEndpoint string `envconfig:"default=/authn-mapping/{authenticator}"`
-	OperationPath                 string `envconfig:"default=/operation"`
-	LastOperationPath             string `envconfig:"default=/last_operation"`
-	PlaygroundAPIEndpoint         string `envconfig:"default=/graphql"`
+	APIEndpoint                   string   `envconfig:"default=/graphql"`
+	TenantMappingEndpoint         string   `envconfig:"default=/tenant-mapping"`
+	RuntimeMappingEndpoint        string   `envconfig:"default=/runtime-mapping"`
+	AuthenticationMappingEndpoint string   `envconfig:"default=/authn-mapping/{authenticator}"`
+	OperationPath                 string   `envconfig:"default=/operation"`
+	LastOperationPath             string   `envconfig:"default=/last_operation"`
+	ORDAggregationTriggerEndpoint string   `envconfig:"default=/ord-aggregation-trigger"`
+	ORDValidationEndpoint         string   `envconfig:"default=/ord/validate"`
+	ORDValidationBlockedCIDRs     []string `envconfig:"optional,APP_ORD_VALIDATION_BLOCKED_CIDRS"`
+	PlaygroundAPIEndpoint         string   `envconfig:"default=/graphql"`
 	ConfigurationFile             string
 	ConfigurationFileReload       time.Duration `envconfig:"default=1m"`
 
@@ -196,6 +205,8 @@ func main() {
 	}
 
 	appRepo := applicationRepo()
+	runtimeRepo := runtime.NewRepository(runtime.NewConverter())
+	biaRepo := bundleinstanceauth.NewRepository(bundleinstanceauth.NewConverter(auth.NewConverter()))
 
 	adminURL, err := url.Parse(cfg.OAuth20.URL)
 	exitOnError(err, "Error while parsing Hydra URL")
@@ -205,6 +216,12 @@ func main() {
 
 	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache)
 
+	operationFetcher, err := buildOperationFetcher(ctx, cfg)
+	exitOnError(err, "Error while creating operations fetcher")
+
+	operationTransitioner, err := buildOperationTransitioner(ctx, cfg, appRepo, runtimeRepo, biaRepo)
+	exitOnError(err, "Error while creating operations transitioner")
+
 	rootResolver := domain.NewRootResolver(
 		&normalizer.DefaultNormalizator{},
 		transact,
@@ -221,12 +238,14 @@ func main() {
 		adminURL,
 		accessStrategyExecutorProvider,
 		certCache,
+		operationFetcher,
+		operationTransitioner,
 	)
 
 	gqlCfg := graphql.Config{
 		Resolvers: rootResolver,
 		Directives: graphql.DirectiveRoot{
-			Async:       getAsyncDirective(ctx, cfg, transact, appRepo),
+			Async:       getAsyncDirective(ctx, cfg, transact, appRepo, runtimeRepo, biaRepo),
 			HasScenario: scenario.NewDirective(transact, label.NewRepository(label.NewConverter()), bundleRepo(), bundleInstanceAuthRepo()).HasScenario,
 			HasScopes:   scope.NewDirective(cfgProvider, &scope.HasScopesErrorProvider{}).VerifyScopes,
 			Sanitize:    scope.NewDirective(cfgProvider, &scope.SanitizeErrorProvider{}).VerifyScopes,
@@ -272,6 +291,8 @@ func main() {
 	gqlAPIRouter.Use(dataloader.HandlerFetchRequestAPIDef(rootResolver.FetchRequestAPIDefDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 	gqlAPIRouter.Use(dataloader.HandlerFetchRequestEventDef(rootResolver.FetchRequestEventDefDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 	gqlAPIRouter.Use(dataloader.HandlerFetchRequestDocument(rootResolver.FetchRequestDocumentDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerSpecRevisionAPIDef(rootResolver.SpecRevisionAPIDefDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
+	gqlAPIRouter.Use(dataloader.HandlerSpecRevisionEventDef(rootResolver.SpecRevisionEventDefDataloader, cfg.DataloaderMaxBatch, cfg.DataloaderWait))
 
 	operationMiddleware := operation.NewMiddleware(cfg.AppURL + cfg.LastOperationPath)
 
@@ -298,21 +319,41 @@ func main() {
 
 	mainRouter.HandleFunc(cfg.AuthenticationMappingEndpoint, authnMappingHandlerFunc.ServeHTTP)
 
-	operationHandler := operation.NewHandler(transact, func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
-		return appRepo.GetByID(ctx, tenantID, resourceID)
-	}, tenant.LoadFromContext)
+	operationHandler := operation.NewHandler(transact, resourceFetcherFuncs(appRepo, runtimeRepo, biaRepo), tenant.LoadFromContext)
 
 	operationsAPIRouter := mainRouter.PathPrefix(cfg.LastOperationPath).Subrouter()
 	operationsAPIRouter.Use(authMiddleware.Handler())
 	operationsAPIRouter.HandleFunc("/{resource_type}/{resource_id}", operationHandler.ServeHTTP)
 
-	operationUpdaterHandler := operation.NewUpdateOperationHandler(transact, map[resource.Type]operation.ResourceUpdaterFunc{
-		resource.Application: appUpdaterFunc(appRepo),
-	}, map[resource.Type]operation.ResourceDeleterFunc{
+	logger.Infof("Registering ORD Aggregation Trigger endpoint on %s...", cfg.ORDAggregationTriggerEndpoint)
+	ordAggregationTriggerHandler := ordaggregationrequest.NewHandler(transact, ordAggregationRequestService(), cfgProvider)
+
+	ordAggregationTriggerRouter := mainRouter.PathPrefix(cfg.ORDAggregationTriggerEndpoint).Subrouter()
+	ordAggregationTriggerRouter.Use(authMiddleware.Handler())
+	ordAggregationTriggerRouter.HandleFunc("", ordAggregationTriggerHandler.ServeHTTP)
+
+	logger.Infof("Registering ORD Validation endpoint on %s...", cfg.ORDValidationEndpoint)
+	// Only the open access strategy is supported, so that the Director credentials are never used for fetching the documents of arbitrary URLs.
+	ordDryRunAccessStrategyExecutorProvider := accessstrategy.NewExecutorProvider(map[accessstrategy.Type]accessstrategy.Executor{
+		accessstrategy.OpenAccessStrategy: accessstrategy.NewOpenAccessStrategyExecutor(),
+	})
+	ordDryRunHTTPClient, err := ord.NewDryRunHTTPClient(cfg.ClientTimeout, cfg.ORDValidationBlockedCIDRs)
+	exitOnError(err, "Error while creating ORD validation HTTP client")
+	ordValidationHandler := ord.NewDryRunHandler(ord.NewDryRunValidator(ord.NewClient(ordDryRunHTTPClient, ordDryRunAccessStrategyExecutorProvider)), cfgProvider)
+
+	ordValidationRouter := mainRouter.PathPrefix(cfg.ORDValidationEndpoint).Subrouter()
+	ordValidationRouter.Use(authMiddleware.Handler())
+	ordValidationRouter.HandleFunc("", ordValidationHandler.ServeHTTP)
+
+	operationUpdaterHandler := operation.NewUpdateOperationHandler(transact, resourceUpdaterFuncs(appRepo, runtimeRepo, biaRepo), map[resource.Type]operation.ResourceDeleterFunc{
 		resource.Application: func(ctx context.Context, id string) error {
 			return appRepo.DeleteGlobal(ctx, id)
 		},
-	})
+		resource.Runtime: rootResolver.FinalizeRuntimeDeletion,
+		resource.BundleInstanceAuth: func(ctx context.Context, id string) error {
+			return biaRepo.DeleteGlobal(ctx, id)
+		},
+	}, operationFetcher)
 
 	internalRouter := mux.NewRouter()
 	internalRouter.Use(correlation.AttachCorrelationIDToContext(), log.RequestLogger(), header.AttachHeadersToContext())
@@ -549,6 +590,29 @@ func applicationRepo() application.ApplicationRepository {
 	return application.NewRepository(appConverter)
 }
 
+func ordAggregationRequestService() ordaggregationrequest.RequestService {
+	authConverter := auth.NewConverter()
+
+	versionConverter := version.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	specConverter := spec.NewConverter(frConverter)
+
+	apiConverter := api.NewConverter(versionConverter, specConverter)
+	eventAPIConverter := eventdef.NewConverter(versionConverter, specConverter)
+	docConverter := document.NewConverter(frConverter)
+
+	webhookConverter := webhook.NewConverter(authConverter)
+	bundleConverter := bundle.NewConverter(authConverter, apiConverter, eventAPIConverter, docConverter)
+
+	appConverter := application.NewConverter(webhookConverter, bundleConverter)
+	appRepo := application.NewRepository(appConverter)
+	appTemplateRepo := apptemplate.NewRepository(apptemplate.NewConverter(appConverter, webhookConverter))
+
+	requestRepo := ordaggregationrequest.NewRepository(ordaggregationrequest.NewConverter())
+
+	return ordaggregationrequest.NewService(requestRepo, appRepo, appTemplateRepo, uid.NewService())
+}
+
 func webhookService() webhook.WebhookService {
 	uidSvc := uid.NewService()
 	authConverter := auth.NewConverter()
@@ -600,7 +664,8 @@ func tokenService(cfg config, cfgProvider *configprovider.Provider, httpClient,
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient, accessStrategyExecutorProvider)
 	eventAPIRepo := eventdef.NewRepository(eventAPIConverter)
 	specRepo := spec.NewRepository(specConverter)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specRevisionSvc := specrevision.NewService(specrevision.NewRepository(specrevision.NewConverter()), uidSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, specRevisionSvc)
 	bundleReferenceRepo := bundlereferences.NewRepository(bundleReferenceConv)
 	bundleReferenceSvc := bundlereferences.NewService(bundleReferenceRepo, uidSvc)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc, bundleReferenceSvc)
@@ -642,15 +707,74 @@ func PrepareHydratorHandler(cfg config, tokenService oathkeeper.SystemAuthServic
 	return handlerWithTimeout, nil
 }
 
-func getAsyncDirective(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
-	resourceFetcherFunc := func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
-		return appRepo.GetByID(ctx, tenantID, resourceID)
-	}
-
+func getAsyncDirective(ctx context.Context, cfg config, transact persistence.Transactioner, appRepo application.ApplicationRepository, runtimeRepo runtimeOperationRepository, bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) func(context.Context, interface{}, gqlgen.Resolver, graphql.OperationType, *graphql.WebhookType, *string) (res interface{}, err error) {
 	scheduler, err := buildScheduler(ctx, cfg)
 	exitOnError(err, "Error while creating operations scheduler")
 
-	return operation.NewDirective(transact, webhookService().ListAllApplicationWebhooks, resourceFetcherFunc, appUpdaterFunc(appRepo), tenant.LoadFromContext, scheduler).HandleOperation
+	appConverter := application.NewConverter(webhook.NewConverter(auth.NewConverter()), nil)
+	ownerIDFunc := bundleInstanceAuthOwnerIDFunc(bundleInstanceAuthRepo)
+
+	ownerFetcherFuncs := map[resource.Type]operation.OwnerFetcherFunc{
+		resource.BundleInstanceAuth: func(ctx context.Context, resourceID string) (pkgwebhook.Resource, error) {
+			appID, err := ownerIDFunc(ctx, resourceID)
+			if err != nil {
+				return nil, err
+			}
+
+			app, err := appRepo.GetGlobalByID(ctx, appID)
+			if err != nil {
+				return nil, err
+			}
+
+			return appConverter.ToGraphQL(app), nil
+		},
+	}
+
+	return operation.NewDirective(transact, webhookFetcherFuncs(bundleInstanceAuthRepo), resourceFetcherFuncs(appRepo, runtimeRepo, bundleInstanceAuthRepo), resourceUpdaterFuncs(appRepo, runtimeRepo, bundleInstanceAuthRepo), ownerFetcherFuncs, tenant.LoadFromContext, scheduler).HandleOperation
+}
+
+// bundleInstanceAuthOwnerIDFunc returns a function which fetches the ID of the application owning a bundle instance auth.
+// The credentials for a bundle instance auth are issued by the application which owns the bundle,
+// so the webhooks of that application are executed for the bundle instance auth operations
+func bundleInstanceAuthOwnerIDFunc(bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) func(ctx context.Context, bundleInstanceAuthID string) (string, error) {
+	bndlRepo := bundleRepo()
+
+	return func(ctx context.Context, bundleInstanceAuthID string) (string, error) {
+		bundleInstanceAuth, err := bundleInstanceAuthRepo.GetGlobalByID(ctx, bundleInstanceAuthID)
+		if err != nil {
+			return "", err
+		}
+
+		tnt, err := tenant.LoadFromContext(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		bndl, err := bndlRepo.GetByID(ctx, tnt, bundleInstanceAuth.BundleID)
+		if err != nil {
+			return "", err
+		}
+
+		return bndl.ApplicationID, nil
+	}
+}
+
+func webhookFetcherFuncs(bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) map[resource.Type]operation.WebhookFetcherFunc {
+	webhookSvc := webhookService()
+	ownerIDFunc := bundleInstanceAuthOwnerIDFunc(bundleInstanceAuthRepo)
+
+	return map[resource.Type]operation.WebhookFetcherFunc{
+		resource.Application: webhookSvc.ListAllApplicationWebhooks,
+		resource.Runtime:     webhookSvc.ListForRuntime,
+		resource.BundleInstanceAuth: func(ctx context.Context, resourceID string) ([]*model.Webhook, error) {
+			appID, err := ownerIDFunc(ctx, resourceID)
+			if err != nil {
+				return nil, err
+			}
+
+			return webhookSvc.ListAllApplicationWebhooks(ctx, appID)
+		},
+	}
 }
 
 func buildScheduler(ctx context.Context, config config) (operation.Scheduler, error) {
@@ -659,6 +783,45 @@ func buildScheduler(ctx context.Context, config config) (operation.Scheduler, er
 		return &operation.DisabledScheduler{}, nil
 	}
 
+	operationsK8sClient, err := buildOperationsK8sClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return k8s.NewScheduler(operationsK8sClient), nil
+}
+
+func buildOperationFetcher(ctx context.Context, config config) (operationdomain.Fetcher, error) {
+	if config.DisableAsyncMode {
+		log.C(ctx).Info("Async operations are disabled, no operations will be fetched")
+		return &operation.DisabledFetcher{}, nil
+	}
+
+	operationsK8sClient, err := buildOperationsK8sClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return k8s.NewFetcher(operationsK8sClient), nil
+}
+
+func buildOperationTransitioner(ctx context.Context, config config, appRepo application.ApplicationRepository, runtimeRepo runtimeOperationRepository, bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) (operationdomain.Transitioner, error) {
+	var updater operation.Updater = &operation.DisabledUpdater{}
+	if config.DisableAsyncMode {
+		log.C(ctx).Info("Async operations are disabled, no operations will be updated")
+	} else {
+		operationsK8sClient, err := buildOperationsK8sClient(config)
+		if err != nil {
+			return nil, err
+		}
+
+		updater = k8s.NewUpdater(operationsK8sClient)
+	}
+
+	return operation.NewTransitioner(webhookFetcherFuncs(bundleInstanceAuthRepo), resourceUpdaterFuncs(appRepo, runtimeRepo, bundleInstanceAuthRepo), updater), nil
+}
+
+func buildOperationsK8sClient(config config) (client.OperationsInterface, error) {
 	cfg, err := cr.GetConfig()
 	exitOnError(err, "Failed to get cluster config for operations k8s client")
 
@@ -667,9 +830,8 @@ func buildScheduler(ctx context.Context, config config) (operation.Scheduler, er
 	if err != nil {
 		return nil, err
 	}
-	operationsK8sClient := k8sClient.Operations(config.OperationsNamespace)
 
-	return k8s.NewScheduler(operationsK8sClient), nil
+	return k8sClient.Operations(config.OperationsNamespace), nil
 }
 
 func appUpdaterFunc(appRepo application.ApplicationRepository) operation.ResourceUpdaterFunc {
@@ -688,6 +850,104 @@ func appUpdaterFunc(appRepo application.ApplicationRepository) operation.Resourc
 	}
 }
 
+// runtimeOperationRepository is the part of the runtime repository needed for processing asynchronous runtime operations
+type runtimeOperationRepository interface {
+	GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error)
+	GetGlobalByID(ctx context.Context, id string) (*model.Runtime, error)
+	TechnicalUpdate(ctx context.Context, item *model.Runtime) error
+	DeleteGlobal(ctx context.Context, id string) error
+}
+
+// bundleInstanceAuthOperationRepository is the part of the bundle instance auth repository needed for processing asynchronous bundle instance auth operations
+type bundleInstanceAuthOperationRepository interface {
+	GetByID(ctx context.Context, tenantID string, id string) (*model.BundleInstanceAuth, error)
+	GetGlobalByID(ctx context.Context, id string) (*model.BundleInstanceAuth, error)
+	TechnicalUpdate(ctx context.Context, item *model.BundleInstanceAuth) error
+	DeleteGlobal(ctx context.Context, id string) error
+}
+
+func resourceFetcherFuncs(appRepo application.ApplicationRepository, runtimeRepo runtimeOperationRepository, bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) map[resource.Type]operation.ResourceFetcherFunc {
+	return map[resource.Type]operation.ResourceFetcherFunc{
+		resource.Application: func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
+			return appRepo.GetByID(ctx, tenantID, resourceID)
+		},
+		resource.Runtime: func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
+			return runtimeRepo.GetByID(ctx, tenantID, resourceID)
+		},
+		resource.BundleInstanceAuth: func(ctx context.Context, tenantID, resourceID string) (model.Entity, error) {
+			return bundleInstanceAuthRepo.GetByID(ctx, tenantID, resourceID)
+		},
+	}
+}
+
+func resourceUpdaterFuncs(appRepo application.ApplicationRepository, runtimeRepo runtimeOperationRepository, bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) map[resource.Type]operation.ResourceUpdaterFunc {
+	return map[resource.Type]operation.ResourceUpdaterFunc{
+		resource.Application:        appUpdaterFunc(appRepo),
+		resource.Runtime:            runtimeUpdaterFunc(runtimeRepo),
+		resource.BundleInstanceAuth: bundleInstanceAuthUpdaterFunc(bundleInstanceAuthRepo),
+	}
+}
+
+func runtimeUpdaterFunc(runtimeRepo runtimeOperationRepository) operation.ResourceUpdaterFunc {
+	return func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		rt, err := runtimeRepo.GetGlobalByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		condition := model.RuntimeStatusConditionInitial
+		if rt.Status != nil {
+			condition = rt.Status.Condition
+		}
+
+		switch appStatusCondition {
+		case model.ApplicationStatusConditionCreating, model.ApplicationStatusConditionUpdating:
+			condition = model.RuntimeStatusConditionProvisioning
+		case model.ApplicationStatusConditionCreateFailed, model.ApplicationStatusConditionUpdateFailed, model.ApplicationStatusConditionDeleteFailed:
+			condition = model.RuntimeStatusConditionFailed
+		case model.ApplicationStatusConditionCreateSucceeded, model.ApplicationStatusConditionUpdateSucceeded:
+			if condition == model.RuntimeStatusConditionProvisioning {
+				condition = model.RuntimeStatusConditionInitial
+			}
+		}
+
+		rt.Status = &model.RuntimeStatus{
+			Condition: condition,
+			Timestamp: time.Now(),
+		}
+		rt.Ready = ready
+		rt.Error = errorMsg
+		return runtimeRepo.TechnicalUpdate(ctx, rt)
+	}
+}
+
+func bundleInstanceAuthUpdaterFunc(bundleInstanceAuthRepo bundleInstanceAuthOperationRepository) operation.ResourceUpdaterFunc {
+	return func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		bundleInstanceAuth, err := bundleInstanceAuthRepo.GetGlobalByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		switch appStatusCondition {
+		case model.ApplicationStatusConditionCreateFailed, model.ApplicationStatusConditionDeleteFailed:
+			message := "Asynchronous operation failed"
+			if errorMsg != nil {
+				message = *errorMsg
+			}
+			bundleInstanceAuth.Status = &model.BundleInstanceAuthStatus{
+				Condition: model.BundleInstanceAuthStatusConditionFailed,
+				Timestamp: time.Now(),
+				Message:   message,
+				Reason:    string(appStatusCondition),
+			}
+		}
+
+		bundleInstanceAuth.Ready = ready
+		bundleInstanceAuth.Error = errorMsg
+		return bundleInstanceAuthRepo.TechnicalUpdate(ctx, bundleInstanceAuth)
+	}
+}
+
 func runtimeSvc(cfg config) claims.RuntimeService {
 	uidSvc := uid.NewService()
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/certloader"
@@ -18,6 +19,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/api"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/application"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/apptemplate"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
 	bundleutil "github.com/kyma-incubator/compass/components/director/internal/domain/bundle"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/document"
@@ -26,11 +28,14 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/integrationsystem"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/label"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/ordvendor"
 	ordpackage "github.com/kyma-incubator/compass/components/director/internal/domain/package"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/product"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tombstone"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/webhook"
@@ -42,17 +47,30 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/normalizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/pkg/errors"
 	"github.com/vrischmann/envconfig"
 )
 
+const (
+	// fullSyncMode aggregates the ORD documents of all applications once and exits.
+	fullSyncMode = "full-sync"
+	// onDemandMode keeps polling for on-demand ORD aggregation requests and aggregates the ORD documents of the requested applications only.
+	onDemandMode = "on-demand"
+)
+
 type config struct {
+	Mode                            string        `envconfig:"default=full-sync"`
+	AggregationRequestsPollInterval time.Duration `envconfig:"default=5s"`
+
 	Database persistence.DatabaseConfig
 
 	Log log.Config
 
 	Features features.Config
 
+	ORDAggregator ord.ServiceConfig
+
 	ConfigurationFile       string
 	ConfigurationFileReload time.Duration `envconfig:"default=1m"`
 
@@ -63,11 +81,15 @@ type config struct {
 }
 
 func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	cfg := config{}
 	err := envconfig.InitWithPrefix(&cfg, "APP")
 	exitOnError(err, "Error while loading app config")
+	exitOnError(cfg.ORDAggregator.Validate(), "Invalid ORD aggregator config")
 
-	ctx, err := log.Configure(context.Background(), &cfg.Log)
+	ctx, err = log.Configure(ctx, &cfg.Log)
 	exitOnError(err, "Error while configuring logger")
 
 	cfgProvider := createAndRunConfigProvider(ctx, cfg)
@@ -94,14 +116,32 @@ func main() {
 
 	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache)
 
-	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg.Features, transact, httpClient, accessStrategyExecutorProvider)
-	err = ordAggregator.SyncORDDocuments(ctx)
-	exitOnError(err, "Error while synchronizing Open Resource Discovery Documents")
+	ordAggregator := createORDAggregatorSvc(cfgProvider, cfg.ORDAggregator, cfg.Features, transact, httpClient, accessStrategyExecutorProvider)
 
-	log.C(ctx).Info("Successfully synchronized Open Resource Discovery Documents")
+	switch cfg.Mode {
+	case fullSyncMode:
+		err = ordAggregator.SyncORDDocuments(ctx)
+		exitOnError(err, "Error while synchronizing Open Resource Discovery Documents")
+
+		log.C(ctx).Info("Successfully synchronized Open Resource Discovery Documents")
+	case onDemandMode:
+		term := make(chan os.Signal)
+		signal.HandleInterrupts(ctx, cancel, term)
+
+		log.C(ctx).Infof("Processing on-demand Open Resource Discovery aggregation requests every %s", cfg.AggregationRequestsPollInterval)
+		executor.NewPeriodic(cfg.AggregationRequestsPollInterval, func(ctx context.Context) {
+			if err := ordAggregator.ProcessAggregationRequests(ctx); err != nil {
+				log.C(ctx).WithError(err).Errorf("Error while processing on-demand Open Resource Discovery aggregation requests: %v", err)
+			}
+		}).Run(ctx)
+
+		<-ctx.Done()
+	default:
+		exitOnError(errors.Errorf("unknown mode %q, expected one of %q and %q", cfg.Mode, fullSyncMode, onDemandMode), "Error while starting the ORD aggregator")
+	}
 }
 
-func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig features.Config, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutorProvider *accessstrategy.Provider) *ord.Service {
+func createORDAggregatorSvc(cfgProvider *configprovider.Provider, aggregatorConfig ord.ServiceConfig, featuresConfig features.Config, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutorProvider *accessstrategy.Provider) *ord.Service {
 	authConverter := auth.NewConverter()
 	frConverter := fetchrequest.NewConverter(authConverter)
 	versionConverter := version.NewConverter()
@@ -121,6 +161,9 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	tombstoneConverter := tombstone.NewConverter()
 	runtimeConverter := runtime.NewConverter()
 	bundleReferenceConv := bundlereferences.NewConverter()
+	aggregationStatusConverter := ordaggregationstatus.NewConverter()
+	aggregationRequestConverter := ordaggregationrequest.NewConverter()
+	appTemplateConverter := apptemplate.NewConverter(appConverter, webhookConverter)
 
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
 	applicationRepo := application.NewRepository(appConverter)
@@ -139,6 +182,9 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	vendorRepo := ordvendor.NewRepository(vendorConverter)
 	tombstoneRepo := tombstone.NewRepository(tombstoneConverter)
 	bundleReferenceRepo := bundlereferences.NewRepository(bundleReferenceConv)
+	aggregationStatusRepo := ordaggregationstatus.NewRepository(aggregationStatusConverter)
+	aggregationRequestRepo := ordaggregationrequest.NewRepository(aggregationRequestConverter)
+	appTemplateRepo := apptemplate.NewRepository(appTemplateConverter)
 
 	uidSvc := uid.NewService()
 	labelSvc := label.NewLabelService(labelRepo, labelDefRepo, uidSvc)
@@ -147,7 +193,8 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	tenantRepo := tenant.NewRepository(tenant.NewConverter())
 	scenariosSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, tenantRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient, accessStrategyExecutorProvider)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specRevisionSvc := specrevision.NewService(specrevision.NewRepository(specrevision.NewConverter()), uidSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, specRevisionSvc)
 	bundleReferenceSvc := bundlereferences.NewService(bundleReferenceRepo, uidSvc)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc, bundleReferenceSvc)
 	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc, bundleReferenceSvc)
@@ -160,10 +207,12 @@ func createORDAggregatorSvc(cfgProvider *configprovider.Provider, featuresConfig
 	vendorSvc := ordvendor.NewService(vendorRepo, uidSvc)
 	tombstoneSvc := tombstone.NewService(tombstoneRepo, uidSvc)
 	tenantSvc := tenant.NewService(tenantRepo, uidSvc)
+	aggregationStatusSvc := ordaggregationstatus.NewService(aggregationStatusRepo, uidSvc)
+	aggregationRequestSvc := ordaggregationrequest.NewService(aggregationRequestRepo, applicationRepo, appTemplateRepo, uidSvc)
 
 	ordClient := ord.NewClient(httpClient, accessStrategyExecutorProvider)
 
-	return ord.NewAggregatorService(transact, labelRepo, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, ordClient)
+	return ord.NewAggregatorService(aggregatorConfig, transact, labelRepo, appSvc, webhookSvc, bundleSvc, bundleReferenceSvc, apiSvc, eventAPISvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, aggregationStatusSvc, aggregationRequestSvc, ordClient)
 }
 
 func createAndRunConfigProvider(ctx context.Context, cfg config) *configprovider.Provider {
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	exitCodeInvalid = 1
+	exitCodeError   = 2
+)
+
+const usage = `Validates ORD documents the same way the ORD Aggregator does, without persisting anything.
+
+Usage:
+  ordvalidator -url <well-known URL>
+  ordvalidator [-config <well-known config file>] <document file>...
+
+The validation report is printed to the standard output. The exit code is 0 if the documents are valid,
+1 if they are invalid, and 2 if the validation could not be run.
+
+Flags:
+`
+
+func main() {
+	wellKnownURL := flag.String("url", "", "URL of the ORD well-known configuration, whose documents are fetched and validated. Only the open access strategy is supported.")
+	configFile := flag.String("config", "", "Path to the ORD well-known configuration, whose baseURL is used for the validation of the document files.")
+	timeout := flag.Duration("timeout", 30*time.Second, "Timeout of the requests for fetching the ORD well-known configuration and documents.")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx, err := log.Configure(context.Background(), &log.Config{
+		Level:                  "warn",
+		Format:                 "text",
+		Output:                 os.Stderr.Name(),
+		BootstrapCorrelationID: "ordvalidator",
+	})
+	exitOnError(err, "Error while configuring logger")
+
+	if (len(*wellKnownURL) > 0) == (flag.NArg() > 0) || (len(*wellKnownURL) > 0 && len(*configFile) > 0) {
+		flag.Usage()
+		os.Exit(exitCodeError)
+	}
+
+	httpClient := &http.Client{
+		Timeout: *timeout,
+	}
+	accessStrategyExecutorProvider := accessstrategy.NewExecutorProvider(map[accessstrategy.Type]accessstrategy.Executor{
+		accessstrategy.OpenAccessStrategy: accessstrategy.NewOpenAccessStrategyExecutor(),
+	})
+	validator := ord.NewDryRunValidator(ord.NewClient(httpClient, accessStrategyExecutorProvider))
+
+	var report *ord.ValidationReport
+	if len(*wellKnownURL) > 0 {
+		report = validator.ValidateWellKnownURL(ctx, *wellKnownURL)
+	} else {
+		config, docs, err := readFiles(*configFile, flag.Args())
+		exitOnError(err, "Error while reading input files")
+
+		report = validator.ValidateDocuments(ctx, config, docs)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(report)
+	exitOnError(err, "Error while printing validation report")
+
+	if !report.Valid {
+		os.Exit(exitCodeInvalid)
+	}
+}
+
+func readFiles(configFile string, docFiles []string) (*ord.WellKnownConfig, ord.Documents, error) {
+	var config *ord.WellKnownConfig
+	if len(configFile) > 0 {
+		config = &ord.WellKnownConfig{}
+		if err := readJSONFile(configFile, config); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	docs := make(ord.Documents, 0, len(docFiles))
+	for _, docFile := range docFiles {
+		doc := &ord.Document{}
+		if err := readJSONFile(docFile, doc); err != nil {
+			return nil, nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	return config, docs, nil
+}
+
+func readJSONFile(path string, out interface{}) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "while reading file %s", path)
+	}
+	if err := json.Unmarshal(content, out); err != nil {
+		return errors.Wrapf(err, "while unmarshalling file %s", path)
+	}
+	return nil
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", context, err)
+		os.Exit(exitCodeError)
+	}
+}
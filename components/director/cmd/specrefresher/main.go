@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/auth"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/fetchrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefresher"
+	"github.com/kyma-incubator/compass/components/director/internal/uid"
+	"github.com/kyma-incubator/compass/components/director/pkg/accessstrategy"
+	"github.com/kyma-incubator/compass/components/director/pkg/certloader"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+	"github.com/vrischmann/envconfig"
+)
+
+type config struct {
+	Database persistence.DatabaseConfig
+
+	Log log.Config
+
+	SpecRefresher specrefresher.Config
+
+	ClientTimeout     time.Duration `envconfig:"default=60s"`
+	SkipSSLValidation bool          `envconfig:"default=false"`
+
+	CertLoaderConfig certloader.Config
+}
+
+func main() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	exitOnError(err, "Error while loading app config")
+	exitOnError(cfg.SpecRefresher.Validate(), "Invalid spec refresher config")
+
+	ctx, err = log.Configure(ctx, &cfg.Log)
+	exitOnError(err, "Error while configuring logger")
+
+	transact, closeFunc, err := persistence.Configure(ctx, cfg.Database)
+	exitOnError(err, "Error while establishing the connection to the database")
+
+	defer func() {
+		err := closeFunc()
+		exitOnError(err, "Error while closing the connection to the database")
+	}()
+
+	httpClient := &http.Client{
+		Timeout: cfg.ClientTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: cfg.SkipSSLValidation,
+			},
+		},
+	}
+
+	certCache, err := certloader.StartCertLoader(ctx, cfg.CertLoaderConfig)
+	exitOnError(err, "Failed to initialize certificate loader")
+
+	accessStrategyExecutorProvider := accessstrategy.NewDefaultExecutorProvider(certCache)
+
+	specRefresher := createSpecRefresherSvc(cfg.SpecRefresher, transact, httpClient, accessStrategyExecutorProvider)
+
+	err = specRefresher.RefreshStaleSpecs(ctx)
+	exitOnError(err, "Error while refreshing stale specifications")
+
+	log.C(ctx).Info("Successfully refreshed stale specifications")
+}
+
+func createSpecRefresherSvc(refresherConfig specrefresher.Config, transact persistence.Transactioner, httpClient *http.Client, accessStrategyExecutorProvider *accessstrategy.Provider) *specrefresher.Service {
+	authConverter := auth.NewConverter()
+	frConverter := fetchrequest.NewConverter(authConverter)
+	specConverter := spec.NewConverter(frConverter)
+
+	fetchRequestRepo := fetchrequest.NewRepository(frConverter)
+	specRepo := spec.NewRepository(specConverter)
+
+	uidSvc := uid.NewService()
+	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient, accessStrategyExecutorProvider)
+	specRevisionSvc := specrevision.NewService(specrevision.NewRepository(specrevision.NewConverter()), uidSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, specRevisionSvc)
+
+	return specrefresher.NewService(refresherConfig, transact, fetchRequestRepo, specSvc)
+}
+
+func exitOnError(err error, context string) {
+	if err != nil {
+		wrappedError := errors.Wrap(err, context)
+		log.D().Fatal(wrappedError)
+	}
+}
@@ -20,7 +20,7 @@ require (
 	github.com/hashicorp/go-multierror v1.1.1
 	github.com/jmoiron/sqlx v1.3.4
 	github.com/kyma-incubator/compass/components/connector v0.0.0-20220104122431-99ed924ea212
-	github.com/kyma-incubator/compass/components/operations-controller v0.0.0-20220104122431-99ed924ea212
+	github.com/kyma-incubator/compass/components/operations-controller v0.0.0-20261018190339-8b3caf2fe03d
 	github.com/kyma-incubator/compass/components/system-broker v0.0.0-20220104122431-99ed924ea212
 	github.com/lestrrat-go/iter v1.0.1
 	github.com/lestrrat-go/jwx v1.2.14
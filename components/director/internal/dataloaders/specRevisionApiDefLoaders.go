@@ -0,0 +1,47 @@
+//go:generate go run github.com/vektah/dataloaden SpecRevisionAPIDefLoader ParamSpecRevisionAPIDef []*github.com/kyma-incubator/compass/components/director/pkg/graphql.SpecRevision
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeySpecRevisionAPIDef contextKey = "dataloadersSpecRevisionAPIDef"
+
+// LoadersSpecRevisionAPIDef holds the loader of the revisions of API specifications.
+type LoadersSpecRevisionAPIDef struct {
+	SpecRevisionAPIDefByID SpecRevisionAPIDefLoader
+}
+
+// ParamSpecRevisionAPIDef is the key of the revisions of the specification of the given type of the API definition with the given ID.
+type ParamSpecRevisionAPIDef struct {
+	ID       string
+	SpecType string
+	Ctx      context.Context
+}
+
+// HandlerSpecRevisionAPIDef returns a middleware, which stores a new loader of the revisions of API specifications in the request context.
+func HandlerSpecRevisionAPIDef(fetchFunc func(keys []ParamSpecRevisionAPIDef) ([][]*graphql.SpecRevision, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeySpecRevisionAPIDef, &LoadersSpecRevisionAPIDef{
+				SpecRevisionAPIDefByID: SpecRevisionAPIDefLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ForSpecRevisionAPIDef returns the loader of the revisions of API specifications from the request context.
+func ForSpecRevisionAPIDef(ctx context.Context) *LoadersSpecRevisionAPIDef {
+	return ctx.Value(loadersKeySpecRevisionAPIDef).(*LoadersSpecRevisionAPIDef)
+}
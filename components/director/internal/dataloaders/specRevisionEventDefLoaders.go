@@ -0,0 +1,47 @@
+//go:generate go run github.com/vektah/dataloaden SpecRevisionEventDefLoader ParamSpecRevisionEventDef []*github.com/kyma-incubator/compass/components/director/pkg/graphql.SpecRevision
+
+package dataloader
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const loadersKeySpecRevisionEventDef contextKey = "dataloadersSpecRevisionEventDef"
+
+// LoadersSpecRevisionEventDef holds the loader of the revisions of Event specifications.
+type LoadersSpecRevisionEventDef struct {
+	SpecRevisionEventDefByID SpecRevisionEventDefLoader
+}
+
+// ParamSpecRevisionEventDef is the key of the revisions of the specification of the given type of the Event definition with the given ID.
+type ParamSpecRevisionEventDef struct {
+	ID       string
+	SpecType string
+	Ctx      context.Context
+}
+
+// HandlerSpecRevisionEventDef returns a middleware, which stores a new loader of the revisions of Event specifications in the request context.
+func HandlerSpecRevisionEventDef(fetchFunc func(keys []ParamSpecRevisionEventDef) ([][]*graphql.SpecRevision, []error), maxBatch int, wait time.Duration) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := context.WithValue(r.Context(), loadersKeySpecRevisionEventDef, &LoadersSpecRevisionEventDef{
+				SpecRevisionEventDefByID: SpecRevisionEventDefLoader{
+					maxBatch: maxBatch,
+					wait:     wait,
+					fetch:    fetchFunc,
+				},
+			})
+			r = r.WithContext(ctx)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// ForSpecRevisionEventDef returns the loader of the revisions of Event specifications from the request context.
+func ForSpecRevisionEventDef(ctx context.Context) *LoadersSpecRevisionEventDef {
+	return ctx.Value(loadersKeySpecRevisionEventDef).(*LoadersSpecRevisionEventDef)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
@@ -277,6 +278,21 @@ func (r *Resolver) RefetchAPISpec(ctx context.Context, apiID string) (*graphql.A
 	return converted, nil
 }
 
+// Data returns the data of the API Spec. If a format is provided, the data is converted from the format of the API Spec to it.
+func (r *Resolver) Data(ctx context.Context, obj *graphql.APISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	if obj.Data == nil || format == nil {
+		return obj.Data, nil
+	}
+
+	data, err := spec.ConvertData(string(*obj.Data), model.SpecFormat(obj.Format), model.SpecFormat(*format))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting API Spec with id %q to %s format", obj.ID, *format)
+	}
+
+	clob := graphql.CLOB(data)
+	return &clob, nil
+}
+
 // FetchRequest returns a FetchRequest by a given EventSpec via dataloaders.
 func (r *Resolver) FetchRequest(ctx context.Context, obj *graphql.APISpec) (*graphql.FetchRequest, error) {
 	params := dataloader.ParamFetchRequestAPIDef{ID: obj.ID, Ctx: ctx}
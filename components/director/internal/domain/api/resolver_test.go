@@ -1259,3 +1259,59 @@ func TestResolver_FetchRequest(t *testing.T) {
 		assert.EqualError(t, err[0], apperrors.NewInternalError("Cannot fetch FetchRequest. APIDefinition Spec ID is empty").Error())
 	})
 }
+
+func TestResolver_Data(t *testing.T) {
+	jsonData := graphql.CLOB(`{"key":"value"}`)
+	yamlData := graphql.CLOB("key: value\n")
+	xmlFormat := graphql.SpecFormatXML
+	yamlFormat := graphql.SpecFormatYaml
+
+	testCases := []struct {
+		Name           string
+		Spec           *graphql.APISpec
+		Format         *graphql.SpecFormat
+		ExpectedResult *graphql.CLOB
+		ExpectedErr    string
+	}{
+		{
+			Name:           "Returns data when format is not provided",
+			Spec:           &graphql.APISpec{ID: "foo", Data: &jsonData, Format: graphql.SpecFormatJSON},
+			ExpectedResult: &jsonData,
+		},
+		{
+			Name:           "Returns converted data",
+			Spec:           &graphql.APISpec{ID: "foo", Data: &jsonData, Format: graphql.SpecFormatJSON},
+			Format:         &yamlFormat,
+			ExpectedResult: &yamlData,
+		},
+		{
+			Name:   "Returns nil when there is no data",
+			Spec:   &graphql.APISpec{ID: "foo", Format: graphql.SpecFormatJSON},
+			Format: &yamlFormat,
+		},
+		{
+			Name:        "Returns error when conversion is not supported",
+			Spec:        &graphql.APISpec{ID: "foo", Data: &jsonData, Format: graphql.SpecFormatJSON},
+			Format:      &xmlFormat,
+			ExpectedErr: "Conversion of specification from JSON to XML format is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			resolver := api.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// WHEN
+			result, err := resolver.Data(context.TODO(), testCase.Spec, testCase.Format)
+
+			// THEN
+			if len(testCase.ExpectedErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+		})
+	}
+}
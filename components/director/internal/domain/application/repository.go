@@ -167,6 +167,17 @@ func (r *pgRepository) ListAll(ctx context.Context, tenantID string) ([]*model.A
 	return r.multipleFromEntities(entities)
 }
 
+// ListAllByApplicationTemplateID lists all applications created from the given application template which are visible for the given tenant.
+func (r *pgRepository) ListAllByApplicationTemplateID(ctx context.Context, tenantID, appTemplateID string) ([]*model.Application, error) {
+	var entities EntityCollection
+
+	if err := r.lister.List(ctx, resource.Application, tenantID, &entities, repo.NewEqualCondition("app_template_id", appTemplateID)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
 // List missing godoc
 func (r *pgRepository) List(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	var appsCollection EntityCollection
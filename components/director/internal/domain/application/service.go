@@ -167,6 +167,16 @@ func (s *service) ListGlobal(ctx context.Context, pageSize int, cursor string) (
 	return s.appRepo.ListGlobal(ctx, pageSize, cursor)
 }
 
+// GetGlobalByID returns the Application with the given ID regardless of its tenant.
+func (s *service) GetGlobalByID(ctx context.Context, id string) (*model.Application, error) {
+	app, err := s.appRepo.GetGlobalByID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Application with id %s", id)
+	}
+
+	return app, nil
+}
+
 // ListByRuntimeID missing godoc
 func (s *service) ListByRuntimeID(ctx context.Context, runtimeID uuid.UUID, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	tenantID, err := tenant.LoadFromContext(ctx)
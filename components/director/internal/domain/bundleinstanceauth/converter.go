@@ -3,6 +3,7 @@ package bundleinstanceauth
 import (
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
@@ -12,6 +13,7 @@ import (
 )
 
 // AuthConverter missing godoc
+//
 //go:generate mockery --name=AuthConverter --output=automock --outpkg=automock --case=underscore
 type AuthConverter interface {
 	ToGraphQL(in *model.Auth) (*graphql.Auth, error)
@@ -48,6 +50,10 @@ func (c *converter) ToGraphQL(in *model.BundleInstanceAuth) (*graphql.BundleInst
 		Status:           c.statusToGraphQL(in.Status),
 		RuntimeID:        in.RuntimeID,
 		RuntimeContextID: in.RuntimeContextID,
+		CreatedAt:        timePtrToTimestampPtr(in.CreatedAt),
+		UpdatedAt:        timePtrToTimestampPtr(in.UpdatedAt),
+		DeletedAt:        timePtrToTimestampPtr(in.DeletedAt),
+		Error:            in.Error,
 	}, nil
 }
 
@@ -102,13 +108,20 @@ func (c *converter) SetInputFromGraphQL(in graphql.BundleInstanceAuthSetInput) (
 // ToEntity missing godoc
 func (c *converter) ToEntity(in *model.BundleInstanceAuth) (*Entity, error) {
 	out := &Entity{
-		ID:               in.ID,
 		BundleID:         in.BundleID,
 		OwnerID:          in.Owner,
 		RuntimeID:        repo.NewNullableString(in.RuntimeID),
 		RuntimeContextID: repo.NewNullableString(in.RuntimeContextID),
 		Context:          repo.NewNullableString(in.Context),
 		InputParams:      repo.NewNullableString(in.InputParams),
+		BaseEntity: &repo.BaseEntity{
+			ID:        in.ID,
+			Ready:     in.Ready,
+			CreatedAt: in.CreatedAt,
+			UpdatedAt: in.UpdatedAt,
+			DeletedAt: in.DeletedAt,
+			Error:     repo.NewNullableString(in.Error),
+		},
 	}
 	authValue, err := c.nullStringFromAuthPtr(in.Auth)
 	if err != nil {
@@ -148,6 +161,11 @@ func (c *converter) FromEntity(in *Entity) (*model.BundleInstanceAuth, error) {
 			Message:   in.StatusMessage,
 			Reason:    in.StatusReason,
 		},
+		Ready:     in.Ready,
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+		DeletedAt: in.DeletedAt,
+		Error:     repo.StringPtrFromNullableString(in.Error),
 	}, nil
 }
 
@@ -205,3 +223,12 @@ func (c *converter) authPtrFromNullString(in sql.NullString) (*model.Auth, error
 	}
 	return &auth, nil
 }
+
+func timePtrToTimestampPtr(time *time.Time) *graphql.Timestamp {
+	if time == nil {
+		return nil
+	}
+
+	t := graphql.Timestamp(*time)
+	return &t
+}
@@ -3,11 +3,12 @@ package bundleinstanceauth
 import (
 	"database/sql"
 	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 )
 
 // Entity missing godoc
 type Entity struct {
-	ID               string         `db:"id"`
 	BundleID         string         `db:"bundle_id"`
 	OwnerID          string         `db:"owner_id"`
 	RuntimeID        sql.NullString `db:"runtime_id"`
@@ -19,6 +20,7 @@ type Entity struct {
 	StatusTimestamp  time.Time      `db:"status_timestamp"`
 	StatusMessage    string         `db:"status_message"`
 	StatusReason     string         `db:"status_reason"`
+	*repo.BaseEntity
 }
 
 // Collection missing godoc
@@ -12,6 +12,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 
 	"github.com/stretchr/testify/require"
 
@@ -28,7 +29,7 @@ var (
 	testInputParams    = `{"bar": "baz"}`
 	testError          = errors.New("test")
 	testTime           = time.Now()
-	testTableColumns   = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id"}
+	testTableColumns   = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id", "ready", "created_at", "updated_at", "deleted_at", "error"}
 )
 
 func fixModelBundleInstanceAuth(id, bundleID, tenant string, auth *model.Auth, status *model.BundleInstanceAuthStatus, runtimeID *string) *model.BundleInstanceAuth {
@@ -47,6 +48,8 @@ func fixModelBundleInstanceAuthWithoutContextAndInputParams(id, bundleID, tenant
 		Owner:     tenant,
 		Auth:      auth,
 		Status:    status,
+		Ready:     true,
+		CreatedAt: &testTime,
 	}
 }
 
@@ -62,11 +65,13 @@ func fixGQLBundleInstanceAuth(id string, auth *graphql.Auth, status *graphql.Bun
 }
 
 func fixGQLBundleInstanceAuthWithoutContextAndInputParams(id string, auth *graphql.Auth, status *graphql.BundleInstanceAuthStatus, runtimeID *string) *graphql.BundleInstanceAuth {
+	createdAt := graphql.Timestamp(testTime)
 	return &graphql.BundleInstanceAuth{
 		ID:        id,
 		Auth:      auth,
 		Status:    status,
 		RuntimeID: runtimeID,
+		CreatedAt: &createdAt,
 	}
 }
 
@@ -168,10 +173,14 @@ func fixEntityBundleInstanceAuthWithoutContextAndInputParams(t *testing.T, id, b
 		sqlNullString.String = *runtimeID
 	}
 	out := bundleinstanceauth.Entity{
-		ID:        id,
 		BundleID:  bundleID,
 		RuntimeID: sqlNullString,
 		OwnerID:   tenant,
+		BaseEntity: &repo.BaseEntity{
+			ID:        id,
+			Ready:     true,
+			CreatedAt: &testTime,
+		},
 	}
 
 	if auth != nil {
@@ -248,12 +257,17 @@ type sqlRow struct {
 	statusTimestamp  time.Time
 	statusMessage    string
 	statusReason     string
+	ready            bool
+	createdAt        *time.Time
+	updatedAt        *time.Time
+	deletedAt        *time.Time
+	err              sql.NullString
 }
 
 func fixSQLRows(rows []sqlRow) *sqlmock.Rows {
 	out := sqlmock.NewRows(testTableColumns)
 	for _, row := range rows {
-		out.AddRow(row.id, row.ownerID, row.bundleID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason, row.runtimeID, row.runtimeContextID)
+		out.AddRow(row.id, row.ownerID, row.bundleID, row.context, row.inputParams, row.authValue, row.statusCondition, row.statusTimestamp, row.statusMessage, row.statusReason, row.runtimeID, row.runtimeContextID, row.ready, row.createdAt, row.updatedAt, row.deletedAt, row.err)
 	}
 	return out
 }
@@ -272,11 +286,16 @@ func fixSQLRowFromEntity(entity bundleinstanceauth.Entity) sqlRow {
 		statusTimestamp:  entity.StatusTimestamp,
 		statusMessage:    entity.StatusMessage,
 		statusReason:     entity.StatusReason,
+		ready:            entity.Ready,
+		createdAt:        entity.CreatedAt,
+		updatedAt:        entity.UpdatedAt,
+		deletedAt:        entity.DeletedAt,
+		err:              entity.Error,
 	}
 }
 
 func fixCreateArgs(ent bundleinstanceauth.Entity) []driver.Value {
-	return []driver.Value{ent.ID, ent.OwnerID, ent.BundleID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason, ent.RuntimeID, ent.RuntimeContextID}
+	return []driver.Value{ent.ID, ent.OwnerID, ent.BundleID, ent.Context, ent.InputParams, ent.AuthValue, ent.StatusCondition, ent.StatusTimestamp, ent.StatusMessage, ent.StatusReason, ent.RuntimeID, ent.RuntimeContextID, ent.Ready, ent.CreatedAt, ent.UpdatedAt, ent.DeletedAt, ent.Error}
 }
 
 func fixSimpleModelBundleInstanceAuth(id string) *model.BundleInstanceAuth {
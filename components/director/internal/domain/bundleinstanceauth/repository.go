@@ -2,6 +2,10 @@ package bundleinstanceauth
 
 import (
 	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
@@ -19,7 +23,8 @@ const tableName string = `public.bundle_instance_auths`
 var (
 	idColumns        = []string{"id"}
 	updatableColumns = []string{"auth_value", "status_condition", "status_timestamp", "status_message", "status_reason"}
-	tableColumns     = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id"}
+	tableColumns     = []string{"id", "owner_id", "bundle_id", "context", "input_params", "auth_value", "status_condition", "status_timestamp", "status_message", "status_reason", "runtime_id", "runtime_context_id", "ready", "created_at", "updated_at", "deleted_at", "error"}
+	technicalColumns = []string{"status_condition", "status_timestamp", "status_message", "status_reason", "ready", "updated_at", "deleted_at", "error"}
 )
 
 // EntityConverter missing godoc
@@ -30,12 +35,15 @@ type EntityConverter interface {
 }
 
 type repository struct {
-	creator      repo.CreatorGlobal
-	singleGetter repo.SingleGetter
-	lister       repo.Lister
-	updater      repo.Updater
-	deleter      repo.Deleter
-	conv         EntityConverter
+	creator            repo.CreatorGlobal
+	singleGetter       repo.SingleGetter
+	singleGetterGlobal repo.SingleGetterGlobal
+	lister             repo.Lister
+	updater            repo.Updater
+	updaterGlobal      repo.UpdaterGlobal
+	deleter            repo.Deleter
+	deleterGlobal      repo.DeleterGlobal
+	conv               EntityConverter
 }
 
 // NewRepository missing godoc
@@ -47,12 +55,15 @@ func NewRepository(conv EntityConverter) *repository {
 		//  the caller has to the parent and allow it.
 		//  However, this cannot be done before formations redesign and due to this the formation check will still take place
 		//  in the pkg/scenario/directive.go. Once formation redesign in in place we can remove this directive and here we can use non-global creator.
-		creator:      repo.NewCreatorGlobal(resource.BundleInstanceAuth, tableName, tableColumns),
-		singleGetter: repo.NewSingleGetter(tableName, tableColumns),
-		lister:       repo.NewLister(tableName, tableColumns),
-		deleter:      repo.NewDeleter(tableName),
-		updater:      repo.NewUpdater(tableName, updatableColumns, idColumns),
-		conv:         conv,
+		creator:            repo.NewCreatorGlobal(resource.BundleInstanceAuth, tableName, tableColumns),
+		singleGetter:       repo.NewSingleGetter(tableName, tableColumns),
+		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.BundleInstanceAuth, tableName, tableColumns),
+		lister:             repo.NewLister(tableName, tableColumns),
+		deleter:            repo.NewDeleter(tableName),
+		deleterGlobal:      repo.NewDeleterGlobal(resource.BundleInstanceAuth, tableName),
+		updater:            repo.NewUpdater(tableName, updatableColumns, idColumns),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.BundleInstanceAuth, tableName, technicalColumns, idColumns),
+		conv:               conv,
 	}
 }
 
@@ -91,6 +102,21 @@ func (r *repository) GetByID(ctx context.Context, tenantID string, id string) (*
 	return itemModel, nil
 }
 
+// GetGlobalByID returns the BundleInstanceAuth with the given ID regardless of the tenant.
+func (r *repository) GetGlobalByID(ctx context.Context, id string) (*model.BundleInstanceAuth, error) {
+	var entity Entity
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	itemModel, err := r.conv.FromEntity(&entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting BundleInstanceAuth entity to model")
+	}
+
+	return itemModel, nil
+}
+
 // GetForBundle missing godoc
 func (r *repository) GetForBundle(ctx context.Context, tenant string, id string, bundleID string) (*model.BundleInstanceAuth, error) {
 	var ent Entity
@@ -160,11 +186,59 @@ func (r *repository) Update(ctx context.Context, tenant string, item *model.Bund
 	return r.updater.UpdateSingle(ctx, resource.BundleInstanceAuth, tenant, entity)
 }
 
-// Delete missing godoc
+// TechnicalUpdate updates the status and the operation state of the BundleInstanceAuth regardless of the tenant.
+func (r *repository) TechnicalUpdate(ctx context.Context, item *model.BundleInstanceAuth) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+
+	entity, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while converting model to entity")
+	}
+
+	log.C(ctx).Debugf("Updating BundleInstanceAuth entity with id %s in db", item.ID)
+	return r.updaterGlobal.TechnicalUpdate(ctx, entity)
+}
+
+// Delete deletes the BundleInstanceAuth with the given ID. In async mode the BundleInstanceAuth is only marked for deletion.
 func (r *repository) Delete(ctx context.Context, tenantID string, id string) error {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		item, err := r.GetByID(ctx, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		return r.markAsDeleted(ctx, item)
+	}
+
 	return r.deleter.DeleteOne(ctx, resource.BundleInstanceAuth, tenantID, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+// DeleteGlobal deletes the BundleInstanceAuth with the given ID regardless of the tenant. In async mode the BundleInstanceAuth is only marked for deletion.
+func (r *repository) DeleteGlobal(ctx context.Context, id string) error {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		item, err := r.GetGlobalByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return r.markAsDeleted(ctx, item)
+	}
+
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
+func (r *repository) markAsDeleted(ctx context.Context, item *model.BundleInstanceAuth) error {
+	item.SetReady(false)
+	item.SetError("")
+	if item.GetDeletedAt().IsZero() {
+		item.SetDeletedAt(time.Now())
+	}
+
+	return r.TechnicalUpdate(ctx, item)
+}
+
 func (r *repository) multipleFromEntities(entities Collection) ([]*model.BundleInstanceAuth, error) {
 	items := make([]*model.BundleInstanceAuth, 0, len(entities))
 	for _, ent := range entities {
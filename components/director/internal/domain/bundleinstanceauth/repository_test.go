@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth"
@@ -13,6 +14,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,7 +34,7 @@ func TestRepository_Create(t *testing.T) {
 		db, dbMock := testdb.MockDatabase(t)
 		defer dbMock.AssertExpectations(t)
 
-		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.bundle_instance_auths ( id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
+		dbMock.ExpectExec(regexp.QuoteMeta(`INSERT INTO public.bundle_instance_auths ( id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`)).
 			WithArgs(fixCreateArgs(*biaEntity)...).
 			WillReturnResult(sqlmock.NewResult(-1, 1))
 
@@ -107,7 +110,7 @@ func TestRepository_GetByID(t *testing.T) {
 		Name: "Get BIA",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error FROM public.bundle_instance_auths WHERE id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -142,7 +145,7 @@ func TestRepository_GetForBundle(t *testing.T) {
 		Name: "Get BIA For Bundle",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE id = $1 AND bundle_id = $2 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $3) OR owner_id = $4)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error FROM public.bundle_instance_auths WHERE id = $1 AND bundle_id = $2 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $3) OR owner_id = $4)`),
 				Args:     []driver.Value{testID, testBundleID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -175,7 +178,7 @@ func TestRepository_ListByBundleID(t *testing.T) {
 		Name: "List BIA by BundleID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE bundle_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error FROM public.bundle_instance_auths WHERE bundle_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testBundleID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -207,7 +210,7 @@ func TestRepository_ListByRuntimeID(t *testing.T) {
 		Name: "List BIA by RuntimeID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id FROM public.bundle_instance_auths WHERE runtime_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
+				Query:    regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error FROM public.bundle_instance_auths WHERE runtime_id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`),
 				Args:     []driver.Value{testRuntimeID, testTenant, testTenant},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
@@ -283,4 +286,43 @@ func TestRepository_Delete(t *testing.T) {
 	}
 
 	suite.Run(t)
+
+	t.Run("Success when operation mode is set to async", func(t *testing.T) {
+		ctx := operation.SaveModeToContext(context.Background(), graphql.OperationModeAsync)
+
+		biaModel := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), nil)
+		biaEntity := fixEntityBundleInstanceAuth(t, testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), nil)
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, owner_id, bundle_id, context, input_params, auth_value, status_condition, status_timestamp, status_message, status_reason, runtime_id, runtime_context_id, ready, created_at, updated_at, deleted_at, error FROM public.bundle_instance_auths WHERE id = $1 AND (id IN (SELECT id FROM bundle_instance_auths_tenants WHERE tenant_id = $2) OR owner_id = $3)`)).
+			WithArgs(testID, testTenant, testTenant).
+			WillReturnRows(fixSQLRows([]sqlRow{fixSQLRowFromEntity(*biaEntity)}))
+
+		deletedAt := time.Now()
+		deletedEntity := fixEntityBundleInstanceAuth(t, testID, testBundleID, testTenant, fixModelAuth(), fixModelStatusSucceeded(), nil)
+		deletedEntity.Ready = false
+		deletedEntity.DeletedAt = &deletedAt
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", biaEntity).Return(biaModel, nil).Once()
+		mockConverter.On("ToEntity", biaModel).Return(deletedEntity, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.bundle_instance_auths SET status_condition = ?, status_timestamp = ?, status_message = ?, status_reason = ?, ready = ?, updated_at = ?, deleted_at = ?, error = ? WHERE id = ?`)).
+			WithArgs(deletedEntity.StatusCondition, deletedEntity.StatusTimestamp, deletedEntity.StatusMessage, deletedEntity.StatusReason, deletedEntity.Ready, deletedEntity.UpdatedAt, deletedEntity.DeletedAt, deletedEntity.Error, testID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx = persistence.SaveToContext(ctx, db)
+		repo := bundleinstanceauth.NewRepository(mockConverter)
+
+		// WHEN
+		err := repo.Delete(ctx, testTenant, testID)
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, biaModel.Ready)
+		assert.NotNil(t, biaModel.DeletedAt)
+	})
 }
@@ -189,14 +189,6 @@ func (r *Resolver) SetBundleInstanceAuth(ctx context.Context, authID string, in
 
 // RequestBundleInstanceAuthCreation missing godoc
 func (r *Resolver) RequestBundleInstanceAuthCreation(ctx context.Context, bundleID string, in graphql.BundleInstanceAuthRequestInput) (*graphql.BundleInstanceAuth, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-	ctx = persistence.SaveToContext(ctx, tx)
-
 	log.C(ctx).Infof("Requesting BundleInstanceAuth creation for Bundle with id %s", bundleID)
 
 	bndl, err := r.bndlSvc.Get(ctx, bundleID)
@@ -217,24 +209,11 @@ func (r *Resolver) RequestBundleInstanceAuthCreation(ctx context.Context, bundle
 	}
 	log.C(ctx).Infof("Successfully created BundleInstanceAuth with id %s for Bundle with id %s", instanceAuthID, bundleID)
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return r.conv.ToGraphQL(instanceAuth)
 }
 
 // RequestBundleInstanceAuthDeletion missing godoc
 func (r *Resolver) RequestBundleInstanceAuthDeletion(ctx context.Context, authID string) (*graphql.BundleInstanceAuth, error) {
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-
-	defer r.transact.RollbackUnlessCommitted(ctx, tx)
-	ctx = persistence.SaveToContext(ctx, tx)
-
 	log.C(ctx).Infof("Requesting BundleInstanceAuth deletion for BundleInstanceAuth with id %s", authID)
 
 	instanceAuth, err := r.svc.Get(ctx, authID)
@@ -261,10 +240,5 @@ func (r *Resolver) RequestBundleInstanceAuthDeletion(ctx context.Context, authID
 
 	log.C(ctx).Infof("BundleInstanceAuth with id %s successfully deleted.", authID)
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return r.conv.ToGraphQL(instanceAuth)
 }
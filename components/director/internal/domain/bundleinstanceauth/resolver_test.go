@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 
@@ -165,11 +166,8 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 	modelInstanceAuth := fixModelBundleInstanceAuthWithoutContextAndInputParams(testID, testBundleID, testTenant, nil, nil, &testRuntimeID)
 	gqlInstanceAuth := fixGQLBundleInstanceAuthWithoutContextAndInputParams(testID, nil, nil, &testRuntimeID)
 
-	txGen := txtest.NewTransactionContextGenerator(testError)
-
 	testCases := []struct {
 		Name              string
-		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn         func() *automock.Service
 		BndlServiceFn     func() *automock.BundleService
 		ConverterFn       func() *automock.Converter
@@ -179,7 +177,6 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 	}{
 		{
 			Name:            "Success",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Create", txtest.CtxWithDBMatcher(), testBundleID, *modelRequestInput, modelInstanceAuth.Auth, modelInstanceAuth.InputParams).Return(testID, nil).Once()
@@ -203,55 +200,8 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 			ExpectedResult: gqlInstanceAuth,
 			ExpectedErr:    nil,
 		},
-		{
-			Name:            "Return error when starting transaction fails",
-			TransactionerFn: txGen.ThatFailsOnBegin,
-			ServiceFn: func() *automock.Service {
-				svc := &automock.Service{}
-				return svc
-			},
-			BndlServiceFn: func() *automock.BundleService {
-				svc := &automock.BundleService{}
-				return svc
-			},
-			ConverterFn: func() *automock.Converter {
-				conv := &automock.Converter{}
-				return conv
-			},
-			BundleConverterFn: func() *pkgmock.BundleConverter {
-				return &pkgmock.BundleConverter{}
-			},
-			ExpectedResult: nil,
-			ExpectedErr:    testError,
-		},
-		{
-			Name:            "Return error when commit transaction fails",
-			TransactionerFn: txGen.ThatFailsOnCommit,
-			ServiceFn: func() *automock.Service {
-				svc := &automock.Service{}
-				svc.On("Create", txtest.CtxWithDBMatcher(), testBundleID, *modelRequestInput, modelInstanceAuth.Auth, modelInstanceAuth.InputParams).Return(testID, nil).Once()
-				svc.On("Get", txtest.CtxWithDBMatcher(), testID).Return(modelInstanceAuth, nil).Once()
-				return svc
-			},
-			BndlServiceFn: func() *automock.BundleService {
-				svc := &automock.BundleService{}
-				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBundle, nil).Once()
-				return svc
-			},
-			ConverterFn: func() *automock.Converter {
-				conv := &automock.Converter{}
-				conv.On("RequestInputFromGraphQL", *gqlRequestInput).Return(*modelRequestInput, nil).Once()
-				return conv
-			},
-			BundleConverterFn: func() *pkgmock.BundleConverter {
-				return &pkgmock.BundleConverter{}
-			},
-			ExpectedResult: nil,
-			ExpectedErr:    testError,
-		},
 		{
 			Name:            "Returns error when Bundle retrieval failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				return svc
@@ -273,7 +223,6 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when Instance Auth creation failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Create", txtest.CtxWithDBMatcher(), testBundleID, *modelRequestInput, modelInstanceAuth.Auth, modelInstanceAuth.InputParams).Return("", testError).Once()
@@ -297,7 +246,6 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when Instance Auth retrieval failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Create", txtest.CtxWithDBMatcher(), testBundleID, *modelRequestInput, modelInstanceAuth.Auth, modelInstanceAuth.InputParams).Return(testID, nil).Once()
@@ -325,21 +273,21 @@ func TestResolver_RequestBundleInstanceAuthCreation(t *testing.T) {
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
-			persist, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			bndlSvc := testCase.BndlServiceFn()
 			converter := testCase.ConverterFn()
 			bndlConverter := testCase.BundleConverterFn()
 
-			resolver := bundleinstanceauth.NewResolver(transact, svc, bndlSvc, converter, bndlConverter)
+			resolver := bundleinstanceauth.NewResolver(nil, svc, bndlSvc, converter, bndlConverter)
 
-			result, err := resolver.RequestBundleInstanceAuthCreation(context.TODO(), testBundleID, *gqlRequestInput)
+			ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+			result, err := resolver.RequestBundleInstanceAuthCreation(ctx, testBundleID, *gqlRequestInput)
 
 			// THEN
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
-			mock.AssertExpectationsForObjects(t, persist, transact, svc, bndlSvc, converter)
+			mock.AssertExpectationsForObjects(t, svc, bndlSvc, converter)
 		})
 	}
 }
@@ -502,11 +450,8 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 	}
 
-	txGen := txtest.NewTransactionContextGenerator(testErr)
-
 	testCases := []struct {
 		Name              string
-		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn         func() *automock.Service
 		BundleServiceFn   func() *automock.BundleService
 		ConverterFn       func() *automock.Converter
@@ -516,7 +461,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 	}{
 		{
 			Name:            "Success - Deleted",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
@@ -541,7 +485,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 		{
 			Name:            "Success - Not Deleted",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Twice()
@@ -566,7 +509,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 		{
 			Name:            "Error - Get Instance Auth",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(nil, testErr).Once()
@@ -586,7 +528,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 		{
 			Name:            "Error - Get Bundle",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
@@ -608,7 +549,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 		{
 			Name:            "Error - Request Deletion",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
@@ -631,7 +571,6 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 		},
 		{
 			Name:            "Error - Get After Setting Status",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.Service {
 				svc := &automock.Service{}
 				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
@@ -653,68 +592,27 @@ func TestResolver_RequestBundleInstanceAuthDeletion(t *testing.T) {
 			ExpectedResult: nil,
 			ExpectedErr:    testErr,
 		},
-		{
-			Name:            "Error - Transaction Begin",
-			TransactionerFn: txGen.ThatFailsOnBegin,
-			ServiceFn: func() *automock.Service {
-				return &automock.Service{}
-			},
-			BundleServiceFn: func() *automock.BundleService {
-				return &automock.BundleService{}
-			},
-			ConverterFn: func() *automock.Converter {
-				return &automock.Converter{}
-			},
-			BundleConverterFn: func() *pkgmock.BundleConverter {
-				return &pkgmock.BundleConverter{}
-			},
-			ExpectedResult: nil,
-			ExpectedErr:    testErr,
-		},
-		{
-			Name:            "Error - Transaction Commit",
-			TransactionerFn: txGen.ThatFailsOnCommit,
-			ServiceFn: func() *automock.Service {
-				svc := &automock.Service{}
-				svc.On("Get", txtest.CtxWithDBMatcher(), id).Return(modelInstanceAuth, nil).Once()
-				svc.On("RequestDeletion", txtest.CtxWithDBMatcher(), modelInstanceAuth, modelBndl.DefaultInstanceAuth).Return(true, nil).Once()
-				return svc
-			},
-			BundleServiceFn: func() *automock.BundleService {
-				svc := &automock.BundleService{}
-				svc.On("Get", txtest.CtxWithDBMatcher(), testBundleID).Return(modelBndl, nil).Once()
-				return svc
-			},
-			ConverterFn: func() *automock.Converter {
-				return &automock.Converter{}
-			},
-			BundleConverterFn: func() *pkgmock.BundleConverter {
-				return &pkgmock.BundleConverter{}
-			},
-			ExpectedResult: nil,
-			ExpectedErr:    testErr,
-		},
 	}
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
 			// GIVEN
-			persist, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			bundleSvc := testCase.BundleServiceFn()
 			converter := testCase.ConverterFn()
 			bndlConverter := testCase.BundleConverterFn()
 
-			resolver := bundleinstanceauth.NewResolver(transact, svc, bundleSvc, converter, bndlConverter)
+			resolver := bundleinstanceauth.NewResolver(nil, svc, bundleSvc, converter, bndlConverter)
 
 			// WHEN
-			result, err := resolver.RequestBundleInstanceAuthDeletion(context.TODO(), id)
+			ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+			result, err := resolver.RequestBundleInstanceAuthDeletion(ctx, id)
 
 			// THEN
 			assert.Equal(t, testCase.ExpectedResult, result)
 			assert.Equal(t, testCase.ExpectedErr, err)
 
-			mock.AssertExpectationsForObjects(t, svc, converter, transact, persist, bundleSvc)
+			mock.AssertExpectationsForObjects(t, svc, converter, bundleSvc)
 		})
 	}
 }
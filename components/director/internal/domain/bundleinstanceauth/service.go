@@ -7,6 +7,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -202,6 +204,15 @@ func (s *service) RequestDeletion(ctx context.Context, instanceAuth *model.Bundl
 		return false, apperrors.NewInternalError("BundleInstanceAuth is required to request its deletion")
 	}
 
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		log.C(ctx).Debugf("Marking BundleInstanceAuth with id %s for deletion until the asynchronous operation completes.", instanceAuth.ID)
+		if err = s.Delete(ctx, instanceAuth.ID); err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+
 	if defaultBundleInstanceAuth == nil {
 		log.C(ctx).Debugf("Default credentials for BundleInstanceAuth with id %s are not provided.", instanceAuth.ID)
 
@@ -11,6 +11,8 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/bundleinstanceauth/automock"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	"github.com/pkg/errors"
@@ -405,6 +407,10 @@ func TestService_Create(t *testing.T) {
 	modelAuth := fixModelAuth()
 	modelExpectedInstanceAuth := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, modelAuth, fixModelStatusSucceeded(), &testRuntimeID)
 	modelExpectedInstanceAuthPending := fixModelBundleInstanceAuth(testID, testBundleID, testTenant, nil, fixModelStatusPending(), &testRuntimeID)
+	for _, instanceAuth := range []*model.BundleInstanceAuth{modelExpectedInstanceAuth, modelExpectedInstanceAuthPending} {
+		instanceAuth.Ready = false
+		instanceAuth.CreatedAt = nil
+	}
 
 	modelRequestInput := fixModelRequestInput()
 
@@ -924,6 +930,24 @@ func TestService_RequestDeletion(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), expectedError.Error())
 	})
+
+	t.Run("Success - async mode marks the instance auth for deletion", func(t *testing.T) {
+		// GIVEN
+		asyncCtx := operation.SaveModeToContext(ctx, graphql.OperationModeAsync)
+
+		instanceAuthRepo := &automock.Repository{}
+		instanceAuthRepo.On("Delete", contextThatHasTenant(tnt), tnt, id).Return(nil).Once()
+		defer instanceAuthRepo.AssertExpectations(t)
+
+		svc := bundleinstanceauth.NewService(instanceAuthRepo, nil)
+
+		// WHEN
+		res, err := svc.RequestDeletion(asyncCtx, bndlInstanceAuth, fixModelAuth())
+
+		// THEN
+		require.NoError(t, err)
+		assert.False(t, res)
+	})
 }
 
 func contextThatHasTenant(expectedTenant string) interface{} {
@@ -12,6 +12,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/pkg/errors"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
@@ -270,6 +271,21 @@ func (r *Resolver) RefetchEventDefinitionSpec(ctx context.Context, eventID strin
 	return converted, nil
 }
 
+// Data returns the data of the Event Spec. If a format is provided, the data is converted from the format of the Event Spec to it.
+func (r *Resolver) Data(ctx context.Context, obj *graphql.EventSpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	if obj.Data == nil || format == nil {
+		return obj.Data, nil
+	}
+
+	data, err := spec.ConvertData(string(*obj.Data), model.SpecFormat(obj.Format), model.SpecFormat(*format))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while converting Event Spec with id %q to %s format", obj.ID, *format)
+	}
+
+	clob := graphql.CLOB(data)
+	return &clob, nil
+}
+
 // FetchRequest returns a FetchRequest by a given EventSpec via dataloaders.
 func (r *Resolver) FetchRequest(ctx context.Context, obj *graphql.EventSpec) (*graphql.FetchRequest, error) {
 	params := dataloader.ParamFetchRequestEventDef{ID: obj.ID, Ctx: ctx}
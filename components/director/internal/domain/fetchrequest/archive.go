@@ -0,0 +1,172 @@
+package fetchrequest
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxArchiveFiles is the maximum number of files in a bundle archive.
+	maxArchiveFiles = 1000
+	// maxArchiveFileSize is the maximum uncompressed size of a spec file in a bundle archive.
+	maxArchiveFileSize = 10 * 1024 * 1024
+	// tarMagicOffset is the offset of the "ustar" magic in the header of a tar archive.
+	tarMagicOffset = 257
+)
+
+var (
+	zipMagic  = []byte("PK\x03\x04")
+	gzipMagic = []byte{0x1f, 0x8b}
+	tarMagic  = []byte("ustar")
+)
+
+// extractSpecFromArchive extracts the spec file selected by the filter from a zip, tar, or tar.gz archive.
+func extractSpecFromArchive(archive []byte, filter *string) ([]byte, error) {
+	switch {
+	case bytes.HasPrefix(archive, zipMagic):
+		return extractFromZip(archive, filter)
+	case bytes.HasPrefix(archive, gzipMagic):
+		return extractFromTar(func() (io.Reader, error) {
+			return gzip.NewReader(bytes.NewReader(archive))
+		}, filter)
+	case isTar(archive):
+		return extractFromTar(func() (io.Reader, error) {
+			return bytes.NewReader(archive), nil
+		}, filter)
+	}
+
+	return nil, errors.New("unsupported bundle format, expected a zip, tar, or tar.gz archive")
+}
+
+func extractFromZip(archive []byte, filter *string) ([]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading zip archive")
+	}
+
+	files := make(map[string]*zip.File)
+	paths := make([]string, 0)
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		name, ok := specFileName(file.Name)
+		if !ok {
+			continue
+		}
+		if len(paths) == maxArchiveFiles {
+			return nil, errors.Errorf("bundle contains more than %d files", maxArchiveFiles)
+		}
+		files[name] = file
+		paths = append(paths, name)
+	}
+
+	selected, err := selectFile(paths, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := files[selected].Open()
+	if err != nil {
+		return nil, errors.Wrapf(err, "while opening file %q", selected)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return readArchiveFile(file, selected)
+}
+
+// extractFromTar reads the tar archive twice, first to select the spec file and then to extract it,
+// so that only the selected file is kept in memory, as the entries of a tar archive can be read only sequentially.
+func extractFromTar(open func() (io.Reader, error), filter *string) ([]byte, error) {
+	paths := make([]string, 0)
+	if err := walkTar(open, func(name string, _ io.Reader) (bool, error) {
+		if len(paths) == maxArchiveFiles {
+			return false, errors.Errorf("bundle contains more than %d files", maxArchiveFiles)
+		}
+		paths = append(paths, name)
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	selected, err := selectFile(paths, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var content []byte
+	err = walkTar(open, func(name string, file io.Reader) (bool, error) {
+		if name != selected {
+			return true, nil
+		}
+		content, err = readArchiveFile(file, name)
+		return false, err
+	})
+	return content, err
+}
+
+// walkTar calls fn for each spec file of the tar archive, until fn returns false or an error.
+func walkTar(open func() (io.Reader, error), fn func(name string, file io.Reader) (bool, error)) error {
+	archive, err := open()
+	if err != nil {
+		return errors.Wrap(err, "while reading gzip archive")
+	}
+
+	tarReader := tar.NewReader(archive)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "while reading tar archive")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name, ok := specFileName(header.Name)
+		if !ok {
+			continue
+		}
+		if next, err := fn(name, tarReader); err != nil || !next {
+			return err
+		}
+	}
+}
+
+func readArchiveFile(file io.Reader, name string) ([]byte, error) {
+	content, err := ioutil.ReadAll(io.LimitReader(file, maxArchiveFileSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading file %q", name)
+	}
+	if len(content) > maxArchiveFileSize {
+		return nil, errors.Errorf("file %q exceeds the maximum size of %d bytes", name, maxArchiveFileSize)
+	}
+	return content, nil
+}
+
+// specFileName returns the normalized path of an archive entry, and whether it may be a spec file.
+// Hidden files and directories, like the metadata added by some archivers, are ignored.
+func specFileName(name string) (string, bool) {
+	name = strings.TrimPrefix(path.Clean("/"+name), "/")
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") || strings.HasPrefix(segment, "__MACOSX") {
+			return "", false
+		}
+	}
+	return name, len(name) > 0
+}
+
+func isTar(archive []byte) bool {
+	return len(archive) >= tarMagicOffset+len(tarMagic) && bytes.Equal(archive[tarMagicOffset:tarMagicOffset+len(tarMagic)], tarMagic)
+}
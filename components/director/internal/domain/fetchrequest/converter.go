@@ -85,6 +85,11 @@ func (c *converter) ToEntity(in *model.FetchRequest) (*Entity, error) {
 		return nil, errors.Wrap(err, "while converting Auth")
 	}
 
+	statusHistory, err := c.statusHistoryToEntity(in.Status.History)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting status history")
+	}
+
 	filter := repo.NewNullableString(in.Filter)
 	message := repo.NewNullableString(in.Status.Message)
 	refID := repo.NewValidNullableString(in.ObjectID)
@@ -111,6 +116,9 @@ func (c *converter) ToEntity(in *model.FetchRequest) (*Entity, error) {
 		StatusCondition: string(in.Status.Condition),
 		StatusMessage:   message,
 		StatusTimestamp: in.Status.Timestamp,
+		StatusHistory:   statusHistory,
+		ETag:            repo.NewNullableString(in.ETag),
+		LastModified:    repo.NewNullableString(in.LastModified),
 	}, nil
 }
 
@@ -126,6 +134,11 @@ func (c *converter) FromEntity(in *Entity, objectType model.FetchRequestReferenc
 		return nil, errors.Wrap(err, "while converting Auth")
 	}
 
+	statusHistory, err := c.statusHistoryToModel(in.StatusHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "while converting status history")
+	}
+
 	return &model.FetchRequest{
 		ID:         in.ID,
 		ObjectID:   objectID,
@@ -134,11 +147,14 @@ func (c *converter) FromEntity(in *Entity, objectType model.FetchRequestReferenc
 			Timestamp: in.StatusTimestamp,
 			Message:   repo.StringPtrFromNullableString(in.StatusMessage),
 			Condition: model.FetchRequestStatusCondition(in.StatusCondition),
+			History:   statusHistory,
 		},
-		URL:    in.URL,
-		Mode:   model.FetchMode(in.Mode),
-		Filter: repo.StringPtrFromNullableString(in.Filter),
-		Auth:   auth,
+		URL:          in.URL,
+		Mode:         model.FetchMode(in.Mode),
+		Filter:       repo.StringPtrFromNullableString(in.Filter),
+		Auth:         auth,
+		ETag:         repo.StringPtrFromNullableString(in.ETag),
+		LastModified: repo.StringPtrFromNullableString(in.LastModified),
 	}, nil
 }
 
@@ -146,25 +162,35 @@ func (c *converter) statusToGraphQL(in *model.FetchRequestStatus) *graphql.Fetch
 	if in == nil {
 		return &graphql.FetchRequestStatus{
 			Condition: graphql.FetchRequestStatusConditionInitial,
+			History:   []*graphql.FetchRequestStatusHistoryEntry{},
 		}
 	}
 
-	var condition graphql.FetchRequestStatusCondition
-	switch in.Condition {
-	case model.FetchRequestStatusConditionInitial:
-		condition = graphql.FetchRequestStatusConditionInitial
-	case model.FetchRequestStatusConditionFailed:
-		condition = graphql.FetchRequestStatusConditionFailed
-	case model.FetchRequestStatusConditionSucceeded:
-		condition = graphql.FetchRequestStatusConditionSucceeded
-	default:
-		condition = graphql.FetchRequestStatusConditionInitial
+	history := make([]*graphql.FetchRequestStatusHistoryEntry, 0, len(in.History))
+	for _, entry := range in.History {
+		history = append(history, &graphql.FetchRequestStatusHistoryEntry{
+			Condition: c.conditionToGraphQL(entry.Condition),
+			Message:   entry.Message,
+			Timestamp: graphql.Timestamp(entry.Timestamp),
+		})
 	}
 
 	return &graphql.FetchRequestStatus{
-		Condition: condition,
+		Condition: c.conditionToGraphQL(in.Condition),
 		Message:   in.Message,
 		Timestamp: graphql.Timestamp(in.Timestamp),
+		History:   history,
+	}
+}
+
+func (c *converter) conditionToGraphQL(in model.FetchRequestStatusCondition) graphql.FetchRequestStatusCondition {
+	switch in {
+	case model.FetchRequestStatusConditionFailed:
+		return graphql.FetchRequestStatusConditionFailed
+	case model.FetchRequestStatusConditionSucceeded:
+		return graphql.FetchRequestStatusConditionSucceeded
+	default:
+		return graphql.FetchRequestStatusConditionInitial
 	}
 }
 
@@ -197,6 +223,32 @@ func (c *converter) authToModel(in sql.NullString) (*model.Auth, error) {
 	return &auth, nil
 }
 
+func (c *converter) statusHistoryToEntity(in []*model.FetchRequestStatusHistoryEntry) (sql.NullString, error) {
+	if len(in) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	historyMarshalled, err := json.Marshal(in)
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling status history")
+	}
+
+	return repo.NewValidNullableString(string(historyMarshalled)), nil
+}
+
+func (c *converter) statusHistoryToModel(in sql.NullString) ([]*model.FetchRequestStatusHistoryEntry, error) {
+	if !in.Valid {
+		return nil, nil
+	}
+
+	var history []*model.FetchRequestStatusHistoryEntry
+	if err := json.Unmarshal([]byte(in.String), &history); err != nil {
+		return nil, errors.Wrap(err, "while unmarshalling status history")
+	}
+
+	return history, nil
+}
+
 func (c *converter) objectIDFromEntity(in Entity) (string, error) {
 	if in.SpecID.Valid {
 		return in.SpecID.String, nil
@@ -36,6 +36,7 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Expected: &graphql.FetchRequest{
 				Status: &graphql.FetchRequestStatus{
 					Condition: graphql.FetchRequestStatusConditionInitial,
+					History:   []*graphql.FetchRequestStatusHistoryEntry{},
 				},
 			},
 		},
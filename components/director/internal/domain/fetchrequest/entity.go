@@ -19,6 +19,9 @@ type Entity struct {
 	StatusCondition string         `db:"status_condition"`
 	StatusMessage   sql.NullString `db:"status_message"`
 	StatusTimestamp time.Time      `db:"status_timestamp"`
+	StatusHistory   sql.NullString `db:"status_history"`
+	ETag            sql.NullString `db:"etag"`
+	LastModified    sql.NullString `db:"last_modified"`
 }
 
 // GetID returns the ID of the fetch request.
@@ -0,0 +1,52 @@
+package fetchrequest
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxListedMatches is the maximum number of matching files listed in the error for an ambiguous filter.
+const maxListedMatches = 5
+
+// selectFile selects the single spec file out of the provided file paths, which matches the filter.
+// The filter is a glob pattern in the syntax of path.Match. A pattern without a slash is matched against the base name
+// of the files, so that "*.yaml" selects a YAML file in any directory. If no filter is provided, there must be exactly one file.
+func selectFile(paths []string, filter *string) (string, error) {
+	matches := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if filter == nil || matchesFilter(*filter, p) {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return matches[0], nil
+	case 0:
+		if filter == nil {
+			return "", errors.New("no spec files found")
+		}
+		return "", errors.Errorf("no spec files match filter %q", *filter)
+	}
+
+	sort.Strings(matches)
+	listed := matches
+	if len(listed) > maxListedMatches {
+		listed = append(listed[:maxListedMatches:maxListedMatches], "...")
+	}
+	if filter == nil {
+		return "", errors.Errorf("found %d spec files, a filter selecting one of them must be provided: %s", len(matches), strings.Join(listed, ", "))
+	}
+	return "", errors.Errorf("%d spec files match filter %q, it must select exactly one of them: %s", len(matches), *filter, strings.Join(listed, ", "))
+}
+
+func matchesFilter(filter, p string) bool {
+	if !strings.Contains(filter, "/") {
+		p = path.Base(p)
+	}
+	matched, err := path.Match(filter, p)
+	return err == nil && matched
+}
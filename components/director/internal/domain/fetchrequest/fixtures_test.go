@@ -1,8 +1,13 @@
 package fetchrequest_test
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"database/sql"
 	"encoding/json"
+	"sort"
 	"testing"
 	"time"
 
@@ -10,16 +15,19 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	tenantID = "b91b59f7-2563-40b2-aba9-fef726037aa3"
-	refID    = "refID"
+	tenantID     = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	refID        = "refID"
+	etag         = `"33a64df5"`
+	lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
 )
 
 func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest {
-	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	timestamp, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
 	require.NoError(t, err)
 
 	return &model.FetchRequest{
@@ -29,13 +37,20 @@ func fixModelFetchRequest(t *testing.T, url, filter string) *model.FetchRequest
 		Filter: &filter,
 		Status: &model.FetchRequestStatus{
 			Condition: model.FetchRequestStatusConditionInitial,
-			Timestamp: time,
+			Timestamp: timestamp,
+			History: []*model.FetchRequestStatusHistoryEntry{
+				{
+					Condition: model.FetchRequestStatusConditionFailed,
+					Message:   str.Ptr("While fetching Spec status code: 500"),
+					Timestamp: timestamp.Add(-1 * time.Hour),
+				},
+			},
 		},
 	}
 }
 
 func fixGQLFetchRequest(t *testing.T, url, filter string) *graphql.FetchRequest {
-	time, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
+	timestamp, err := time.Parse(time.RFC3339, "2002-10-02T10:00:00-05:00")
 	require.NoError(t, err)
 
 	return &graphql.FetchRequest{
@@ -45,7 +60,14 @@ func fixGQLFetchRequest(t *testing.T, url, filter string) *graphql.FetchRequest
 		Filter: &filter,
 		Status: &graphql.FetchRequestStatus{
 			Condition: graphql.FetchRequestStatusConditionInitial,
-			Timestamp: graphql.Timestamp(time),
+			Timestamp: graphql.Timestamp(timestamp),
+			History: []*graphql.FetchRequestStatusHistoryEntry{
+				{
+					Condition: graphql.FetchRequestStatusConditionFailed,
+					Message:   str.Ptr("While fetching Spec status code: 500"),
+					Timestamp: graphql.Timestamp(timestamp.Add(-1 * time.Hour)),
+				},
+			},
 		},
 	}
 }
@@ -86,7 +108,10 @@ func fixFullFetchRequestModelWithRefID(id string, timestamp time.Time, objectTyp
 		Status: &model.FetchRequestStatus{
 			Condition: model.FetchRequestStatusConditionSucceeded,
 			Timestamp: timestamp,
+			History:   fixStatusHistory(),
 		},
+		ETag:         str.Ptr(etag),
+		LastModified: str.Ptr(lastModified),
 		Auth: &model.Auth{
 			Credential: model.CredentialData{
 				Basic: &model.BasicCredentialData{
@@ -117,6 +142,9 @@ func fixFullFetchRequestEntityWithRefID(t *testing.T, id string, timestamp time.
 	bytes, err := json.Marshal(auth)
 	require.NoError(t, err)
 
+	history, err := json.Marshal(fixStatusHistory())
+	require.NoError(t, err)
+
 	var documentID sql.NullString
 	var specID sql.NullString
 	switch objectType {
@@ -139,6 +167,12 @@ func fixFullFetchRequestEntityWithRefID(t *testing.T, id string, timestamp time.
 		},
 		StatusCondition: string(model.FetchRequestStatusConditionSucceeded),
 		StatusTimestamp: timestamp,
+		StatusHistory: sql.NullString{
+			Valid:  true,
+			String: string(history),
+		},
+		ETag:         sql.NullString{Valid: true, String: etag},
+		LastModified: sql.NullString{Valid: true, String: lastModified},
 		Auth: sql.NullString{
 			Valid:  true,
 			String: string(bytes),
@@ -148,6 +182,16 @@ func fixFullFetchRequestEntityWithRefID(t *testing.T, id string, timestamp time.
 	}
 }
 
+func fixStatusHistory() []*model.FetchRequestStatusHistoryEntry {
+	return []*model.FetchRequestStatusHistoryEntry{
+		{
+			Condition: model.FetchRequestStatusConditionFailed,
+			Message:   str.Ptr("While fetching Spec status code: 500"),
+			Timestamp: time.Date(2022, 1, 18, 10, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
 func fixFetchRequestModelWithReference(id string, timestamp time.Time, objectType model.FetchRequestReferenceObjectType, objectID string) model.FetchRequest {
 	filter := "filter"
 	return model.FetchRequest{
@@ -183,5 +227,46 @@ func fixFetchRequestEntityWithReferences(id string, timestamp time.Time, specID,
 }
 
 func fixColumns() []string {
-	return []string{"id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id"}
+	return []string{"id", "document_id", "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", "spec_id", "status_history", "etag", "last_modified"}
+}
+
+func fixZipArchive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(buf)
+	for _, name := range sortedNames(files) {
+		file, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = file.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+	return buf.Bytes()
+}
+
+func fixTarGzArchive(t *testing.T, files map[string]string) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range sortedNames(files) {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tarWriter.Write([]byte(files[name]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func sortedNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
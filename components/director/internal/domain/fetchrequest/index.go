@@ -0,0 +1,65 @@
+package fetchrequest
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// specIndex is a document listing the specs of a provider, in the format of the swagger-ui configuration.
+type specIndex struct {
+	URLs []specIndexEntry `json:"urls"`
+}
+
+// specIndexEntry is a spec listed in a specIndex. The URL may be relative to the URL of the index.
+type specIndexEntry struct {
+	URL  string `json:"url"`
+	Name string `json:"name,omitempty"`
+}
+
+// selectSpecFromIndex returns the absolute URL of the spec from the index, which is selected by the filter.
+// The filter is matched against the path of the spec URL, and against the name of the spec, if there is such.
+// The specs must be served from the same origin as the index, as they are fetched with the same credentials.
+func selectSpecFromIndex(body []byte, indexURL string, filter *string) (string, error) {
+	var index specIndex
+	if err := json.Unmarshal(body, &index); err != nil {
+		return "", errors.Wrap(err, "while unmarshalling index")
+	}
+
+	base, err := url.Parse(indexURL)
+	if err != nil {
+		return "", errors.Wrap(err, "while parsing index URL")
+	}
+
+	specURLs := make(map[string]string, len(index.URLs))
+	paths := make([]string, 0, len(index.URLs))
+	for _, entry := range index.URLs {
+		ref, err := url.Parse(entry.URL)
+		if err != nil {
+			return "", errors.Wrapf(err, "while parsing spec URL %q", entry.URL)
+		}
+
+		specURL := base.ResolveReference(ref)
+		if specURL.Scheme != base.Scheme || specURL.Host != base.Host {
+			return "", errors.Errorf("spec URL %q is not on the same origin as the index", entry.URL)
+		}
+
+		p := strings.TrimPrefix(specURL.Path, "/")
+		if filter != nil && len(entry.Name) > 0 && matchesFilter(*filter, entry.Name) {
+			p = entry.Name
+		}
+		if _, ok := specURLs[p]; ok {
+			continue
+		}
+		specURLs[p] = specURL.String()
+		paths = append(paths, p)
+	}
+
+	selected, err := selectFile(paths, filter)
+	if err != nil {
+		return "", err
+	}
+	return specURLs[selected], nil
+}
@@ -2,6 +2,7 @@ package fetchrequest
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
@@ -16,7 +17,7 @@ const documentIDColumn = "document_id"
 const specIDColumn = "spec_id"
 
 var (
-	fetchRequestColumns = []string{"id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn}
+	fetchRequestColumns = []string{"id", documentIDColumn, "url", "auth", "mode", "filter", "status_condition", "status_message", "status_timestamp", specIDColumn, "status_history", "etag", "last_modified"}
 )
 
 // Converter missing godoc
@@ -42,7 +43,7 @@ func NewRepository(conv Converter) *repository {
 		singleGetter: repo.NewSingleGetter(fetchRequestTable, fetchRequestColumns),
 		lister:       repo.NewLister(fetchRequestTable, fetchRequestColumns),
 		deleter:      repo.NewDeleter(fetchRequestTable),
-		updater:      repo.NewUpdater(fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp"}, []string{"id"}),
+		updater:      repo.NewUpdater(fetchRequestTable, []string{"status_condition", "status_message", "status_timestamp", "status_history", "etag", "last_modified"}, []string{"id"}),
 		conv:         conv,
 	}
 }
@@ -148,6 +149,49 @@ func (r *repository) ListByReferenceObjectIDs(ctx context.Context, tenant string
 	return fetchRequests, nil
 }
 
+// ListStaleByTenant lists the fetch requests of API or Event specifications across all tenants, which have been executed before staleBefore.
+// The fetch requests are grouped by the ID of a tenant owning the specification, so that each of them is listed only once.
+func (r *repository) ListStaleByTenant(ctx context.Context, objectType model.FetchRequestReferenceObjectType, staleBefore time.Time) (map[string][]*model.FetchRequest, error) {
+	if objectType != model.APISpecFetchRequestReference && objectType != model.EventSpecFetchRequestReference {
+		return nil, apperrors.NewInternalError("Stale fetch requests can be listed only for API and Event specifications")
+	}
+
+	tenantAccessView, ok := objectType.GetResourceType().TenantAccessTable()
+	if !ok {
+		return nil, apperrors.NewInternalError("Missing tenant access view for %s fetch requests", objectType)
+	}
+
+	columns := make([]string, 0, len(fetchRequestColumns)+1)
+	columns = append(columns, fetchRequestColumns...)
+	columns = append(columns, repo.M2MTenantIDColumn)
+
+	var entities entitiesWithTenant
+	lister := repo.NewListerGlobal(objectType.GetResourceType(), tenantAccessView, columns)
+	if err := lister.ListGlobal(ctx, &entities,
+		repo.NewEqualCondition(repo.M2MOwnerColumn, true),
+		repo.NewNotEqualCondition("status_condition", string(model.FetchRequestStatusConditionInitial)),
+		repo.NewLessThanCondition("status_timestamp", staleBefore)); err != nil {
+		return nil, err
+	}
+
+	listed := make(map[string]bool, len(entities))
+	fetchRequestsByTenant := make(map[string][]*model.FetchRequest)
+	for _, entity := range entities {
+		if listed[entity.ID] {
+			continue
+		}
+		listed[entity.ID] = true
+
+		m, err := r.conv.FromEntity(&entity.Entity, objectType)
+		if err != nil {
+			return nil, errors.Wrap(err, "while creating FetchRequest model from entity")
+		}
+		fetchRequestsByTenant[entity.TenantID] = append(fetchRequestsByTenant[entity.TenantID], m)
+	}
+
+	return fetchRequestsByTenant, nil
+}
+
 func (r *repository) referenceObjectFieldName(objectType model.FetchRequestReferenceObjectType) (string, error) {
 	switch objectType {
 	case model.DocumentFetchRequestReference:
@@ -161,6 +205,18 @@ func (r *repository) referenceObjectFieldName(objectType model.FetchRequestRefer
 	return "", apperrors.NewInternalError("Invalid type of the Fetch Request reference object")
 }
 
+type entityWithTenant struct {
+	Entity
+	TenantID string `db:"tenant_id"`
+}
+
+type entitiesWithTenant []entityWithTenant
+
+// Len returns the number of entities in the collection.
+func (r entitiesWithTenant) Len() int {
+	return len(r)
+}
+
 // FetchRequestsCollection missing godoc
 type FetchRequestsCollection []Entity
 
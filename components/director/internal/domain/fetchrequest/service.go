@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"path"
 	"time"
 
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
@@ -24,6 +25,15 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 )
 
+const (
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+
+	specNotModifiedMessage = "Spec has not been modified"
+)
+
 type service struct {
 	repo                           FetchRequestRepository
 	client                         *http.Client
@@ -47,16 +57,28 @@ func NewService(repo FetchRequestRepository, client *http.Client, executorProvid
 	}
 }
 
-// HandleSpec missing godoc
-func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest) *string {
+// HandleSpec fetches the spec of the fetch request and records the outcome in the fetch request status.
+// If a validator is provided, a fetched spec, which is not valid, is not returned, and the fetch request status is set to failed.
+func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string {
+	return s.handleSpec(ctx, fr, validator, false)
+}
+
+// RefreshSpec re-fetches the spec of an already executed fetch request. The spec is fetched with a conditional request,
+// based on the HTTP cache validators of the last fetched spec, so nil is returned if the spec has not been modified since then.
+// As with HandleSpec, nil is returned if the spec could not be fetched, and the outcome is recorded in the fetch request status.
+func (s *service) RefreshSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string {
+	return s.handleSpec(ctx, fr, validator, true)
+}
+
+func (s *service) handleSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator, conditional bool) *string {
 	tnt, err := tenant.LoadFromContext(ctx)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while getting tenant: %v", err)
 		return nil
 	}
 
-	var data *string
-	data, fr.Status = s.fetchSpec(ctx, fr)
+	data, status := s.fetchSpec(ctx, fr, validator, conditional)
+	setStatus(fr, status)
 
 	if err := s.repo.Update(ctx, tnt, fr); err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while updating fetch request status: %v", err)
@@ -66,32 +88,85 @@ func (s *service) HandleSpec(ctx context.Context, fr *model.FetchRequest) *strin
 	return data
 }
 
-func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*string, *model.FetchRequestStatus) {
+func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator, conditional bool) (*string, *model.FetchRequestStatus) {
 	err := s.validateFetchRequest(fr)
 	if err != nil {
 		log.C(ctx).WithError(err).Error()
 		return nil, FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr(err.Error()), s.timestampGen())
 	}
 
+	specURL := fr.URL
+	if fr.Mode == model.FetchModeIndex {
+		index, _, status := s.fetch(ctx, fr, fr.URL, nil)
+		if status != nil {
+			return nil, status
+		}
+
+		specURL, err = selectSpecFromIndex(index, fr.URL, fr.Filter)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while selecting Spec from index: %v", err)
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While selecting Spec from index: %s", err.Error())), s.timestampGen())
+		}
+		log.C(ctx).Infof("Fetching Spec %s selected from the index of Fetch Request with id %s", specURL, fr.ID)
+	}
+
+	// The validators are those of the spec itself, so for an INDEX fetch request the index is always fetched, and only the spec is fetched conditionally.
+	var headers http.Header
+	if conditional {
+		headers = conditionalHeaders(fr)
+	}
+
+	body, respHeaders, status := s.fetch(ctx, fr, specURL, headers)
+	if status != nil {
+		return nil, status
+	}
+
+	if fr.Mode == model.FetchModeBundle {
+		body, err = extractSpecFromArchive(body, fr.Filter)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error has occurred while extracting Spec from bundle: %v", err)
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While extracting Spec from bundle: %s", err.Error())), s.timestampGen())
+		}
+	}
+
+	// The cache validators are not stored for an invalid spec, so that it is fetched again on the next refresh.
+	if validator != nil {
+		if err := validator(string(body)); err != nil {
+			log.C(ctx).WithError(err).Errorf("Fetched Spec of Fetch Request with id %s is not valid: %v", fr.ID, err)
+			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While validating Spec: %s", err.Error())), s.timestampGen())
+		}
+	}
+
+	fr.ETag = headerValue(respHeaders, etagHeader)
+	fr.LastModified = headerValue(respHeaders, lastModifiedHeader)
+
+	spec := string(body)
+	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+}
+
+// fetch executes a GET request to the given URL with the authentication of the fetch request and returns the body and the headers of the response.
+// A status is returned if the response could not be fetched successfully, or if the resource has not been modified since the conditional request validators.
+func (s *service) fetch(ctx context.Context, fr *model.FetchRequest, url string, headers http.Header) ([]byte, http.Header, *model.FetchRequestStatus) {
 	var resp *http.Response
+	var err error
 	if fr.Auth != nil && fr.Auth.AccessStrategy != nil && len(*fr.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("Fetch Request with id %s is configured with %s access strategy.", fr.ID, *fr.Auth.AccessStrategy)
 		var executor accessstrategy.Executor
 		executor, err = s.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*fr.Auth.AccessStrategy))
 		if err != nil {
 			log.C(ctx).WithError(err).Errorf("Cannot find executor for access strategy %q as part of fetch request %s processing: %v", *fr.Auth.AccessStrategy, fr.ID, err)
-			return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", err.Error())), s.timestampGen())
+			return nil, nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", err.Error())), s.timestampGen())
 		}
-		resp, err = executor.Execute(s.client, fr.URL)
+		resp, err = executor.Execute(s.client, url, headers)
 	} else if fr.Auth != nil {
-		resp, err = httputil.GetRequestWithCredentials(ctx, s.client, fr.URL, fr.Auth)
+		resp, err = httputil.GetRequestWithCredentialsAndHeaders(ctx, s.client, url, headers, fr.Auth)
 	} else {
-		resp, err = httputil.GetRequestWithoutCredentials(s.client, fr.URL)
+		resp, err = httputil.GetRequestWithoutCredentialsAndHeaders(s.client, url, headers)
 	}
 
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while fetching Spec: %v", err)
-		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", err.Error())), s.timestampGen())
+		return nil, nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec: %s", err.Error())), s.timestampGen())
 	}
 
 	defer func() {
@@ -103,30 +178,82 @@ func (s *service) fetchSpec(ctx context.Context, fr *model.FetchRequest) (*strin
 		}
 	}()
 
+	if resp.StatusCode == http.StatusNotModified && len(headers) > 0 {
+		log.C(ctx).Infof("Spec of fetch request with id %q has not been modified", fr.ID)
+		return nil, nil, FixStatus(model.FetchRequestStatusConditionSucceeded, str.Ptr(specNotModifiedMessage), s.timestampGen())
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error has occurred while reading Spec: %v", err)
-		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
+		return nil, nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While reading Spec: %s", err.Error())), s.timestampGen())
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		log.C(ctx).Errorf("Failed to execute fetch request for %s with id %q: status code: %d body: %s", fr.ObjectType, fr.ObjectID, resp.StatusCode, string(body))
-		return nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
+		return nil, nil, FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(fmt.Sprintf("While fetching Spec status code: %d", resp.StatusCode)), s.timestampGen())
 	}
 
-	spec := string(body)
-	return &spec, FixStatus(model.FetchRequestStatusConditionSucceeded, nil, s.timestampGen())
+	return body, resp.Header, nil
 }
 
 func (s *service) validateFetchRequest(fr *model.FetchRequest) error {
-	if fr.Mode != model.FetchModeSingle {
+	switch fr.Mode {
+	case model.FetchModeSingle:
+		if fr.Filter != nil {
+			return apperrors.NewInvalidDataError("Filter for Fetch Request is supported only for %s and %s fetch modes", model.FetchModeBundle, model.FetchModeIndex)
+		}
+	case model.FetchModeBundle, model.FetchModeIndex:
+		if fr.Filter != nil {
+			if _, err := path.Match(*fr.Filter, ""); err != nil {
+				return apperrors.NewInvalidDataError("Invalid filter for Fetch Request: %s", err)
+			}
+		}
+	default:
 		return apperrors.NewInvalidDataError("Unsupported fetch mode: %s", fr.Mode)
 	}
 
-	if fr.Filter != nil {
-		return apperrors.NewInvalidDataError("Filter for Fetch Request was provided, currently it's unsupported")
+	return nil
+}
+
+// setStatus sets the new status of the fetch request, and records the previous one in the status history.
+// The initial status of a fetch request, which has never been executed, is not recorded.
+func setStatus(fr *model.FetchRequest, status *model.FetchRequestStatus) {
+	var history []*model.FetchRequestStatusHistoryEntry
+	if previous := fr.Status; previous != nil {
+		history = previous.History
+		if previous.Condition != model.FetchRequestStatusConditionInitial || previous.Message != nil {
+			history = append([]*model.FetchRequestStatusHistoryEntry{{
+				Condition: previous.Condition,
+				Message:   previous.Message,
+				Timestamp: previous.Timestamp,
+			}}, history...)
+		}
+	}
+	if len(history) > model.MaxFetchRequestStatusHistory {
+		history = history[:model.MaxFetchRequestStatusHistory]
+	}
+
+	status.History = history
+	fr.Status = status
+}
+
+// conditionalHeaders returns the headers of a conditional request based on the cache validators of the fetch request.
+func conditionalHeaders(fr *model.FetchRequest) http.Header {
+	headers := http.Header{}
+	if fr.ETag != nil {
+		headers.Set(ifNoneMatchHeader, *fr.ETag)
 	}
+	if fr.LastModified != nil {
+		headers.Set(ifModifiedSinceHeader, *fr.LastModified)
+	}
+	return headers
+}
 
+func headerValue(headers http.Header, key string) *string {
+	if value := headers.Get(key); len(value) > 0 {
+		return &value
+	}
 	return nil
 }
 
@@ -62,11 +62,49 @@ func TestService_HandleSpec(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
+	const indexURL = "http://test.com/index.json"
+
+	modelInputUnknownMode := model.FetchRequest{
+		ID:   "test",
+		Mode: "UNKNOWN",
+	}
+
+	modelInputMalformedFilter := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("["),
+	}
+
 	modelInputBundle := model.FetchRequest{
 		ID:   "test",
 		Mode: model.FetchModeBundle,
 	}
 
+	modelInputBundleWithFilter := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("specs/api.*"),
+	}
+
+	modelInputBundleWithAmbiguousFilter := model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeBundle,
+		Filter: str.Ptr("*.yaml"),
+	}
+
+	modelInputIndex := model.FetchRequest{
+		ID:   "test",
+		URL:  indexURL,
+		Mode: model.FetchModeIndex,
+	}
+
+	modelInputIndexWithFilter := model.FetchRequest{
+		ID:     "test",
+		URL:    indexURL,
+		Mode:   model.FetchModeIndex,
+		Filter: str.Ptr("api"),
+	}
+
 	modelInputFilter := model.FetchRequest{
 		ID:     "test",
 		Mode:   model.FetchModeSingle,
@@ -144,16 +182,178 @@ func TestService_HandleSpec(t *testing.T) {
 			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
 		},
 		{
-			Name: "Nil when fetch request validation fails due to mode Bundle",
+			Name: "Nil when fetch request validation fails due to unknown mode",
 			Client: func(t *testing.T) *http.Client {
 				return NewTestClient(func(req *http.Request) *http.Response {
 					return &http.Response{}
 				})
 			},
 
-			InputFr:        modelInputBundle,
+			InputFr:        modelInputUnknownMode,
+			ExpectedResult: nil,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: UNKNOWN]"), timestamp),
+		},
+		{
+			Name: "Nil when fetch request validation fails due to malformed filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{}
+				})
+			},
+
+			InputFr:        modelInputMalformedFilter,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Unsupported fetch mode: BUNDLE]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Invalid filter for Fetch Request: syntax error in pattern]"), timestamp),
+		},
+		{
+			Name: "Success with mode Bundle for zip archive and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader(fixZipArchive(t, map[string]string{
+							"specs/api.yaml":          mockSpec,
+							"specs/schemas/pet.yaml":  "pet",
+							"README.md":               "readme",
+							"__MACOSX/specs/api.yaml": "metadata",
+						}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithFilter,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success with mode Bundle for tar.gz archive with a single spec",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader(fixTarGzArchive(t, map[string]string{
+							"./api.json": mockSpec,
+							".hidden":    "hidden",
+						}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Success with mode Bundle for tar.gz archive and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader(fixTarGzArchive(t, map[string]string{
+							"specs/api.yaml":         mockSpec,
+							"specs/schemas/pet.json": "pet",
+						}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithFilter,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails with mode Bundle when filter matches multiple specs",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader(fixZipArchive(t, map[string]string{
+							"specs/api.yaml":         mockSpec,
+							"specs/schemas/pet.yaml": "pet",
+						}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundleWithAmbiguousFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(`While extracting Spec from bundle: 2 spec files match filter "*.yaml", it must select exactly one of them: specs/api.yaml, specs/schemas/pet.yaml`), timestamp),
+		},
+		{
+			Name: "Fails with mode Bundle when no filter is provided for multiple specs",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body: ioutil.NopCloser(bytes.NewReader(fixTarGzArchive(t, map[string]string{
+							"api.yaml":    mockSpec,
+							"events.yaml": "events",
+						}))),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: found 2 spec files, a filter selecting one of them must be provided: api.yaml, events.yaml"), timestamp),
+		},
+		{
+			Name: "Fails with mode Bundle when response is not an archive",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:        modelInputBundle,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While extracting Spec from bundle: unsupported bundle format, expected a zip, tar, or tar.gz archive"), timestamp),
+		},
+		{
+			Name: "Success with mode Index and filter",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					body := mockSpec
+					if req.URL.String() == indexURL {
+						body = `{"urls":[{"url":"/specs/api.yaml","name":"api"},{"url":"events/events.yaml","name":"events"}]}`
+					} else {
+						assert.Equal(t, "http://test.com/specs/api.yaml", req.URL.String())
+					}
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+					}
+				})
+			},
+			InputFr:        modelInputIndexWithFilter,
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp),
+		},
+		{
+			Name: "Fails with mode Index when spec is not on the same origin",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(`{"urls":[{"url":"http://other.com/specs/api.yaml","name":"api"}]}`)),
+					}
+				})
+			},
+			InputFr:        modelInputIndexWithFilter,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr(`While selecting Spec from index: spec URL "http://other.com/specs/api.yaml" is not on the same origin as the index`), timestamp),
+		},
+		{
+			Name: "Fails with mode Index when selected spec fetch fails",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					if req.URL.String() == indexURL {
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(`{"urls":[{"url":"/specs/api.yaml"}]}`)),
+						}
+					}
+					return &http.Response{
+						StatusCode: http.StatusNotFound,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:        modelInputIndex,
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 404"), timestamp),
 		},
 		{
 			Name: "Nil when fetch request validation fails due to provided filter",
@@ -165,13 +365,13 @@ func TestService_HandleSpec(t *testing.T) {
 
 			InputFr:        modelInputFilter,
 			ExpectedResult: nil,
-			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request was provided, currently it's unsupported]"), timestamp),
+			ExpectedStatus: fetchrequest.FixStatus(model.FetchRequestStatusConditionInitial, str.Ptr("Invalid data [reason=Filter for Fetch Request is supported only for BUNDLE and INDEX fetch modes]"), timestamp),
 		},
 		{
 			Name: "Success with access strategy",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL).Return(&http.Response{
+				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL, http.Header(nil)).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
 				}, nil).Once()
@@ -204,7 +404,7 @@ func TestService_HandleSpec(t *testing.T) {
 			Name: "Fails when access strategy execution fail",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &accessstrategyautomock.Executor{}
-				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL).Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, modelInputAccessStrategy.URL, http.Header(nil)).Return(nil, testErr).Once()
 
 				executorProvider := &accessstrategyautomock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -351,7 +551,7 @@ func TestService_HandleSpec(t *testing.T) {
 			svc := fetchrequest.NewService(frRepo, testCase.Client(t), executorProviderMock)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
-			result := svc.HandleSpec(ctx, &testCase.InputFr)
+			result := svc.HandleSpec(ctx, &testCase.InputFr, nil)
 
 			assert.Equal(t, testCase.ExpectedStatus, testCase.InputFr.Status)
 			assert.Equal(t, testCase.ExpectedResult, result)
@@ -385,9 +585,251 @@ func TestService_HandleSpec_FailedToUpdateStatusAfterFetching(t *testing.T) {
 		Mode: model.FetchModeSingle,
 	}
 
-	result := svc.HandleSpec(ctx, modelInput)
+	result := svc.HandleSpec(ctx, modelInput, nil)
 	expectedStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp)
 
 	assert.Equal(t, expectedStatus, modelInput.Status)
 	assert.Nil(t, result)
 }
+
+func TestService_RefreshSpec(t *testing.T) {
+	const (
+		specURL     = "http://test.com/specs/api.yaml"
+		indexURL    = "http://test.com/index.json"
+		newETag     = `"new-etag"`
+		newModified = "Thu, 22 Oct 2015 07:28:00 GMT"
+	)
+
+	mockSpec := "spec"
+	timestamp := time.Now()
+	previousTimestamp := timestamp.Add(-24 * time.Hour)
+
+	previousStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, previousTimestamp)
+	previousHistoryEntry := &model.FetchRequestStatusHistoryEntry{
+		Condition: model.FetchRequestStatusConditionSucceeded,
+		Timestamp: previousTimestamp,
+	}
+
+	fixFetchRequest := func(url string, mode model.FetchMode, etag, lastModified *string) model.FetchRequest {
+		status := *previousStatus
+		return model.FetchRequest{
+			ID:           "test",
+			URL:          url,
+			Mode:         mode,
+			Status:       &status,
+			ETag:         etag,
+			LastModified: lastModified,
+		}
+	}
+
+	fixStatusWithHistory := func(condition model.FetchRequestStatusCondition, message *string) *model.FetchRequestStatus {
+		status := fetchrequest.FixStatus(condition, message, timestamp)
+		status.History = []*model.FetchRequestStatusHistoryEntry{previousHistoryEntry}
+		return status
+	}
+
+	testCases := []struct {
+		Name                 string
+		Client               func(t *testing.T) *http.Client
+		InputFr              model.FetchRequest
+		ExpectedResult       *string
+		ExpectedStatus       *model.FetchRequestStatus
+		ExpectedETag         *string
+		ExpectedLastModified *string
+	}{
+		{
+			Name: "Not modified spec is not returned",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					assert.Equal(t, lastModified, req.Header.Get("If-Modified-Since"))
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:              fixFetchRequest(specURL, model.FetchModeSingle, str.Ptr(etag), str.Ptr(lastModified)),
+			ExpectedResult:       nil,
+			ExpectedStatus:       fixStatusWithHistory(model.FetchRequestStatusConditionSucceeded, str.Ptr("Spec has not been modified")),
+			ExpectedETag:         str.Ptr(etag),
+			ExpectedLastModified: str.Ptr(lastModified),
+		},
+		{
+			Name: "Modified spec is returned with its new validators",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Header:     http.Header{"Etag": []string{newETag}, "Last-Modified": []string{newModified}},
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:              fixFetchRequest(specURL, model.FetchModeSingle, str.Ptr(etag), nil),
+			ExpectedResult:       &mockSpec,
+			ExpectedStatus:       fixStatusWithHistory(model.FetchRequestStatusConditionSucceeded, nil),
+			ExpectedETag:         str.Ptr(newETag),
+			ExpectedLastModified: str.Ptr(newModified),
+		},
+		{
+			Name: "Spec without validators is fetched unconditionally",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					assert.Empty(t, req.Header.Get("If-None-Match"))
+					assert.Empty(t, req.Header.Get("If-Modified-Since"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewBufferString(mockSpec)),
+					}
+				})
+			},
+			InputFr:        fixFetchRequest(specURL, model.FetchModeSingle, nil, nil),
+			ExpectedResult: &mockSpec,
+			ExpectedStatus: fixStatusWithHistory(model.FetchRequestStatusConditionSucceeded, nil),
+		},
+		{
+			Name: "Only the spec selected from the index is fetched conditionally",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					if req.URL.String() == indexURL {
+						assert.Empty(t, req.Header.Get("If-None-Match"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body:       ioutil.NopCloser(bytes.NewBufferString(`{"urls":[{"url":"/specs/api.yaml"}]}`)),
+						}
+					}
+					assert.Equal(t, specURL, req.URL.String())
+					assert.Equal(t, etag, req.Header.Get("If-None-Match"))
+					return &http.Response{
+						StatusCode: http.StatusNotModified,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:        fixFetchRequest(indexURL, model.FetchModeIndex, str.Ptr(etag), nil),
+			ExpectedResult: nil,
+			ExpectedStatus: fixStatusWithHistory(model.FetchRequestStatusConditionSucceeded, str.Ptr("Spec has not been modified")),
+			ExpectedETag:   str.Ptr(etag),
+		},
+		{
+			Name: "Validators are kept when the spec could not be fetched",
+			Client: func(t *testing.T) *http.Client {
+				return NewTestClient(func(req *http.Request) *http.Response {
+					return &http.Response{
+						StatusCode: http.StatusInternalServerError,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("")),
+					}
+				})
+			},
+			InputFr:              fixFetchRequest(specURL, model.FetchModeSingle, str.Ptr(etag), str.Ptr(lastModified)),
+			ExpectedResult:       nil,
+			ExpectedStatus:       fixStatusWithHistory(model.FetchRequestStatusConditionFailed, str.Ptr("While fetching Spec status code: 500")),
+			ExpectedETag:         str.Ptr(etag),
+			ExpectedLastModified: str.Ptr(lastModified),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			ctx := tenant.SaveToContext(context.TODO(), tenantID, tenantID)
+
+			frRepo := &automock.FetchRequestRepository{}
+			frRepo.On("Update", ctx, tenantID, &testCase.InputFr).Return(nil).Once()
+
+			svc := fetchrequest.NewService(frRepo, testCase.Client(t), accessstrategy.NewDefaultExecutorProvider(certloader.NewCertificateCache()))
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			result := svc.RefreshSpec(ctx, &testCase.InputFr, nil)
+
+			assert.Equal(t, testCase.ExpectedResult, result)
+			assert.Equal(t, testCase.ExpectedStatus, testCase.InputFr.Status)
+			assert.Equal(t, testCase.ExpectedETag, testCase.InputFr.ETag)
+			assert.Equal(t, testCase.ExpectedLastModified, testCase.InputFr.LastModified)
+			frRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_HandleSpec_LimitsStatusHistory(t *testing.T) {
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, tenantID)
+	timestamp := time.Now()
+
+	history := make([]*model.FetchRequestStatusHistoryEntry, 0, model.MaxFetchRequestStatusHistory)
+	for i := 0; i < model.MaxFetchRequestStatusHistory; i++ {
+		history = append(history, &model.FetchRequestStatusHistoryEntry{
+			Condition: model.FetchRequestStatusConditionFailed,
+			Message:   str.Ptr(fmt.Sprintf("failure %d", i)),
+			Timestamp: timestamp.Add(-time.Duration(i+2) * time.Hour),
+		})
+	}
+	previousStatus := fetchrequest.FixStatus(model.FetchRequestStatusConditionSucceeded, nil, timestamp.Add(-time.Hour))
+	previousStatus.History = history
+
+	frRepo := &automock.FetchRequestRepository{}
+	frRepo.On("Update", ctx, tenantID, mock.Anything).Return(nil).Once()
+
+	svc := fetchrequest.NewService(frRepo, NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("spec")),
+		}
+	}), accessstrategy.NewDefaultExecutorProvider(certloader.NewCertificateCache()))
+	svc.SetTimestampGen(func() time.Time { return timestamp })
+
+	fr := &model.FetchRequest{
+		ID:     "test",
+		Mode:   model.FetchModeSingle,
+		Status: previousStatus,
+	}
+
+	svc.HandleSpec(ctx, fr, nil)
+
+	assert.Equal(t, model.FetchRequestStatusConditionSucceeded, fr.Status.Condition)
+	assert.Equal(t, timestamp, fr.Status.Timestamp)
+	assert.Len(t, fr.Status.History, model.MaxFetchRequestStatusHistory)
+	assert.Equal(t, &model.FetchRequestStatusHistoryEntry{
+		Condition: model.FetchRequestStatusConditionSucceeded,
+		Timestamp: timestamp.Add(-time.Hour),
+	}, fr.Status.History[0])
+	assert.Equal(t, history[:model.MaxFetchRequestStatusHistory-1], fr.Status.History[1:])
+	frRepo.AssertExpectations(t)
+}
+
+func TestService_HandleSpec_InvalidSpec(t *testing.T) {
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, tenantID)
+
+	timestamp := time.Now()
+	frRepo := &automock.FetchRequestRepository{}
+	frRepo.On("Update", ctx, tenantID, mock.Anything).Return(nil).Once()
+
+	svc := fetchrequest.NewService(frRepo, NewTestClient(func(req *http.Request) *http.Response {
+		header := http.Header{}
+		header.Set("ETag", `"etag"`)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("spec")),
+		}
+	}), accessstrategy.NewDefaultExecutorProvider(certloader.NewCertificateCache()))
+	svc.SetTimestampGen(func() time.Time { return timestamp })
+
+	fr := &model.FetchRequest{
+		ID:   "test",
+		Mode: model.FetchModeSingle,
+	}
+
+	var validatedSpec string
+	result := svc.HandleSpec(ctx, fr, func(spec string) error {
+		validatedSpec = spec
+		return errors.New("invalid spec")
+	})
+
+	assert.Nil(t, result)
+	assert.Equal(t, "spec", validatedSpec)
+	assert.Equal(t, fetchrequest.FixStatus(model.FetchRequestStatusConditionFailed, str.Ptr("While validating Spec: invalid spec"), timestamp), fr.Status)
+	assert.Nil(t, fr.ETag)
+	frRepo.AssertExpectations(t)
+}
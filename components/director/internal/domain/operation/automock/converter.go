@@ -0,0 +1,47 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	operation "github.com/kyma-incubator/compass/components/director/pkg/operation"
+)
+
+// Converter is an autogenerated mock type for the Converter type
+type Converter struct {
+	mock.Mock
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *Converter) MultipleToGraphQL(in []*operation.Details) []*graphql.Operation {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.Operation
+	if rf, ok := ret.Get(0).(func([]*operation.Details) []*graphql.Operation); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.Operation)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *Converter) ToGraphQL(in *operation.Details) *graphql.Operation {
+	ret := _m.Called(in)
+
+	var r0 *graphql.Operation
+	if rf, ok := ret.Get(0).(func(*operation.Details) *graphql.Operation); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.Operation)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,62 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	operation "github.com/kyma-incubator/compass/components/director/pkg/operation"
+)
+
+// Fetcher is an autogenerated mock type for the Fetcher type
+type Fetcher struct {
+	mock.Mock
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *Fetcher) GetByID(ctx context.Context, id string) (*operation.Details, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *operation.Details
+	if rf, ok := ret.Get(0).(func(context.Context, string) *operation.Details); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operation.Details)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, filter
+func (_m *Fetcher) List(ctx context.Context, filter operation.Filter) ([]*operation.Details, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 []*operation.Details
+	if rf, ok := ret.Get(0).(func(context.Context, operation.Filter) []*operation.Details); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*operation.Details)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, operation.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,62 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	operation "github.com/kyma-incubator/compass/components/director/pkg/operation"
+)
+
+// Transitioner is an autogenerated mock type for the Transitioner type
+type Transitioner struct {
+	mock.Mock
+}
+
+// Cancel provides a mock function with given fields: ctx, details, triggeredBy
+func (_m *Transitioner) Cancel(ctx context.Context, details *operation.Details, triggeredBy string) (*operation.Details, error) {
+	ret := _m.Called(ctx, details, triggeredBy)
+
+	var r0 *operation.Details
+	if rf, ok := ret.Get(0).(func(context.Context, *operation.Details, string) *operation.Details); ok {
+		r0 = rf(ctx, details, triggeredBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operation.Details)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *operation.Details, string) error); ok {
+		r1 = rf(ctx, details, triggeredBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Retry provides a mock function with given fields: ctx, details, triggeredBy
+func (_m *Transitioner) Retry(ctx context.Context, details *operation.Details, triggeredBy string) (*operation.Details, error) {
+	ret := _m.Called(ctx, details, triggeredBy)
+
+	var r0 *operation.Details
+	if rf, ok := ret.Get(0).(func(context.Context, *operation.Details, string) *operation.Details); ok {
+		r0 = rf(ctx, details, triggeredBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operation.Details)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *operation.Details, string) error); ok {
+		r1 = rf(ctx, details, triggeredBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,82 @@
+package operation
+
+import (
+	"strings"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+)
+
+type converter struct{}
+
+// NewConverter returns a new Converter that can later be used to make the conversions between the operation-layer and graphql-layer representations of an asynchronous operation.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToGraphQL converts the provided operation details to their graphql-layer representation.
+func (c *converter) ToGraphQL(in *operation.Details) *graphql.Operation {
+	if in == nil {
+		return nil
+	}
+
+	webhooks := make([]*graphql.OperationWebhook, 0, len(in.Webhooks))
+	for _, webhook := range in.Webhooks {
+		webhooks = append(webhooks, &graphql.OperationWebhook{
+			WebhookID:          webhook.WebhookID,
+			State:              graphql.OperationState(webhook.Status),
+			RetriesCount:       webhook.RetriesCount,
+			LastPollTimestamp:  strPtrOrNil(webhook.LastPollTimestamp),
+			FailedAttempts:     webhook.FailedAttempts,
+			LastError:          strPtrOrNil(webhook.LastError),
+			NextRetryTimestamp: strPtrOrNil(webhook.NextRetryTimestamp),
+		})
+	}
+
+	result := &graphql.Operation{
+		ID:                in.OperationID,
+		OperationType:     graphql.OperationType(strings.ToUpper(string(in.OperationType))),
+		OperationCategory: strPtrOrNil(in.OperationCategory),
+		ResourceType:      string(in.ResourceType),
+		ResourceID:        in.ResourceID,
+		CorrelationID:     strPtrOrNil(in.CorrelationID),
+		State:             graphql.OperationState(in.Status),
+		Error:             in.Error,
+		Webhooks:          webhooks,
+		CancelledBy:       strPtrOrNil(in.CancelledBy),
+		RetriedBy:         strPtrOrNil(in.RetriedBy),
+	}
+
+	if !in.CreationTime.IsZero() {
+		createdAt := graphql.Timestamp(in.CreationTime)
+		result.CreatedAt = &createdAt
+	}
+
+	if in.InitializedAt != nil {
+		initializedAt := graphql.Timestamp(*in.InitializedAt)
+		result.InitializedAt = &initializedAt
+	}
+
+	return result
+}
+
+// MultipleToGraphQL converts the provided operation details to their graphql-layer representations.
+func (c *converter) MultipleToGraphQL(in []*operation.Details) []*graphql.Operation {
+	operations := make([]*graphql.Operation, 0, len(in))
+	for _, details := range in {
+		if details == nil {
+			continue
+		}
+		operations = append(operations, c.ToGraphQL(details))
+	}
+
+	return operations
+}
+
+func strPtrOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
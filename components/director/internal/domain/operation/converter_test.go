@@ -0,0 +1,64 @@
+package operation_test
+
+import (
+	"testing"
+
+	domainoperation "github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		// WHEN
+		result := domainoperation.NewConverter().ToGraphQL(fixOperationDetails())
+
+		// THEN
+		assert.Equal(t, fixOperationGraphQL(), result)
+	})
+
+	t.Run("Success for operation which has not been processed yet", func(t *testing.T) {
+		// GIVEN
+		details := &operation.Details{
+			Operation: operation.Operation{
+				OperationID:   operationID,
+				OperationType: operation.OperationTypeDelete,
+				ResourceID:    resourceID,
+				ResourceType:  "runtime",
+				WebhookIDs:    []string{webhookID},
+			},
+			Status: operation.OperationStatusInProgress,
+			Webhooks: []operation.WebhookDetails{
+				{WebhookID: webhookID, Status: operation.OperationStatusPending},
+			},
+		}
+
+		// WHEN
+		result := domainoperation.NewConverter().ToGraphQL(details)
+
+		// THEN
+		assert.Equal(t, &graphql.Operation{
+			ID:            operationID,
+			OperationType: graphql.OperationTypeDelete,
+			ResourceType:  "runtime",
+			ResourceID:    resourceID,
+			State:         graphql.OperationStateInProgress,
+			Webhooks: []*graphql.OperationWebhook{
+				{WebhookID: webhookID, State: graphql.OperationStatePending},
+			},
+		}, result)
+	})
+
+	t.Run("Returns nil when input is nil", func(t *testing.T) {
+		assert.Nil(t, domainoperation.NewConverter().ToGraphQL(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	// WHEN
+	result := domainoperation.NewConverter().MultipleToGraphQL([]*operation.Details{fixOperationDetails(), nil})
+
+	// THEN
+	assert.Equal(t, []*graphql.Operation{fixOperationGraphQL()}, result)
+}
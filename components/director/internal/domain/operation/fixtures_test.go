@@ -0,0 +1,90 @@
+package operation_test
+
+import (
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	operationID    = "0b4fc816-da70-4505-961e-db346388fdb7"
+	resourceID     = "c7092c57-7a5c-4ebe-8c58-03c0f85ade6c"
+	webhookID      = "d09731af-bc0a-4abf-9b09-f3c9d25d064b"
+	correlationID  = "dea327aa-c173-46d5-9431-497e4057a83a"
+	tenantID       = "4b7aa2e1-e060-4633-a795-1be0d207c3e2"
+	externalTenant = "ext-tenant"
+	consumerID     = "admin"
+	lastPollTime   = "2022-01-27T10:00:00Z"
+	lastError      = "connection refused"
+	nextRetryTime  = "2022-01-27T10:00:30Z"
+	errorMsg       = "webhook failed"
+)
+
+var (
+	creationTime     = time.Date(2022, 1, 27, 9, 0, 0, 0, time.UTC)
+	initializationTs = time.Date(2022, 1, 27, 9, 0, 5, 0, time.UTC)
+)
+
+func fixOperationDetails() *operation.Details {
+	return &operation.Details{
+		Operation: operation.Operation{
+			OperationID:       operationID,
+			OperationType:     operation.OperationTypeCreate,
+			OperationCategory: "registerApplication",
+			ResourceID:        resourceID,
+			ResourceType:      resource.Application,
+			CreationTime:      creationTime,
+			CorrelationID:     correlationID,
+			WebhookIDs:        []string{webhookID},
+		},
+		TenantID:      tenantID,
+		Status:        operation.OperationStatusFailed,
+		Error:         str.Ptr(errorMsg),
+		InitializedAt: &initializationTs,
+		CancelledBy:   consumerID,
+		Webhooks: []operation.WebhookDetails{
+			{
+				WebhookID:          webhookID,
+				Status:             operation.OperationStatusFailed,
+				RetriesCount:       3,
+				LastPollTimestamp:  lastPollTime,
+				FailedAttempts:     2,
+				LastError:          lastError,
+				NextRetryTimestamp: nextRetryTime,
+			},
+		},
+	}
+}
+
+func fixOperationGraphQL() *graphql.Operation {
+	createdAt := graphql.Timestamp(creationTime)
+	initializedAt := graphql.Timestamp(initializationTs)
+
+	return &graphql.Operation{
+		ID:                operationID,
+		OperationType:     graphql.OperationTypeCreate,
+		OperationCategory: str.Ptr("registerApplication"),
+		ResourceType:      string(resource.Application),
+		ResourceID:        resourceID,
+		CorrelationID:     str.Ptr(correlationID),
+		State:             graphql.OperationStateFailed,
+		Error:             str.Ptr(errorMsg),
+		Webhooks: []*graphql.OperationWebhook{
+			{
+				WebhookID:          webhookID,
+				State:              graphql.OperationStateFailed,
+				RetriesCount:       3,
+				LastPollTimestamp:  str.Ptr(lastPollTime),
+				FailedAttempts:     2,
+				LastError:          str.Ptr(lastError),
+				NextRetryTimestamp: str.Ptr(nextRetryTime),
+			},
+		},
+		CreatedAt:     &createdAt,
+		InitializedAt: &initializedAt,
+		CancelledBy:   str.Ptr(consumerID),
+	}
+}
@@ -0,0 +1,153 @@
+package operation
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// Fetcher is responsible for fetching asynchronous operations from the place where they are scheduled.
+//go:generate mockery --name=Fetcher --output=automock --outpkg=automock --case=underscore
+type Fetcher interface {
+	GetByID(ctx context.Context, id string) (*operation.Details, error)
+	List(ctx context.Context, filter operation.Filter) ([]*operation.Details, error)
+}
+
+// Transitioner is responsible for cancelling and retrying asynchronous operations.
+//go:generate mockery --name=Transitioner --output=automock --outpkg=automock --case=underscore
+type Transitioner interface {
+	Cancel(ctx context.Context, details *operation.Details, triggeredBy string) (*operation.Details, error)
+	Retry(ctx context.Context, details *operation.Details, triggeredBy string) (*operation.Details, error)
+}
+
+// Converter converts asynchronous operations to their graphql-layer representation.
+//go:generate mockery --name=Converter --output=automock --outpkg=automock --case=underscore
+type Converter interface {
+	ToGraphQL(in *operation.Details) *graphql.Operation
+	MultipleToGraphQL(in []*operation.Details) []*graphql.Operation
+}
+
+// Resolver is an object responsible for resolver-layer asynchronous operation operations.
+type Resolver struct {
+	transact     persistence.Transactioner
+	fetcher      Fetcher
+	transitioner Transitioner
+	conv         Converter
+}
+
+// NewResolver returns a new object responsible for resolver-layer asynchronous operation operations.
+func NewResolver(transact persistence.Transactioner, fetcher Fetcher, transitioner Transitioner, conv Converter) *Resolver {
+	return &Resolver{
+		transact:     transact,
+		fetcher:      fetcher,
+		transitioner: transitioner,
+		conv:         conv,
+	}
+}
+
+// Operations returns the asynchronous operations of the caller's tenant which match the provided resource and state.
+func (r *Resolver) Operations(ctx context.Context, resourceID *string, resourceType *string, state *graphql.OperationState) ([]*graphql.Operation, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := operation.Filter{TenantID: tnt}
+	if resourceID != nil {
+		filter.ResourceID = *resourceID
+	}
+	if resourceType != nil {
+		filter.ResourceType = resource.Type(*resourceType)
+	}
+	if state != nil {
+		filter.Status = operation.OperationStatus(*state)
+	}
+
+	operations, err := r.fetcher.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.conv.MultipleToGraphQL(operations), nil
+}
+
+// Operation returns the asynchronous operation with the given ID if it belongs to the caller's tenant.
+func (r *Resolver) Operation(ctx context.Context, id string) (*graphql.Operation, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := r.fetcher.GetByID(ctx, id)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if details.TenantID != tnt {
+		return nil, nil
+	}
+
+	return r.conv.ToGraphQL(details), nil
+}
+
+// CancelOperation cancels the asynchronous operation with the given ID and records the caller as the one who cancelled it.
+func (r *Resolver) CancelOperation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.transition(ctx, id, r.transitioner.Cancel)
+}
+
+// RetryOperation retries the asynchronous operation with the given ID and records the caller as the one who retried it.
+func (r *Resolver) RetryOperation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.transition(ctx, id, r.transitioner.Retry)
+}
+
+type transitionFunc func(ctx context.Context, details *operation.Details, triggeredBy string) (*operation.Details, error)
+
+func (r *Resolver) transition(ctx context.Context, id string, transitionFn transitionFunc) (*graphql.Operation, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	consumerInfo, err := consumer.LoadFromContext(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while loading consumer")
+	}
+
+	details, err := r.fetcher.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if details.TenantID != tnt {
+		return nil, apperrors.NewNotFoundError(resource.Operation, id)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	details, err = transitionFn(ctx, details, consumerInfo.ConsumerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return r.conv.ToGraphQL(details), nil
+}
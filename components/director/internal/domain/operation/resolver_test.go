@@ -0,0 +1,509 @@
+package operation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/consumer"
+	domainoperation "github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_Operations(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenant)
+
+	operations := []*operation.Details{fixOperationDetails()}
+	gqlOperations := []*graphql.Operation{fixOperationGraphQL()}
+	failedState := graphql.OperationStateFailed
+
+	testCases := []struct {
+		Name               string
+		Context            context.Context
+		FetcherFn          func() *automock.Fetcher
+		ConverterFn        func() *automock.Converter
+		ResourceID         *string
+		ResourceType       *string
+		State              *graphql.OperationState
+		ExpectedOperations []*graphql.Operation
+		ExpectedErr        error
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("List", ctx, operation.Filter{
+					TenantID:     tenantID,
+					ResourceID:   resourceID,
+					ResourceType: resource.Application,
+					Status:       operation.OperationStatusFailed,
+				}).Return(operations, nil).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", operations).Return(gqlOperations).Once()
+				return conv
+			},
+			ResourceID:         str.Ptr(resourceID),
+			ResourceType:       str.Ptr(string(resource.Application)),
+			State:              &failedState,
+			ExpectedOperations: gqlOperations,
+		},
+		{
+			Name:    "Success - lists all operations of the tenant when filters are not provided",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("List", ctx, operation.Filter{TenantID: tenantID}).Return(operations, nil).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("MultipleToGraphQL", operations).Return(gqlOperations).Once()
+				return conv
+			},
+			ExpectedOperations: gqlOperations,
+		},
+		{
+			Name:    "Returns error when tenant is missing in context",
+			Context: context.TODO(),
+			FetcherFn: func() *automock.Fetcher {
+				return &automock.Fetcher{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: apperrors.NewCannotReadTenantError(),
+		},
+		{
+			Name:    "Returns error when listing operations fails",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("List", ctx, operation.Filter{TenantID: tenantID}).Return(nil, testErr).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			fetcher := testCase.FetcherFn()
+			conv := testCase.ConverterFn()
+
+			resolver := domainoperation.NewResolver(nil, fetcher, nil, conv)
+
+			// WHEN
+			result, err := resolver.Operations(testCase.Context, testCase.ResourceID, testCase.ResourceType, testCase.State)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOperations, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, fetcher, conv)
+		})
+	}
+}
+
+func TestResolver_Operation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenant)
+
+	details := fixOperationDetails()
+	gqlOperation := fixOperationGraphQL()
+
+	otherTenantDetails := fixOperationDetails()
+	otherTenantDetails.TenantID = "other-tenant"
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		FetcherFn         func() *automock.Fetcher
+		ConverterFn       func() *automock.Converter
+		ExpectedOperation *graphql.Operation
+		ExpectedErr       error
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", details).Return(gqlOperation).Once()
+				return conv
+			},
+			ExpectedOperation: gqlOperation,
+		},
+		{
+			Name:    "Returns nil when operation is not found",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(nil, apperrors.NewNotFoundError(resource.Operation, operationID)).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+		},
+		{
+			Name:    "Returns nil when operation belongs to another tenant",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(otherTenantDetails, nil).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+		},
+		{
+			Name:    "Returns error when tenant is missing in context",
+			Context: context.TODO(),
+			FetcherFn: func() *automock.Fetcher {
+				return &automock.Fetcher{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: apperrors.NewCannotReadTenantError(),
+		},
+		{
+			Name:    "Returns error when fetching operation fails",
+			Context: ctx,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(nil, testErr).Once()
+				return fetcher
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			fetcher := testCase.FetcherFn()
+			conv := testCase.ConverterFn()
+
+			resolver := domainoperation.NewResolver(nil, fetcher, nil, conv)
+
+			// WHEN
+			result, err := resolver.Operation(testCase.Context, operationID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOperation, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, fetcher, conv)
+		})
+	}
+}
+
+func TestResolver_CancelOperation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenant)
+	ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: consumerID, ConsumerType: consumer.User})
+
+	details := fixOperationDetails()
+	details.Status = operation.OperationStatusInProgress
+	cancelledDetails := fixOperationDetails()
+	cancelledDetails.OperationCategory = operation.OperationCategoryCancelOperation
+	gqlOperation := fixOperationGraphQL()
+
+	otherTenantDetails := fixOperationDetails()
+	otherTenantDetails.TenantID = "other-tenant"
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FetcherFn         func() *automock.Fetcher
+		TransitionerFn    func() *automock.Transitioner
+		ConverterFn       func() *automock.Converter
+		ExpectedOperation *graphql.Operation
+		ExpectedErr       error
+	}{
+		{
+			Name:            "Success",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatSucceeds,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				transitioner := &automock.Transitioner{}
+				transitioner.On("Cancel", txtest.CtxWithDBMatcher(), details, consumerID).Return(cancelledDetails, nil).Once()
+				return transitioner
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", cancelledDetails).Return(gqlOperation).Once()
+				return conv
+			},
+			ExpectedOperation: gqlOperation,
+		},
+		{
+			Name:            "Returns error when tenant is missing in context",
+			Context:         consumer.SaveToContext(context.TODO(), consumer.Consumer{ConsumerID: consumerID}),
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			FetcherFn: func() *automock.Fetcher {
+				return &automock.Fetcher{}
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				return &automock.Transitioner{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: apperrors.NewCannotReadTenantError(),
+		},
+		{
+			Name:            "Returns error when consumer is missing in context",
+			Context:         tenant.SaveToContext(context.TODO(), tenantID, externalTenant),
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			FetcherFn: func() *automock.Fetcher {
+				return &automock.Fetcher{}
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				return &automock.Transitioner{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: errors.New("while loading consumer"),
+		},
+		{
+			Name:            "Returns error when fetching operation fails",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(nil, testErr).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				return &automock.Transitioner{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns not found error when operation belongs to another tenant",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(otherTenantDetails, nil).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				return &automock.Transitioner{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: apperrors.NewNotFoundError(resource.Operation, operationID),
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				return &automock.Transitioner{}
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when cancelling operation fails",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				transitioner := &automock.Transitioner{}
+				transitioner.On("Cancel", txtest.CtxWithDBMatcher(), details, consumerID).Return(nil, testErr).Once()
+				return transitioner
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit fails",
+			Context:         ctx,
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			FetcherFn: func() *automock.Fetcher {
+				fetcher := &automock.Fetcher{}
+				fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+				return fetcher
+			},
+			TransitionerFn: func() *automock.Transitioner {
+				transitioner := &automock.Transitioner{}
+				transitioner.On("Cancel", txtest.CtxWithDBMatcher(), details, consumerID).Return(cancelledDetails, nil).Once()
+				return transitioner
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			fetcher := testCase.FetcherFn()
+			transitioner := testCase.TransitionerFn()
+			conv := testCase.ConverterFn()
+
+			resolver := domainoperation.NewResolver(transact, fetcher, transitioner, conv)
+
+			// WHEN
+			result, err := resolver.CancelOperation(testCase.Context, operationID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOperation, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, fetcher, transitioner, conv)
+		})
+	}
+}
+
+func TestResolver_RetryOperation(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenant)
+	ctx = consumer.SaveToContext(ctx, consumer.Consumer{ConsumerID: consumerID, ConsumerType: consumer.User})
+
+	details := fixOperationDetails()
+	retriedDetails := fixOperationDetails()
+	retriedDetails.Status = operation.OperationStatusInProgress
+	gqlOperation := fixOperationGraphQL()
+
+	testCases := []struct {
+		Name              string
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		TransitionerFn    func() *automock.Transitioner
+		ConverterFn       func() *automock.Converter
+		ExpectedOperation *graphql.Operation
+		ExpectedErr       error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			TransitionerFn: func() *automock.Transitioner {
+				transitioner := &automock.Transitioner{}
+				transitioner.On("Retry", txtest.CtxWithDBMatcher(), details, consumerID).Return(retriedDetails, nil).Once()
+				return transitioner
+			},
+			ConverterFn: func() *automock.Converter {
+				conv := &automock.Converter{}
+				conv.On("ToGraphQL", retriedDetails).Return(gqlOperation).Once()
+				return conv
+			},
+			ExpectedOperation: gqlOperation,
+		},
+		{
+			Name:            "Returns error when retrying operation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			TransitionerFn: func() *automock.Transitioner {
+				transitioner := &automock.Transitioner{}
+				transitioner.On("Retry", txtest.CtxWithDBMatcher(), details, consumerID).Return(nil, testErr).Once()
+				return transitioner
+			},
+			ConverterFn: func() *automock.Converter {
+				return &automock.Converter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			fetcher := &automock.Fetcher{}
+			fetcher.On("GetByID", ctx, operationID).Return(details, nil).Once()
+			transitioner := testCase.TransitionerFn()
+			conv := testCase.ConverterFn()
+
+			resolver := domainoperation.NewResolver(transact, fetcher, transitioner, conv)
+
+			// WHEN
+			result, err := resolver.RetryOperation(ctx, operationID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedOperation, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, fetcher, transitioner, conv)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ApplicationRepository is an autogenerated mock type for the ApplicationRepository type
+type ApplicationRepository struct {
+	mock.Mock
+}
+
+// Exists provides a mock function with given fields: ctx, tenant, id
+func (_m *ApplicationRepository) Exists(ctx context.Context, tenant string, id string) (bool, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAllByApplicationTemplateID provides a mock function with given fields: ctx, tenant, appTemplateID
+func (_m *ApplicationRepository) ListAllByApplicationTemplateID(ctx context.Context, tenant string, appTemplateID string) ([]*model.Application, error) {
+	ret := _m.Called(ctx, tenant, appTemplateID)
+
+	var r0 []*model.Application
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.Application); ok {
+		r0 = rf(ctx, tenant, appTemplateID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Application)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, appTemplateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	ordaggregationrequest "github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: entity
+func (_m *EntityConverter) FromEntity(entity *ordaggregationrequest.Entity) *model.ORDAggregationRequest {
+	ret := _m.Called(entity)
+
+	var r0 *model.ORDAggregationRequest
+	if rf, ok := ret.Get(0).(func(*ordaggregationrequest.Entity) *model.ORDAggregationRequest); ok {
+		r0 = rf(entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ORDAggregationRequest)
+		}
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.ORDAggregationRequest) *ordaggregationrequest.Entity {
+	ret := _m.Called(in)
+
+	var r0 *ordaggregationrequest.Entity
+	if rf, ok := ret.Get(0).(func(*model.ORDAggregationRequest) *ordaggregationrequest.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ordaggregationrequest.Entity)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestRepository is an autogenerated mock type for the RequestRepository type
+type RequestRepository struct {
+	mock.Mock
+}
+
+// DeleteProcessedGlobal provides a mock function with given fields: ctx, request
+func (_m *RequestRepository) DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ORDAggregationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListGlobal provides a mock function with given fields: ctx
+func (_m *RequestRepository) ListGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.ORDAggregationRequest
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.ORDAggregationRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ORDAggregationRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAttemptsGlobal provides a mock function with given fields: ctx, request
+func (_m *RequestRepository) UpdateAttemptsGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ORDAggregationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upsert provides a mock function with given fields: ctx, item
+func (_m *RequestRepository) Upsert(ctx context.Context, item *model.ORDAggregationRequest) error {
+	ret := _m.Called(ctx, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ORDAggregationRequest) error); ok {
+		r0 = rf(ctx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,49 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RequestService is an autogenerated mock type for the RequestService type
+type RequestService struct {
+	mock.Mock
+}
+
+// RequestForApplication provides a mock function with given fields: ctx, appID
+func (_m *RequestService) RequestForApplication(ctx context.Context, appID string) error {
+	ret := _m.Called(ctx, appID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestForApplicationTemplate provides a mock function with given fields: ctx, appTemplateID
+func (_m *RequestService) RequestForApplicationTemplate(ctx context.Context, appTemplateID string) (int, error) {
+	ret := _m.Called(ctx, appTemplateID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, appTemplateID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appTemplateID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
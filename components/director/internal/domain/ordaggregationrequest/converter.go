@@ -0,0 +1,43 @@
+package ordaggregationrequest
+
+import (
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+type converter struct {
+}
+
+// NewConverter returns a new converter for on-demand ORD aggregation requests.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of an ORD aggregation request to the repository-layer one.
+func (c *converter) ToEntity(in *model.ORDAggregationRequest) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	return &Entity{
+		ID:            in.ID,
+		ApplicationID: in.ApplicationID,
+		RequestedAt:   in.RequestedAt,
+		Attempts:      in.Attempts,
+		NextAttemptAt: in.NextAttemptAt,
+	}
+}
+
+// FromEntity converts the provided repository-layer representation of an ORD aggregation request to the service-layer one.
+func (c *converter) FromEntity(entity *Entity) *model.ORDAggregationRequest {
+	if entity == nil {
+		return nil
+	}
+
+	return &model.ORDAggregationRequest{
+		ID:            entity.ID,
+		ApplicationID: entity.ApplicationID,
+		RequestedAt:   entity.RequestedAt,
+		Attempts:      entity.Attempts,
+		NextAttemptAt: entity.NextAttemptAt,
+	}
+}
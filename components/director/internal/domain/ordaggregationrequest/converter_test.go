@@ -0,0 +1,45 @@
+package ordaggregationrequest_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordaggregationrequest.NewConverter()
+
+		entity := conv.ToEntity(fixRequestModel())
+
+		assert.Equal(t, fixRequestEntity(), entity)
+	})
+
+	t.Run("Returns nil if model is nil", func(t *testing.T) {
+		conv := ordaggregationrequest.NewConverter()
+
+		entity := conv.ToEntity(nil)
+
+		require.Nil(t, entity)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordaggregationrequest.NewConverter()
+
+		request := conv.FromEntity(fixRequestEntity())
+
+		assert.Equal(t, fixRequestModel(), request)
+	})
+
+	t.Run("Returns nil if entity is nil", func(t *testing.T) {
+		conv := ordaggregationrequest.NewConverter()
+
+		request := conv.FromEntity(nil)
+
+		require.Nil(t, request)
+	})
+}
@@ -0,0 +1,19 @@
+package ordaggregationrequest
+
+import (
+	"time"
+)
+
+// Entity represents an on-demand ORD aggregation request entity.
+type Entity struct {
+	ID            string     `db:"id"`
+	ApplicationID string     `db:"app_id"`
+	RequestedAt   time.Time  `db:"requested_at"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt *time.Time `db:"next_attempt_at"`
+}
+
+// GetID returns the entity's ID.
+func (e *Entity) GetID() string {
+	return e.ID
+}
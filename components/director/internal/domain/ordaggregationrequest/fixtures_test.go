@@ -0,0 +1,71 @@
+package ordaggregationrequest_test
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+const (
+	requestID        = "4f2bc8f3-3f4b-4a7e-a3d6-34a8ddf2b6c9"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID = "externalTenantID"
+	appID            = "c5f2ab35-3ed5-4a5f-8b3b-b5c4d1ee9a29"
+	appTemplateID    = "0a4c6a49-fa5f-4b5b-b7a6-3d1b4e5f1c0d"
+)
+
+var (
+	requestedAt   = time.Date(2022, 1, 14, 10, 0, 0, 0, time.UTC)
+	nextAttemptAt = requestedAt.Add(time.Minute)
+)
+
+func fixRequestModel() *model.ORDAggregationRequest {
+	return fixRequestModelWithIDs(requestID, appID)
+}
+
+func fixRequestModelWithIDs(id, applicationID string) *model.ORDAggregationRequest {
+	return &model.ORDAggregationRequest{
+		ID:            id,
+		ApplicationID: applicationID,
+		RequestedAt:   requestedAt,
+	}
+}
+
+func fixFailedRequestModel(attempts int) *model.ORDAggregationRequest {
+	request := fixRequestModel()
+	request.Attempts = attempts
+	request.NextAttemptAt = &nextAttemptAt
+	return request
+}
+
+func fixRequestEntity() *ordaggregationrequest.Entity {
+	return fixRequestEntityWithIDs(requestID, appID)
+}
+
+func fixRequestEntityWithIDs(id, applicationID string) *ordaggregationrequest.Entity {
+	return &ordaggregationrequest.Entity{
+		ID:            id,
+		ApplicationID: applicationID,
+		RequestedAt:   requestedAt,
+	}
+}
+
+func fixRequestColumns() []string {
+	return []string{"id", "app_id", "requested_at", "attempts", "next_attempt_at"}
+}
+
+func fixRequestRowWithIDs(id, applicationID string) []driver.Value {
+	return []driver.Value{id, applicationID, requestedAt, 0, nil}
+}
+
+func fixApplicationModel(id string, appTemplateID *string) *model.Application {
+	return &model.Application{
+		Name:                  "app-" + id,
+		ApplicationTemplateID: appTemplateID,
+		BaseEntity: &model.BaseEntity{
+			ID: id,
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package ordaggregationrequest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// RequiredScopesPath is the path in the scopes configuration of the scopes required for triggering an on-demand ORD aggregation.
+// It is shared with the resyncOpenResourceDiscovery mutation, so that both ways of triggering the aggregation are protected equally.
+const RequiredScopesPath = "graphql.mutation.resyncOpenResourceDiscovery"
+
+// ScopesGetter is responsible for getting the scopes required for triggering an on-demand ORD aggregation.
+//go:generate mockery --name=ScopesGetter --output=automock --outpkg=automock --case=underscore
+type ScopesGetter interface {
+	GetRequiredScopes(scopesDefinition string) ([]string, error)
+}
+
+// TriggerRequest is the expected request body of the ORD aggregation trigger endpoint.
+// Exactly one of ApplicationID and ApplicationTemplateID must be provided.
+type TriggerRequest struct {
+	ApplicationID         string `json:"applicationID,omitempty"`
+	ApplicationTemplateID string `json:"applicationTemplateID,omitempty"`
+}
+
+// TriggerResponse is the response body of the ORD aggregation trigger endpoint.
+type TriggerResponse struct {
+	ApplicationsCount int `json:"applicationsCount"`
+}
+
+// Validate validates the ORD aggregation trigger request.
+func (r TriggerRequest) Validate() error {
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.ApplicationID, validation.When(len(r.ApplicationTemplateID) == 0, validation.Required).Else(validation.Empty), is.UUID),
+		validation.Field(&r.ApplicationTemplateID, is.UUID),
+	)
+}
+
+type handler struct {
+	transact     persistence.Transactioner
+	requestSvc   RequestService
+	scopesGetter ScopesGetter
+}
+
+// NewHandler returns a new HTTP handler which enqueues on-demand ORD aggregations of applications or application templates.
+func NewHandler(transact persistence.Transactioner, requestSvc RequestService, scopesGetter ScopesGetter) *handler {
+	return &handler{
+		transact:     transact,
+		requestSvc:   requestSvc,
+		scopesGetter: scopesGetter,
+	}
+}
+
+// ServeHTTP handles the ORD aggregation trigger requests.
+func (h *handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	if request.Method != http.MethodPost {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.verifyScopes(ctx); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while verifying scopes: %v", err)
+		apperrors.WriteAppError(ctx, writer, err, http.StatusForbidden)
+		return
+	}
+
+	var triggerRequest TriggerRequest
+	if err := json.NewDecoder(request.Body).Decode(&triggerRequest); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while decoding request body: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unable to decode request body"), http.StatusBadRequest)
+		return
+	}
+
+	if err := triggerRequest.Validate(); err != nil {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid trigger request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	tx, err := h.transact.Begin()
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
+		return
+	}
+	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	applicationsCount := 1
+	if len(triggerRequest.ApplicationID) > 0 {
+		err = h.requestSvc.RequestForApplication(ctx, triggerRequest.ApplicationID)
+	} else {
+		applicationsCount, err = h.requestSvc.RequestForApplicationTemplate(ctx, triggerRequest.ApplicationTemplateID)
+	}
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while requesting ORD aggregation: %v", err)
+		if apperrors.IsNotFoundError(err) {
+			apperrors.WriteAppError(ctx, writer, err, http.StatusNotFound)
+			return
+		}
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to request ORD aggregation"), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while closing database transaction: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
+		return
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusAccepted, TriggerResponse{ApplicationsCount: applicationsCount})
+}
+
+func (h *handler) verifyScopes(ctx context.Context) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := h.scopesGetter.GetRequiredScopes(RequiredScopesPath)
+	if err != nil {
+		return apperrors.InternalErrorFrom(err, "while getting required scopes")
+	}
+
+	if !str.Matches(actualScopes, requiredScopes) {
+		return apperrors.NewInsufficientScopesError(requiredScopes, actualScopes)
+	}
+	return nil
+}
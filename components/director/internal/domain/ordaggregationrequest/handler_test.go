@@ -0,0 +1,219 @@
+package ordaggregationrequest_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const requiredScope = "application:write"
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	testErr := errors.New("test error")
+	appBody := fmt.Sprintf(`{"applicationID": "%s"}`, appID)
+	appTemplateBody := fmt.Sprintf(`{"applicationTemplateID": "%s"}`, appTemplateID)
+
+	t.Run("when request method is not POST it should return method not allowed", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		handler := ordaggregationrequest.NewHandler(nil, nil, nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Method not allowed")
+		require.Equal(t, http.StatusMethodNotAllowed, writer.Code)
+	})
+
+	t.Run("when scopes are missing in context it should return forbidden", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, context.Background(), appBody)
+
+		handler := ordaggregationrequest.NewHandler(nil, nil, nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when scopes are insufficient it should return forbidden", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, scope.SaveToContext(context.Background(), []string{"application:read"}), appBody)
+		scopesGetter := fixScopesGetter()
+		defer scopesGetter.AssertExpectations(t)
+
+		handler := ordaggregationrequest.NewHandler(nil, nil, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "insufficient scopes provided")
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when getting required scopes fails it should return forbidden", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appBody)
+		scopesGetter := &automock.ScopesGetter{}
+		defer scopesGetter.AssertExpectations(t)
+		scopesGetter.On("GetRequiredScopes", ordaggregationrequest.RequiredScopesPath).Return(nil, testErr).Once()
+
+		handler := ordaggregationrequest.NewHandler(nil, nil, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when request body is not valid JSON it should return bad request", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), `{"applicationID": 1}`)
+		scopesGetter := fixScopesGetter()
+		defer scopesGetter.AssertExpectations(t)
+
+		handler := ordaggregationrequest.NewHandler(nil, nil, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to decode request body")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+
+	invalidBodies := map[string]string{
+		"no ID is provided":          `{}`,
+		"both IDs are provided":      fmt.Sprintf(`{"applicationID": "%s", "applicationTemplateID": "%s"}`, appID, appTemplateID),
+		"application ID is not UUID": `{"applicationID": "not-a-uuid"}`,
+		"template ID is not UUID":    `{"applicationTemplateID": "not-a-uuid"}`,
+	}
+	for name, body := range invalidBodies {
+		t.Run(fmt.Sprintf("when %s it should return bad request", name), func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			req := fixTriggerRequest(t, fixContextWithScopes(), body)
+			scopesGetter := fixScopesGetter()
+			defer scopesGetter.AssertExpectations(t)
+
+			handler := ordaggregationrequest.NewHandler(nil, nil, scopesGetter)
+			handler.ServeHTTP(writer, req)
+
+			require.Contains(t, writer.Body.String(), "Invalid trigger request")
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+		})
+	}
+
+	t.Run("when transaction fails to begin it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, &automock.RequestService{}, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to establish connection with database")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+
+	t.Run("when application does not exist it should return not found", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		requestSvc := &automock.RequestService{}
+		requestSvc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(apperrors.NewNotFoundError(resource.Application, appID)).Once()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner, requestSvc)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, requestSvc, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusNotFound, writer.Code)
+	})
+
+	t.Run("when requesting ORD aggregation fails it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appTemplateBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		requestSvc := &automock.RequestService{}
+		requestSvc.On("RequestForApplicationTemplate", txtest.CtxWithDBMatcher(), appTemplateID).Return(0, testErr).Once()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner, requestSvc)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, requestSvc, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to request ORD aggregation")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+
+	t.Run("when transaction fails to commit it should return internal server error", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
+		requestSvc := &automock.RequestService{}
+		requestSvc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil).Once()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner, requestSvc)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, requestSvc, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to finalize database operation")
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+	})
+
+	t.Run("when aggregation is requested for application it should return accepted", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		requestSvc := &automock.RequestService{}
+		requestSvc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil).Once()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner, requestSvc)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, requestSvc, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.JSONEq(t, `{"applicationsCount": 1}`, writer.Body.String())
+		require.Equal(t, http.StatusAccepted, writer.Code)
+	})
+
+	t.Run("when aggregation is requested for application template it should return accepted", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixTriggerRequest(t, fixContextWithScopes(), appTemplateBody)
+		scopesGetter := fixScopesGetter()
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		requestSvc := &automock.RequestService{}
+		requestSvc.On("RequestForApplicationTemplate", txtest.CtxWithDBMatcher(), appTemplateID).Return(3, nil).Once()
+		defer mock.AssertExpectationsForObjects(t, scopesGetter, mockedTx, mockedTransactioner, requestSvc)
+
+		handler := ordaggregationrequest.NewHandler(mockedTransactioner, requestSvc, scopesGetter)
+		handler.ServeHTTP(writer, req)
+
+		require.JSONEq(t, `{"applicationsCount": 3}`, writer.Body.String())
+		require.Equal(t, http.StatusAccepted, writer.Code)
+	})
+}
+
+func fixTriggerRequest(t *testing.T, ctx context.Context, body string) *http.Request {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader([]byte(body)))
+	require.NoError(t, err)
+	return req
+}
+
+func fixContextWithScopes() context.Context {
+	return scope.SaveToContext(context.Background(), []string{requiredScope})
+}
+
+func fixScopesGetter() *automock.ScopesGetter {
+	scopesGetter := &automock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", ordaggregationrequest.RequiredScopesPath).Return([]string{requiredScope}, nil).Once()
+	return scopesGetter
+}
@@ -0,0 +1,102 @@
+package ordaggregationrequest
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const requestTable string = `public.ord_aggregation_requests`
+
+var (
+	requestColumns     = []string{"id", "app_id", "requested_at", "attempts", "next_attempt_at"}
+	conflictingColumns = []string{"app_id"}
+	updatableColumns   = []string{"requested_at", "attempts", "next_attempt_at"}
+	attemptColumns     = []string{"attempts", "next_attempt_at"}
+	processedIDColumns = []string{"id", "requested_at"}
+)
+
+// EntityConverter converts between the service-layer and repository-layer representations of ORD aggregation requests.
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.ORDAggregationRequest) *Entity
+	FromEntity(entity *Entity) *model.ORDAggregationRequest
+}
+
+type pgRepository struct {
+	conv          EntityConverter
+	upserter      repo.UpserterGlobal
+	globalUpdater repo.UpdaterGlobal
+	globalLister  repo.ListerGlobal
+	globalDeleter repo.DeleterGlobal
+}
+
+// NewRepository returns a new repository for on-demand ORD aggregation requests.
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:          conv,
+		upserter:      repo.NewUpserterGlobal(resource.ORDAggregationRequest, requestTable, requestColumns, conflictingColumns, updatableColumns),
+		globalUpdater: repo.NewUpdaterGlobal(resource.ORDAggregationRequest, requestTable, attemptColumns, processedIDColumns),
+		globalLister:  repo.NewListerGlobal(resource.ORDAggregationRequest, requestTable, requestColumns),
+		globalDeleter: repo.NewDeleterGlobal(resource.ORDAggregationRequest, requestTable),
+	}
+}
+
+// Upsert persists a new ORD aggregation request. If there is already a pending request for the same application,
+// only its request time is updated, so that a processing of the pending request which is already running does not complete it.
+// The failed attempts of the pending request are reset, so that it is processed right away.
+func (r *pgRepository) Upsert(ctx context.Context, model *model.ORDAggregationRequest) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	log.C(ctx).Debugf("Persisting ORD aggregation request entity for Application with id %q", model.ApplicationID)
+	return r.upserter.UpsertGlobal(ctx, r.conv.ToEntity(model))
+}
+
+// ListGlobal returns all pending ORD aggregation requests.
+func (r *pgRepository) ListGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error) {
+	var entities requestCollection
+	if err := r.globalLister.ListGlobal(ctx, &entities); err != nil {
+		return nil, err
+	}
+
+	requests := make([]*model.ORDAggregationRequest, 0, len(entities))
+	for i := range entities {
+		requests = append(requests, r.conv.FromEntity(&entities[i]))
+	}
+	return requests, nil
+}
+
+// DeleteProcessedGlobal deletes the given ORD aggregation request unless it has been requested again after it was listed.
+func (r *pgRepository) DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	if request == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	return r.globalDeleter.DeleteManyGlobal(ctx, repo.Conditions{
+		repo.NewEqualCondition("id", request.ID),
+		repo.NewEqualCondition("requested_at", request.RequestedAt),
+	})
+}
+
+// UpdateAttemptsGlobal updates the failed attempts of the given ORD aggregation request.
+// It fails if the request has been requested again after it was listed, so that the attempts of the new request are not overwritten.
+func (r *pgRepository) UpdateAttemptsGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	if request == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	return r.globalUpdater.UpdateSingleGlobal(ctx, r.conv.ToEntity(request))
+}
+
+type requestCollection []Entity
+
+// Len returns the length of the collection.
+func (c requestCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,280 @@
+package ordaggregationrequest_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPgRepository_Upsert(t *testing.T) {
+	upsertQuery := regexp.QuoteMeta(`INSERT INTO public.ord_aggregation_requests ( id, app_id, requested_at, attempts, next_attempt_at ) VALUES ( ?, ?, ?, ?, ? ) ON CONFLICT ( app_id ) DO UPDATE SET requested_at=EXCLUDED.requested_at, attempts=EXCLUDED.attempts, next_attempt_at=EXCLUDED.next_attempt_at`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", fixRequestModel()).Return(fixRequestEntity()).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(upsertQuery).
+			WithArgs(fixRequestRowWithIDs(requestID, appID)...).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(conv)
+
+		// WHEN
+		err := repo.Upsert(ctx, fixRequestModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when upserting", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", fixRequestModel()).Return(fixRequestEntity()).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(upsertQuery).
+			WithArgs(fixRequestRowWithIDs(requestID, appID)...).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(conv)
+
+		// WHEN
+		err := repo.Upsert(ctx, fixRequestModel())
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when model is nil", func(t *testing.T) {
+		// GIVEN
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.Upsert(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InternalError, apperrors.ErrorCode(err))
+	})
+}
+
+func TestPgRepository_ListGlobal(t *testing.T) {
+	selectQuery := regexp.QuoteMeta(`SELECT id, app_id, requested_at, attempts, next_attempt_at FROM public.ord_aggregation_requests`)
+	secondRequestID := "secondRequestID"
+	secondAppID := "secondAppID"
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("FromEntity", fixRequestEntity()).Return(fixRequestModel()).Once()
+		conv.On("FromEntity", fixRequestEntityWithIDs(secondRequestID, secondAppID)).Return(fixRequestModelWithIDs(secondRequestID, secondAppID)).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		rows := sqlmock.NewRows(fixRequestColumns()).
+			AddRow(fixRequestRowWithIDs(requestID, appID)...).
+			AddRow(fixRequestRowWithIDs(secondRequestID, secondAppID)...)
+		dbMock.ExpectQuery(selectQuery).WithArgs().WillReturnRows(rows)
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(conv)
+
+		// WHEN
+		requests, err := repo.ListGlobal(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ORDAggregationRequest{fixRequestModel(), fixRequestModelWithIDs(secondRequestID, secondAppID)}, requests)
+	})
+
+	t.Run("Success when there are no requests", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs().WillReturnRows(sqlmock.NewRows(fixRequestColumns()))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		requests, err := repo.ListGlobal(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("Error when listing", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectQuery(selectQuery).WithArgs().WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		requests, err := repo.ListGlobal(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Nil(t, requests)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+}
+
+func TestPgRepository_DeleteProcessedGlobal(t *testing.T) {
+	deleteQuery := regexp.QuoteMeta(`DELETE FROM public.ord_aggregation_requests WHERE id = $1 AND requested_at = $2`)
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteQuery).
+			WithArgs(requestID, requestedAt).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.DeleteProcessedGlobal(ctx, fixRequestModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Success when the request has been requested again", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteQuery).
+			WithArgs(requestID, requestedAt).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.DeleteProcessedGlobal(ctx, fixRequestModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting", func(t *testing.T) {
+		// GIVEN
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(deleteQuery).
+			WithArgs(requestID, requestedAt).
+			WillReturnError(errors.New("test error"))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.DeleteProcessedGlobal(ctx, fixRequestModel())
+
+		// THEN
+		require.Error(t, err)
+		assert.EqualError(t, err, "Internal Server Error: Unexpected error while executing SQL query")
+	})
+
+	t.Run("Error when model is nil", func(t *testing.T) {
+		// GIVEN
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.DeleteProcessedGlobal(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InternalError, apperrors.ErrorCode(err))
+	})
+}
+
+func TestPgRepository_UpdateAttemptsGlobal(t *testing.T) {
+	updateQuery := regexp.QuoteMeta(`UPDATE public.ord_aggregation_requests SET attempts = ?, next_attempt_at = ? WHERE id = ? AND requested_at = ?`)
+	failedEntity := fixRequestEntity()
+	failedEntity.Attempts = 1
+	failedEntity.NextAttemptAt = &nextAttemptAt
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", fixFailedRequestModel(1)).Return(failedEntity).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(1, nextAttemptAt, requestID, requestedAt).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(conv)
+
+		// WHEN
+		err := repo.UpdateAttemptsGlobal(ctx, fixFailedRequestModel(1))
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when the request has been requested again", func(t *testing.T) {
+		// GIVEN
+		conv := &automock.EntityConverter{}
+		defer conv.AssertExpectations(t)
+		conv.On("ToEntity", fixFailedRequestModel(1)).Return(failedEntity).Once()
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+		dbMock.ExpectExec(updateQuery).
+			WithArgs(1, nextAttemptAt, requestID, requestedAt).
+			WillReturnResult(sqlmock.NewResult(-1, 0))
+
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		repo := ordaggregationrequest.NewRepository(conv)
+
+		// WHEN
+		err := repo.UpdateAttemptsGlobal(ctx, fixFailedRequestModel(1))
+
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InternalError, apperrors.ErrorCode(err))
+	})
+
+	t.Run("Error when model is nil", func(t *testing.T) {
+		// GIVEN
+		repo := ordaggregationrequest.NewRepository(&automock.EntityConverter{})
+
+		// WHEN
+		err := repo.UpdateAttemptsGlobal(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Equal(t, apperrors.InternalError, apperrors.ErrorCode(err))
+	})
+}
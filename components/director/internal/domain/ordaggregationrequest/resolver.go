@@ -0,0 +1,50 @@
+package ordaggregationrequest
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// RequestService is responsible for the service-layer ORD aggregation request operations.
+//go:generate mockery --name=RequestService --output=automock --outpkg=automock --case=underscore
+type RequestService interface {
+	RequestForApplication(ctx context.Context, appID string) error
+	RequestForApplicationTemplate(ctx context.Context, appTemplateID string) (int, error)
+}
+
+// Resolver is an object responsible for resolver-layer ORD aggregation request operations.
+type Resolver struct {
+	transact   persistence.Transactioner
+	requestSvc RequestService
+}
+
+// NewResolver returns a new object responsible for resolver-layer ORD aggregation request operations.
+func NewResolver(transact persistence.Transactioner, requestSvc RequestService) *Resolver {
+	return &Resolver{
+		transact:   transact,
+		requestSvc: requestSvc,
+	}
+}
+
+// ResyncOpenResourceDiscovery enqueues an on-demand ORD aggregation of the given application.
+func (r *Resolver) ResyncOpenResourceDiscovery(ctx context.Context, applicationID string) (bool, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return false, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err = r.requestSvc.RequestForApplication(ctx, applicationID); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return true, nil
+}
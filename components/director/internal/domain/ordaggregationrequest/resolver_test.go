@@ -0,0 +1,91 @@
+package ordaggregationrequest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolver_ResyncOpenResourceDiscovery(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		RequestSvcFn    func() *automock.RequestService
+		ExpectedResult  bool
+		ExpectedErrMsg  string
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			RequestSvcFn: func() *automock.RequestService {
+				svc := &automock.RequestService{}
+				svc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil).Once()
+				return svc
+			},
+			ExpectedResult: true,
+		},
+		{
+			Name:            "Returns error when requesting ORD aggregation fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			RequestSvcFn: func() *automock.RequestService {
+				svc := &automock.RequestService{}
+				svc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			RequestSvcFn: func() *automock.RequestService {
+				return &automock.RequestService{}
+			},
+			ExpectedErrMsg: "while opening the transaction",
+		},
+		{
+			Name:            "Returns error when transaction commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			RequestSvcFn: func() *automock.RequestService {
+				svc := &automock.RequestService{}
+				svc.On("RequestForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil).Once()
+				return svc
+			},
+			ExpectedErrMsg: "while committing the transaction",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			requestSvc := testCase.RequestSvcFn()
+
+			resolver := ordaggregationrequest.NewResolver(transact, requestSvc)
+
+			// WHEN
+			result, err := resolver.ResyncOpenResourceDiscovery(context.TODO(), appID)
+
+			// THEN
+			if len(testCase.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedResult, result)
+
+			mock.AssertExpectationsForObjects(t, persist, transact, requestSvc)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package ordaggregationrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+// RequestRepository is responsible for the repo-layer ORD aggregation request operations.
+//go:generate mockery --name=RequestRepository --output=automock --outpkg=automock --case=underscore
+type RequestRepository interface {
+	Upsert(ctx context.Context, item *model.ORDAggregationRequest) error
+	ListGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error)
+	DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error
+	UpdateAttemptsGlobal(ctx context.Context, request *model.ORDAggregationRequest) error
+}
+
+// ApplicationRepository is responsible for the repo-layer Application operations.
+//go:generate mockery --name=ApplicationRepository --output=automock --outpkg=automock --case=underscore
+type ApplicationRepository interface {
+	Exists(ctx context.Context, tenant, id string) (bool, error)
+	ListAllByApplicationTemplateID(ctx context.Context, tenant, appTemplateID string) ([]*model.Application, error)
+}
+
+// ApplicationTemplateRepository is responsible for the repo-layer Application Template operations.
+//go:generate mockery --name=ApplicationTemplateRepository --output=automock --outpkg=automock --case=underscore
+type ApplicationTemplateRepository interface {
+	Exists(ctx context.Context, id string) (bool, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal ORD aggregation request IDs.
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	requestRepo     RequestRepository
+	appRepo         ApplicationRepository
+	appTemplateRepo ApplicationTemplateRepository
+	uidService      UIDService
+	timestampGen    timestamp.Generator
+}
+
+// NewService returns a new service for on-demand ORD aggregation requests.
+func NewService(requestRepo RequestRepository, appRepo ApplicationRepository, appTemplateRepo ApplicationTemplateRepository, uidService UIDService) *service {
+	return &service{
+		requestRepo:     requestRepo,
+		appRepo:         appRepo,
+		appTemplateRepo: appTemplateRepo,
+		uidService:      uidService,
+		timestampGen:    timestamp.DefaultGenerator,
+	}
+}
+
+// RequestForApplication enqueues an on-demand ORD aggregation of the given application.
+// If there is already a pending request for the application, its request time is updated instead of creating a new request.
+func (s *service) RequestForApplication(ctx context.Context, appID string) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.appRepo.Exists(ctx, tnt, appID)
+	if err != nil {
+		return errors.Wrapf(err, "while checking if Application with id %s exists", appID)
+	}
+	if !exists {
+		return apperrors.NewNotFoundError(resource.Application, appID)
+	}
+
+	return s.request(ctx, appID)
+}
+
+// RequestForApplicationTemplate enqueues an on-demand ORD aggregation of all applications created from the given application template
+// that are visible for the tenant in the context. It returns the number of applications for which the aggregation was requested.
+func (s *service) RequestForApplicationTemplate(ctx context.Context, appTemplateID string) (int, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	exists, err := s.appTemplateRepo.Exists(ctx, appTemplateID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while checking if Application Template with id %s exists", appTemplateID)
+	}
+	if !exists {
+		return 0, apperrors.NewNotFoundError(resource.ApplicationTemplate, appTemplateID)
+	}
+
+	apps, err := s.appRepo.ListAllByApplicationTemplateID(ctx, tnt, appTemplateID)
+	if err != nil {
+		return 0, errors.Wrap(err, "while listing Applications")
+	}
+
+	for _, app := range apps {
+		if err := s.request(ctx, app.ID); err != nil {
+			return 0, err
+		}
+	}
+
+	log.C(ctx).Infof("Requested ORD aggregation for %d Applications created from Application Template with id %s", len(apps), appTemplateID)
+	return len(apps), nil
+}
+
+// ListDueGlobal returns the pending ORD aggregation requests regardless of their tenant, except for the ones whose next attempt is not due yet.
+func (s *service) ListDueGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error) {
+	requests, err := s.requestRepo.ListGlobal(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ORD aggregation requests")
+	}
+
+	now := s.timestampGen()
+	dueRequests := make([]*model.ORDAggregationRequest, 0, len(requests))
+	for _, request := range requests {
+		if request.NextAttemptAt == nil || !request.NextAttemptAt.After(now) {
+			dueRequests = append(dueRequests, request)
+		}
+	}
+	return dueRequests, nil
+}
+
+// RecordFailedAttemptGlobal counts a failed aggregation of an ORD aggregation request, and postpones its next attempt by the given delay.
+// The request is not updated if the aggregation of the application has been requested again while it was being processed.
+func (s *service) RecordFailedAttemptGlobal(ctx context.Context, request *model.ORDAggregationRequest, retryAfter time.Duration) error {
+	nextAttemptAt := s.timestampGen().Add(retryAfter)
+	failedRequest := *request
+	failedRequest.Attempts++
+	failedRequest.NextAttemptAt = &nextAttemptAt
+
+	if err := s.requestRepo.UpdateAttemptsGlobal(ctx, &failedRequest); err != nil {
+		return errors.Wrapf(err, "while recording failed attempt of ORD aggregation request with id %s", request.ID)
+	}
+	return nil
+}
+
+// DeleteProcessedGlobal deletes an ORD aggregation request after the aggregation of its application has been processed.
+// The request is kept if the aggregation of the application has been requested again while it was being processed.
+func (s *service) DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	if err := s.requestRepo.DeleteProcessedGlobal(ctx, request); err != nil {
+		return errors.Wrapf(err, "while deleting ORD aggregation request with id %s", request.ID)
+	}
+	return nil
+}
+
+func (s *service) request(ctx context.Context, appID string) error {
+	request := &model.ORDAggregationRequest{
+		ID:            s.uidService.Generate(),
+		ApplicationID: appID,
+		RequestedAt:   s.timestampGen(),
+	}
+
+	if err := s.requestRepo.Upsert(ctx, request); err != nil {
+		return errors.Wrapf(err, "while requesting ORD aggregation for Application with id %s", appID)
+	}
+
+	log.C(ctx).Infof("Requested ORD aggregation for Application with id %s", appID)
+	return nil
+}
@@ -0,0 +1,426 @@
+package ordaggregationrequest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RequestForApplication(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name              string
+		Context           context.Context
+		RequestRepoFn     func() *automock.RequestRepository
+		AppRepoFn         func() *automock.ApplicationRepository
+		UIDServiceFn      func() *automock.UIDService
+		ExpectedErrorCode *apperrors.ErrorType
+		ExpectedErrMsg    string
+	}{
+		{
+			Name:    "Success",
+			Context: ctx,
+			RequestRepoFn: func() *automock.RequestRepository {
+				repo := &automock.RequestRepository{}
+				repo.On("Upsert", ctx, fixRequestModel()).Return(nil).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tenantID, appID).Return(true, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(requestID).Once()
+				return svc
+			},
+		},
+		{
+			Name:          "Returns not found error when application does not exist",
+			Context:       ctx,
+			RequestRepoFn: emptyRequestRepo,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tenantID, appID).Return(false, nil).Once()
+				return repo
+			},
+			UIDServiceFn:      emptyUIDService,
+			ExpectedErrorCode: errorCode(apperrors.NotFound),
+		},
+		{
+			Name:          "Returns error when checking application existence fails",
+			Context:       ctx,
+			RequestRepoFn: emptyRequestRepo,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tenantID, appID).Return(false, testErr).Once()
+				return repo
+			},
+			UIDServiceFn:   emptyUIDService,
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:    "Returns error when creating request fails",
+			Context: ctx,
+			RequestRepoFn: func() *automock.RequestRepository {
+				repo := &automock.RequestRepository{}
+				repo.On("Upsert", ctx, fixRequestModel()).Return(testErr).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("Exists", ctx, tenantID, appID).Return(true, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(requestID).Once()
+				return svc
+			},
+			ExpectedErrMsg: "while requesting ORD aggregation for Application with id " + appID,
+		},
+		{
+			Name:           "Returns error when tenant is missing in context",
+			Context:        context.TODO(),
+			RequestRepoFn:  emptyRequestRepo,
+			AppRepoFn:      emptyAppRepo,
+			UIDServiceFn:   emptyUIDService,
+			ExpectedErrMsg: "cannot read tenant from context",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			requestRepo := testCase.RequestRepoFn()
+			appRepo := testCase.AppRepoFn()
+			appTemplateRepo := &automock.ApplicationTemplateRepository{}
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := ordaggregationrequest.NewService(requestRepo, appRepo, appTemplateRepo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return requestedAt })
+
+			// WHEN
+			err := svc.RequestForApplication(testCase.Context, appID)
+
+			// THEN
+			if testCase.ExpectedErrorCode != nil {
+				require.Error(t, err)
+				assert.Equal(t, *testCase.ExpectedErrorCode, apperrors.ErrorCode(err))
+			} else if len(testCase.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, requestRepo, appRepo, appTemplateRepo, uidSvc)
+		})
+	}
+}
+
+func TestService_RequestForApplicationTemplate(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("test error")
+	ctx := tenant.SaveToContext(context.TODO(), tenantID, externalTenantID)
+	secondAppID := "secondAppID"
+	secondRequestID := "secondRequestID"
+
+	apps := []*model.Application{
+		fixApplicationModel(appID, str.Ptr(appTemplateID)),
+		fixApplicationModel(secondAppID, str.Ptr(appTemplateID)),
+	}
+
+	testCases := []struct {
+		Name              string
+		RequestRepoFn     func() *automock.RequestRepository
+		AppRepoFn         func() *automock.ApplicationRepository
+		AppTemplateRepoFn func() *automock.ApplicationTemplateRepository
+		UIDServiceFn      func() *automock.UIDService
+		ExpectedCount     int
+		ExpectedErrorCode *apperrors.ErrorType
+		ExpectedErrMsg    string
+	}{
+		{
+			Name: "Success requests all applications created from the template",
+			RequestRepoFn: func() *automock.RequestRepository {
+				repo := &automock.RequestRepository{}
+				repo.On("Upsert", ctx, fixRequestModel()).Return(nil).Once()
+				repo.On("Upsert", ctx, fixRequestModelWithIDs(secondRequestID, secondAppID)).Return(nil).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByApplicationTemplateID", ctx, tenantID, appTemplateID).Return(apps, nil).Once()
+				return repo
+			},
+			AppTemplateRepoFn: existingAppTemplateRepo(ctx),
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(requestID).Once()
+				svc.On("Generate").Return(secondRequestID).Once()
+				return svc
+			},
+			ExpectedCount: 2,
+		},
+		{
+			Name:          "Returns not found error when application template does not exist",
+			RequestRepoFn: emptyRequestRepo,
+			AppRepoFn:     emptyAppRepo,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				repo := &automock.ApplicationTemplateRepository{}
+				repo.On("Exists", ctx, appTemplateID).Return(false, nil).Once()
+				return repo
+			},
+			UIDServiceFn:      emptyUIDService,
+			ExpectedErrorCode: errorCode(apperrors.NotFound),
+		},
+		{
+			Name:          "Returns error when checking application template existence fails",
+			RequestRepoFn: emptyRequestRepo,
+			AppRepoFn:     emptyAppRepo,
+			AppTemplateRepoFn: func() *automock.ApplicationTemplateRepository {
+				repo := &automock.ApplicationTemplateRepository{}
+				repo.On("Exists", ctx, appTemplateID).Return(false, testErr).Once()
+				return repo
+			},
+			UIDServiceFn:   emptyUIDService,
+			ExpectedErrMsg: testErr.Error(),
+		},
+		{
+			Name:          "Returns error when listing applications fails",
+			RequestRepoFn: emptyRequestRepo,
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByApplicationTemplateID", ctx, tenantID, appTemplateID).Return(nil, testErr).Once()
+				return repo
+			},
+			AppTemplateRepoFn: existingAppTemplateRepo(ctx),
+			UIDServiceFn:      emptyUIDService,
+			ExpectedErrMsg:    "while listing Applications",
+		},
+		{
+			Name: "Returns error when creating request fails",
+			RequestRepoFn: func() *automock.RequestRepository {
+				repo := &automock.RequestRepository{}
+				repo.On("Upsert", ctx, fixRequestModel()).Return(testErr).Once()
+				return repo
+			},
+			AppRepoFn: func() *automock.ApplicationRepository {
+				repo := &automock.ApplicationRepository{}
+				repo.On("ListAllByApplicationTemplateID", ctx, tenantID, appTemplateID).Return(apps, nil).Once()
+				return repo
+			},
+			AppTemplateRepoFn: existingAppTemplateRepo(ctx),
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(requestID).Once()
+				return svc
+			},
+			ExpectedErrMsg: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			requestRepo := testCase.RequestRepoFn()
+			appRepo := testCase.AppRepoFn()
+			appTemplateRepo := testCase.AppTemplateRepoFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := ordaggregationrequest.NewService(requestRepo, appRepo, appTemplateRepo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return requestedAt })
+
+			// WHEN
+			count, err := svc.RequestForApplicationTemplate(ctx, appTemplateID)
+
+			// THEN
+			if testCase.ExpectedErrorCode != nil {
+				require.Error(t, err)
+				assert.Equal(t, *testCase.ExpectedErrorCode, apperrors.ErrorCode(err))
+			} else if len(testCase.ExpectedErrMsg) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedCount, count)
+
+			mock.AssertExpectationsForObjects(t, requestRepo, appRepo, appTemplateRepo, uidSvc)
+		})
+	}
+}
+
+func TestService_ListDueGlobal(t *testing.T) {
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		requests := []*model.ORDAggregationRequest{fixRequestModel()}
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListGlobal", ctx).Return(requests, nil).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.ListDueGlobal(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, requests, result)
+	})
+
+	t.Run("Success skips the requests whose next attempt is not due", func(t *testing.T) {
+		// GIVEN
+		dueRequest := fixFailedRequestModel(1)
+		postponedRequest := fixRequestModelWithIDs("postponedRequestID", "postponedAppID")
+		postponedAttemptAt := nextAttemptAt.Add(time.Second)
+		postponedRequest.Attempts = 2
+		postponedRequest.NextAttemptAt = &postponedAttemptAt
+
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListGlobal", ctx).Return([]*model.ORDAggregationRequest{fixRequestModel(), dueRequest, postponedRequest}, nil).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+		svc.SetTimestampGen(func() time.Time { return nextAttemptAt })
+
+		// WHEN
+		result, err := svc.ListDueGlobal(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, []*model.ORDAggregationRequest{fixRequestModel(), dueRequest}, result)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListGlobal", ctx).Return(nil, testErr).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		result, err := svc.ListDueGlobal(ctx)
+
+		// THEN
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestService_DeleteProcessedGlobal(t *testing.T) {
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("DeleteProcessedGlobal", ctx, fixRequestModel()).Return(nil).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		err := svc.DeleteProcessedGlobal(ctx, fixRequestModel())
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when deleting fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("DeleteProcessedGlobal", ctx, fixRequestModel()).Return(testErr).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+
+		// WHEN
+		err := svc.DeleteProcessedGlobal(ctx, fixRequestModel())
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func TestService_RecordFailedAttemptGlobal(t *testing.T) {
+	testErr := errors.New("test error")
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("UpdateAttemptsGlobal", ctx, fixFailedRequestModel(2)).Return(nil).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+		svc.SetTimestampGen(func() time.Time { return requestedAt })
+
+		// WHEN
+		err := svc.RecordFailedAttemptGlobal(ctx, fixFailedRequestModel(1), time.Minute)
+
+		// THEN
+		require.NoError(t, err)
+	})
+
+	t.Run("Error when updating fails", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.RequestRepository{}
+		defer repo.AssertExpectations(t)
+		repo.On("UpdateAttemptsGlobal", ctx, fixFailedRequestModel(1)).Return(testErr).Once()
+
+		svc := ordaggregationrequest.NewService(repo, nil, nil, nil)
+		svc.SetTimestampGen(func() time.Time { return requestedAt })
+
+		// WHEN
+		err := svc.RecordFailedAttemptGlobal(ctx, fixRequestModel(), time.Minute)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+	})
+}
+
+func emptyRequestRepo() *automock.RequestRepository {
+	return &automock.RequestRepository{}
+}
+
+func emptyAppRepo() *automock.ApplicationRepository {
+	return &automock.ApplicationRepository{}
+}
+
+func emptyUIDService() *automock.UIDService {
+	return &automock.UIDService{}
+}
+
+func existingAppTemplateRepo(ctx context.Context) func() *automock.ApplicationTemplateRepository {
+	return func() *automock.ApplicationTemplateRepository {
+		repo := &automock.ApplicationTemplateRepository{}
+		repo.On("Exists", ctx, appTemplateID).Return(true, nil).Once()
+		return repo
+	}
+}
+
+func errorCode(code apperrors.ErrorType) *apperrors.ErrorType {
+	return &code
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	ordaggregationstatus "github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: entity
+func (_m *EntityConverter) FromEntity(entity *ordaggregationstatus.Entity) (*model.ORDAggregationStatus, error) {
+	ret := _m.Called(entity)
+
+	var r0 *model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(*ordaggregationstatus.Entity) *model.ORDAggregationStatus); ok {
+		r0 = rf(entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*ordaggregationstatus.Entity) error); ok {
+		r1 = rf(entity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.ORDAggregationStatus) (*ordaggregationstatus.Entity, error) {
+	ret := _m.Called(in)
+
+	var r0 *ordaggregationstatus.Entity
+	if rf, ok := ret.Get(0).(func(*model.ORDAggregationStatus) *ordaggregationstatus.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ordaggregationstatus.Entity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.ORDAggregationStatus) error); ok {
+		r1 = rf(in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// StatusConverter is an autogenerated mock type for the StatusConverter type
+type StatusConverter struct {
+	mock.Mock
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *StatusConverter) MultipleToGraphQL(in []*model.ORDAggregationStatus) []*graphql.ORDAggregationStatus {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func([]*model.ORDAggregationStatus) []*graphql.ORDAggregationStatus); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.ORDAggregationStatus)
+		}
+	}
+
+	return r0
+}
+
+// ToGraphQL provides a mock function with given fields: in
+func (_m *StatusConverter) ToGraphQL(in *model.ORDAggregationStatus) *graphql.ORDAggregationStatus {
+	ret := _m.Called(in)
+
+	var r0 *graphql.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(*model.ORDAggregationStatus) *graphql.ORDAggregationStatus); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.ORDAggregationStatus)
+		}
+	}
+
+	return r0
+}
@@ -0,0 +1,112 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatusRepository is an autogenerated mock type for the StatusRepository type
+type StatusRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, tenant, item
+func (_m *StatusRepository) Create(ctx context.Context, tenant string, item *model.ORDAggregationStatus) error {
+	ret := _m.Called(ctx, tenant, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.ORDAggregationStatus) error); ok {
+		r0 = rf(ctx, tenant, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByApplicationIDAndWebhookID provides a mock function with given fields: ctx, tenant, appID, webhookID
+func (_m *StatusRepository) GetByApplicationIDAndWebhookID(ctx context.Context, tenant string, appID string, webhookID string) (*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, tenant, appID, webhookID)
+
+	var r0 *model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, tenant, appID, webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, tenant, appID, webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, tenant
+func (_m *StatusRepository) List(ctx context.Context, tenant string) ([]*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 []*model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByApplicationID provides a mock function with given fields: ctx, tenant, appID
+func (_m *StatusRepository) ListByApplicationID(ctx context.Context, tenant string, appID string) ([]*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, tenant, appID)
+
+	var r0 []*model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, tenant, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, tenant, item
+func (_m *StatusRepository) Update(ctx context.Context, tenant string, item *model.ORDAggregationStatus) error {
+	ret := _m.Called(ctx, tenant, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.ORDAggregationStatus) error); ok {
+		r0 = rf(ctx, tenant, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StatusService is an autogenerated mock type for the StatusService type
+type StatusService struct {
+	mock.Mock
+}
+
+// GetForApplication provides a mock function with given fields: ctx, appID
+func (_m *StatusService) GetForApplication(ctx context.Context, appID string) (*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, appID)
+
+	var r0 *model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, appID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// List provides a mock function with given fields: ctx, failedOnly
+func (_m *StatusService) List(ctx context.Context, failedOnly bool) ([]*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, failedOnly)
+
+	var r0 []*model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, failedOnly)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, failedOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,161 @@
+package ordaggregationstatus
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/pkg/errors"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+type converter struct {
+}
+
+// NewConverter returns a new converter for ORD aggregation statuses.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of an ORD aggregation status to the repository-layer one.
+func (c *converter) ToEntity(in *model.ORDAggregationStatus) (*Entity, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	validationErrors := sql.NullString{}
+	if len(in.ValidationErrors) > 0 {
+		marshalled, err := json.Marshal(in.ValidationErrors)
+		if err != nil {
+			return nil, errors.Wrap(err, "while marshalling validation errors")
+		}
+		validationErrors = repo.NewValidNullableString(string(marshalled))
+	}
+
+	fetchCache := sql.NullString{}
+	if in.FetchCache != nil {
+		marshalled, err := json.Marshal(in.FetchCache)
+		if err != nil {
+			return nil, errors.Wrap(err, "while marshalling fetch cache")
+		}
+		fetchCache = repo.NewValidNullableString(string(marshalled))
+	}
+
+	lastSuccessAt := sql.NullTime{}
+	if in.LastSuccessAt != nil {
+		lastSuccessAt = sql.NullTime{Time: *in.LastSuccessAt, Valid: true}
+	}
+
+	return &Entity{
+		ID:               in.ID,
+		ApplicationID:    in.ApplicationID,
+		WebhookID:        in.WebhookID,
+		LastAttemptAt:    in.LastAttemptAt,
+		LastSuccessAt:    lastSuccessAt,
+		DocumentsCount:   in.DocumentsCount,
+		Error:            repo.NewNullableString(in.Error),
+		ValidationErrors: validationErrors,
+		DocumentsHash:    repo.NewNullableString(in.DocumentsHash),
+		FetchCache:       fetchCache,
+	}, nil
+}
+
+// FromEntity converts the provided repository-layer representation of an ORD aggregation status to the service-layer one.
+func (c *converter) FromEntity(entity *Entity) (*model.ORDAggregationStatus, error) {
+	if entity == nil {
+		return nil, apperrors.NewInternalError("the ORD aggregation status entity is nil")
+	}
+
+	var validationErrors []*model.ORDValidationError
+	if entity.ValidationErrors.Valid {
+		if err := json.Unmarshal([]byte(entity.ValidationErrors.String), &validationErrors); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling validation errors")
+		}
+	}
+
+	var fetchCache *model.ORDFetchCache
+	if entity.FetchCache.Valid {
+		if err := json.Unmarshal([]byte(entity.FetchCache.String), &fetchCache); err != nil {
+			return nil, errors.Wrap(err, "while unmarshalling fetch cache")
+		}
+	}
+
+	var lastSuccessAt *time.Time
+	if entity.LastSuccessAt.Valid {
+		lastSuccessAt = &entity.LastSuccessAt.Time
+	}
+
+	return &model.ORDAggregationStatus{
+		ID:               entity.ID,
+		ApplicationID:    entity.ApplicationID,
+		WebhookID:        entity.WebhookID,
+		LastAttemptAt:    entity.LastAttemptAt,
+		LastSuccessAt:    lastSuccessAt,
+		DocumentsCount:   entity.DocumentsCount,
+		Error:            repo.StringPtrFromNullableString(entity.Error),
+		ValidationErrors: validationErrors,
+		DocumentsHash:    repo.StringPtrFromNullableString(entity.DocumentsHash),
+		FetchCache:       fetchCache,
+	}, nil
+}
+
+// ToGraphQL converts the provided service-layer representation of an ORD aggregation status to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.ORDAggregationStatus) *graphql.ORDAggregationStatus {
+	if in == nil {
+		return nil
+	}
+
+	var lastSuccessAt *graphql.Timestamp
+	if in.LastSuccessAt != nil {
+		timestamp := graphql.Timestamp(*in.LastSuccessAt)
+		lastSuccessAt = &timestamp
+	}
+
+	validationErrors := make([]*graphql.ORDValidationError, 0, len(in.ValidationErrors))
+	for _, validationErr := range in.ValidationErrors {
+		if validationErr == nil {
+			continue
+		}
+		validationErrors = append(validationErrors, &graphql.ORDValidationError{
+			DocumentIndex: validationErr.DocumentIndex,
+			ResourceType:  validationErr.ResourceType,
+			OrdID:         validationErr.OrdID,
+			Path:          optionalString(validationErr.Path),
+			Rule:          optionalString(validationErr.Rule),
+			Message:       validationErr.Message,
+		})
+	}
+
+	return &graphql.ORDAggregationStatus{
+		ApplicationID:    in.ApplicationID,
+		WebhookID:        in.WebhookID,
+		LastAttemptAt:    graphql.Timestamp(in.LastAttemptAt),
+		LastSuccessAt:    lastSuccessAt,
+		DocumentsCount:   in.DocumentsCount,
+		Error:            in.Error,
+		ValidationErrors: validationErrors,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer representations of ORD aggregation statuses to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.ORDAggregationStatus) []*graphql.ORDAggregationStatus {
+	statuses := make([]*graphql.ORDAggregationStatus, 0, len(in))
+	for _, status := range in {
+		if status == nil {
+			continue
+		}
+		statuses = append(statuses, c.ToGraphQL(status))
+	}
+	return statuses
+}
+
+func optionalString(s string) *string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
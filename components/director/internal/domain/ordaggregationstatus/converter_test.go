@@ -0,0 +1,125 @@
+package ordaggregationstatus_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success for succeeded aggregation", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		entity, err := conv.ToEntity(fixSucceededStatusModel())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixSucceededStatusEntity(), entity)
+	})
+
+	t.Run("Success for failed aggregation", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		entity, err := conv.ToEntity(fixFailedStatusModel())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixFailedStatusEntity(), entity)
+	})
+
+	t.Run("Returns nil if model is nil", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		entity, err := conv.ToEntity(nil)
+
+		require.NoError(t, err)
+		require.Nil(t, entity)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success for succeeded aggregation", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		status, err := conv.FromEntity(fixSucceededStatusEntity())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixSucceededStatusModel(), status)
+	})
+
+	t.Run("Success for failed aggregation", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		status, err := conv.FromEntity(fixFailedStatusEntity())
+
+		require.NoError(t, err)
+		assert.Equal(t, fixFailedStatusModel(), status)
+	})
+
+	t.Run("Returns error if validation errors are malformed", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+		entity := fixFailedStatusEntity()
+		entity.ValidationErrors = sql.NullString{String: "{", Valid: true}
+
+		_, err := conv.FromEntity(entity)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling validation errors")
+	})
+
+	t.Run("Returns error if fetch cache is malformed", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+		entity := fixSucceededStatusEntity()
+		entity.FetchCache = sql.NullString{String: "{", Valid: true}
+
+		_, err := conv.FromEntity(entity)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while unmarshalling fetch cache")
+	})
+
+	t.Run("Returns error if entity is nil", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		_, err := conv.FromEntity(nil)
+
+		require.Error(t, err)
+	})
+}
+
+func TestConverter_ToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		status := conv.ToGraphQL(fixFailedStatusModel())
+
+		assert.Equal(t, fixFailedStatusGraphQL(), status)
+	})
+
+	t.Run("Returns empty validation errors for succeeded aggregation", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		status := conv.ToGraphQL(fixSucceededStatusModel())
+
+		require.NotNil(t, status)
+		assert.Nil(t, status.Error)
+		assert.Equal(t, []*graphql.ORDValidationError{}, status.ValidationErrors)
+	})
+
+	t.Run("Returns nil if model is nil", func(t *testing.T) {
+		conv := ordaggregationstatus.NewConverter()
+
+		require.Nil(t, conv.ToGraphQL(nil))
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := ordaggregationstatus.NewConverter()
+
+	statuses := conv.MultipleToGraphQL([]*model.ORDAggregationStatus{fixFailedStatusModel(), nil})
+
+	assert.Equal(t, []*graphql.ORDAggregationStatus{fixFailedStatusGraphQL()}, statuses)
+}
@@ -0,0 +1,43 @@
+package ordaggregationstatus
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Entity represents an ORD aggregation status entity.
+type Entity struct {
+	ID               string         `db:"id"`
+	ApplicationID    string         `db:"app_id"`
+	WebhookID        string         `db:"webhook_id"`
+	LastAttemptAt    time.Time      `db:"last_attempt_at"`
+	LastSuccessAt    sql.NullTime   `db:"last_success_at"`
+	DocumentsCount   int            `db:"documents_count"`
+	Error            sql.NullString `db:"error"`
+	ValidationErrors sql.NullString `db:"validation_errors"`
+	DocumentsHash    sql.NullString `db:"documents_hash"`
+	FetchCache       sql.NullString `db:"fetch_cache"`
+}
+
+// GetID returns the entity's ID.
+func (e *Entity) GetID() string {
+	return e.ID
+}
+
+// GetParent returns the parent type and the parent ID of the entity.
+func (e *Entity) GetParent(_ resource.Type) (resource.Type, string) {
+	return resource.Application, e.ApplicationID
+}
+
+// DecorateWithTenantID decorates the entity with the given tenant ID.
+func (e *Entity) DecorateWithTenantID(tenant string) interface{} {
+	return struct {
+		*Entity
+		TenantID string `db:"tenant_id"`
+	}{
+		Entity:   e,
+		TenantID: tenant,
+	}
+}
@@ -0,0 +1,164 @@
+package ordaggregationstatus_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+const (
+	statusID         = "statusID"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID = "externalTenantID"
+	appID            = "appID"
+	webhookID        = "webhookID"
+	errMsg           = "error fetching ORD document"
+	validationErrors = `[{"resourceType":"vendor","ordId":"sap:vendor:SAP:","message":"ordId: must be in a valid format."},{"documentIndex":0,"resourceType":"package","ordId":"ns:package:PACKAGE_ID:v1","path":"packages[0].title","rule":"validation_required","message":"cannot be blank"}]`
+	documentsHash    = "8246829460521424516"
+	fetchCache       = `{"config":{"etag":"\"config-etag\""},"baseUrl":"http://test.com","documents":[{"url":"http://test.com/ord/v1/documents/example1","accessStrategy":"open","lastModified":"Mon, 10 Jan 2022 10:00:00 GMT"}]}`
+)
+
+var (
+	lastAttemptAt = time.Date(2022, 1, 10, 10, 0, 0, 0, time.UTC)
+	lastSuccessAt = time.Date(2022, 1, 9, 10, 0, 0, 0, time.UTC)
+)
+
+func fixSucceededStatusModel() *model.ORDAggregationStatus {
+	return fixSucceededStatusModelWithID(statusID)
+}
+
+func fixSucceededStatusModelWithID(id string) *model.ORDAggregationStatus {
+	successAt := lastSuccessAt
+	return &model.ORDAggregationStatus{
+		ID:             id,
+		ApplicationID:  appID,
+		WebhookID:      webhookID,
+		LastAttemptAt:  lastSuccessAt,
+		LastSuccessAt:  &successAt,
+		DocumentsCount: 2,
+		DocumentsHash:  str.Ptr(documentsHash),
+		FetchCache:     fixFetchCache(),
+	}
+}
+
+func fixFetchCache() *model.ORDFetchCache {
+	return &model.ORDFetchCache{
+		Config:  model.HTTPCacheValidators{ETag: `"config-etag"`},
+		BaseURL: "http://test.com",
+		Documents: []*model.ORDDocumentCacheEntry{
+			{
+				URL:                 "http://test.com/ord/v1/documents/example1",
+				AccessStrategy:      "open",
+				HTTPCacheValidators: model.HTTPCacheValidators{LastModified: "Mon, 10 Jan 2022 10:00:00 GMT"},
+			},
+		},
+	}
+}
+
+func fixFailedStatusModel() *model.ORDAggregationStatus {
+	successAt := lastSuccessAt
+	documentIndex := 0
+	return &model.ORDAggregationStatus{
+		ID:             statusID,
+		ApplicationID:  appID,
+		WebhookID:      webhookID,
+		LastAttemptAt:  lastAttemptAt,
+		LastSuccessAt:  &successAt,
+		DocumentsCount: 1,
+		Error:          str.Ptr(errMsg),
+		ValidationErrors: []*model.ORDValidationError{
+			{
+				ResourceType: "vendor",
+				OrdID:        "sap:vendor:SAP:",
+				Message:      "ordId: must be in a valid format.",
+			},
+			{
+				DocumentIndex: &documentIndex,
+				ResourceType:  "package",
+				OrdID:         "ns:package:PACKAGE_ID:v1",
+				Path:          "packages[0].title",
+				Rule:          "validation_required",
+				Message:       "cannot be blank",
+			},
+		},
+	}
+}
+
+func fixSucceededStatusEntity() *ordaggregationstatus.Entity {
+	return fixSucceededStatusEntityWithID(statusID)
+}
+
+func fixSucceededStatusEntityWithID(id string) *ordaggregationstatus.Entity {
+	return &ordaggregationstatus.Entity{
+		ID:             id,
+		ApplicationID:  appID,
+		WebhookID:      webhookID,
+		LastAttemptAt:  lastSuccessAt,
+		LastSuccessAt:  sql.NullTime{Time: lastSuccessAt, Valid: true},
+		DocumentsCount: 2,
+		DocumentsHash:  sql.NullString{String: documentsHash, Valid: true},
+		FetchCache:     sql.NullString{String: fetchCache, Valid: true},
+	}
+}
+
+func fixFailedStatusEntity() *ordaggregationstatus.Entity {
+	return &ordaggregationstatus.Entity{
+		ID:               statusID,
+		ApplicationID:    appID,
+		WebhookID:        webhookID,
+		LastAttemptAt:    lastAttemptAt,
+		LastSuccessAt:    sql.NullTime{Time: lastSuccessAt, Valid: true},
+		DocumentsCount:   1,
+		Error:            sql.NullString{String: errMsg, Valid: true},
+		ValidationErrors: sql.NullString{String: validationErrors, Valid: true},
+	}
+}
+
+func fixFailedStatusGraphQL() *graphql.ORDAggregationStatus {
+	successAt := graphql.Timestamp(lastSuccessAt)
+	documentIndex := 0
+	return &graphql.ORDAggregationStatus{
+		ApplicationID:  appID,
+		WebhookID:      webhookID,
+		LastAttemptAt:  graphql.Timestamp(lastAttemptAt),
+		LastSuccessAt:  &successAt,
+		DocumentsCount: 1,
+		Error:          str.Ptr(errMsg),
+		ValidationErrors: []*graphql.ORDValidationError{
+			{
+				ResourceType: "vendor",
+				OrdID:        "sap:vendor:SAP:",
+				Message:      "ordId: must be in a valid format.",
+			},
+			{
+				DocumentIndex: &documentIndex,
+				ResourceType:  "package",
+				OrdID:         "ns:package:PACKAGE_ID:v1",
+				Path:          str.Ptr("packages[0].title"),
+				Rule:          str.Ptr("validation_required"),
+				Message:       "cannot be blank",
+			},
+		},
+	}
+}
+
+func fixStatusColumns() []string {
+	return []string{"id", "app_id", "webhook_id", "last_attempt_at", "last_success_at", "documents_count", "error", "validation_errors", "documents_hash", "fetch_cache"}
+}
+
+func fixSucceededStatusRowWithID(id string) []driver.Value {
+	return []driver.Value{id, appID, webhookID, lastSuccessAt, lastSuccessAt, 2, nil, nil, documentsHash, fetchCache}
+}
+
+func fixSucceededStatusRow() []driver.Value {
+	return fixSucceededStatusRowWithID(statusID)
+}
+
+func fixSucceededStatusUpdateArgs() []driver.Value {
+	return []driver.Value{lastSuccessAt, lastSuccessAt, 2, nil, nil, documentsHash, fetchCache}
+}
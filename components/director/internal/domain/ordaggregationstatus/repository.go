@@ -0,0 +1,128 @@
+package ordaggregationstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/pkg/errors"
+)
+
+const statusTable string = `public.ord_aggregation_statuses`
+
+var (
+	statusColumns    = []string{"id", "app_id", "webhook_id", "last_attempt_at", "last_success_at", "documents_count", "error", "validation_errors", "documents_hash", "fetch_cache"}
+	updatableColumns = []string{"last_attempt_at", "last_success_at", "documents_count", "error", "validation_errors", "documents_hash", "fetch_cache"}
+)
+
+// EntityConverter converts between the service-layer and repository-layer representations of ORD aggregation statuses.
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.ORDAggregationStatus) (*Entity, error)
+	FromEntity(entity *Entity) (*model.ORDAggregationStatus, error)
+}
+
+type pgRepository struct {
+	conv         EntityConverter
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	creator      repo.Creator
+	updater      repo.Updater
+}
+
+// NewRepository returns a new repository for ORD aggregation statuses.
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:         conv,
+		singleGetter: repo.NewSingleGetter(statusTable, statusColumns),
+		lister:       repo.NewListerWithOrderBy(statusTable, statusColumns, repo.OrderByParams{repo.NewDescOrderBy("last_attempt_at")}),
+		creator:      repo.NewCreator(statusTable, statusColumns),
+		updater:      repo.NewUpdater(statusTable, updatableColumns, []string{"id"}),
+	}
+}
+
+// Create persists a new ORD aggregation status.
+func (r *pgRepository) Create(ctx context.Context, tenant string, model *model.ORDAggregationStatus) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity, err := r.conv.ToEntity(model)
+	if err != nil {
+		return errors.Wrap(err, "while converting ORD aggregation status to entity")
+	}
+
+	log.C(ctx).Debugf("Persisting ORD aggregation status entity with id %q", model.ID)
+	return r.creator.Create(ctx, resource.ORDAggregationStatus, tenant, entity)
+}
+
+// Update updates an existing ORD aggregation status.
+func (r *pgRepository) Update(ctx context.Context, tenant string, model *model.ORDAggregationStatus) error {
+	if model == nil {
+		return apperrors.NewInternalError("model can not be nil")
+	}
+
+	entity, err := r.conv.ToEntity(model)
+	if err != nil {
+		return errors.Wrap(err, "while converting ORD aggregation status to entity")
+	}
+
+	log.C(ctx).Debugf("Updating ORD aggregation status entity with id %q", model.ID)
+	return r.updater.UpdateSingle(ctx, resource.ORDAggregationStatus, tenant, entity)
+}
+
+// GetByApplicationIDAndWebhookID returns the ORD aggregation status of the given application webhook.
+func (r *pgRepository) GetByApplicationIDAndWebhookID(ctx context.Context, tenant, appID, webhookID string) (*model.ORDAggregationStatus, error) {
+	var entity Entity
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("app_id", appID),
+		repo.NewEqualCondition("webhook_id", webhookID),
+	}
+	if err := r.singleGetter.Get(ctx, resource.ORDAggregationStatus, tenant, conditions, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity)
+}
+
+// ListByApplicationID returns the ORD aggregation statuses of all webhooks of the given application, the most recently attempted first.
+func (r *pgRepository) ListByApplicationID(ctx context.Context, tenant, appID string) ([]*model.ORDAggregationStatus, error) {
+	var entities statusCollection
+	if err := r.lister.List(ctx, resource.ORDAggregationStatus, tenant, &entities, repo.NewEqualCondition("app_id", appID)); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+// List returns all ORD aggregation statuses visible for the given tenant, the most recently attempted first.
+func (r *pgRepository) List(ctx context.Context, tenant string) ([]*model.ORDAggregationStatus, error) {
+	var entities statusCollection
+	if err := r.lister.List(ctx, resource.ORDAggregationStatus, tenant, &entities); err != nil {
+		return nil, err
+	}
+
+	return r.multipleFromEntities(entities)
+}
+
+func (r *pgRepository) multipleFromEntities(entities statusCollection) ([]*model.ORDAggregationStatus, error) {
+	statuses := make([]*model.ORDAggregationStatus, 0, len(entities))
+	for i := range entities {
+		status, err := r.conv.FromEntity(&entities[i])
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+type statusCollection []Entity
+
+// Len returns the length of the collection.
+func (c statusCollection) Len() int {
+	return len(c)
+}
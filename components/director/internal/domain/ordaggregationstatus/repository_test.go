@@ -0,0 +1,160 @@
+package ordaggregationstatus_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	var nilStatusModel *model.ORDAggregationStatus
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create ORD aggregation status",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta("SELECT 1 FROM tenant_applications WHERE tenant_id = $1 AND id = $2 AND owner = $3"),
+				Args:     []driver.Value{tenantID, appID, true},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+			{
+				Query:       `^INSERT INTO public.ord_aggregation_statuses \(.+\) VALUES \(.+\)$`,
+				Args:        fixSucceededStatusRow(),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: ordaggregationstatus.NewRepository,
+		ModelEntity:         fixSucceededStatusModel(),
+		DBEntity:            fixSucceededStatusEntity(),
+		NilModelEntity:      nilStatusModel,
+		TenantID:            tenantID,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_Update(t *testing.T) {
+	var nilStatusModel *model.ORDAggregationStatus
+	suite := testdb.RepoUpdateTestSuite{
+		Name: "Update ORD aggregation status",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`UPDATE public.ord_aggregation_statuses SET last_attempt_at = ?, last_success_at = ?, documents_count = ?, error = ?, validation_errors = ?, documents_hash = ?, fetch_cache = ? WHERE id = ? AND (id IN (SELECT id FROM ord_aggregation_statuses_tenants WHERE tenant_id = ? AND owner = true))`),
+				Args:          append(fixSucceededStatusUpdateArgs(), statusID, tenantID),
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 0),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: ordaggregationstatus.NewRepository,
+		ModelEntity:         fixSucceededStatusModel(),
+		DBEntity:            fixSucceededStatusEntity(),
+		NilModelEntity:      nilStatusModel,
+		TenantID:            tenantID,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByApplicationIDAndWebhookID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get ORD aggregation status",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, webhook_id, last_attempt_at, last_success_at, documents_count, error, validation_errors, documents_hash, fetch_cache FROM public.ord_aggregation_statuses WHERE app_id = $1 AND webhook_id = $2 AND (id IN (SELECT id FROM ord_aggregation_statuses_tenants WHERE tenant_id = $3))`),
+				Args:     []driver.Value{appID, webhookID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns()).AddRow(fixSucceededStatusRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: ordaggregationstatus.NewRepository,
+		ExpectedModelEntity: fixSucceededStatusModel(),
+		ExpectedDBEntity:    fixSucceededStatusEntity(),
+		MethodArgs:          []interface{}{tenantID, appID, webhookID},
+		MethodName:          "GetByApplicationIDAndWebhookID",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByApplicationID(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List ORD aggregation statuses for application",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, webhook_id, last_attempt_at, last_success_at, documents_count, error, validation_errors, documents_hash, fetch_cache FROM public.ord_aggregation_statuses WHERE app_id = $1 AND (id IN (SELECT id FROM ord_aggregation_statuses_tenants WHERE tenant_id = $2)) ORDER BY last_attempt_at DESC`),
+				Args:     []driver.Value{appID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns()).AddRow(fixSucceededStatusRowWithID("id1")...).AddRow(fixSucceededStatusRowWithID("id2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   ordaggregationstatus.NewRepository,
+		ExpectedModelEntities: []interface{}{fixSucceededStatusModelWithID("id1"), fixSucceededStatusModelWithID("id2")},
+		ExpectedDBEntities:    []interface{}{fixSucceededStatusEntityWithID("id1"), fixSucceededStatusEntityWithID("id2")},
+		MethodArgs:            []interface{}{tenantID, appID},
+		MethodName:            "ListByApplicationID",
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_List(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List ORD aggregation statuses",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, app_id, webhook_id, last_attempt_at, last_success_at, documents_count, error, validation_errors, documents_hash, fetch_cache FROM public.ord_aggregation_statuses WHERE (id IN (SELECT id FROM ord_aggregation_statuses_tenants WHERE tenant_id = $1)) ORDER BY last_attempt_at DESC`),
+				Args:     []driver.Value{tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns()).AddRow(fixSucceededStatusRowWithID("id1")...).AddRow(fixSucceededStatusRowWithID("id2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixStatusColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:   ordaggregationstatus.NewRepository,
+		ExpectedModelEntities: []interface{}{fixSucceededStatusModelWithID("id1"), fixSucceededStatusModelWithID("id2")},
+		ExpectedDBEntities:    []interface{}{fixSucceededStatusEntityWithID("id1"), fixSucceededStatusEntityWithID("id2")},
+		MethodArgs:            []interface{}{tenantID},
+		MethodName:            "List",
+	}
+
+	suite.Run(t)
+}
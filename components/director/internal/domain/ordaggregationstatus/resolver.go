@@ -0,0 +1,89 @@
+package ordaggregationstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// StatusService is responsible for the service-layer ORD aggregation status operations.
+//go:generate mockery --name=StatusService --output=automock --outpkg=automock --case=underscore
+type StatusService interface {
+	GetForApplication(ctx context.Context, appID string) (*model.ORDAggregationStatus, error)
+	List(ctx context.Context, failedOnly bool) ([]*model.ORDAggregationStatus, error)
+}
+
+// StatusConverter converts ORD aggregation statuses to their graphql-layer representation.
+//go:generate mockery --name=StatusConverter --output=automock --outpkg=automock --case=underscore
+type StatusConverter interface {
+	ToGraphQL(in *model.ORDAggregationStatus) *graphql.ORDAggregationStatus
+	MultipleToGraphQL(in []*model.ORDAggregationStatus) []*graphql.ORDAggregationStatus
+}
+
+// Resolver is an object responsible for resolver-layer ORD aggregation status operations.
+type Resolver struct {
+	transact   persistence.Transactioner
+	statusSvc  StatusService
+	statusConv StatusConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer ORD aggregation status operations.
+func NewResolver(transact persistence.Transactioner, statusSvc StatusService, statusConv StatusConverter) *Resolver {
+	return &Resolver{
+		transact:   transact,
+		statusSvc:  statusSvc,
+		statusConv: statusConv,
+	}
+}
+
+// ORDAggregationStatuses returns the ORD aggregation statuses of all applications visible for the caller's tenant.
+func (r *Resolver) ORDAggregationStatuses(ctx context.Context, failedOnly *bool) ([]*graphql.ORDAggregationStatus, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	statuses, err := r.statusSvc.List(ctx, failedOnly != nil && *failedOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return r.statusConv.MultipleToGraphQL(statuses), nil
+}
+
+// ApplicationORDAggregationStatus returns the most recent ORD aggregation status of the given application.
+func (r *Resolver) ApplicationORDAggregationStatus(ctx context.Context, obj *graphql.Application) (*graphql.ORDAggregationStatus, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Application cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	status, err := r.statusSvc.GetForApplication(ctx, obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return r.statusConv.ToGraphQL(status), nil
+}
@@ -0,0 +1,244 @@
+package ordaggregationstatus_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var contextParam = txtest.CtxWithDBMatcher()
+
+func TestResolver_ORDAggregationStatuses(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	failedOnly := true
+	modelStatuses := []*model.ORDAggregationStatus{fixFailedStatusModel()}
+	gqlStatuses := []*graphql.ORDAggregationStatus{fixFailedStatusGraphQL()}
+
+	testCases := []struct {
+		Name             string
+		TransactionerFn  func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn        func() *automock.StatusService
+		ConverterFn      func() *automock.StatusConverter
+		FailedOnly       *bool
+		ExpectedStatuses []*graphql.ORDAggregationStatus
+		ExpectedErr      error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("List", contextParam, true).Return(modelStatuses, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				conv := &automock.StatusConverter{}
+				conv.On("MultipleToGraphQL", modelStatuses).Return(gqlStatuses).Once()
+				return conv
+			},
+			FailedOnly:       &failedOnly,
+			ExpectedStatuses: gqlStatuses,
+		},
+		{
+			Name:            "Success - lists all statuses when filter is not provided",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("List", contextParam, false).Return(modelStatuses, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				conv := &automock.StatusConverter{}
+				conv.On("MultipleToGraphQL", modelStatuses).Return(gqlStatuses).Once()
+				return conv
+			},
+			ExpectedStatuses: gqlStatuses,
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.StatusService {
+				return &automock.StatusService{}
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when listing statuses fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("List", contextParam, false).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("List", contextParam, false).Return(modelStatuses, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordaggregationstatus.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ORDAggregationStatuses(context.TODO(), testCase.FailedOnly)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedStatuses, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_ApplicationORDAggregationStatus(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appID}}
+	modelStatus := fixFailedStatusModel()
+	gqlStatus := fixFailedStatusGraphQL()
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.StatusService
+		ConverterFn     func() *automock.StatusConverter
+		Application     *graphql.Application
+		ExpectedStatus  *graphql.ORDAggregationStatus
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("GetForApplication", contextParam, appID).Return(modelStatus, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				conv := &automock.StatusConverter{}
+				conv.On("ToGraphQL", modelStatus).Return(gqlStatus).Once()
+				return conv
+			},
+			Application:    app,
+			ExpectedStatus: gqlStatus,
+		},
+		{
+			Name:            "Returns error when application is nil",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.StatusService {
+				return &automock.StatusService{}
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			ExpectedErr: errors.New("Application cannot be empty"),
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.StatusService {
+				return &automock.StatusService{}
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			Application: app,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when getting status fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("GetForApplication", contextParam, appID).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			Application: app,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.StatusService {
+				svc := &automock.StatusService{}
+				svc.On("GetForApplication", contextParam, appID).Return(modelStatus, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.StatusConverter {
+				return &automock.StatusConverter{}
+			},
+			Application: app,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := ordaggregationstatus.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.ApplicationORDAggregationStatus(context.TODO(), testCase.Application)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedStatus, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
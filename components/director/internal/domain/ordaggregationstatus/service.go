@@ -0,0 +1,141 @@
+package ordaggregationstatus
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+// StatusRepository is responsible for the repo-layer ORD aggregation status operations.
+//go:generate mockery --name=StatusRepository --output=automock --outpkg=automock --case=underscore
+type StatusRepository interface {
+	Create(ctx context.Context, tenant string, item *model.ORDAggregationStatus) error
+	Update(ctx context.Context, tenant string, item *model.ORDAggregationStatus) error
+	GetByApplicationIDAndWebhookID(ctx context.Context, tenant, appID, webhookID string) (*model.ORDAggregationStatus, error)
+	ListByApplicationID(ctx context.Context, tenant, appID string) ([]*model.ORDAggregationStatus, error)
+	List(ctx context.Context, tenant string) ([]*model.ORDAggregationStatus, error)
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal ORD aggregation status IDs.
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	statusRepo   StatusRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+// NewService returns a new service for ORD aggregation statuses.
+func NewService(statusRepo StatusRepository, uidService UIDService) *service {
+	return &service{
+		statusRepo:   statusRepo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator,
+	}
+}
+
+// Record persists the result of an ORD aggregation attempt of the given application webhook.
+// The status is created on the first attempt and updated on each subsequent one.
+func (s *service) Record(ctx context.Context, appID, webhookID string, result model.ORDAggregationResult) error {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	status, err := s.statusRepo.GetByApplicationIDAndWebhookID(ctx, tnt, appID, webhookID)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting ORD aggregation status for webhook with id %s of Application with id %s", webhookID, appID)
+	}
+
+	if status == nil {
+		status = &model.ORDAggregationStatus{
+			ID:            s.uidService.Generate(),
+			ApplicationID: appID,
+			WebhookID:     webhookID,
+		}
+		status.SetFromResult(result, s.timestampGen())
+
+		if err = s.statusRepo.Create(ctx, tnt, status); err != nil {
+			return errors.Wrapf(err, "while creating ORD aggregation status for webhook with id %s of Application with id %s", webhookID, appID)
+		}
+		log.C(ctx).Debugf("Successfully created ORD aggregation status with id %s for Application with id %s", status.ID, appID)
+		return nil
+	}
+
+	status.SetFromResult(result, s.timestampGen())
+	if err = s.statusRepo.Update(ctx, tnt, status); err != nil {
+		return errors.Wrapf(err, "while updating ORD aggregation status with id %s", status.ID)
+	}
+	log.C(ctx).Debugf("Successfully updated ORD aggregation status with id %s for Application with id %s", status.ID, appID)
+	return nil
+}
+
+// GetForWebhook returns the ORD aggregation status of the given application webhook or nil if the webhook was never aggregated.
+func (s *service) GetForWebhook(ctx context.Context, appID, webhookID string) (*model.ORDAggregationStatus, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.statusRepo.GetByApplicationIDAndWebhookID(ctx, tnt, appID, webhookID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "while getting ORD aggregation status for webhook with id %s of Application with id %s", webhookID, appID)
+	}
+
+	return status, nil
+}
+
+// GetForApplication returns the most recent ORD aggregation status of the given application or nil if the application was never aggregated.
+func (s *service) GetForApplication(ctx context.Context, appID string) (*model.ORDAggregationStatus, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.statusRepo.ListByApplicationID(ctx, tnt, appID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing ORD aggregation statuses for Application with id %s", appID)
+	}
+
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	return statuses[0], nil
+}
+
+// List returns the ORD aggregation statuses of all applications visible for the tenant in the context.
+// If failedOnly is true, only the statuses of failed aggregations are returned.
+func (s *service) List(ctx context.Context, failedOnly bool) ([]*model.ORDAggregationStatus, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := s.statusRepo.List(ctx, tnt)
+	if err != nil {
+		return nil, errors.Wrap(err, "while listing ORD aggregation statuses")
+	}
+
+	if !failedOnly {
+		return statuses, nil
+	}
+
+	failed := make([]*model.ORDAggregationStatus, 0)
+	for _, status := range statuses {
+		if status.IsFailed() {
+			failed = append(failed, status)
+		}
+	}
+	return failed, nil
+}
@@ -0,0 +1,376 @@
+package ordaggregationstatus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Record(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	notFoundErr := apperrors.NewNotFoundError(resource.ORDAggregationStatus, "")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	now := time.Date(2022, 1, 11, 10, 0, 0, 0, time.UTC)
+	succeededResult := model.ORDAggregationResult{DocumentsCount: 3}
+	failedResult := model.ORDAggregationResult{Error: str.Ptr(errMsg)}
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.StatusRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        model.ORDAggregationResult
+		Context      context.Context
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success - creates status on first aggregation",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, tenantID, &model.ORDAggregationStatus{
+					ID:             statusID,
+					ApplicationID:  appID,
+					WebhookID:      webhookID,
+					LastAttemptAt:  now,
+					LastSuccessAt:  &now,
+					DocumentsCount: 3,
+				}).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(statusID).Once()
+				return svc
+			},
+			Input: succeededResult,
+		},
+		{
+			Name: "Success - updates status and keeps last success time on failed aggregation",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(fixSucceededStatusModel(), nil).Once()
+				repo.On("Update", ctx, tenantID, mock.MatchedBy(func(status *model.ORDAggregationStatus) bool {
+					return status.ID == statusID && status.LastAttemptAt.Equal(now) && status.LastSuccessAt.Equal(lastSuccessAt) &&
+						status.DocumentsCount == 0 && *status.Error == errMsg && *status.DocumentsHash == documentsHash && status.FetchCache != nil
+				})).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input: failedResult,
+		},
+		{
+			Name: "Success - updates status and clears previous error on succeeded aggregation",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(fixFailedStatusModel(), nil).Once()
+				repo.On("Update", ctx, tenantID, mock.MatchedBy(func(status *model.ORDAggregationStatus) bool {
+					return status.LastSuccessAt.Equal(now) && status.Error == nil && len(status.ValidationErrors) == 0 && status.DocumentsCount == 3
+				})).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input: succeededResult,
+		},
+		{
+			Name: "Error - getting status",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:       succeededResult,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - creating status",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, tenantID, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(statusID).Once()
+				return svc
+			},
+			Input:       succeededResult,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - updating status",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(fixSucceededStatusModel(), nil).Once()
+				repo.On("Update", ctx, tenantID, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:       succeededResult,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - missing tenant",
+			RepositoryFn: func() *automock.StatusRepository {
+				return &automock.StatusRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:       succeededResult,
+			Context:     context.TODO(),
+			ExpectedErr: errors.New("cannot read tenant from context"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := ordaggregationstatus.NewService(repo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return now })
+
+			testCtx := ctx
+			if testCase.Context != nil {
+				testCtx = testCase.Context
+			}
+
+			// WHEN
+			err := svc.Record(testCtx, appID, webhookID, testCase.Input)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
+
+func TestService_GetForWebhook(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.StatusRepository
+		ExpectedStatus *model.ORDAggregationStatus
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(fixSucceededStatusModel(), nil).Once()
+				return repo
+			},
+			ExpectedStatus: fixSucceededStatusModel(),
+		},
+		{
+			Name: "Success - returns nil when webhook was never aggregated",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(nil, apperrors.NewNotFoundError(resource.ORDAggregationStatus, "")).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error - getting status",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("GetByApplicationIDAndWebhookID", ctx, tenantID, appID, webhookID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			svc := ordaggregationstatus.NewService(repo, nil)
+
+			// WHEN
+			status, err := svc.GetForWebhook(ctx, appID, webhookID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedStatus, status)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_GetForApplication(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	testCases := []struct {
+		Name           string
+		RepositoryFn   func() *automock.StatusRepository
+		ExpectedStatus *model.ORDAggregationStatus
+		ExpectedErr    error
+	}{
+		{
+			Name: "Success - returns the most recent status",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("ListByApplicationID", ctx, tenantID, appID).Return([]*model.ORDAggregationStatus{fixFailedStatusModel(), fixSucceededStatusModel()}, nil).Once()
+				return repo
+			},
+			ExpectedStatus: fixFailedStatusModel(),
+		},
+		{
+			Name: "Success - returns nil when application was never aggregated",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("ListByApplicationID", ctx, tenantID, appID).Return([]*model.ORDAggregationStatus{}, nil).Once()
+				return repo
+			},
+		},
+		{
+			Name: "Error - listing statuses",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("ListByApplicationID", ctx, tenantID, appID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			svc := ordaggregationstatus.NewService(repo, nil)
+
+			// WHEN
+			status, err := svc.GetForApplication(ctx, appID)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedStatus, status)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_List(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	statuses := []*model.ORDAggregationStatus{fixFailedStatusModel(), fixSucceededStatusModel()}
+
+	testCases := []struct {
+		Name             string
+		RepositoryFn     func() *automock.StatusRepository
+		FailedOnly       bool
+		ExpectedStatuses []*model.ORDAggregationStatus
+		ExpectedErr      error
+	}{
+		{
+			Name: "Success - all statuses",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("List", ctx, tenantID).Return(statuses, nil).Once()
+				return repo
+			},
+			ExpectedStatuses: statuses,
+		},
+		{
+			Name: "Success - failed statuses only",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("List", ctx, tenantID).Return(statuses, nil).Once()
+				return repo
+			},
+			FailedOnly:       true,
+			ExpectedStatuses: []*model.ORDAggregationStatus{fixFailedStatusModel()},
+		},
+		{
+			Name: "Error - listing statuses",
+			RepositoryFn: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.On("List", ctx, tenantID).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			svc := ordaggregationstatus.NewService(repo, nil)
+
+			// WHEN
+			result, err := svc.List(ctx, testCase.FailedOnly)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedStatuses, result)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
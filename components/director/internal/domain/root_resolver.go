@@ -29,10 +29,14 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/labeldef"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/oauth20"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/onetimetoken"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/operation"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationrequest"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/ordaggregationstatus"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	runtimectx "github.com/kyma-incubator/compass/components/director/internal/domain/runtime_context"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/scenarioassignment"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/systemauth"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/version"
@@ -59,28 +63,32 @@ var _ graphql.ResolverRoot = &RootResolver{}
 
 // RootResolver missing godoc
 type RootResolver struct {
-	appNameNormalizer  normalizer.Normalizator
-	app                *application.Resolver
-	appTemplate        *apptemplate.Resolver
-	api                *api.Resolver
-	eventAPI           *eventdef.Resolver
-	eventing           *eventing.Resolver
-	doc                *document.Resolver
-	formation          *formation.Resolver
-	runtime            *runtime.Resolver
-	runtimeContext     *runtimectx.Resolver
-	healthCheck        *healthcheck.Resolver
-	webhook            *webhook.Resolver
-	labelDef           *labeldef.Resolver
-	token              *onetimetoken.Resolver
-	systemAuth         *systemauth.Resolver
-	oAuth20            *oauth20.Resolver
-	intSys             *integrationsystem.Resolver
-	viewer             *viewer.Resolver
-	tenant             *tenant.Resolver
-	mpBundle           *bundleutil.Resolver
-	bundleInstanceAuth *bundleinstanceauth.Resolver
-	scenarioAssignment *scenarioassignment.Resolver
+	appNameNormalizer     normalizer.Normalizator
+	app                   *application.Resolver
+	appTemplate           *apptemplate.Resolver
+	api                   *api.Resolver
+	eventAPI              *eventdef.Resolver
+	eventing              *eventing.Resolver
+	doc                   *document.Resolver
+	formation             *formation.Resolver
+	runtime               *runtime.Resolver
+	runtimeContext        *runtimectx.Resolver
+	healthCheck           *healthcheck.Resolver
+	webhook               *webhook.Resolver
+	labelDef              *labeldef.Resolver
+	token                 *onetimetoken.Resolver
+	systemAuth            *systemauth.Resolver
+	oAuth20               *oauth20.Resolver
+	intSys                *integrationsystem.Resolver
+	viewer                *viewer.Resolver
+	tenant                *tenant.Resolver
+	mpBundle              *bundleutil.Resolver
+	bundleInstanceAuth    *bundleinstanceauth.Resolver
+	scenarioAssignment    *scenarioassignment.Resolver
+	ordAggregationStatus  *ordaggregationstatus.Resolver
+	ordAggregationRequest *ordaggregationrequest.Resolver
+	specRevision          *specrevision.Resolver
+	operation             *operation.Resolver
 }
 
 // NewRootResolver missing godoc
@@ -99,6 +107,8 @@ func NewRootResolver(
 	hydraURL *url.URL,
 	accessStrategyExecutorProvider *accessstrategy.Provider,
 	cache certloader.Cache,
+	operationFetcher operation.Fetcher,
+	operationTransitioner operation.Transitioner,
 ) *RootResolver {
 	oAuth20HTTPClient := &http.Client{
 		Timeout:   oAuth20Cfg.HTTPClientTimeout,
@@ -149,6 +159,8 @@ func NewRootResolver(
 	assignmentConv := scenarioassignment.NewConverter()
 	bundleReferenceConv := bundlereferences.NewConverter()
 	formationConv := formation.NewConverter()
+	ordAggregationStatusConv := ordaggregationstatus.NewConverter()
+	specRevisionConv := specrevision.NewConverter()
 
 	healthcheckRepo := healthcheck.NewRepository()
 	runtimeRepo := runtime.NewRepository(runtimeConverter)
@@ -170,6 +182,9 @@ func NewRootResolver(
 	bundleInstanceAuthRepo := bundleinstanceauth.NewRepository(bundleInstanceAuthConv)
 	scenarioAssignmentRepo := scenarioassignment.NewRepository(assignmentConv)
 	bundleReferenceRepo := bundlereferences.NewRepository(bundleReferenceConv)
+	ordAggregationStatusRepo := ordaggregationstatus.NewRepository(ordAggregationStatusConv)
+	ordAggregationRequestRepo := ordaggregationrequest.NewRepository(ordaggregationrequest.NewConverter())
+	specRevisionRepo := specrevision.NewRepository(specRevisionConv)
 
 	uidSvc := uid.NewService()
 	labelSvc := label.NewLabelService(labelRepo, labelDefRepo, uidSvc)
@@ -177,7 +192,8 @@ func NewRootResolver(
 
 	labelDefSvc := labeldef.NewService(labelDefRepo, labelRepo, scenarioAssignmentRepo, tenantRepo, uidSvc, featuresConfig.DefaultScenarioEnabled)
 	fetchRequestSvc := fetchrequest.NewService(fetchRequestRepo, httpClient, accessStrategyExecutorProvider)
-	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+	specRevisionSvc := specrevision.NewService(specRevisionRepo, uidSvc)
+	specSvc := spec.NewService(specRepo, fetchRequestRepo, uidSvc, fetchRequestSvc, specRevisionSvc)
 	bundleReferenceSvc := bundlereferences.NewService(bundleReferenceRepo, uidSvc)
 	apiSvc := api.NewService(apiRepo, uidSvc, specSvc, bundleReferenceSvc)
 	eventAPISvc := eventdef.NewService(eventAPIRepo, uidSvc, specSvc, bundleReferenceSvc)
@@ -199,30 +215,36 @@ func NewRootResolver(
 	tokenSvc := onetimetoken.NewTokenService(systemAuthSvc, appSvc, appConverter, tenantSvc, internalHTTPClient, onetimetoken.NewTokenGenerator(tokenLength), oneTimeTokenCfg, pairingAdaptersMapping, timeService)
 	bundleInstanceAuthSvc := bundleinstanceauth.NewService(bundleInstanceAuthRepo, uidSvc)
 	formationSvc := formation.NewService(labelDefRepo, labelRepo, labelSvc, uidSvc, labelDefSvc, scenarioAssignmentSvc, tenantSvc)
+	ordAggregationStatusSvc := ordaggregationstatus.NewService(ordAggregationStatusRepo, uidSvc)
+	ordAggregationRequestSvc := ordaggregationrequest.NewService(ordAggregationRequestRepo, applicationRepo, appTemplateRepo, uidSvc)
 
 	return &RootResolver{
-		appNameNormalizer:  appNameNormalizer,
-		app:                application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, bundleSvc, bundleConverter),
-		appTemplate:        apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookSvc, webhookConverter),
-		api:                api.NewResolver(transact, apiSvc, runtimeSvc, bundleSvc, bundleReferenceSvc, apiConverter, frConverter, specSvc, specConverter),
-		eventAPI:           eventdef.NewResolver(transact, eventAPISvc, bundleSvc, bundleReferenceSvc, eventAPIConverter, frConverter, specSvc, specConverter),
-		eventing:           eventing.NewResolver(transact, eventingSvc, appSvc),
-		doc:                document.NewResolver(transact, docSvc, appSvc, bundleSvc, frConverter),
-		formation:          formation.NewResolver(transact, formationSvc, formationConv),
-		runtime:            runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, bundleInstanceAuthSvc, selfRegisterManager, uidSvc),
-		runtimeContext:     runtimectx.NewResolver(transact, runtimeCtxSvc, runtimeContextConverter),
-		healthCheck:        healthcheck.NewResolver(healthCheckSvc),
-		webhook:            webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, webhookConverter),
-		labelDef:           labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
-		token:              onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter, oneTimeTokenCfg.SuggestTokenHeaderKey),
-		systemAuth:         systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
-		oAuth20:            oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
-		intSys:             integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
-		viewer:             viewer.NewViewerResolver(),
-		tenant:             tenant.NewResolver(transact, tenantSvc, tenantConverter),
-		mpBundle:           bundleutil.NewResolver(transact, bundleSvc, bundleInstanceAuthSvc, bundleReferenceSvc, apiSvc, eventAPISvc, docSvc, bundleConverter, bundleInstanceAuthConv, apiConverter, eventAPIConverter, docConverter, specSvc),
-		bundleInstanceAuth: bundleinstanceauth.NewResolver(transact, bundleInstanceAuthSvc, bundleSvc, bundleInstanceAuthConv, bundleConverter),
-		scenarioAssignment: scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv, tenantSvc),
+		appNameNormalizer:     appNameNormalizer,
+		app:                   application.NewResolver(transact, appSvc, webhookSvc, oAuth20Svc, systemAuthSvc, appConverter, webhookConverter, systemAuthConverter, eventingSvc, bundleSvc, bundleConverter),
+		appTemplate:           apptemplate.NewResolver(transact, appSvc, appConverter, appTemplateSvc, appTemplateConverter, webhookSvc, webhookConverter),
+		api:                   api.NewResolver(transact, apiSvc, runtimeSvc, bundleSvc, bundleReferenceSvc, apiConverter, frConverter, specSvc, specConverter),
+		eventAPI:              eventdef.NewResolver(transact, eventAPISvc, bundleSvc, bundleReferenceSvc, eventAPIConverter, frConverter, specSvc, specConverter),
+		eventing:              eventing.NewResolver(transact, eventingSvc, appSvc),
+		doc:                   document.NewResolver(transact, docSvc, appSvc, bundleSvc, frConverter),
+		formation:             formation.NewResolver(transact, formationSvc, formationConv),
+		runtime:               runtime.NewResolver(transact, runtimeSvc, scenarioAssignmentSvc, systemAuthSvc, oAuth20Svc, runtimeConverter, systemAuthConverter, eventingSvc, bundleInstanceAuthSvc, selfRegisterManager, uidSvc, webhookSvc, webhookConverter, tenantSvc),
+		runtimeContext:        runtimectx.NewResolver(transact, runtimeCtxSvc, runtimeContextConverter),
+		healthCheck:           healthcheck.NewResolver(healthCheckSvc),
+		webhook:               webhook.NewResolver(transact, webhookSvc, appSvc, appTemplateSvc, runtimeSvc, webhookConverter),
+		labelDef:              labeldef.NewResolver(transact, labelDefSvc, labelDefConverter),
+		token:                 onetimetoken.NewTokenResolver(transact, tokenSvc, tokenConverter, oneTimeTokenCfg.SuggestTokenHeaderKey),
+		systemAuth:            systemauth.NewResolver(transact, systemAuthSvc, oAuth20Svc, systemAuthConverter),
+		oAuth20:               oauth20.NewResolver(transact, oAuth20Svc, appSvc, runtimeSvc, intSysSvc, systemAuthSvc, systemAuthConverter),
+		intSys:                integrationsystem.NewResolver(transact, intSysSvc, systemAuthSvc, oAuth20Svc, intSysConverter, systemAuthConverter),
+		viewer:                viewer.NewViewerResolver(),
+		tenant:                tenant.NewResolver(transact, tenantSvc, tenantConverter),
+		mpBundle:              bundleutil.NewResolver(transact, bundleSvc, bundleInstanceAuthSvc, bundleReferenceSvc, apiSvc, eventAPISvc, docSvc, bundleConverter, bundleInstanceAuthConv, apiConverter, eventAPIConverter, docConverter, specSvc),
+		bundleInstanceAuth:    bundleinstanceauth.NewResolver(transact, bundleInstanceAuthSvc, bundleSvc, bundleInstanceAuthConv, bundleConverter),
+		scenarioAssignment:    scenarioassignment.NewResolver(transact, scenarioAssignmentSvc, assignmentConv, tenantSvc),
+		ordAggregationStatus:  ordaggregationstatus.NewResolver(transact, ordAggregationStatusSvc, ordAggregationStatusConv),
+		ordAggregationRequest: ordaggregationrequest.NewResolver(transact, ordAggregationRequestSvc),
+		specRevision:          specrevision.NewResolver(transact, specRevisionSvc, specRevisionConv),
+		operation:             operation.NewResolver(transact, operationFetcher, operationTransitioner, operation.NewConverter()),
 	}
 }
 
@@ -261,6 +283,21 @@ func (r *RootResolver) FetchRequestDocumentDataloader(ids []dataloader.ParamFetc
 	return r.doc.FetchRequestDocumentDataLoader(ids)
 }
 
+// SpecRevisionAPIDefDataloader returns the revisions of the given API specifications
+func (r *RootResolver) SpecRevisionAPIDefDataloader(ids []dataloader.ParamSpecRevisionAPIDef) ([][]*graphql.SpecRevision, []error) {
+	return r.specRevision.SpecRevisionAPIDefDataLoader(ids)
+}
+
+// SpecRevisionEventDefDataloader returns the revisions of the given Event specifications
+func (r *RootResolver) SpecRevisionEventDefDataloader(ids []dataloader.ParamSpecRevisionEventDef) ([][]*graphql.SpecRevision, []error) {
+	return r.specRevision.SpecRevisionEventDefDataLoader(ids)
+}
+
+// FinalizeRuntimeDeletion deletes the runtime marked for deletion by an asynchronous unregisterRuntime operation which succeeded
+func (r *RootResolver) FinalizeRuntimeDeletion(ctx context.Context, id string) error {
+	return r.runtime.FinalizeDeletion(ctx, id)
+}
+
 // Mutation missing godoc
 func (r *RootResolver) Mutation() graphql.MutationResolver {
 	return &mutationResolver{r}
@@ -477,6 +514,26 @@ func (r *queryResolver) AutomaticScenarioAssignments(ctx context.Context, first
 	return r.scenarioAssignment.AutomaticScenarioAssignments(ctx, first, after)
 }
 
+// OrdAggregationStatuses returns the ORD aggregation statuses of the applications of the caller's tenant
+func (r *queryResolver) OrdAggregationStatuses(ctx context.Context, failedOnly *bool) ([]*graphql.ORDAggregationStatus, error) {
+	return r.ordAggregationStatus.ORDAggregationStatuses(ctx, failedOnly)
+}
+
+// SpecDiff returns the difference between two revisions of the same specification
+func (r *queryResolver) SpecDiff(ctx context.Context, from string, to string) (*graphql.SpecDiff, error) {
+	return r.specRevision.SpecDiff(ctx, from, to)
+}
+
+// Operations returns the asynchronous operations of the caller's tenant filtered by resource and state
+func (r *queryResolver) Operations(ctx context.Context, resourceID *string, resourceType *string, state *graphql.OperationState) ([]*graphql.Operation, error) {
+	return r.operation.Operations(ctx, resourceID, resourceType, state)
+}
+
+// Operation returns the asynchronous operation with the given ID
+func (r *queryResolver) Operation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.operation.Operation(ctx, id)
+}
+
 type mutationResolver struct {
 	*RootResolver
 }
@@ -498,7 +555,7 @@ func (r *mutationResolver) DeleteFormation(ctx context.Context, formation graphq
 }
 
 // RegisterApplication missing godoc
-func (r *mutationResolver) RegisterApplication(ctx context.Context, in graphql.ApplicationRegisterInput, _ *graphql.OperationMode) (*graphql.Application, error) {
+func (r *mutationResolver) RegisterApplication(ctx context.Context, in graphql.ApplicationRegisterInput, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.Application, error) {
 	return r.app.RegisterApplication(ctx, in)
 }
 
@@ -508,12 +565,12 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, id string, in
 }
 
 // UnregisterApplication missing godoc
-func (r *mutationResolver) UnregisterApplication(ctx context.Context, id string, _ *graphql.OperationMode) (*graphql.Application, error) {
+func (r *mutationResolver) UnregisterApplication(ctx context.Context, id string, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.Application, error) {
 	return r.app.UnregisterApplication(ctx, id)
 }
 
 // UnpairApplication removes system auths, hydra client credentials and performs the "unpair" flow in the database
-func (r *mutationResolver) UnpairApplication(ctx context.Context, id string, _ *graphql.OperationMode) (*graphql.Application, error) {
+func (r *mutationResolver) UnpairApplication(ctx context.Context, id string, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.Application, error) {
 	return r.app.UnpairApplication(ctx, id)
 }
 
@@ -538,8 +595,8 @@ func (r *mutationResolver) DeleteApplicationTemplate(ctx context.Context, id str
 }
 
 // AddWebhook missing godoc
-func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
-	return r.webhook.AddWebhook(ctx, applicationID, applicationTemplateID, in)
+func (r *mutationResolver) AddWebhook(ctx context.Context, applicationID *string, applicationTemplateID *string, runtimeID *string, in graphql.WebhookInput) (*graphql.Webhook, error) {
+	return r.webhook.AddWebhook(ctx, applicationID, applicationTemplateID, runtimeID, in)
 }
 
 // UpdateWebhook missing godoc
@@ -567,6 +624,11 @@ func (r *mutationResolver) RefetchAPISpec(ctx context.Context, apiID string) (*g
 	return r.api.RefetchAPISpec(ctx, apiID)
 }
 
+// ResyncOpenResourceDiscovery enqueues an on-demand ORD aggregation of the given application
+func (r *mutationResolver) ResyncOpenResourceDiscovery(ctx context.Context, applicationID string) (bool, error) {
+	return r.ordAggregationRequest.ResyncOpenResourceDiscovery(ctx, applicationID)
+}
+
 // UpdateEventDefinition missing godoc
 func (r *mutationResolver) UpdateEventDefinition(ctx context.Context, id string, in graphql.EventDefinitionInput) (*graphql.EventDefinition, error) {
 	return r.eventAPI.UpdateEventDefinition(ctx, id, in)
@@ -583,7 +645,7 @@ func (r *mutationResolver) RefetchEventDefinitionSpec(ctx context.Context, event
 }
 
 // RegisterRuntime missing godoc
-func (r *mutationResolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput) (*graphql.Runtime, error) {
+func (r *mutationResolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.Runtime, error) {
 	return r.runtime.RegisterRuntime(ctx, in)
 }
 
@@ -593,7 +655,7 @@ func (r *mutationResolver) UpdateRuntime(ctx context.Context, id string, in grap
 }
 
 // UnregisterRuntime missing godoc
-func (r *mutationResolver) UnregisterRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
+func (r *mutationResolver) UnregisterRuntime(ctx context.Context, id string, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.Runtime, error) {
 	return r.runtime.DeleteRuntime(ctx, id)
 }
 
@@ -746,12 +808,12 @@ func (r *mutationResolver) DeleteBundleInstanceAuth(ctx context.Context, authID
 }
 
 // RequestBundleInstanceAuthCreation missing godoc
-func (r *mutationResolver) RequestBundleInstanceAuthCreation(ctx context.Context, bundleID string, in graphql.BundleInstanceAuthRequestInput) (*graphql.BundleInstanceAuth, error) {
+func (r *mutationResolver) RequestBundleInstanceAuthCreation(ctx context.Context, bundleID string, in graphql.BundleInstanceAuthRequestInput, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.BundleInstanceAuth, error) {
 	return r.bundleInstanceAuth.RequestBundleInstanceAuthCreation(ctx, bundleID, in)
 }
 
 // RequestBundleInstanceAuthDeletion missing godoc
-func (r *mutationResolver) RequestBundleInstanceAuthDeletion(ctx context.Context, authID string) (*graphql.BundleInstanceAuth, error) {
+func (r *mutationResolver) RequestBundleInstanceAuthDeletion(ctx context.Context, authID string, _ *graphql.OperationMode, _ *graphql.WebhookExecutionPolicy) (*graphql.BundleInstanceAuth, error) {
 	return r.bundleInstanceAuth.RequestBundleInstanceAuthDeletion(ctx, authID)
 }
 
@@ -800,6 +862,16 @@ func (r *mutationResolver) UpdateTenant(ctx context.Context, id string, in graph
 	return r.tenant.Update(ctx, id, in)
 }
 
+// CancelOperation cancels the asynchronous operation with the given ID
+func (r *mutationResolver) CancelOperation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.operation.CancelOperation(ctx, id)
+}
+
+// RetryOperation retries the failed asynchronous operation with the given ID
+func (r *mutationResolver) RetryOperation(ctx context.Context, id string) (*graphql.Operation, error) {
+	return r.operation.RetryOperation(ctx, id)
+}
+
 type applicationResolver struct {
 	*RootResolver
 }
@@ -834,6 +906,11 @@ func (r *applicationResolver) Bundle(ctx context.Context, obj *graphql.Applicati
 	return r.app.Bundle(ctx, obj, id)
 }
 
+// OrdAggregationStatus returns the most recent ORD aggregation status of the application
+func (r *applicationResolver) OrdAggregationStatus(ctx context.Context, obj *graphql.Application) (*graphql.ORDAggregationStatus, error) {
+	return r.ordAggregationStatus.ApplicationORDAggregationStatus(ctx, obj)
+}
+
 type applicationTemplateResolver struct {
 	*RootResolver
 }
@@ -857,6 +934,11 @@ func (r *runtimeResolver) Auths(ctx context.Context, obj *graphql.Runtime) ([]*g
 	return r.runtime.Auths(ctx, obj)
 }
 
+// Webhooks returns the webhooks of the given runtime.
+func (r *runtimeResolver) Webhooks(ctx context.Context, obj *graphql.Runtime) ([]*graphql.Webhook, error) {
+	return r.runtime.Webhooks(ctx, obj)
+}
+
 // EventingConfiguration missing godoc
 func (r *runtimeResolver) EventingConfiguration(ctx context.Context, obj *graphql.Runtime) (*graphql.RuntimeEventingConfiguration, error) {
 	return r.runtime.EventingConfiguration(ctx, obj)
@@ -869,6 +951,16 @@ func (r *apiSpecResolver) FetchRequest(ctx context.Context, obj *graphql.APISpec
 	return r.api.FetchRequest(ctx, obj)
 }
 
+// Data returns the data of the API Spec in the requested format.
+func (r *apiSpecResolver) Data(ctx context.Context, obj *graphql.APISpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	return r.api.Data(ctx, obj, format)
+}
+
+// Revisions returns the revisions of the API Spec, the most recent first.
+func (r *apiSpecResolver) Revisions(ctx context.Context, obj *graphql.APISpec) ([]*graphql.SpecRevision, error) {
+	return r.specRevision.APISpecRevisions(ctx, obj)
+}
+
 type documentResolver struct{ *RootResolver }
 
 // FetchRequest missing godoc
@@ -883,6 +975,16 @@ func (r *eventSpecResolver) FetchRequest(ctx context.Context, obj *graphql.Event
 	return r.eventAPI.FetchRequest(ctx, obj)
 }
 
+// Data returns the data of the Event Spec in the requested format.
+func (r *eventSpecResolver) Data(ctx context.Context, obj *graphql.EventSpec, format *graphql.SpecFormat) (*graphql.CLOB, error) {
+	return r.eventAPI.Data(ctx, obj, format)
+}
+
+// Revisions returns the revisions of the Event Spec, the most recent first.
+func (r *eventSpecResolver) Revisions(ctx context.Context, obj *graphql.EventSpec) ([]*graphql.SpecRevision, error) {
+	return r.specRevision.EventSpecRevisions(ctx, obj)
+}
+
 type integrationSystemResolver struct{ *RootResolver }
 
 // Auths missing godoc
@@ -44,6 +44,20 @@ func (_m *RuntimeRepository) Delete(ctx context.Context, tenant string, id strin
 	return r0
 }
 
+// DeleteGlobal provides a mock function with given fields: ctx, id
+func (_m *RuntimeRepository) DeleteGlobal(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exists provides a mock function with given fields: ctx, tenant, id
 func (_m *RuntimeRepository) Exists(ctx context.Context, tenant string, id string) (bool, error) {
 	ret := _m.Called(ctx, tenant, id)
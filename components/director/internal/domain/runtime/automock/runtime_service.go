@@ -1,4 +1,4 @@
-// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+// Code generated by mockery v2.9.4. DO NOT EDIT.
 
 package automock
 
@@ -65,6 +65,20 @@ func (_m *RuntimeService) Delete(ctx context.Context, id string) error {
 	return r0
 }
 
+// DeleteGlobal provides a mock function with given fields: ctx, id
+func (_m *RuntimeService) DeleteGlobal(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteLabel provides a mock function with given fields: ctx, runtimeID, key
 func (_m *RuntimeService) DeleteLabel(ctx context.Context, runtimeID string, key string) error {
 	ret := _m.Called(ctx, runtimeID, key)
@@ -125,6 +139,29 @@ func (_m *RuntimeService) GetLabel(ctx context.Context, runtimeID string, key st
 	return r0, r1
 }
 
+// GetLabelGlobal provides a mock function with given fields: ctx, runtimeID, key
+func (_m *RuntimeService) GetLabelGlobal(ctx context.Context, runtimeID string, key string) (*model.Label, error) {
+	ret := _m.Called(ctx, runtimeID, key)
+
+	var r0 *model.Label
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Label); ok {
+		r0 = rf(ctx, runtimeID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Label)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, runtimeID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // List provides a mock function with given fields: ctx, filter, pageSize, cursor
 func (_m *RuntimeService) List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error) {
 	ret := _m.Called(ctx, filter, pageSize, cursor)
@@ -1,4 +1,4 @@
-// Code generated by mockery v0.0.0-dev. DO NOT EDIT.
+// Code generated by mockery v2.9.4. DO NOT EDIT.
 
 package automock
 
@@ -36,3 +36,26 @@ func (_m *SystemAuthService) ListForObject(ctx context.Context, objectType model
 
 	return r0, r1
 }
+
+// ListForObjectGlobal provides a mock function with given fields: ctx, objectType, objectID
+func (_m *SystemAuthService) ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
+	ret := _m.Called(ctx, objectType, objectID)
+
+	var r0 []model.SystemAuth
+	if rf, ok := ret.Get(0).(func(context.Context, model.SystemAuthReferenceObjectType, string) []model.SystemAuth); ok {
+		r0 = rf(ctx, objectType, objectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.SystemAuth)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SystemAuthReferenceObjectType, string) error); ok {
+		r1 = rf(ctx, objectType, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
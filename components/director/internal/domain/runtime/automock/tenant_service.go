@@ -7,6 +7,8 @@ import (
 
 	model "github.com/kyma-incubator/compass/components/director/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	resource "github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 // TenantService is an autogenerated mock type for the tenantService type
@@ -35,6 +37,27 @@ func (_m *TenantService) CreateManyIfNotExists(ctx context.Context, tenantInputs
 	return r0
 }
 
+// GetLowestOwnerForResource provides a mock function with given fields: ctx, resourceType, objectID
+func (_m *TenantService) GetLowestOwnerForResource(ctx context.Context, resourceType resource.Type, objectID string) (string, error) {
+	ret := _m.Called(ctx, resourceType, objectID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Type, string) string); ok {
+		r0 = rf(ctx, resourceType, objectID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Type, string) error); ok {
+		r1 = rf(ctx, resourceType, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTenantByExternalID provides a mock function with given fields: ctx, id
 func (_m *TenantService) GetTenantByExternalID(ctx context.Context, id string) (*model.BusinessTenantMapping, error) {
 	ret := _m.Called(ctx, id)
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
@@ -29,6 +30,9 @@ func (c *converter) ToGraphQL(in *model.Runtime) *graphql.Runtime {
 		Name:        in.Name,
 		Description: in.Description,
 		Metadata:    c.metadataToGraphQL(in.CreationTimestamp),
+		UpdatedAt:   timePtrToTimestampPtr(in.UpdatedAt),
+		DeletedAt:   timePtrToTimestampPtr(in.DeletedAt),
+		Error:       in.Error,
 	}
 }
 
@@ -139,6 +143,10 @@ func (*converter) ToEntity(model *model.Runtime) (*Runtime, error) {
 		StatusCondition:   string(model.Status.Condition),
 		StatusTimestamp:   model.Status.Timestamp,
 		CreationTimestamp: model.CreationTimestamp,
+		Ready:             model.Ready,
+		UpdatedAt:         model.UpdatedAt,
+		DeletedAt:         model.DeletedAt,
+		Error:             repo.NewNullableString(model.Error),
 	}, nil
 }
 
@@ -162,5 +170,18 @@ func (*converter) FromEntity(e *Runtime) *model.Runtime {
 			Timestamp: e.StatusTimestamp,
 		},
 		CreationTimestamp: e.CreationTimestamp,
+		Ready:             e.Ready,
+		UpdatedAt:         e.UpdatedAt,
+		DeletedAt:         e.DeletedAt,
+		Error:             repo.StringPtrFromNullableString(e.Error),
 	}
 }
+
+func timePtrToTimestampPtr(time *time.Time) *graphql.Timestamp {
+	if time == nil {
+		return nil
+	}
+
+	t := graphql.Timestamp(*time)
+	return &t
+}
@@ -244,4 +244,8 @@ func assertRuntimeDefinition(t *testing.T, runtimeModel *model.Runtime, entity *
 	}
 
 	testdb.AssertSQLNullStringEqualTo(t, entity.Description, runtimeModel.Description)
+	testdb.AssertSQLNullStringEqualTo(t, entity.Error, runtimeModel.Error)
+	assert.Equal(t, runtimeModel.Ready, entity.Ready)
+	assert.Equal(t, runtimeModel.UpdatedAt, entity.UpdatedAt)
+	assert.Equal(t, runtimeModel.DeletedAt, entity.DeletedAt)
 }
@@ -13,6 +13,10 @@ type Runtime struct {
 	StatusCondition   string         `db:"status_condition"`
 	StatusTimestamp   time.Time      `db:"status_timestamp"`
 	CreationTimestamp time.Time      `db:"creation_timestamp"`
+	Ready             bool           `db:"ready"`
+	UpdatedAt         *time.Time     `db:"updated_at"`
+	DeletedAt         *time.Time     `db:"deleted_at"`
+	Error             sql.NullString `db:"error"`
 }
 
 // GetID returns ID of the runtime
@@ -20,6 +24,62 @@ func (e *Runtime) GetID() string {
 	return e.ID
 }
 
+// GetReady returns the ready value of the runtime
+func (e *Runtime) GetReady() bool {
+	return e.Ready
+}
+
+// SetReady sets the ready value of the runtime
+func (e *Runtime) SetReady(ready bool) {
+	e.Ready = ready
+}
+
+// GetCreatedAt returns the creation timestamp of the runtime
+func (e *Runtime) GetCreatedAt() time.Time {
+	return e.CreationTimestamp
+}
+
+// SetCreatedAt sets the creation timestamp of the runtime
+func (e *Runtime) SetCreatedAt(t time.Time) {
+	e.CreationTimestamp = t
+}
+
+// GetUpdatedAt returns the updated_at value of the runtime
+func (e *Runtime) GetUpdatedAt() time.Time {
+	if e.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *e.UpdatedAt
+}
+
+// SetUpdatedAt sets the updated_at value of the runtime
+func (e *Runtime) SetUpdatedAt(t time.Time) {
+	e.UpdatedAt = &t
+}
+
+// GetDeletedAt returns the deleted_at value of the runtime
+func (e *Runtime) GetDeletedAt() time.Time {
+	if e.DeletedAt == nil {
+		return time.Time{}
+	}
+	return *e.DeletedAt
+}
+
+// SetDeletedAt sets the deleted_at value of the runtime
+func (e *Runtime) SetDeletedAt(t time.Time) {
+	e.DeletedAt = &t
+}
+
+// GetError returns the error value of the runtime
+func (e *Runtime) GetError() sql.NullString {
+	return e.Error
+}
+
+// SetError sets the error value of the runtime
+func (e *Runtime) SetError(err sql.NullString) {
+	e.Error = err
+}
+
 // DecorateWithTenantID decorates the entity with the given tenant ID.
 func (e *Runtime) DecorateWithTenantID(tenant string) interface{} {
 	return struct {
@@ -19,7 +19,7 @@ const (
 	runtimeID = "runtimeID"
 )
 
-var fixColumns = []string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}
+var fixColumns = []string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp", "ready", "updated_at", "deleted_at", "error"}
 
 func fixRuntimePage(runtimes []*model.Runtime) *model.RuntimePage {
 	return &model.RuntimePage{
@@ -90,6 +90,7 @@ func fixDetailedModelRuntime(t *testing.T, id, name, description string) *model.
 		Name:              name,
 		Description:       &description,
 		CreationTimestamp: time,
+		Ready:             true,
 	}
 }
 
@@ -104,6 +105,7 @@ func fixDetailedEntityRuntime(t *testing.T, id, name, description string) *runti
 		Name:              name,
 		Description:       repo.NewValidNullableString(description),
 		CreationTimestamp: time,
+		Ready:             true,
 	}
 }
 
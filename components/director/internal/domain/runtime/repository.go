@@ -2,6 +2,10 @@ package runtime
 
 import (
 	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
@@ -18,10 +22,13 @@ import (
 const runtimeTable string = `public.runtimes`
 
 var (
-	runtimeColumns = []string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}
+	runtimeColumns   = []string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp", "ready", "updated_at", "deleted_at", "error"}
+	updatableColumns = []string{"name", "description", "status_condition", "status_timestamp"}
+	technicalColumns = []string{"status_condition", "status_timestamp", "ready", "updated_at", "deleted_at", "error"}
 )
 
 // EntityConverter missing godoc
+//
 //go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore
 type EntityConverter interface {
 	ToEntity(in *model.Runtime) (*Runtime, error)
@@ -33,11 +40,13 @@ type pgRepository struct {
 	singleGetter       repo.SingleGetter
 	singleGetterGlobal repo.SingleGetterGlobal
 	deleter            repo.Deleter
+	deleterGlobal      repo.DeleterGlobal
 	pageableQuerier    repo.PageableQuerier
 	lister             repo.Lister
 	listerGlobal       repo.ListerGlobal
 	creator            repo.Creator
 	updater            repo.Updater
+	updaterGlobal      repo.UpdaterGlobal
 	conv               EntityConverter
 }
 
@@ -48,11 +57,13 @@ func NewRepository(conv EntityConverter) *pgRepository {
 		singleGetter:       repo.NewSingleGetter(runtimeTable, runtimeColumns),
 		singleGetterGlobal: repo.NewSingleGetterGlobal(resource.Runtime, runtimeTable, runtimeColumns),
 		deleter:            repo.NewDeleter(runtimeTable),
+		deleterGlobal:      repo.NewDeleterGlobal(resource.Runtime, runtimeTable),
 		pageableQuerier:    repo.NewPageableQuerier(runtimeTable, runtimeColumns),
 		lister:             repo.NewLister(runtimeTable, runtimeColumns),
 		listerGlobal:       repo.NewListerGlobal(resource.Runtime, runtimeTable, runtimeColumns),
 		creator:            repo.NewCreator(runtimeTable, runtimeColumns),
-		updater:            repo.NewUpdater(runtimeTable, []string{"name", "description", "status_condition", "status_timestamp"}, []string{"id"}),
+		updater:            repo.NewUpdater(runtimeTable, updatableColumns, []string{"id"}),
+		updaterGlobal:      repo.NewUpdaterGlobal(resource.Runtime, runtimeTable, technicalColumns, []string{"id"}),
 		conv:               conv,
 	}
 }
@@ -62,11 +73,34 @@ func (r *pgRepository) Exists(ctx context.Context, tenant, id string) (bool, err
 	return r.existQuerier.Exists(ctx, resource.Runtime, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
-// Delete missing godoc
+// Delete deletes the runtime with the given ID. In async mode the runtime is only marked for deletion.
 func (r *pgRepository) Delete(ctx context.Context, tenant string, id string) error {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		runtime, err := r.GetByID(ctx, tenant, id)
+		if err != nil {
+			return err
+		}
+
+		return r.markAsDeleted(ctx, runtime)
+	}
+
 	return r.deleter.DeleteOne(ctx, resource.Runtime, tenant, repo.Conditions{repo.NewEqualCondition("id", id)})
 }
 
+// DeleteGlobal deletes the runtime with the given ID regardless of the tenant. In async mode the runtime is only marked for deletion.
+func (r *pgRepository) DeleteGlobal(ctx context.Context, id string) error {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		runtime, err := r.GetGlobalByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return r.markAsDeleted(ctx, runtime)
+	}
+
+	return r.deleterGlobal.DeleteOneGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)})
+}
+
 // GetByID missing godoc
 func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.Runtime, error) {
 	var runtimeEnt Runtime
@@ -106,6 +140,16 @@ func (r *pgRepository) GetByFiltersAndID(ctx context.Context, tenant, id string,
 	return runtimeModel, nil
 }
 
+// GetGlobalByID returns the runtime with the given ID regardless of the tenant.
+func (r *pgRepository) GetGlobalByID(ctx context.Context, id string) (*model.Runtime, error) {
+	var runtimeEnt Runtime
+	if err := r.singleGetterGlobal.GetGlobal(ctx, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &runtimeEnt); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&runtimeEnt), nil
+}
+
 // GetByFiltersGlobal missing godoc
 func (r *pgRepository) GetByFiltersGlobal(ctx context.Context, filter []*labelfilter.LabelFilter) (*model.Runtime, error) {
 	filterSubquery, args, err := label.FilterQueryGlobal(model.RuntimeLabelableObject, label.IntersectSet, filter)
@@ -225,6 +269,8 @@ func (r *pgRepository) Create(ctx context.Context, tenant string, item *model.Ru
 		return errors.Wrap(err, "while creating runtime entity from model")
 	}
 
+	runtimeEnt.Ready = operation.ModeFromCtx(ctx) != graphql.OperationModeAsync
+
 	return r.creator.Create(ctx, resource.Runtime, tenant, runtimeEnt)
 }
 
@@ -240,6 +286,28 @@ func (r *pgRepository) Update(ctx context.Context, tenant string, item *model.Ru
 	return r.updater.UpdateSingle(ctx, resource.Runtime, tenant, runtimeEnt)
 }
 
+// TechnicalUpdate updates the status and the operation state of the runtime regardless of the tenant.
+func (r *pgRepository) TechnicalUpdate(ctx context.Context, item *model.Runtime) error {
+	if item == nil {
+		return apperrors.NewInternalError("item cannot be nil")
+	}
+	runtimeEnt, err := r.conv.ToEntity(item)
+	if err != nil {
+		return errors.Wrap(err, "while creating runtime entity from model")
+	}
+	return r.updaterGlobal.TechnicalUpdate(ctx, runtimeEnt)
+}
+
+func (r *pgRepository) markAsDeleted(ctx context.Context, runtime *model.Runtime) error {
+	runtime.SetReady(false)
+	runtime.SetError("")
+	if runtime.GetDeletedAt().IsZero() {
+		runtime.SetDeletedAt(time.Now())
+	}
+
+	return r.TechnicalUpdate(ctx, runtime)
+}
+
 // GetOldestForFilters missing godoc
 func (r *pgRepository) GetOldestForFilters(ctx context.Context, tenant string, filter []*labelfilter.LabelFilter) (*model.Runtime, error) {
 	tenantID, err := uuid.Parse(tenant)
@@ -5,8 +5,11 @@ import (
 	"database/sql/driver"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
@@ -28,11 +31,11 @@ func TestPgRepository_GetByID(t *testing.T) {
 		Name: "Get Runtime By ID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:    regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp FROM public.runtimes WHERE id = $1 AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $2))`),
+				Query:    regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes WHERE id = $1 AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $2))`),
 				Args:     []driver.Value{runtimeID, tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
@@ -60,13 +63,13 @@ func TestPgRepository_GetByFiltersAndID(t *testing.T) {
 		Name: "Get Runtime By Filters and ID",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp FROM public.runtimes WHERE id = $1 
+				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes WHERE id = $1 
 												AND id IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_labels_tenants WHERE tenant_id = $2)) AND "key" = $3 AND "value" ?| array[$4]) 
 												AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $5))`),
 				Args:     []driver.Value{runtimeID, tenantID, model.ScenariosKey, "scenario", tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
@@ -98,8 +101,8 @@ func TestPgRepository_GetByFiltersGlobal_ShouldReturnRuntimeModelForRuntimeEntit
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
-		AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp)
+	rows := sqlmock.NewRows(fixColumns).
+		AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)
 
 	sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE id IN \(SELECT "runtime_id" FROM public\.labels WHERE "runtime_id" IS NOT NULL AND "key" = \$1\)$`).
 		WithArgs("someKey").
@@ -127,13 +130,13 @@ func TestPgRepository_GetOldestForFilters(t *testing.T) {
 		Name: "Get Oldest Runtime By Filters",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp FROM public.runtimes WHERE  
+				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes WHERE  
 												id IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_labels_tenants WHERE tenant_id = $1)) AND "key" = $2 AND "value" ?| array[$3]) 
 												AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $4)) ORDER BY creation_timestamp ASC`),
 				Args:     []driver.Value{tenantID, model.ScenariosKey, "scenario", tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
-					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp)}
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
@@ -171,9 +174,9 @@ func TestPgRepository_ListByFiltersGlobal(t *testing.T) {
 	sqlxDB, sqlMock := testdb.MockDatabase(t)
 	defer sqlMock.AssertExpectations(t)
 
-	rows := sqlmock.NewRows([]string{"id", "name", "description", "status_condition", "status_timestamp", "creation_timestamp"}).
-		AddRow(runtime1ID, runtimeModel1.Name, runtimeModel1.Description, runtimeModel1.Status.Condition, runtimeModel1.CreationTimestamp, runtimeModel1.CreationTimestamp).
-		AddRow(runtime2ID, runtimeModel2.Name, runtimeModel2.Description, runtimeModel2.Status.Condition, runtimeModel2.CreationTimestamp, runtimeModel2.CreationTimestamp)
+	rows := sqlmock.NewRows(fixColumns).
+		AddRow(runtime1ID, runtimeModel1.Name, runtimeModel1.Description, runtimeModel1.Status.Condition, runtimeModel1.CreationTimestamp, runtimeModel1.CreationTimestamp, runtimeEntity1.Ready, runtimeEntity1.UpdatedAt, runtimeEntity1.DeletedAt, runtimeEntity1.Error).
+		AddRow(runtime2ID, runtimeModel2.Name, runtimeModel2.Description, runtimeModel2.Status.Condition, runtimeModel2.CreationTimestamp, runtimeModel2.CreationTimestamp, runtimeEntity2.Ready, runtimeEntity2.UpdatedAt, runtimeEntity2.DeletedAt, runtimeEntity2.Error)
 
 	sqlMock.ExpectQuery(`^SELECT (.+) FROM public.runtimes WHERE id IN \(SELECT "runtime_id" FROM public\.labels WHERE "runtime_id" IS NOT NULL AND "key" = \$1 AND "value" \@\> \$2\ INTERSECT SELECT "runtime_id" FROM public\.labels WHERE "runtime_id" IS NOT NULL AND "key" = \$3 AND "value" \@\> \$4\)$`).
 		WithArgs("someKey", "someValue", "someKey2", "someValue2").
@@ -219,15 +222,15 @@ func TestPgRepository_List(t *testing.T) {
 		Name: "List Runtimes",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp FROM public.runtimes
+				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes
 												WHERE (id IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_labels_tenants WHERE tenant_id = $1)) AND "key" = $2 AND "value" ?| array[$3])
 												AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $4))) ORDER BY name LIMIT 2 OFFSET 0`),
 				Args:     []driver.Value{tenantID, model.ScenariosKey, "scenario", tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).
-						AddRow(runtimeEntity1.ID, runtimeEntity1.Name, runtimeEntity1.Description, runtimeEntity1.StatusCondition, runtimeEntity1.StatusTimestamp, runtimeEntity1.CreationTimestamp).
-						AddRow(runtimeEntity2.ID, runtimeEntity2.Name, runtimeEntity2.Description, runtimeEntity2.StatusCondition, runtimeEntity2.StatusTimestamp, runtimeEntity2.CreationTimestamp),
+						AddRow(runtimeEntity1.ID, runtimeEntity1.Name, runtimeEntity1.Description, runtimeEntity1.StatusCondition, runtimeEntity1.StatusTimestamp, runtimeEntity1.CreationTimestamp, runtimeEntity1.Ready, runtimeEntity1.UpdatedAt, runtimeEntity1.DeletedAt, runtimeEntity1.Error).
+						AddRow(runtimeEntity2.ID, runtimeEntity2.Name, runtimeEntity2.Description, runtimeEntity2.StatusCondition, runtimeEntity2.StatusTimestamp, runtimeEntity2.CreationTimestamp, runtimeEntity2.Ready, runtimeEntity2.UpdatedAt, runtimeEntity2.DeletedAt, runtimeEntity2.Error),
 					}
 				},
 			},
@@ -282,15 +285,15 @@ func TestPgRepository_ListAll(t *testing.T) {
 		Name: "List Runtimes Without Paging",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp FROM public.runtimes 
+				Query: regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes 
 												WHERE id IN (SELECT "runtime_id" FROM public.labels WHERE "runtime_id" IS NOT NULL AND (id IN (SELECT id FROM runtime_labels_tenants WHERE tenant_id = $1)) AND "key" = $2 AND "value" ?| array[$3])
 												AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $4))`),
 				Args:     []driver.Value{tenantID, model.ScenariosKey, "scenario", tenantID},
 				IsSelect: true,
 				ValidRowsProvider: func() []*sqlmock.Rows {
 					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).
-						AddRow(runtimeEntity1.ID, runtimeEntity1.Name, runtimeEntity1.Description, runtimeEntity1.StatusCondition, runtimeEntity1.StatusTimestamp, runtimeEntity1.CreationTimestamp).
-						AddRow(runtimeEntity2.ID, runtimeEntity2.Name, runtimeEntity2.Description, runtimeEntity2.StatusCondition, runtimeEntity2.StatusTimestamp, runtimeEntity2.CreationTimestamp),
+						AddRow(runtimeEntity1.ID, runtimeEntity1.Name, runtimeEntity1.Description, runtimeEntity1.StatusCondition, runtimeEntity1.StatusTimestamp, runtimeEntity1.CreationTimestamp, runtimeEntity1.Ready, runtimeEntity1.UpdatedAt, runtimeEntity1.DeletedAt, runtimeEntity1.Error).
+						AddRow(runtimeEntity2.ID, runtimeEntity2.Name, runtimeEntity2.Description, runtimeEntity2.StatusCondition, runtimeEntity2.StatusTimestamp, runtimeEntity2.CreationTimestamp, runtimeEntity2.Ready, runtimeEntity2.UpdatedAt, runtimeEntity2.DeletedAt, runtimeEntity2.Error),
 					}
 				},
 				InvalidRowsProvider: func() []*sqlmock.Rows {
@@ -321,8 +324,8 @@ func TestPgRepository_Create(t *testing.T) {
 		Name: "Generic Create Runtime",
 		SQLQueryDetails: []testdb.SQLQueryDetails{
 			{
-				Query:       regexp.QuoteMeta(`INSERT INTO public.runtimes ( id, name, description, status_condition, status_timestamp, creation_timestamp ) VALUES ( ?, ?, ?, ?, ?, ? )`),
-				Args:        []driver.Value{rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp},
+				Query:       regexp.QuoteMeta(`INSERT INTO public.runtimes ( id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )`),
+				Args:        []driver.Value{rtModel.ID, rtModel.Name, rtModel.Description, rtModel.Status.Condition, rtModel.Status.Timestamp, rtModel.CreationTimestamp, rtModel.Ready, rtModel.UpdatedAt, rtModel.DeletedAt, rtModel.Error},
 				ValidResult: sqlmock.NewResult(-1, 1),
 			},
 			{
@@ -392,6 +395,82 @@ func TestPgRepository_Delete(t *testing.T) {
 	}
 
 	suite.Run(t)
+
+	t.Run("Success when operation mode is set to async", func(t *testing.T) {
+		ctx := operation.SaveModeToContext(context.Background(), graphql.OperationModeAsync)
+
+		rtModel := fixDetailedModelRuntime(t, runtimeID, "Foo", "Lorem ipsum")
+		rtEntity := fixDetailedEntityRuntime(t, runtimeID, "Foo", "Lorem ipsum")
+
+		db, dbMock := testdb.MockDatabase(t)
+		defer dbMock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows(fixColumns).
+			AddRow(rtEntity.ID, rtEntity.Name, rtEntity.Description, rtEntity.StatusCondition, rtEntity.StatusTimestamp, rtEntity.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)
+
+		dbMock.ExpectQuery(regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes WHERE id = $1 AND (id IN (SELECT id FROM tenant_runtimes WHERE tenant_id = $2))`)).
+			WithArgs(runtimeID, tenantID).
+			WillReturnRows(rows)
+
+		deletedAt := time.Now()
+		deletedEntity := fixDetailedEntityRuntime(t, runtimeID, "Foo", "Lorem ipsum")
+		deletedEntity.Ready = false
+		deletedEntity.DeletedAt = &deletedAt
+
+		mockConverter := &automock.EntityConverter{}
+		mockConverter.On("FromEntity", rtEntity).Return(rtModel).Once()
+		mockConverter.On("ToEntity", rtModel).Return(deletedEntity, nil).Once()
+		defer mockConverter.AssertExpectations(t)
+
+		dbMock.ExpectExec(regexp.QuoteMeta(`UPDATE public.runtimes SET status_condition = ?, status_timestamp = ?, ready = ?, updated_at = ?, deleted_at = ?, error = ? WHERE id = ?`)).
+			WithArgs(deletedEntity.StatusCondition, deletedEntity.StatusTimestamp, deletedEntity.Ready, deletedEntity.UpdatedAt, deletedEntity.DeletedAt, deletedEntity.Error, runtimeID).
+			WillReturnResult(sqlmock.NewResult(-1, 1))
+
+		ctx = persistence.SaveToContext(ctx, db)
+
+		pgRepository := runtime.NewRepository(mockConverter)
+
+		// WHEN
+		err := pgRepository.Delete(ctx, tenantID, runtimeID)
+
+		// THEN
+		require.NoError(t, err)
+		require.False(t, rtModel.Ready)
+		require.NotNil(t, rtModel.DeletedAt)
+	})
+}
+
+func TestPgRepository_GetGlobalByID(t *testing.T) {
+	rtModel := fixDetailedModelRuntime(t, runtimeID, "Foo", "Lorem ipsum")
+	rtEntity := fixDetailedEntityRuntime(t, runtimeID, "Foo", "Lorem ipsum")
+
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Global Runtime By ID",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, name, description, status_condition, status_timestamp, creation_timestamp, ready, updated_at, deleted_at, error FROM public.runtimes WHERE id = $1`),
+				Args:     []driver.Value{runtimeID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns).AddRow(rtEntity.ID, rtEntity.Name, rtEntity.Description, rtEntity.StatusCondition, rtEntity.StatusTimestamp, rtEntity.CreationTimestamp, rtEntity.Ready, rtEntity.UpdatedAt, rtEntity.DeletedAt, rtEntity.Error)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixColumns)}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       runtime.NewRepository,
+		ExpectedModelEntity:       rtModel,
+		ExpectedDBEntity:          rtEntity,
+		MethodArgs:                []interface{}{runtimeID},
+		MethodName:                "GetGlobalByID",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
 }
 
 func TestPgRepository_Exist(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 
 	labelPkg "github.com/kyma-incubator/compass/components/director/internal/domain/label"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/inputvalidation"
@@ -26,6 +27,8 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
 // EventingService missing godoc
@@ -48,9 +51,11 @@ type RuntimeService interface {
 	Update(ctx context.Context, id string, in model.RuntimeInput) error
 	Get(ctx context.Context, id string) (*model.Runtime, error)
 	Delete(ctx context.Context, id string) error
+	DeleteGlobal(ctx context.Context, id string) error
 	List(ctx context.Context, filter []*labelfilter.LabelFilter, pageSize int, cursor string) (*model.RuntimePage, error)
 	SetLabel(ctx context.Context, label *model.LabelInput) error
 	GetLabel(ctx context.Context, runtimeID string, key string) (*model.Label, error)
+	GetLabelGlobal(ctx context.Context, runtimeID string, key string) (*model.Label, error)
 	ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error)
 	DeleteLabel(ctx context.Context, runtimeID string, key string) error
 }
@@ -80,6 +85,7 @@ type SystemAuthConverter interface {
 //go:generate mockery --name=SystemAuthService --output=automock --outpkg=automock --case=underscore
 type SystemAuthService interface {
 	ListForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
+	ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error)
 }
 
 // BundleInstanceAuthService missing godoc
@@ -97,6 +103,18 @@ type SelfRegisterManager interface {
 	GetSelfRegDistinguishingLabelKey() string
 }
 
+// WebhookService is responsible for the service-layer Webhook operations.
+//go:generate mockery --name=WebhookService --output=automock --outpkg=automock --case=underscore
+type WebhookService interface {
+	ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error)
+}
+
+// WebhookConverter converts between the graphql and model webhooks.
+//go:generate mockery --name=WebhookConverter --output=automock --outpkg=automock --case=underscore
+type WebhookConverter interface {
+	MultipleToGraphQL(in []*model.Webhook) ([]*graphql.Webhook, error)
+}
+
 // Resolver missing godoc
 type Resolver struct {
 	transact                  persistence.Transactioner
@@ -110,10 +128,13 @@ type Resolver struct {
 	bundleInstanceAuthSvc     BundleInstanceAuthService
 	selfRegManager            SelfRegisterManager
 	uidService                uidService
+	webhookSvc                WebhookService
+	webhookConverter          WebhookConverter
+	tenantSvc                 tenantService
 }
 
 // NewResolver missing godoc
-func NewResolver(transact persistence.Transactioner, runtimeService RuntimeService, scenarioAssignmentService ScenarioAssignmentService, sysAuthSvc SystemAuthService, oAuthSvc OAuth20Service, conv RuntimeConverter, sysAuthConv SystemAuthConverter, eventingSvc EventingService, bundleInstanceAuthSvc BundleInstanceAuthService, selfRegManager SelfRegisterManager, uidService uidService) *Resolver {
+func NewResolver(transact persistence.Transactioner, runtimeService RuntimeService, scenarioAssignmentService ScenarioAssignmentService, sysAuthSvc SystemAuthService, oAuthSvc OAuth20Service, conv RuntimeConverter, sysAuthConv SystemAuthConverter, eventingSvc EventingService, bundleInstanceAuthSvc BundleInstanceAuthService, selfRegManager SelfRegisterManager, uidService uidService, webhookSvc WebhookService, webhookConverter WebhookConverter, tenantSvc tenantService) *Resolver {
 	return &Resolver{
 		transact:                  transact,
 		runtimeService:            runtimeService,
@@ -126,6 +147,9 @@ func NewResolver(transact persistence.Transactioner, runtimeService RuntimeServi
 		bundleInstanceAuthSvc:     bundleInstanceAuthSvc,
 		selfRegManager:            selfRegManager,
 		uidService:                uidService,
+		webhookSvc:                webhookSvc,
+		webhookConverter:          webhookConverter,
+		tenantSvc:                 tenantSvc,
 	}
 }
 
@@ -209,34 +233,18 @@ func (r *Resolver) RegisterRuntime(ctx context.Context, in graphql.RuntimeInput)
 		return nil, err
 	}
 
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		didRollback := r.transact.RollbackUnlessCommitted(ctx, tx)
-		if didRollback {
-			labelVal := str.CastOrEmpty(convertedIn.Labels[r.selfRegManager.GetSelfRegDistinguishingLabelKey()])
-			r.cleanupAndLogOnError(ctx, labelVal)
-		}
-	}()
-
-	ctx = persistence.SaveToContext(ctx, tx)
-
-	if err = r.runtimeService.CreateWithMandatoryLabels(ctx, convertedIn, id, labels); err != nil {
-		return nil, err
-	}
-
-	runtime, err := r.runtimeService.Get(ctx, id)
+	runtime, err := r.createRuntime(ctx, convertedIn, id, labels)
 	if err != nil {
+		labelVal := str.CastOrEmpty(convertedIn.Labels[r.selfRegManager.GetSelfRegDistinguishingLabelKey()])
+		r.cleanupAndLogOnError(ctx, labelVal)
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
+	// The runtime is created in the transaction of the mutation, which can still be rolled back after the runtime is created
+	operation.OnRollback(ctx, func(ctx context.Context) {
+		labelVal := str.CastOrEmpty(convertedIn.Labels[r.selfRegManager.GetSelfRegDistinguishingLabelKey()])
+		r.cleanupAndLogOnError(ctx, labelVal)
+	})
 
 	gqlRuntime := r.converter.ToGraphQL(runtime)
 
@@ -278,26 +286,22 @@ func (r *Resolver) UpdateRuntime(ctx context.Context, id string, in graphql.Runt
 // DeleteRuntime missing godoc
 func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runtime, error) {
 	var selfRegLabelVal string
-	tx, err := r.transact.Begin()
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		didRollback := r.transact.RollbackUnlessCommitted(ctx, tx)
-		if !didRollback { // if we did rollback we should not try to execute the cleanup
-			r.cleanupAndLogOnError(ctx, selfRegLabelVal)
-		}
-	}()
-
-	ctx = persistence.SaveToContext(ctx, tx)
 
 	runtime, err := r.runtimeService.Get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	bundleInstanceAuths, err := r.bundleInstanceAuthSvc.ListByRuntimeID(ctx, runtime.ID)
-	if err != nil {
+	if operation.ModeFromCtx(ctx) == graphql.OperationModeAsync {
+		// The runtime is only marked for deletion, its associations are removed and its resources are released by FinalizeDeletion when the operation succeeds
+		deletedRuntime := r.converter.ToGraphQL(runtime)
+		if err = r.runtimeService.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		return deletedRuntime, nil
+	}
+
+	if err = r.markBundleInstanceAuthsUnused(ctx, runtime.ID); err != nil {
 		return nil, err
 	}
 
@@ -311,20 +315,6 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		selfRegLabelVal = str.CastOrEmpty(selfRegLabel.Value)
 	}
 
-	currentTimestamp := timestamp.DefaultGenerator
-	for _, auth := range bundleInstanceAuths {
-		if auth.Status.Condition != model.BundleInstanceAuthStatusConditionUnused {
-			if err := auth.SetDefaultStatus(model.BundleInstanceAuthStatusConditionUnused, currentTimestamp()); err != nil {
-				log.C(ctx).WithError(err).Errorf("while update bundle instance auth status condition: %v", err)
-				return nil, err
-			}
-			if err := r.bundleInstanceAuthSvc.Update(ctx, auth); err != nil {
-				log.C(ctx).WithError(err).Errorf("Unable to update bundle instance auth with ID: %s for corresponding bundle with ID: %s: %v", auth.ID, auth.BundleID, err)
-				return nil, err
-			}
-		}
-	}
-
 	auths, err := r.sysAuthSvc.ListForObject(ctx, model.RuntimeReference, runtime.ID)
 	if err != nil {
 		return nil, err
@@ -340,15 +330,54 @@ func (r *Resolver) DeleteRuntime(ctx context.Context, id string) (*graphql.Runti
 		return nil, err
 	}
 
-	if err = r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
-		return nil, err
+	operation.OnCommit(ctx, func(ctx context.Context) {
+		r.releaseResources(ctx, auths, selfRegLabelVal)
+	})
+
+	return deletedRuntime, nil
+}
+
+// FinalizeDeletion deletes the runtime marked for deletion by an asynchronous unregisterRuntime operation which succeeded.
+// It uses the transaction from the context, removes the associations of the runtime in the tenant which owns it, and
+// releases the OAuth 2.0 clients and the self-registration of the runtime once the transaction is committed.
+func (r *Resolver) FinalizeDeletion(ctx context.Context, id string) error {
+	tnt, err := r.tenantSvc.GetLowestOwnerForResource(ctx, resource.Runtime, id)
+	if err != nil {
+		return errors.Wrapf(err, "while getting lowest owner of runtime with id %q", id)
 	}
 
-	if err = tx.Commit(); err != nil {
-		return nil, err
+	tenantCtx := tenant.SaveToContext(ctx, tnt, "")
+	if err := r.markBundleInstanceAuthsUnused(tenantCtx, id); err != nil {
+		return err
+	}
+	if err := r.deleteAssociatedScenarioAssignments(tenantCtx, id); err != nil {
+		return err
 	}
 
-	return deletedRuntime, nil
+	auths, err := r.sysAuthSvc.ListForObjectGlobal(ctx, model.RuntimeReference, id)
+	if err != nil {
+		return err
+	}
+
+	var selfRegLabelVal string
+	selfRegLabel, err := r.runtimeService.GetLabelGlobal(ctx, id, r.selfRegManager.GetSelfRegDistinguishingLabelKey())
+	if err != nil {
+		if !apperrors.IsNotFoundError(err) {
+			return errors.Wrapf(err, "while getting self register info label")
+		}
+	} else {
+		selfRegLabelVal = str.CastOrEmpty(selfRegLabel.Value)
+	}
+
+	if err := r.runtimeService.DeleteGlobal(ctx, id); err != nil {
+		return err
+	}
+
+	operation.OnCommit(ctx, func(ctx context.Context) {
+		r.releaseResources(ctx, auths, selfRegLabelVal)
+	})
+
+	return nil
 }
 
 // GetLabel missing godoc
@@ -536,6 +565,40 @@ func (r *Resolver) Auths(ctx context.Context, obj *graphql.Runtime) ([]*graphql.
 	return out, nil
 }
 
+// Webhooks returns the webhooks of the given runtime.
+func (r *Resolver) Webhooks(ctx context.Context, obj *graphql.Runtime) ([]*graphql.Webhook, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Runtime cannot be empty")
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	webhooks, err := r.webhookSvc.ListForRuntime(ctx, obj.ID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return nil, tx.Commit()
+		}
+		return nil, err
+	}
+
+	gqlWebhooks, err := r.webhookConverter.MultipleToGraphQL(webhooks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return gqlWebhooks, nil
+}
+
 // EventingConfiguration missing godoc
 func (r *Resolver) EventingConfiguration(ctx context.Context, obj *graphql.Runtime) (*graphql.RuntimeEventingConfiguration, error) {
 	if obj == nil {
@@ -567,6 +630,38 @@ func (r *Resolver) EventingConfiguration(ctx context.Context, obj *graphql.Runti
 	return eventing.RuntimeEventingConfigurationToGraphQL(eventingCfg), nil
 }
 
+func (r *Resolver) createRuntime(ctx context.Context, in model.RuntimeInput, id string, labels map[string]interface{}) (*model.Runtime, error) {
+	if err := r.runtimeService.CreateWithMandatoryLabels(ctx, in, id, labels); err != nil {
+		return nil, err
+	}
+
+	return r.runtimeService.Get(ctx, id)
+}
+
+// markBundleInstanceAuthsUnused sets the status of the bundle instance auths of the runtime to UNUSED
+func (r *Resolver) markBundleInstanceAuthsUnused(ctx context.Context, runtimeID string) error {
+	bundleInstanceAuths, err := r.bundleInstanceAuthSvc.ListByRuntimeID(ctx, runtimeID)
+	if err != nil {
+		return err
+	}
+
+	currentTimestamp := timestamp.DefaultGenerator
+	for _, auth := range bundleInstanceAuths {
+		if auth.Status.Condition != model.BundleInstanceAuthStatusConditionUnused {
+			if err := auth.SetDefaultStatus(model.BundleInstanceAuthStatusConditionUnused, currentTimestamp()); err != nil {
+				log.C(ctx).WithError(err).Errorf("while update bundle instance auth status condition: %v", err)
+				return err
+			}
+			if err := r.bundleInstanceAuthSvc.Update(ctx, auth); err != nil {
+				log.C(ctx).WithError(err).Errorf("Unable to update bundle instance auth with ID: %s for corresponding bundle with ID: %s: %v", auth.ID, auth.BundleID, err)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // deleteAssociatedScenarioAssignments ensures that scenario assignments which are responsible for creation of certain runtime labels are deleted,
 // if runtime doesn't have the scenarios label or is part of a scenario for which no scenario assignment exists => noop
 func (r *Resolver) deleteAssociatedScenarioAssignments(ctx context.Context, runtimeID string) error {
@@ -604,6 +699,15 @@ func (r *Resolver) deleteAssociatedScenarioAssignments(ctx context.Context, runt
 	return nil
 }
 
+// releaseResources deletes the OAuth 2.0 clients and cleans up the self-registration of a deleted runtime
+func (r *Resolver) releaseResources(ctx context.Context, auths []model.SystemAuth, selfRegLabelVal string) {
+	if err := r.oAuth20Svc.DeleteMultipleClientCredentials(ctx, auths); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while deleting the OAuth 2.0 clients of the deleted runtime: %v", err)
+	}
+
+	r.cleanupAndLogOnError(ctx, selfRegLabelVal)
+}
+
 func (r *Resolver) cleanupAndLogOnError(ctx context.Context, labelVal string) {
 	if err := r.selfRegManager.CleanupSelfRegisteredRuntime(ctx, labelVal); err != nil {
 		log.C(ctx).Errorf("An error occurred during cleanup of self-registered runtime: %v", err)
@@ -28,6 +28,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/domain/runtime"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -57,8 +58,6 @@ func TestResolver_CreateRuntime(t *testing.T) {
 
 	testCases := []struct {
 		Name             string
-		PersistenceFn    func() *persistenceautomock.PersistenceTx
-		TransactionerFn  func(persistTx *persistenceautomock.PersistenceTx) *persistenceautomock.Transactioner
 		ServiceFn        func() *automock.RuntimeService
 		ConverterFn      func() *automock.RuntimeConverter
 		SelfRegManagerFn func() *automock.SelfRegisterManager
@@ -70,12 +69,6 @@ func TestResolver_CreateRuntime(t *testing.T) {
 	}{
 		{
 			Name: "Success",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				persistTx.On("Commit").Return(nil).Once()
-				return persistTx
-			},
-			TransactionerFn: txtest.TransactionerThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, testUUID).Return(modelRuntime, nil).Once()
@@ -100,11 +93,6 @@ func TestResolver_CreateRuntime(t *testing.T) {
 		},
 		{
 			Name: "Returns error when runtime creation failed",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				return persistTx
-			},
-			TransactionerFn: txtest.TransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("CreateWithMandatoryLabels", contextParam, modelInput, testUUID, labels).Return(testErr).Once()
@@ -127,11 +115,6 @@ func TestResolver_CreateRuntime(t *testing.T) {
 		},
 		{
 			Name: "Returns error when runtime retrieval failed",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				persistTx := &persistenceautomock.PersistenceTx{}
-				return persistTx
-			},
-			TransactionerFn: txtest.TransactionerThatDoesARollback,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("CreateWithMandatoryLabels", contextParam, modelInput, testUUID, labels).Return(nil).Once()
@@ -155,10 +138,6 @@ func TestResolver_CreateRuntime(t *testing.T) {
 		},
 		{
 			Name: "Returns error when preaparation for self-registration failed",
-			PersistenceFn: func() *persistenceautomock.PersistenceTx {
-				return &persistenceautomock.PersistenceTx{}
-			},
-			TransactionerFn: txtest.NoopTransactioner,
 			ServiceFn: func() *automock.RuntimeService {
 				return &automock.RuntimeService{}
 			},
@@ -181,17 +160,16 @@ func TestResolver_CreateRuntime(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			persistTx := testCase.PersistenceFn()
-			transact := testCase.TransactionerFn(persistTx)
 			svc := testCase.ServiceFn()
 			converter := testCase.ConverterFn()
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := testCase.UUIDSvcFn()
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(nil, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
-			result, err := resolver.RegisterRuntime(context.TODO(), testCase.Input)
+			ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+			result, err := resolver.RegisterRuntime(ctx, testCase.Input)
 
 			// then
 			assert.Equal(t, testCase.ExpectedRuntime, result)
@@ -201,7 +179,7 @@ func TestResolver_CreateRuntime(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, svc, converter, transact, persistTx, selfRegManager)
+			mock.AssertExpectationsForObjects(t, svc, converter, selfRegManager)
 		})
 	}
 }
@@ -320,7 +298,7 @@ func TestResolver_UpdateRuntime(t *testing.T) {
 			selfRegMng := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegMng, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegMng, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.UpdateRuntime(context.TODO(), testCase.RuntimeID, testCase.Input)
@@ -341,7 +319,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 	testErr := errors.New("Test error")
 	labelNotFoundErr := apperrors.NewNotFoundError(resource.Label, "")
 	scenarioAssignmentNotFoundErr := apperrors.NewNotFoundError(resource.AutomaticScenarioAssigment, "")
-	txGen := txtest.NewTransactionContextGenerator(testErr)
 	testAuths := fixOAuths()
 	emptyScenariosLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{}}
 	singleScenarioLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{"scenario-0"}}
@@ -349,7 +326,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 
 	testCases := []struct {
 		Name                    string
-		TransactionerFn         func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
 		ServiceFn               func() *automock.RuntimeService
 		ScenarioAssignmentFn    func() *automock.ScenarioAssignmentService
 		SysAuthServiceFn        func() *automock.SystemAuthService
@@ -363,7 +339,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 	}{
 		{
 			Name:            "Success",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -410,7 +385,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Success when self-registration had been done even if cleanup fails",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -457,7 +431,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when runtime deletion failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -503,7 +476,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns error when runtime retrieval failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(nil, testErr).Once()
@@ -534,81 +506,8 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			ExpectedRuntime:  nil,
 			ExpectedErr:      testErr,
 		},
-		{
-			Name:            "Returns error when transaction starting failed",
-			TransactionerFn: txGen.ThatFailsOnBegin,
-			ServiceFn: func() *automock.RuntimeService {
-				svc := &automock.RuntimeService{}
-				return svc
-			},
-			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
-				svc := &automock.ScenarioAssignmentService{}
-				return svc
-			},
-			ConverterFn: func() *automock.RuntimeConverter {
-				conv := &automock.RuntimeConverter{}
-				return conv
-			},
-			SysAuthServiceFn: func() *automock.SystemAuthService {
-				svc := &automock.SystemAuthService{}
-				return svc
-			},
-			OAuth20ServiceFn: func() *automock.OAuth20Service {
-				svc := &automock.OAuth20Service{}
-				return svc
-			},
-			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
-				svc := &automock.BundleInstanceAuthService{}
-				return svc
-			},
-			SelfRegManagerFn: rtmtest.NoopSelfRegManager,
-			InputID:          "foo",
-			ExpectedRuntime:  nil,
-			ExpectedErr:      testErr,
-		},
-		{
-			Name:            "Returns error when transaction commit failed",
-			TransactionerFn: txGen.ThatFailsOnCommit,
-			ServiceFn: func() *automock.RuntimeService {
-				svc := &automock.RuntimeService{}
-				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("Delete", contextParam, modelRuntime.ID).Return(nil)
-				svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
-				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
-				return svc
-			},
-			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
-				svc := &automock.ScenarioAssignmentService{}
-				return svc
-			},
-			ConverterFn: func() *automock.RuntimeConverter {
-				conv := &automock.RuntimeConverter{}
-				conv.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
-				return conv
-			},
-			SysAuthServiceFn: func() *automock.SystemAuthService {
-				svc := &automock.SystemAuthService{}
-				svc.On("ListForObject", contextParam, model.RuntimeReference, modelRuntime.ID).Return(testAuths, nil)
-				return svc
-			},
-			OAuth20ServiceFn: func() *automock.OAuth20Service {
-				svc := &automock.OAuth20Service{}
-				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil)
-				return svc
-			},
-			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
-				svc := &automock.BundleInstanceAuthService{}
-				svc.On("ListByRuntimeID", contextParam, modelRuntime.ID).Return([]*model.BundleInstanceAuth{}, nil)
-				return svc
-			},
-			SelfRegManagerFn: rtmtest.SelfRegManagerReturnsDistinguishingLabel,
-			InputID:          "foo",
-			ExpectedRuntime:  nil,
-			ExpectedErr:      testErr,
-		},
 		{
 			Name:            "Return error when listing all system auths failed",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -644,7 +543,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Fails when cannot list bundle instance auths by runtime id",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -678,7 +576,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Fails when cannot list self-register distinguishing label because of error which is other than not found",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -713,11 +610,9 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Fails when cannot update bundle instance auths status to unused",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
-				svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
 				return svc
 			},
 			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
@@ -748,14 +643,15 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("Update", contextParam, auth).Return(testErr)
 				return svc
 			},
-			SelfRegManagerFn: rtmtest.SelfRegManagerReturnsDistinguishingLabel,
+			SelfRegManagerFn: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
 			InputID:          "foo",
 			ExpectedRuntime:  nil,
 			ExpectedErr:      testErr,
 		},
 		{
-			Name:            "Return error when removing oauth from hydra",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			Name:            "Success even if removing oauth from hydra fails",
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -788,14 +684,13 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				svc.On("ListByRuntimeID", contextParam, modelRuntime.ID).Return([]*model.BundleInstanceAuth{}, nil)
 				return svc
 			},
-			SelfRegManagerFn: rtmtest.SelfRegManagerReturnsDistinguishingLabel,
+			SelfRegManagerFn: rtmtest.SelfRegManagerThatDoesCleanupWithNoErrors,
 			InputID:          "foo",
-			ExpectedRuntime:  nil,
-			ExpectedErr:      testErr,
+			ExpectedRuntime:  gqlRuntime,
+			ExpectedErr:      nil,
 		},
 		{
 			Name:            "Returns error when listing scenarios label",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -832,7 +727,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns empty scenarios when listing scenarios label should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -874,7 +768,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and error when listing scenario assignments should fail",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -915,7 +808,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and not found when listing scenario assignments should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -958,7 +850,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments but fails on deletion of scenario assignment should fail",
-			TransactionerFn: txGen.ThatDoesntExpectCommit,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1000,7 +891,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns scenario when listing scenarios label and scenario assignment when listing scenario assignments and succeeds on deletion of scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1045,7 +935,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and only some are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1100,7 +989,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and all are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1158,7 +1046,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and none are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1208,7 +1095,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 		},
 		{
 			Name:            "Returns multiple scenarios when listing scenarios label and none are created by a scenario assignment should succeed",
-			TransactionerFn: txGen.ThatSucceeds,
 			ServiceFn: func() *automock.RuntimeService {
 				svc := &automock.RuntimeService{}
 				svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
@@ -1259,7 +1145,6 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.Name, func(t *testing.T) {
-			persistTx, transact := testCase.TransactionerFn()
 			svc := testCase.ServiceFn()
 			scenarioAssignmentSvc := testCase.ScenarioAssignmentFn()
 			converter := testCase.ConverterFn()
@@ -1269,10 +1154,11 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 			selfRegisterManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil, bundleInstanceAuthSvc, selfRegisterManager, uuidSvc)
+			resolver := runtime.NewResolver(nil, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil, bundleInstanceAuthSvc, selfRegisterManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
-			result, err := resolver.DeleteRuntime(context.TODO(), testCase.InputID)
+			ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+			result, err := resolver.DeleteRuntime(ctx, testCase.InputID)
 
 			// then
 			assert.Equal(t, testCase.ExpectedRuntime, result)
@@ -1282,7 +1168,423 @@ func TestResolver_DeleteRuntime(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, svc, scenarioAssignmentSvc, converter, transact, persistTx, sysAuthSvc, oAuth20Svc, selfRegisterManager)
+			mock.AssertExpectationsForObjects(t, svc, scenarioAssignmentSvc, converter, sysAuthSvc, oAuth20Svc, selfRegisterManager)
+		})
+	}
+}
+
+func TestResolver_CreateRuntimeCleansUpOnRollback(t *testing.T) {
+	// GIVEN
+	modelRuntime := fixModelRuntime(t, "foo", "tenant-foo", "Foo", "Lorem ipsum")
+	gqlRuntime := fixGQLRuntime(t, "foo", "Foo", "Lorem ipsum")
+
+	gqlInput := graphql.RuntimeInput{Name: "Foo"}
+	modelInput := model.RuntimeInput{Name: "Foo"}
+	labels := map[string]interface{}{"xsappnameCMPClone": "clone"}
+
+	svc := &automock.RuntimeService{}
+	svc.On("CreateWithMandatoryLabels", contextParam, modelInput, testUUID, labels).Return(nil).Once()
+	svc.On("Get", contextParam, testUUID).Return(modelRuntime, nil).Once()
+	converter := &automock.RuntimeConverter{}
+	converter.On("InputFromGraphQL", gqlInput).Return(modelInput).Once()
+	converter.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+	uuidSvc := &automock.UidService{}
+	uuidSvc.On("Generate").Return(testUUID).Once()
+	selfRegManager := rtmtest.SelfRegManagerThatDoesPrepWithNoErrors(labels)()
+
+	resolver := runtime.NewResolver(nil, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
+
+	ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+	ctx, hooks := operation.SaveHooksToContext(ctx)
+
+	// WHEN
+	result, err := resolver.RegisterRuntime(ctx, gqlInput)
+
+	// THEN
+	require.NoError(t, err)
+	assert.Equal(t, gqlRuntime, result)
+	selfRegManager.AssertNotCalled(t, "CleanupSelfRegisteredRuntime", mock.Anything, mock.Anything)
+
+	selfRegManager.On("GetSelfRegDistinguishingLabelKey").Return(rtmtest.TestDistinguishLabel).Once()
+	selfRegManager.On("CleanupSelfRegisteredRuntime", mock.Anything, mock.AnythingOfType("string")).Return(nil).Once()
+	hooks.RolledBack(ctx)
+
+	mock.AssertExpectationsForObjects(t, svc, converter, uuidSvc, selfRegManager)
+}
+
+func TestResolver_DeleteRuntimeReleasesResourcesAfterCommit(t *testing.T) {
+	// GIVEN
+	modelRuntime := fixModelRuntime(t, "foo", "tenant-foo", "Foo", "Bar")
+	gqlRuntime := fixGQLRuntime(t, "foo", "Foo", "Bar")
+	labelNotFoundErr := apperrors.NewNotFoundError(resource.Label, "")
+	testAuths := fixOAuths()
+
+	fixMocks := func() (*automock.RuntimeService, *automock.SystemAuthService, *automock.RuntimeConverter, *automock.BundleInstanceAuthService) {
+		svc := &automock.RuntimeService{}
+		svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+		svc.On("Delete", contextParam, "foo").Return(nil).Once()
+		svc.On("GetLabel", contextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
+		svc.On("GetLabel", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(&model.Label{Key: rtmtest.TestDistinguishLabel, Value: "foo"}, nil).Once()
+		sysAuthSvc := &automock.SystemAuthService{}
+		sysAuthSvc.On("ListForObject", contextParam, model.RuntimeReference, modelRuntime.ID).Return(testAuths, nil).Once()
+		converter := &automock.RuntimeConverter{}
+		converter.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+		bundleInstanceAuthSvc := &automock.BundleInstanceAuthService{}
+		bundleInstanceAuthSvc.On("ListByRuntimeID", contextParam, modelRuntime.ID).Return([]*model.BundleInstanceAuth{}, nil).Once()
+		return svc, sysAuthSvc, converter, bundleInstanceAuthSvc
+	}
+
+	t.Run("Releases resources when the transaction is committed", func(t *testing.T) {
+		svc, sysAuthSvc, converter, bundleInstanceAuthSvc := fixMocks()
+		oAuth20Svc := &automock.OAuth20Service{}
+		selfRegManager := rtmtest.SelfRegManagerReturnsDistinguishingLabel()
+
+		resolver := runtime.NewResolver(nil, svc, nil, sysAuthSvc, oAuth20Svc, converter, nil, nil, bundleInstanceAuthSvc, selfRegManager, nil, nil, nil, nil)
+
+		ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+		ctx, hooks := operation.SaveHooksToContext(ctx)
+
+		// WHEN
+		result, err := resolver.DeleteRuntime(ctx, "foo")
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, gqlRuntime, result)
+		oAuth20Svc.AssertNotCalled(t, "DeleteMultipleClientCredentials", mock.Anything, mock.Anything)
+		selfRegManager.AssertNotCalled(t, "CleanupSelfRegisteredRuntime", mock.Anything, mock.Anything)
+
+		oAuth20Svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+		selfRegManager.On("CleanupSelfRegisteredRuntime", mock.Anything, "foo").Return(nil).Once()
+		hooks.Committed(ctx)
+
+		mock.AssertExpectationsForObjects(t, svc, sysAuthSvc, converter, bundleInstanceAuthSvc, oAuth20Svc, selfRegManager)
+	})
+
+	t.Run("Does not release resources when the transaction is rolled back", func(t *testing.T) {
+		svc, sysAuthSvc, converter, bundleInstanceAuthSvc := fixMocks()
+		oAuth20Svc := &automock.OAuth20Service{}
+		selfRegManager := rtmtest.SelfRegManagerReturnsDistinguishingLabel()
+
+		resolver := runtime.NewResolver(nil, svc, nil, sysAuthSvc, oAuth20Svc, converter, nil, nil, bundleInstanceAuthSvc, selfRegManager, nil, nil, nil, nil)
+
+		ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+		ctx, hooks := operation.SaveHooksToContext(ctx)
+
+		// WHEN
+		_, err := resolver.DeleteRuntime(ctx, "foo")
+		hooks.RolledBack(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, svc, sysAuthSvc, converter, bundleInstanceAuthSvc, oAuth20Svc, selfRegManager)
+	})
+
+	t.Run("Only marks the runtime for deletion in asynchronous mode", func(t *testing.T) {
+		svc := &automock.RuntimeService{}
+		svc.On("Get", contextParam, "foo").Return(modelRuntime, nil).Once()
+		svc.On("Delete", contextParam, "foo").Return(nil).Once()
+		converter := &automock.RuntimeConverter{}
+		converter.On("ToGraphQL", modelRuntime).Return(gqlRuntime).Once()
+		sysAuthSvc := &automock.SystemAuthService{}
+		bundleInstanceAuthSvc := &automock.BundleInstanceAuthService{}
+		scenarioAssignmentSvc := &automock.ScenarioAssignmentService{}
+		oAuth20Svc := &automock.OAuth20Service{}
+		selfRegManager := &automock.SelfRegisterManager{}
+
+		resolver := runtime.NewResolver(nil, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, converter, nil, nil, bundleInstanceAuthSvc, selfRegManager, nil, nil, nil, nil)
+
+		ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+		ctx = operation.SaveModeToContext(ctx, graphql.OperationModeAsync)
+		ctx, hooks := operation.SaveHooksToContext(ctx)
+
+		// WHEN
+		result, err := resolver.DeleteRuntime(ctx, "foo")
+		hooks.Committed(ctx)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, gqlRuntime, result)
+		mock.AssertExpectationsForObjects(t, svc, sysAuthSvc, converter, bundleInstanceAuthSvc, scenarioAssignmentSvc, oAuth20Svc, selfRegManager)
+	})
+}
+
+func TestResolver_FinalizeDeletion(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	labelNotFoundErr := apperrors.NewNotFoundError(resource.Label, "")
+	testAuths := fixOAuths()
+	selfRegLabel := &model.Label{Key: rtmtest.TestDistinguishLabel, Value: "foo"}
+	scenariosLabel := &model.Label{Key: model.ScenariosKey, Value: []interface{}{"scenario-0"}}
+	scenarioAssignment := model.AutomaticScenarioAssignment{ScenarioName: "scenario-0"}
+	tenantContextParam := mock.MatchedBy(func(ctx context.Context) bool {
+		tnt, err := tenant.LoadFromContext(ctx)
+		return err == nil && tnt == "tenant-foo"
+	})
+
+	tenantSvcFn := func() *automock.TenantService {
+		svc := &automock.TenantService{}
+		svc.On("GetLowestOwnerForResource", contextParam, resource.Runtime, "foo").Return("tenant-foo", nil).Once()
+		return svc
+	}
+	noBundleInstanceAuthsFn := func() *automock.BundleInstanceAuthService {
+		svc := &automock.BundleInstanceAuthService{}
+		svc.On("ListByRuntimeID", tenantContextParam, "foo").Return([]*model.BundleInstanceAuth{}, nil).Once()
+		return svc
+	}
+	noScenarioAssignmentsFn := func() *automock.ScenarioAssignmentService {
+		return &automock.ScenarioAssignmentService{}
+	}
+
+	testCases := []struct {
+		Name                    string
+		ServiceFn               func() *automock.RuntimeService
+		TenantSvcFn             func() *automock.TenantService
+		BundleInstanceAuthSvcFn func() *automock.BundleInstanceAuthService
+		ScenarioAssignmentFn    func() *automock.ScenarioAssignmentService
+		SysAuthServiceFn        func() *automock.SystemAuthService
+		OAuth20ServiceFn        func() *automock.OAuth20Service
+		SelfRegManagerFn        func() *automock.SelfRegisterManager
+		ExpectedErr             error
+	}{
+		{
+			Name: "Success",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(scenariosLabel, nil).Once()
+				svc.On("GetLabelGlobal", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(selfRegLabel, nil).Once()
+				svc.On("DeleteGlobal", contextParam, "foo").Return(nil).Once()
+				return svc
+			},
+			TenantSvcFn: tenantSvcFn,
+			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
+				svc := &automock.BundleInstanceAuthService{}
+				auth := &model.BundleInstanceAuth{
+					Status: &model.BundleInstanceAuthStatus{
+						Condition: model.BundleInstanceAuthStatusConditionSucceeded,
+					},
+				}
+				svc.On("ListByRuntimeID", tenantContextParam, "foo").Return([]*model.BundleInstanceAuth{auth}, nil).Once()
+				svc.On("Update", tenantContextParam, mock.MatchedBy(func(auth *model.BundleInstanceAuth) bool {
+					return auth.Status.Condition == model.BundleInstanceAuthStatusConditionUnused
+				})).Return(nil).Once()
+				return svc
+			},
+			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
+				svc := &automock.ScenarioAssignmentService{}
+				svc.On("GetForScenarioName", tenantContextParam, "scenario-0").Return(scenarioAssignment, nil).Once()
+				svc.On("Delete", tenantContextParam, scenarioAssignment).Return(nil).Once()
+				return svc
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObjectGlobal", contextParam, model.RuntimeReference, "foo").Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(nil).Once()
+				return svc
+			},
+			SelfRegManagerFn: rtmtest.SelfRegManagerThatDoesCleanupWithNoErrors,
+		},
+		{
+			Name: "Success when the runtime is not self-registered even if removing oauth from hydra fails",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
+				svc.On("GetLabelGlobal", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, labelNotFoundErr).Once()
+				svc.On("DeleteGlobal", contextParam, "foo").Return(nil).Once()
+				return svc
+			},
+			TenantSvcFn:             tenantSvcFn,
+			BundleInstanceAuthSvcFn: noBundleInstanceAuthsFn,
+			ScenarioAssignmentFn:    noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObjectGlobal", contextParam, model.RuntimeReference, "foo").Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				svc := &automock.OAuth20Service{}
+				svc.On("DeleteMultipleClientCredentials", contextParam, testAuths).Return(testErr).Once()
+				return svc
+			},
+			SelfRegManagerFn: rtmtest.SelfRegManagerThatDoesCleanupWithNoErrors,
+		},
+		{
+			Name: "Returns error when getting the owner of the runtime failed",
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				svc := &automock.TenantService{}
+				svc.On("GetLowestOwnerForResource", contextParam, resource.Runtime, "foo").Return("", testErr).Once()
+				return svc
+			},
+			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
+				return &automock.BundleInstanceAuthService{}
+			},
+			ScenarioAssignmentFn: noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when listing bundle instance auths failed",
+			ServiceFn: func() *automock.RuntimeService {
+				return &automock.RuntimeService{}
+			},
+			TenantSvcFn: tenantSvcFn,
+			BundleInstanceAuthSvcFn: func() *automock.BundleInstanceAuthService {
+				svc := &automock.BundleInstanceAuthService{}
+				svc.On("ListByRuntimeID", tenantContextParam, "foo").Return(nil, testErr).Once()
+				return svc
+			},
+			ScenarioAssignmentFn: noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when deleting scenario assignments failed",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(scenariosLabel, nil).Once()
+				return svc
+			},
+			TenantSvcFn:             tenantSvcFn,
+			BundleInstanceAuthSvcFn: noBundleInstanceAuthsFn,
+			ScenarioAssignmentFn: func() *automock.ScenarioAssignmentService {
+				svc := &automock.ScenarioAssignmentService{}
+				svc.On("GetForScenarioName", tenantContextParam, "scenario-0").Return(scenarioAssignment, nil).Once()
+				svc.On("Delete", tenantContextParam, scenarioAssignment).Return(testErr).Once()
+				return svc
+			},
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				return &automock.SystemAuthService{}
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when listing system auths failed",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
+				return svc
+			},
+			TenantSvcFn:             tenantSvcFn,
+			BundleInstanceAuthSvcFn: noBundleInstanceAuthsFn,
+			ScenarioAssignmentFn:    noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObjectGlobal", contextParam, model.RuntimeReference, "foo").Return(nil, testErr).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: func() *automock.SelfRegisterManager {
+				return &automock.SelfRegisterManager{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Returns error when getting self register label failed",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
+				svc.On("GetLabelGlobal", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(nil, testErr).Once()
+				return svc
+			},
+			TenantSvcFn:             tenantSvcFn,
+			BundleInstanceAuthSvcFn: noBundleInstanceAuthsFn,
+			ScenarioAssignmentFn:    noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObjectGlobal", contextParam, model.RuntimeReference, "foo").Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: rtmtest.SelfRegManagerReturnsDistinguishingLabel,
+			ExpectedErr:      testErr,
+		},
+		{
+			Name: "Returns error when runtime deletion failed",
+			ServiceFn: func() *automock.RuntimeService {
+				svc := &automock.RuntimeService{}
+				svc.On("GetLabel", tenantContextParam, "foo", model.ScenariosKey).Return(nil, labelNotFoundErr).Once()
+				svc.On("GetLabelGlobal", contextParam, "foo", rtmtest.TestDistinguishLabel).Return(selfRegLabel, nil).Once()
+				svc.On("DeleteGlobal", contextParam, "foo").Return(testErr).Once()
+				return svc
+			},
+			TenantSvcFn:             tenantSvcFn,
+			BundleInstanceAuthSvcFn: noBundleInstanceAuthsFn,
+			ScenarioAssignmentFn:    noScenarioAssignmentsFn,
+			SysAuthServiceFn: func() *automock.SystemAuthService {
+				svc := &automock.SystemAuthService{}
+				svc.On("ListForObjectGlobal", contextParam, model.RuntimeReference, "foo").Return(testAuths, nil).Once()
+				return svc
+			},
+			OAuth20ServiceFn: func() *automock.OAuth20Service {
+				return &automock.OAuth20Service{}
+			},
+			SelfRegManagerFn: rtmtest.SelfRegManagerReturnsDistinguishingLabel,
+			ExpectedErr:      testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			svc := testCase.ServiceFn()
+			tenantSvc := testCase.TenantSvcFn()
+			bundleInstanceAuthSvc := testCase.BundleInstanceAuthSvcFn()
+			scenarioAssignmentSvc := testCase.ScenarioAssignmentFn()
+			sysAuthSvc := testCase.SysAuthServiceFn()
+			oAuth20Svc := testCase.OAuth20ServiceFn()
+			selfRegManager := testCase.SelfRegManagerFn()
+
+			resolver := runtime.NewResolver(nil, svc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, nil, nil, nil, bundleInstanceAuthSvc, selfRegManager, nil, nil, nil, tenantSvc)
+
+			ctx := persistence.SaveToContext(context.TODO(), &persistenceautomock.PersistenceTx{})
+			ctx, hooks := operation.SaveHooksToContext(ctx)
+
+			// WHEN
+			err := resolver.FinalizeDeletion(ctx, "foo")
+			if err == nil {
+				hooks.Committed(ctx)
+			}
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, svc, tenantSvc, bundleInstanceAuthSvc, scenarioAssignmentSvc, sysAuthSvc, oAuth20Svc, selfRegManager)
 		})
 	}
 }
@@ -1384,7 +1686,7 @@ func TestResolver_Runtime(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.Runtime(context.TODO(), testCase.InputID)
@@ -1489,7 +1791,7 @@ func TestResolver_Runtimes(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.Runtimes(context.TODO(), testCase.InputLabelFilters, testCase.InputFirst, testCase.InputAfter)
@@ -1601,7 +1903,7 @@ func TestResolver_SetRuntimeLabel(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.SetRuntimeLabel(context.TODO(), testCase.InputRuntimeID, testCase.InputKey, testCase.InputValue)
@@ -1615,7 +1917,7 @@ func TestResolver_SetRuntimeLabel(t *testing.T) {
 	}
 
 	t.Run("Returns error when Label input validation failed", func(t *testing.T) {
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.SetRuntimeLabel(context.TODO(), "", "", "")
@@ -1735,7 +2037,7 @@ func TestResolver_DeleteRuntimeLabel(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, converter, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.DeleteRuntimeLabel(context.TODO(), testCase.InputRuntimeID, testCase.InputKey)
@@ -1879,7 +2181,7 @@ func TestResolver_Labels(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.Labels(context.TODO(), gqlRuntime, testCase.InputKey)
@@ -1987,7 +2289,7 @@ func TestResolver_GetLabel(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, svc, nil, nil, nil, nil, nil, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.GetLabel(context.TODO(), runtimeID, labelKey)
@@ -2110,7 +2412,7 @@ func TestResolver_Auths(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, nil, nil, sysAuthSvc, nil, nil, sysAuthConv, nil, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, nil, nil, sysAuthSvc, nil, nil, sysAuthConv, nil, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.Auths(ctx, parentRuntime)
@@ -2129,7 +2431,7 @@ func TestResolver_Auths(t *testing.T) {
 	}
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.Auths(context.TODO(), nil)
@@ -2141,6 +2443,153 @@ func TestResolver_Auths(t *testing.T) {
 	})
 }
 
+func TestResolver_Webhooks(t *testing.T) {
+	// GIVEN
+	ctx := context.TODO()
+	parentRuntime := fixGQLRuntime(t, "foo", "bar", "baz")
+
+	url := "http://kyma.io"
+	modelWebhooks := []*model.Webhook{
+		{ID: "wh1", ObjectID: parentRuntime.ID, ObjectType: model.RuntimeWebhookReference, Type: model.WebhookTypeRegisterRuntime, URL: &url},
+		{ID: "wh2", ObjectID: parentRuntime.ID, ObjectType: model.RuntimeWebhookReference, Type: model.WebhookTypeUnregisterRuntime, URL: &url},
+	}
+	gqlWebhooks := []*graphql.Webhook{
+		{ID: "wh1", RuntimeID: &parentRuntime.ID, Type: graphql.WebhookTypeRegisterRuntime, URL: &url},
+		{ID: "wh2", RuntimeID: &parentRuntime.ID, Type: graphql.WebhookTypeUnregisterRuntime, URL: &url},
+	}
+
+	testErr := errors.New("this is a test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		WebhookSvcFn       func() *automock.WebhookService
+		WebhookConverterFn func() *automock.WebhookConverter
+		ExpectedOutput     []*graphql.Webhook
+		ExpectedError      error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			WebhookSvcFn: func() *automock.WebhookService {
+				webhookSvc := &automock.WebhookService{}
+				webhookSvc.On("ListForRuntime", txtest.CtxWithDBMatcher(), parentRuntime.ID).Return(modelWebhooks, nil).Once()
+				return webhookSvc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				webhookConv := &automock.WebhookConverter{}
+				webhookConv.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+				return webhookConv
+			},
+			ExpectedOutput: gqlWebhooks,
+		},
+		{
+			Name:            "Returns nil when runtime webhooks are not found",
+			TransactionerFn: txGen.ThatSucceeds,
+			WebhookSvcFn: func() *automock.WebhookService {
+				webhookSvc := &automock.WebhookService{}
+				webhookSvc.On("ListForRuntime", txtest.CtxWithDBMatcher(), parentRuntime.ID).Return(nil, apperrors.NewNotFoundError(resource.Webhook, "")).Once()
+				return webhookSvc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedOutput: nil,
+		},
+		{
+			Name:            "Returns error when listing webhooks failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			WebhookSvcFn: func() *automock.WebhookService {
+				webhookSvc := &automock.WebhookService{}
+				webhookSvc.On("ListForRuntime", txtest.CtxWithDBMatcher(), parentRuntime.ID).Return(nil, testErr).Once()
+				return webhookSvc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Returns error when converting webhooks failed",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			WebhookSvcFn: func() *automock.WebhookService {
+				webhookSvc := &automock.WebhookService{}
+				webhookSvc.On("ListForRuntime", txtest.CtxWithDBMatcher(), parentRuntime.ID).Return(modelWebhooks, nil).Once()
+				return webhookSvc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				webhookConv := &automock.WebhookConverter{}
+				webhookConv.On("MultipleToGraphQL", modelWebhooks).Return(nil, testErr).Once()
+				return webhookConv
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Returns error when transaction begin failed",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			WebhookSvcFn: func() *automock.WebhookService {
+				return &automock.WebhookService{}
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				return &automock.WebhookConverter{}
+			},
+			ExpectedError: testErr,
+		},
+		{
+			Name:            "Returns error when transaction commit failed",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			WebhookSvcFn: func() *automock.WebhookService {
+				webhookSvc := &automock.WebhookService{}
+				webhookSvc.On("ListForRuntime", txtest.CtxWithDBMatcher(), parentRuntime.ID).Return(modelWebhooks, nil).Once()
+				return webhookSvc
+			},
+			WebhookConverterFn: func() *automock.WebhookConverter {
+				webhookConv := &automock.WebhookConverter{}
+				webhookConv.On("MultipleToGraphQL", modelWebhooks).Return(gqlWebhooks, nil).Once()
+				return webhookConv
+			},
+			ExpectedError: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			persist, transact := testCase.TransactionerFn()
+			webhookSvc := testCase.WebhookSvcFn()
+			webhookConv := testCase.WebhookConverterFn()
+
+			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, webhookSvc, webhookConv, nil)
+
+			// WHEN
+			result, err := resolver.Webhooks(ctx, parentRuntime)
+
+			// THEN
+			if testCase.ExpectedError != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedError.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.ExpectedOutput, result)
+
+			mock.AssertExpectationsForObjects(t, webhookSvc, webhookConv, transact, persist)
+		})
+	}
+
+	t.Run("Error when parent object is nil", func(t *testing.T) {
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		result, err := resolver.Webhooks(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Runtime cannot be empty")
+		assert.Nil(t, result)
+	})
+}
+
 func TestResolver_EventingConfiguration(t *testing.T) {
 	// GIVEN
 	tnt := "tnt"
@@ -2222,7 +2671,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 			selfRegManager := testCase.SelfRegManagerFn()
 			uuidSvc := &automock.UidService{}
 
-			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, eventingSvc, nil, selfRegManager, uuidSvc)
+			resolver := runtime.NewResolver(transact, nil, nil, nil, nil, nil, nil, eventingSvc, nil, selfRegManager, uuidSvc, nil, nil, nil)
 
 			// WHEN
 			result, err := resolver.EventingConfiguration(ctx, gqlRuntime)
@@ -2242,7 +2691,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object ID is not a valid UUID", func(t *testing.T) {
 		// GIVEN
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(ctx, &graphql.Runtime{ID: "abc"})
@@ -2255,7 +2704,7 @@ func TestResolver_EventingConfiguration(t *testing.T) {
 
 	t.Run("Error when parent object is nil", func(t *testing.T) {
 		// GIVEN
-		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := runtime.NewResolver(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		result, err := resolver.EventingConfiguration(context.TODO(), nil)
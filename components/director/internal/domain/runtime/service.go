@@ -15,6 +15,7 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/labelfilter"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
@@ -37,6 +38,7 @@ type runtimeRepository interface {
 	Update(ctx context.Context, tenant string, item *model.Runtime) error
 	ListAll(context.Context, string, []*labelfilter.LabelFilter) ([]*model.Runtime, error)
 	Delete(ctx context.Context, tenant, id string) error
+	DeleteGlobal(ctx context.Context, id string) error
 }
 
 //go:generate mockery --exported --name=labelRepository --output=automock --outpkg=automock --case=underscore
@@ -46,6 +48,7 @@ type labelRepository interface {
 	Delete(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, key string) error
 	DeleteAll(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string) error
 	DeleteByKeyNegationPattern(ctx context.Context, tenant string, objectType model.LabelableObject, objectID string, labelKeyPattern string) error
+	ListGlobalByKeyAndObjects(ctx context.Context, objectType model.LabelableObject, objectIDs []string, key string) ([]*model.Label, error)
 }
 
 //go:generate mockery --exported --name=labelUpsertService --output=automock --outpkg=automock --case=underscore
@@ -70,6 +73,7 @@ type tenantService interface {
 	GetTenantByExternalID(ctx context.Context, id string) (*model.BusinessTenantMapping, error)
 	CreateManyIfNotExists(ctx context.Context, tenantInputs ...model.BusinessTenantMappingInput) error
 	GetTenantByID(ctx context.Context, id string) (*model.BusinessTenantMapping, error)
+	GetLowestOwnerForResource(ctx context.Context, resourceType resource.Type, objectID string) (string, error)
 }
 
 //go:generate mockery --exported --name=uidService --output=automock --outpkg=automock --case=underscore
@@ -370,6 +374,15 @@ func (s *service) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// DeleteGlobal deletes the runtime with the given ID regardless of the tenant
+func (s *service) DeleteGlobal(ctx context.Context, id string) error {
+	if err := s.repo.DeleteGlobal(ctx, id); err != nil {
+		return errors.Wrapf(err, "while deleting Runtime")
+	}
+
+	return nil
+}
+
 // SetLabel missing godoc
 func (s *service) SetLabel(ctx context.Context, labelInput *model.LabelInput) error {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
@@ -437,6 +450,19 @@ func (s *service) GetLabel(ctx context.Context, runtimeID string, key string) (*
 	return label, nil
 }
 
+// GetLabelGlobal returns the label of the runtime with the given key regardless of the tenant
+func (s *service) GetLabelGlobal(ctx context.Context, runtimeID string, key string) (*model.Label, error) {
+	labels, err := s.labelRepo.ListGlobalByKeyAndObjects(ctx, model.RuntimeLabelableObject, []string{runtimeID}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting label for Runtime")
+	}
+	if len(labels) == 0 {
+		return nil, apperrors.NewNotFoundError(resource.Label, key)
+	}
+
+	return labels[0], nil
+}
+
 // ListLabels missing godoc
 func (s *service) ListLabels(ctx context.Context, runtimeID string) (map[string]*model.Label, error) {
 	rtmTenant, err := tenant.LoadFromContext(ctx)
@@ -15,6 +15,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
@@ -1100,6 +1101,39 @@ func TestService_Delete(t *testing.T) {
 	})
 }
 
+func TestService_DeleteGlobal(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.RuntimeRepository{}
+		repo.On("DeleteGlobal", ctx, "foo").Return(nil).Once()
+		svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, "", "")
+
+		// WHEN
+		err := svc.DeleteGlobal(ctx, "foo")
+
+		// then
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Returns error when runtime deletion failed", func(t *testing.T) {
+		repo := &automock.RuntimeRepository{}
+		repo.On("DeleteGlobal", ctx, "foo").Return(testErr).Once()
+		svc := runtime.NewService(repo, nil, nil, nil, nil, nil, nil, "", "")
+
+		// WHEN
+		err := svc.DeleteGlobal(ctx, "foo")
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+}
+
 func TestService_Get(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
@@ -1597,6 +1631,71 @@ func TestService_GetLabel(t *testing.T) {
 	})
 }
 
+func TestService_GetLabelGlobal(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	ctx := context.TODO()
+	runtimeID := "foo"
+	labelKey := "key"
+	modelLabel := &model.Label{Key: labelKey, Value: "value", ObjectID: runtimeID, ObjectType: model.RuntimeLabelableObject}
+
+	testCases := []struct {
+		Name               string
+		LabelRepositoryFn  func() *automock.LabelRepository
+		ExpectedLabel      *model.Label
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListGlobalByKeyAndObjects", ctx, model.RuntimeLabelableObject, []string{runtimeID}, labelKey).Return([]*model.Label{modelLabel}, nil).Once()
+				return repo
+			},
+			ExpectedLabel: modelLabel,
+		},
+		{
+			Name: "Returns not found error when the label does not exist",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListGlobalByKeyAndObjects", ctx, model.RuntimeLabelableObject, []string{runtimeID}, labelKey).Return([]*model.Label{}, nil).Once()
+				return repo
+			},
+			ExpectedErrMessage: apperrors.NewNotFoundError(resource.Label, labelKey).Error(),
+		},
+		{
+			Name: "Returns error when listing labels failed",
+			LabelRepositoryFn: func() *automock.LabelRepository {
+				repo := &automock.LabelRepository{}
+				repo.On("ListGlobalByKeyAndObjects", ctx, model.RuntimeLabelableObject, []string{runtimeID}, labelKey).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			labelRepo := testCase.LabelRepositoryFn()
+			svc := runtime.NewService(nil, labelRepo, nil, nil, nil, nil, nil, "", "")
+
+			// WHEN
+			label, err := svc.GetLabelGlobal(ctx, runtimeID, labelKey)
+
+			// then
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+			assert.Equal(t, testCase.ExpectedLabel, label)
+
+			labelRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestService_ListLabels(t *testing.T) {
 	// GIVEN
 	tnt := "tenant"
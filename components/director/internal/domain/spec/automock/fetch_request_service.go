@@ -14,13 +14,29 @@ type FetchRequestService struct {
 	mock.Mock
 }
 
-// HandleSpec provides a mock function with given fields: ctx, fr
-func (_m *FetchRequestService) HandleSpec(ctx context.Context, fr *model.FetchRequest) *string {
-	ret := _m.Called(ctx, fr)
+// HandleSpec provides a mock function with given fields: ctx, fr, validator
+func (_m *FetchRequestService) HandleSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string {
+	ret := _m.Called(ctx, fr, validator)
 
 	var r0 *string
-	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest) *string); ok {
-		r0 = rf(ctx, fr)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest, model.SpecValidator) *string); ok {
+		r0 = rf(ctx, fr, validator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*string)
+		}
+	}
+
+	return r0
+}
+
+// RefreshSpec provides a mock function with given fields: ctx, fr, validator
+func (_m *FetchRequestService) RefreshSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string {
+	ret := _m.Called(ctx, fr, validator)
+
+	var r0 *string
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FetchRequest, model.SpecValidator) *string); ok {
+		r0 = rf(ctx, fr, validator)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*string)
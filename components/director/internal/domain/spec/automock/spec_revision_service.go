@@ -0,0 +1,29 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpecRevisionService is an autogenerated mock type for the SpecRevisionService type
+type SpecRevisionService struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, spec, source
+func (_m *SpecRevisionService) Record(ctx context.Context, spec *model.Spec, source model.SpecRevisionSource) error {
+	ret := _m.Called(ctx, spec, source)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Spec, model.SpecRevisionSource) error); ok {
+		r0 = rf(ctx, spec, source)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
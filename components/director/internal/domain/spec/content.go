@@ -0,0 +1,331 @@
+package spec
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/pkg/errors"
+)
+
+const (
+	edmxElement         = "Edmx"
+	dataServicesElement = "DataServices"
+	edmxNamespaceV4     = "http://docs.oasis-open.org/odata/ns/edmx"
+	edmxNamespaceV2     = "http://schemas.microsoft.com/ado/2007/06/edmx"
+)
+
+// contentFormat is the syntax of the content of a Specification, regardless of how its SpecFormat is named.
+type contentFormat string
+
+const (
+	contentFormatJSON    contentFormat = "JSON"
+	contentFormatYAML    contentFormat = "YAML"
+	contentFormatXML     contentFormat = "XML"
+	contentFormatUnknown contentFormat = ""
+)
+
+// ValidateData validates that the data can be parsed in the format of the Specification, and that it is a valid document of its type.
+// OpenAPI 2 and 3, OData EDMX and CSDL JSON, and AsyncAPI 2 documents are checked for their required fields,
+// while the documents of the rest of the types are only checked to be well-formed.
+func ValidateData(spec *model.Spec, data string) error {
+	var err error
+	switch {
+	case spec.APIType != nil:
+		err = validateAPIData(*spec.APIType, spec.Format, data)
+	case spec.EventType != nil:
+		err = validateEventData(*spec.EventType, spec.Format, data)
+	default:
+		_, err = parse(spec.Format, data)
+	}
+
+	if err != nil {
+		return apperrors.NewInvalidDataError("Invalid %s specification: %s", specType(spec), err.Error())
+	}
+	return nil
+}
+
+// ConvertData converts the data of a Specification between the YAML and JSON formats.
+// The data is returned unchanged if both formats are the same.
+func ConvertData(data string, from, to model.SpecFormat) (string, error) {
+	fromFormat, toFormat := contentFormatOf(from), contentFormatOf(to)
+	if fromFormat == toFormat {
+		return data, nil
+	}
+
+	var converted []byte
+	var err error
+	switch {
+	case fromFormat == contentFormatYAML && toFormat == contentFormatJSON:
+		converted, err = yaml.YAMLToJSON([]byte(data))
+	case fromFormat == contentFormatJSON && toFormat == contentFormatYAML:
+		converted, err = yaml.JSONToYAML([]byte(data))
+	default:
+		return "", apperrors.NewInvalidDataError("Conversion of specification from %s to %s format is not supported", from, to)
+	}
+
+	if err != nil {
+		return "", apperrors.NewInvalidDataError("Specification cannot be converted from %s to %s format: %s", from, to, err.Error())
+	}
+	return string(converted), nil
+}
+
+func validateAPIData(apiType model.APISpecType, format model.SpecFormat, data string) error {
+	switch apiType {
+	case model.APISpecTypeOpenAPI:
+		return validateOpenAPI(format, data, "")
+	case model.APISpecTypeOpenAPIV2:
+		return validateOpenAPI(format, data, "2")
+	case model.APISpecTypeOpenAPIV3:
+		return validateOpenAPI(format, data, "3")
+	case model.APISpecTypeOdata, model.APISpecTypeEDMX, model.APISpecTypeCsdl:
+		return validateOData(format, data)
+	}
+
+	_, err := parse(format, data)
+	return err
+}
+
+func validateEventData(eventType model.EventSpecType, format model.SpecFormat, data string) error {
+	switch eventType {
+	case model.EventSpecTypeAsyncAPI, model.EventSpecTypeAsyncAPIV2:
+		return validateAsyncAPI(format, data)
+	}
+
+	_, err := parse(format, data)
+	return err
+}
+
+func validateOpenAPI(format model.SpecFormat, data string, majorVersion string) error {
+	doc, err := parseDocument(format, data)
+	if err != nil {
+		return err
+	}
+
+	var version string
+	if swagger, ok := doc["swagger"]; ok {
+		if v := scalar(swagger); v != "2.0" && v != "2" {
+			return errors.Errorf("unsupported swagger version %q, expected 2.0", v)
+		}
+		version = "2"
+	} else if openapi, ok := doc["openapi"]; ok {
+		if v := scalar(openapi); !strings.HasPrefix(v, "3.") {
+			return errors.Errorf("unsupported openapi version %q, expected 3.x", v)
+		}
+		version = "3"
+	} else {
+		return errors.New("missing swagger or openapi version")
+	}
+
+	if len(majorVersion) > 0 && version != majorVersion {
+		return errors.Errorf("OpenAPI %s document does not match the OpenAPI %s specification type", version, majorVersion)
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+
+	// Since OpenAPI 3.1 the paths are optional, as long as there are either components or webhooks.
+	if version == "3" && !strings.HasPrefix(scalar(doc["openapi"]), "3.0") {
+		return requireObject(doc, "paths", "components", "webhooks")
+	}
+	return requireObject(doc, "paths")
+}
+
+func validateAsyncAPI(format model.SpecFormat, data string) error {
+	doc, err := parseDocument(format, data)
+	if err != nil {
+		return err
+	}
+
+	asyncapi, ok := doc["asyncapi"]
+	if !ok {
+		return errors.New("missing asyncapi version")
+	}
+	if v := scalar(asyncapi); !strings.HasPrefix(v, "2.") {
+		return errors.Errorf("unsupported asyncapi version %q, expected 2.x", v)
+	}
+
+	if err := validateInfo(doc); err != nil {
+		return err
+	}
+	return requireObject(doc, "channels")
+}
+
+func validateOData(format model.SpecFormat, data string) error {
+	switch contentFormatOf(format) {
+	case contentFormatXML:
+		return validateEDMX(data)
+	case contentFormatJSON:
+		doc, err := parseDocument(format, data)
+		if err != nil {
+			return err
+		}
+		if _, ok := doc["$Version"].(string); !ok {
+			return errors.New("missing $Version of the CSDL JSON document")
+		}
+		return nil
+	}
+	return errors.Errorf("OData specification cannot be in %s format", format)
+}
+
+// validateEDMX validates that the data is a well-formed XML document, whose root is an EDMX element with data services.
+func validateEDMX(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	hasRoot, hasDataServices := false, false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "while parsing XML")
+		}
+
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if !hasRoot {
+			hasRoot = true
+			if element.Name.Local != edmxElement || (element.Name.Space != edmxNamespaceV4 && element.Name.Space != edmxNamespaceV2) {
+				return errors.Errorf("root element must be %s in the %s or %s namespace", edmxElement, edmxNamespaceV4, edmxNamespaceV2)
+			}
+		}
+		if element.Name.Local == dataServicesElement {
+			hasDataServices = true
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("missing root element")
+	}
+	if !hasDataServices {
+		return errors.Errorf("missing %s element", dataServicesElement)
+	}
+	return nil
+}
+
+func validateInfo(doc map[string]interface{}) error {
+	info, ok := doc["info"].(map[string]interface{})
+	if !ok {
+		return errors.New("missing info object")
+	}
+	for _, field := range []string{"title", "version"} {
+		if len(scalar(info[field])) == 0 {
+			return errors.Errorf("missing info.%s", field)
+		}
+	}
+	return nil
+}
+
+// requireObject validates that at least one of the fields is present in the document and is an object.
+func requireObject(doc map[string]interface{}, fields ...string) error {
+	for _, field := range fields {
+		if _, ok := doc[field].(map[string]interface{}); ok {
+			return nil
+		}
+	}
+	return errors.Errorf("missing %s object", strings.Join(fields, " or "))
+}
+
+// parseDocument parses JSON or YAML data, which must be an object.
+func parseDocument(format model.SpecFormat, data string) (map[string]interface{}, error) {
+	switch contentFormatOf(format) {
+	case contentFormatJSON, contentFormatYAML:
+	default:
+		return nil, errors.Errorf("specification cannot be in %s format", format)
+	}
+
+	parsed, err := parse(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, ok := parsed.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("document must be an object")
+	}
+	return doc, nil
+}
+
+// parse parses the data according to its format. Data in formats, which are not JSON, YAML, or XML, is not parsed.
+func parse(format model.SpecFormat, data string) (interface{}, error) {
+	var parsed interface{}
+	switch contentFormatOf(format) {
+	case contentFormatJSON:
+		if err := json.Unmarshal([]byte(data), &parsed); err != nil {
+			return nil, errors.Wrap(err, "while parsing JSON")
+		}
+	case contentFormatYAML:
+		if err := yaml.Unmarshal([]byte(data), &parsed); err != nil {
+			return nil, errors.Wrap(err, "while parsing YAML")
+		}
+	case contentFormatXML:
+		if err := parseXML(data); err != nil {
+			return nil, errors.Wrap(err, "while parsing XML")
+		}
+	}
+	return parsed, nil
+}
+
+func parseXML(data string) error {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+	hasRoot := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := token.(xml.StartElement); ok {
+			hasRoot = true
+		}
+	}
+
+	if !hasRoot {
+		return errors.New("missing root element")
+	}
+	return nil
+}
+
+func contentFormatOf(format model.SpecFormat) contentFormat {
+	switch format {
+	case model.SpecFormatJSON, model.SpecFormatApplicationJSON:
+		return contentFormatJSON
+	case model.SpecFormatYaml, model.SpecFormatTextYAML:
+		return contentFormatYAML
+	case model.SpecFormatXML, model.SpecFormatApplicationXML:
+		return contentFormatXML
+	}
+	return contentFormatUnknown
+}
+
+// scalar returns the string representation of a scalar value of a parsed document, as versions are often not quoted in YAML documents.
+func scalar(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	}
+	return ""
+}
+
+func specType(spec *model.Spec) string {
+	switch {
+	case spec.APIType != nil:
+		return string(*spec.APIType)
+	case spec.EventType != nil:
+		return string(*spec.EventType)
+	}
+	return string(spec.Format)
+}
@@ -0,0 +1,240 @@
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/spec"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	openAPIV3JSON = `{"openapi":"3.0.2","info":{"title":"Test API","version":"1.0.0"},"paths":{}}`
+	openAPIV2YAML = `swagger: "2.0"
+info:
+  title: Test API
+  version: 1.0
+paths: {}
+`
+	edmxXML = `<?xml version="1.0" encoding="utf-8"?>
+<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">
+  <edmx:DataServices>
+    <Schema Namespace="Test" xmlns="http://docs.oasis-open.org/odata/ns/edm"/>
+  </edmx:DataServices>
+</edmx:Edmx>`
+	asyncAPIYAML = `asyncapi: 2.0.0
+info:
+  title: Test Events
+  version: 1.0.0
+channels: {}
+`
+)
+
+func TestValidateData(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Spec        *model.Spec
+		Data        string
+		ExpectedErr string
+	}{
+		{
+			Name: "Valid OpenAPI 3 JSON",
+			Spec: fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatJSON),
+			Data: openAPIV3JSON,
+		},
+		{
+			Name: "Valid OpenAPI 2 YAML",
+			Spec: fixSpecWithAPIType(model.APISpecTypeOpenAPIV2, model.SpecFormatTextYAML),
+			Data: openAPIV2YAML,
+		},
+		{
+			Name: "Valid OpenAPI 3.1 without paths",
+			Spec: fixSpecWithAPIType(model.APISpecTypeOpenAPIV3, model.SpecFormatApplicationJSON),
+			Data: `{"openapi":"3.1.0","info":{"title":"Test API","version":"1.0.0"},"webhooks":{}}`,
+		},
+		{
+			Name:        "OpenAPI which cannot be parsed",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatJSON),
+			Data:        `{"openapi":`,
+			ExpectedErr: "Invalid OPEN_API specification: while parsing JSON",
+		},
+		{
+			Name:        "OpenAPI without version",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatJSON),
+			Data:        `{"info":{"title":"Test API","version":"1.0.0"},"paths":{}}`,
+			ExpectedErr: "missing swagger or openapi version",
+		},
+		{
+			Name:        "OpenAPI 2 document for OpenAPI 3 type",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPIV3, model.SpecFormatTextYAML),
+			Data:        openAPIV2YAML,
+			ExpectedErr: "OpenAPI 2 document does not match the OpenAPI 3 specification type",
+		},
+		{
+			Name:        "OpenAPI without info title",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatJSON),
+			Data:        `{"openapi":"3.0.2","info":{"version":"1.0.0"},"paths":{}}`,
+			ExpectedErr: "missing info.title",
+		},
+		{
+			Name:        "OpenAPI 3.0 without paths",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatJSON),
+			Data:        `{"openapi":"3.0.2","info":{"title":"Test API","version":"1.0.0"}}`,
+			ExpectedErr: "missing paths object",
+		},
+		{
+			Name:        "OpenAPI in XML format",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOpenAPI, model.SpecFormatXML),
+			Data:        edmxXML,
+			ExpectedErr: "specification cannot be in XML format",
+		},
+		{
+			Name: "Valid OData EDMX",
+			Spec: fixSpecWithAPIType(model.APISpecTypeOdata, model.SpecFormatXML),
+			Data: edmxXML,
+		},
+		{
+			Name: "Valid OData CSDL JSON",
+			Spec: fixSpecWithAPIType(model.APISpecTypeCsdl, model.SpecFormatApplicationJSON),
+			Data: `{"$Version":"4.0"}`,
+		},
+		{
+			Name:        "OData which is not XML",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOdata, model.SpecFormatXML),
+			Data:        "odata",
+			ExpectedErr: "Invalid ODATA specification: missing root element",
+		},
+		{
+			Name:        "OData with wrong root element",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeEDMX, model.SpecFormatApplicationXML),
+			Data:        `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/"/>`,
+			ExpectedErr: "root element must be Edmx",
+		},
+		{
+			Name:        "OData without data services",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOdata, model.SpecFormatXML),
+			Data:        `<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx"></edmx:Edmx>`,
+			ExpectedErr: "missing DataServices element",
+		},
+		{
+			Name:        "OData in YAML format",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeOdata, model.SpecFormatYaml),
+			Data:        "odata: true",
+			ExpectedErr: "OData specification cannot be in YAML format",
+		},
+		{
+			Name: "Well-formed WSDL",
+			Spec: fixSpecWithAPIType(model.APISpecTypeWsdlV1, model.SpecFormatApplicationXML),
+			Data: `<definitions xmlns="http://schemas.xmlsoap.org/wsdl/"/>`,
+		},
+		{
+			Name:        "Malformed WSDL",
+			Spec:        fixSpecWithAPIType(model.APISpecTypeWsdlV1, model.SpecFormatApplicationXML),
+			Data:        `<definitions>`,
+			ExpectedErr: "while parsing XML",
+		},
+		{
+			Name: "Custom spec in plain text format",
+			Spec: fixSpecWithAPIType(model.APISpecTypeCustom, model.SpecFormatPlainText),
+			Data: "custom",
+		},
+		{
+			Name: "Valid AsyncAPI YAML",
+			Spec: fixSpecWithEventType(model.EventSpecTypeAsyncAPIV2, model.SpecFormatTextYAML),
+			Data: asyncAPIYAML,
+		},
+		{
+			Name:        "AsyncAPI 1",
+			Spec:        fixSpecWithEventType(model.EventSpecTypeAsyncAPI, model.SpecFormatJSON),
+			Data:        `{"asyncapi":"1.2.0"}`,
+			ExpectedErr: `Invalid ASYNC_API specification: unsupported asyncapi version "1.2.0", expected 2.x`,
+		},
+		{
+			Name:        "AsyncAPI without channels",
+			Spec:        fixSpecWithEventType(model.EventSpecTypeAsyncAPI, model.SpecFormatJSON),
+			Data:        `{"asyncapi":"2.0.0","info":{"title":"Test Events","version":"1.0.0"}}`,
+			ExpectedErr: "missing channels object",
+		},
+		{
+			Name:        "AsyncAPI which is not an object",
+			Spec:        fixSpecWithEventType(model.EventSpecTypeAsyncAPI, model.SpecFormatYaml),
+			Data:        "- asyncapi",
+			ExpectedErr: "document must be an object",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := spec.ValidateData(testCase.Spec, testCase.Data)
+
+			if len(testCase.ExpectedErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConvertData(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Data           string
+		From           model.SpecFormat
+		To             model.SpecFormat
+		ExpectedResult string
+		ExpectedErr    string
+	}{
+		{
+			Name:           "YAML to JSON",
+			Data:           asyncAPIYAML,
+			From:           model.SpecFormatYaml,
+			To:             model.SpecFormatJSON,
+			ExpectedResult: `{"asyncapi":"2.0.0","channels":{},"info":{"title":"Test Events","version":"1.0.0"}}`,
+		},
+		{
+			Name:           "JSON to YAML",
+			Data:           `{"openapi":"3.0.2","paths":{}}`,
+			From:           model.SpecFormatApplicationJSON,
+			To:             model.SpecFormatYaml,
+			ExpectedResult: "openapi: 3.0.2\npaths: {}\n",
+		},
+		{
+			Name:           "Same format",
+			Data:           openAPIV2YAML,
+			From:           model.SpecFormatTextYAML,
+			To:             model.SpecFormatYaml,
+			ExpectedResult: openAPIV2YAML,
+		},
+		{
+			Name:        "XML to JSON",
+			Data:        edmxXML,
+			From:        model.SpecFormatXML,
+			To:          model.SpecFormatJSON,
+			ExpectedErr: "Conversion of specification from XML to JSON format is not supported",
+		},
+		{
+			Name:        "Malformed JSON",
+			Data:        `{"openapi":`,
+			From:        model.SpecFormatJSON,
+			To:          model.SpecFormatYaml,
+			ExpectedErr: "Specification cannot be converted from JSON to YAML format",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			result, err := spec.ConvertData(testCase.Data, testCase.From, testCase.To)
+
+			if len(testCase.ExpectedErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, result)
+			}
+		})
+	}
+}
@@ -297,3 +297,19 @@ func fixEventSpecEntityWithIDs(id, eventDefID string) spec.Entity {
 		EventSpecFormat: repo.NewValidNullableString("JSON"),
 	}
 }
+
+func fixSpecWithAPIType(apiType model.APISpecType, format model.SpecFormat) *model.Spec {
+	return &model.Spec{
+		ObjectType: model.APISpecReference,
+		APIType:    &apiType,
+		Format:     format,
+	}
+}
+
+func fixSpecWithEventType(eventType model.EventSpecType, format model.SpecFormat) *model.Spec {
+	return &model.Spec{
+		ObjectType: model.EventSpecReference,
+		EventType:  &eventType,
+		Format:     format,
+	}
+}
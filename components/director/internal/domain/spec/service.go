@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -43,7 +44,14 @@ type UIDService interface {
 // FetchRequestService missing godoc
 //go:generate mockery --name=FetchRequestService --output=automock --outpkg=automock --case=underscore
 type FetchRequestService interface {
-	HandleSpec(ctx context.Context, fr *model.FetchRequest) *string
+	HandleSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string
+	RefreshSpec(ctx context.Context, fr *model.FetchRequest, validator model.SpecValidator) *string
+}
+
+// SpecRevisionService is responsible for recording the revisions of the Specifications.
+//go:generate mockery --name=SpecRevisionService --output=automock --outpkg=automock --case=underscore
+type SpecRevisionService interface {
+	Record(ctx context.Context, spec *model.Spec, source model.SpecRevisionSource) error
 }
 
 type service struct {
@@ -51,16 +59,18 @@ type service struct {
 	fetchRequestRepo    FetchRequestRepository
 	uidService          UIDService
 	fetchRequestService FetchRequestService
+	revisionService     SpecRevisionService
 	timestampGen        timestamp.Generator
 }
 
 // NewService missing godoc
-func NewService(repo SpecRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService) *service {
+func NewService(repo SpecRepository, fetchRequestRepo FetchRequestRepository, uidService UIDService, fetchRequestService FetchRequestService, revisionService SpecRevisionService) *service {
 	return &service{
 		repo:                repo,
 		fetchRequestRepo:    fetchRequestRepo,
 		uidService:          uidService,
 		fetchRequestService: fetchRequestService,
+		revisionService:     revisionService,
 		timestampGen:        timestamp.DefaultGenerator,
 	}
 }
@@ -120,6 +130,12 @@ func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecIn
 		return "", err
 	}
 
+	if spec.Data != nil {
+		if err = ValidateData(spec, *spec.Data); err != nil {
+			return "", err
+		}
+	}
+
 	if err = s.repo.Create(ctx, tnt, spec); err != nil {
 		return "", errors.Wrapf(err, "while creating spec for %q with id %q", objectType, objectID)
 	}
@@ -130,13 +146,17 @@ func (s *service) CreateByReferenceObjectID(ctx context.Context, in model.SpecIn
 			return "", errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr, specValidator(spec))
 
 		if err = s.repo.Update(ctx, tnt, spec); err != nil {
 			return "", errors.Wrapf(err, "while updating %s Specification with id %q", objectType, id)
 		}
 	}
 
+	if err = s.revisionService.Record(ctx, spec, specrevision.LoadSourceFromContext(ctx)); err != nil {
+		return "", err
+	}
+
 	return id, nil
 }
 
@@ -160,20 +180,26 @@ func (s *service) UpdateByReferenceObjectID(ctx context.Context, id string, in m
 		return err
 	}
 
+	if spec.Data != nil {
+		if err = ValidateData(spec, *spec.Data); err != nil {
+			return err
+		}
+	}
+
 	if in.Data == nil && in.FetchRequest != nil {
 		fr, err := s.createFetchRequest(ctx, tnt, *in.FetchRequest, id, objectType)
 		if err != nil {
 			return errors.Wrapf(err, "while creating FetchRequest for %s Specification with id %q", objectType, id)
 		}
 
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr)
+		spec.Data = s.fetchRequestService.HandleSpec(ctx, fr, specValidator(spec))
 	}
 
 	if err = s.repo.Update(ctx, tnt, spec); err != nil {
 		return errors.Wrapf(err, "while updating %s Specification with id %q", objectType, id)
 	}
 
-	return nil
+	return s.revisionService.Record(ctx, spec, specrevision.LoadSourceFromContext(ctx))
 }
 
 // DeleteByReferenceObjectID missing godoc
@@ -219,16 +245,55 @@ func (s *service) RefetchSpec(ctx context.Context, id string, objectType model.S
 	}
 
 	if fetchRequest != nil {
-		spec.Data = s.fetchRequestService.HandleSpec(ctx, fetchRequest)
+		spec.Data = s.fetchRequestService.HandleSpec(ctx, fetchRequest, specValidator(spec))
 	}
 
 	if err = s.repo.Update(ctx, tnt, spec); err != nil {
 		return nil, errors.Wrapf(err, "while updating Specification with id %q", id)
 	}
 
+	if err = s.revisionService.Record(ctx, spec, model.SpecRevisionSourceRefetch); err != nil {
+		return nil, err
+	}
+
 	return spec, nil
 }
 
+// RefreshSpec re-fetches the Specification with the given ID with a conditional request from its FetchRequest.
+// The Specification is updated only if its content has changed, and true is returned in that case.
+func (s *service) RefreshSpec(ctx context.Context, id string, objectType model.SpecReferenceObjectType) (bool, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	spec, err := s.repo.GetByID(ctx, tnt, id, objectType)
+	if err != nil {
+		return false, err
+	}
+
+	fetchRequest, err := s.fetchRequestRepo.GetByReferenceObjectID(ctx, tnt, getFetchRequestObjectTypeBySpecObjectType(objectType), id)
+	if err != nil {
+		return false, errors.Wrapf(err, "while getting FetchRequest for Specification with id %q", id)
+	}
+
+	data := s.fetchRequestService.RefreshSpec(ctx, fetchRequest, specValidator(spec))
+	if data == nil || (spec.Data != nil && *spec.Data == *data) {
+		return false, nil
+	}
+
+	spec.Data = data
+	if err = s.repo.Update(ctx, tnt, spec); err != nil {
+		return false, errors.Wrapf(err, "while updating Specification with id %q", id)
+	}
+
+	if err = s.revisionService.Record(ctx, spec, model.SpecRevisionSourceRefresh); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 // GetFetchRequest missing godoc
 func (s *service) GetFetchRequest(ctx context.Context, specID string, objectType model.SpecReferenceObjectType) (*model.FetchRequest, error) {
 	tnt, err := tenant.LoadFromContext(ctx)
@@ -267,6 +332,13 @@ func (s *service) createFetchRequest(ctx context.Context, tenant string, in mode
 	return fr, nil
 }
 
+// specValidator returns a validator of the content fetched for the Specification.
+func specValidator(spec *model.Spec) model.SpecValidator {
+	return func(data string) error {
+		return ValidateData(spec, data)
+	}
+}
+
 func getFetchRequestObjectTypeBySpecObjectType(specObjectType model.SpecReferenceObjectType) model.FetchRequestReferenceObjectType {
 	switch specObjectType {
 	case model.APISpecReference:
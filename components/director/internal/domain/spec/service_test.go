@@ -12,8 +12,10 @@ import (
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,7 +64,7 @@ func TestService_ListByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, nil)
 
 			// WHEN
 			docs, err := svc.ListByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -80,7 +82,7 @@ func TestService_ListByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -134,7 +136,7 @@ func TestService_ListByReferenceObjectIDs(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, nil)
 
 			// WHEN
 			specifications, err := svc.ListByReferenceObjectIDs(ctx, model.APISpecReference, apiIDs)
@@ -152,7 +154,7 @@ func TestService_ListByReferenceObjectIDs(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.ListByReferenceObjectIDs(context.TODO(), model.APISpecReference, apiIDs)
 		// THEN
@@ -206,7 +208,7 @@ func TestService_DeleteByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, nil)
 
 			// WHEN
 			err := svc.DeleteByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -223,7 +225,7 @@ func TestService_DeleteByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.DeleteByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -286,7 +288,7 @@ func TestService_GetByReferenceObjectID(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, nil)
 
 			// WHEN
 			docs, err := svc.GetByReferenceObjectID(ctx, model.APISpecReference, apiID)
@@ -304,7 +306,7 @@ func TestService_GetByReferenceObjectID(t *testing.T) {
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetByReferenceObjectID(context.TODO(), model.APISpecReference, apiID)
 		// THEN
@@ -348,6 +350,7 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 		FetchRequestRepoFn    func() *automock.FetchRequestRepository
 		UIDServiceFn          func() *automock.UIDService
 		FetchRequestServiceFn func() *automock.FetchRequestService
+		RevisionServiceFn     func() *automock.SpecRevisionService
 		Input                 model.SpecInput
 		ExpectedErr           error
 	}{
@@ -372,7 +375,12 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(nil)
+				return svc
+			},
+			RevisionServiceFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, specModel, model.SpecRevisionSourceManual).Return(nil).Once()
 				return svc
 			},
 			Input:       *specInputWithFR,
@@ -400,12 +408,36 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&specData)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&specData)
+				return svc
+			},
+			RevisionServiceFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, fixModelAPISpec(), model.SpecRevisionSourceManual).Return(nil).Once()
 				return svc
 			},
 			Input:       *specInputWithFR,
 			ExpectedErr: nil,
 		},
+		{
+			Name: "Error - Invalid Spec data",
+			RepositoryFn: func() *automock.SpecRepository {
+				return &automock.SpecRepository{}
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Once()
+				return svc
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			Input:       *fixModelAPISpecInput(),
+			ExpectedErr: errors.New("Invalid ODATA specification"),
+		},
 		{
 			Name: "Error - Spec Creation",
 			RepositoryFn: func() *automock.SpecRepository {
@@ -471,7 +503,38 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(nil)
+				return svc
+			},
+			Input:       *specInputWithFR,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - Revision Recording",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("Create", ctx, tenant, specModel).Return(nil).Once()
+				repo.On("Update", ctx, tenant, fixModelAPISpec()).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("Create", ctx, tenant, fr).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(specID).Twice()
+				return svc
+			},
+			FetchRequestServiceFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&specData)
+				return svc
+			},
+			RevisionServiceFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, fixModelAPISpec(), model.SpecRevisionSourceManual).Return(testErr).Once()
 				return svc
 			},
 			Input:       *specInputWithFR,
@@ -487,7 +550,12 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			uidService := testCase.UIDServiceFn()
 			fetchRequestService := testCase.FetchRequestServiceFn()
 
-			svc := spec.NewService(repo, fetchRequestRepo, uidService, fetchRequestService)
+			revisionSvc := &automock.SpecRevisionService{}
+			if testCase.RevisionServiceFn != nil {
+				revisionSvc = testCase.RevisionServiceFn()
+			}
+
+			svc := spec.NewService(repo, fetchRequestRepo, uidService, fetchRequestService, revisionSvc)
 			svc.SetTimestampGen(func() time.Time {
 				return timestamp
 			})
@@ -506,10 +574,11 @@ func TestService_CreateByReferenceObjectID(t *testing.T) {
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
 			uidService.AssertExpectations(t)
+			revisionSvc.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.CreateByReferenceObjectID(context.TODO(), model.SpecInput{}, model.APISpecReference, apiID)
 		// THEN
@@ -553,6 +622,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 		FetchRequestRepoFn    func() *automock.FetchRequestRepository
 		UIDServiceFn          func() *automock.UIDService
 		FetchRequestServiceFn func() *automock.FetchRequestService
+		RevisionServiceFn     func() *automock.SpecRevisionService
 		Input                 model.SpecInput
 		InputID               string
 		ExpectedErr           error
@@ -579,7 +649,12 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&specData)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&specData)
+				return svc
+			},
+			RevisionServiceFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, fixModelAPISpec(), model.SpecRevisionSourceManual).Return(nil).Once()
 				return svc
 			},
 			InputID:     specID,
@@ -608,7 +683,7 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			},
 			FetchRequestServiceFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(nil)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(nil)
 				return svc
 			},
 			InputID:     specID,
@@ -698,7 +773,12 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			uidSvc := testCase.UIDServiceFn()
 			fetchRequestSvc := testCase.FetchRequestServiceFn()
 
-			svc := spec.NewService(repo, fetchRequestRepo, uidSvc, fetchRequestSvc)
+			revisionSvc := &automock.SpecRevisionService{}
+			if testCase.RevisionServiceFn != nil {
+				revisionSvc = testCase.RevisionServiceFn()
+			}
+
+			svc := spec.NewService(repo, fetchRequestRepo, uidSvc, fetchRequestSvc, revisionSvc)
 			svc.SetTimestampGen(func() time.Time { return timestamp })
 
 			// WHEN
@@ -715,10 +795,11 @@ func TestService_UpdateByReferenceObjectID(t *testing.T) {
 			repo.AssertExpectations(t)
 			fetchRequestRepo.AssertExpectations(t)
 			uidSvc.AssertExpectations(t)
+			revisionSvc.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.UpdateByReferenceObjectID(context.TODO(), "", model.SpecInput{}, model.APISpecReference, apiID)
 		// THEN
@@ -768,7 +849,7 @@ func TestService_Delete(t *testing.T) {
 			// GIVEN
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(repo, nil, nil, nil)
+			svc := spec.NewService(repo, nil, nil, nil, nil)
 
 			// WHEN
 			err := svc.Delete(ctx, testCase.InputID, model.APISpecReference)
@@ -786,7 +867,7 @@ func TestService_Delete(t *testing.T) {
 	}
 
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		err := svc.Delete(context.TODO(), "", model.APISpecReference)
 		// THEN
@@ -820,6 +901,7 @@ func TestService_RefetchSpec(t *testing.T) {
 		RepositoryFn       func() *automock.SpecRepository
 		FetchRequestRepoFn func() *automock.FetchRequestRepository
 		FetchRequestSvcFn  func() *automock.FetchRequestService
+		RevisionSvcFn      func() *automock.SpecRevisionService
 		ExpectedAPISpec    *model.Spec
 		ExpectedErr        error
 	}{
@@ -839,6 +921,11 @@ func TestService_RefetchSpec(t *testing.T) {
 			FetchRequestSvcFn: func() *automock.FetchRequestService {
 				return &automock.FetchRequestService{}
 			},
+			RevisionSvcFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, modelSpec, model.SpecRevisionSourceRefetch).Return(nil).Once()
+				return svc
+			},
 			ExpectedAPISpec: modelSpec,
 			ExpectedErr:     nil,
 		},
@@ -857,7 +944,12 @@ func TestService_RefetchSpec(t *testing.T) {
 			},
 			FetchRequestSvcFn: func() *automock.FetchRequestService {
 				svc := &automock.FetchRequestService{}
-				svc.On("HandleSpec", ctx, fr).Return(&dataBytes)
+				svc.On("HandleSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&dataBytes)
+				return svc
+			},
+			RevisionSvcFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, modelSpec, model.SpecRevisionSourceRefetch).Return(nil).Once()
 				return svc
 			},
 			ExpectedAPISpec: modelSpec,
@@ -926,7 +1018,12 @@ func TestService_RefetchSpec(t *testing.T) {
 			frRepo := testCase.FetchRequestRepoFn()
 			frSvc := testCase.FetchRequestSvcFn()
 
-			svc := spec.NewService(repo, frRepo, nil, frSvc)
+			revisionSvc := &automock.SpecRevisionService{}
+			if testCase.RevisionSvcFn != nil {
+				revisionSvc = testCase.RevisionSvcFn()
+			}
+
+			svc := spec.NewService(repo, frRepo, nil, frSvc, revisionSvc)
 
 			// WHEN
 			result, err := svc.RefetchSpec(ctx, specID, model.APISpecReference)
@@ -941,10 +1038,11 @@ func TestService_RefetchSpec(t *testing.T) {
 				assert.NoError(t, err)
 			}
 			repo.AssertExpectations(t)
+			revisionSvc.AssertExpectations(t)
 		})
 	}
 	t.Run("Error when tenant not in context", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.RefetchSpec(context.TODO(), "", model.APISpecReference)
 		// THEN
@@ -953,6 +1051,224 @@ func TestService_RefetchSpec(t *testing.T) {
 	})
 }
 
+func TestService_RefreshSpec(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tnt.SaveToContext(ctx, tenant, externalTenant)
+
+	currentData := "data"
+	newData := "new data"
+	fixSpec := func() *model.Spec {
+		data := currentData
+		return &model.Spec{
+			ID:   specID,
+			Data: &data,
+		}
+	}
+	updatedSpec := &model.Spec{
+		ID:   specID,
+		Data: &newData,
+	}
+
+	fr := &model.FetchRequest{
+		Status: &model.FetchRequestStatus{
+			Condition: model.FetchRequestStatusConditionSucceeded,
+			Timestamp: time.Now(),
+		},
+	}
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.SpecRepository
+		FetchRequestRepoFn func() *automock.FetchRequestRepository
+		FetchRequestSvcFn  func() *automock.FetchRequestService
+		RevisionSvcFn      func() *automock.SpecRevisionService
+		ExpectedUpdated    bool
+		ExpectedErr        error
+	}{
+		{
+			Name: "Success - spec is updated when its content has changed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				repo.On("Update", ctx, tenant, updatedSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(fr, nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("RefreshSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&newData).Once()
+				return svc
+			},
+			RevisionSvcFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, updatedSpec, model.SpecRevisionSourceRefresh).Return(nil).Once()
+				return svc
+			},
+			ExpectedUpdated: true,
+		},
+		{
+			Name: "Success - spec is not updated when its content has not changed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(fr, nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("RefreshSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(str.Ptr(currentData)).Once()
+				return svc
+			},
+			ExpectedUpdated: false,
+		},
+		{
+			Name: "Success - spec is not updated when it has not been modified or could not be fetched",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(fr, nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("RefreshSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(nil).Once()
+				return svc
+			},
+			ExpectedUpdated: false,
+		},
+		{
+			Name: "Get from repository error",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Get fetch request error",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(nil, testErr).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				return &automock.FetchRequestService{}
+			},
+			ExpectedErr: errors.Wrapf(testErr, "while getting FetchRequest for Specification with id %q", specID),
+		},
+		{
+			Name: "Error when updating Specification failed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				repo.On("Update", ctx, tenant, updatedSpec).Return(testErr).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(fr, nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("RefreshSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&newData).Once()
+				return svc
+			},
+			ExpectedErr: errors.Wrapf(testErr, "while updating Specification with id %q", specID),
+		},
+		{
+			Name: "Error when recording revision failed",
+			RepositoryFn: func() *automock.SpecRepository {
+				repo := &automock.SpecRepository{}
+				repo.On("GetByID", ctx, tenant, specID, model.APISpecReference).Return(fixSpec(), nil).Once()
+				repo.On("Update", ctx, tenant, updatedSpec).Return(nil).Once()
+				return repo
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("GetByReferenceObjectID", ctx, tenant, model.APISpecFetchRequestReference, specID).Return(fr, nil).Once()
+				return repo
+			},
+			FetchRequestSvcFn: func() *automock.FetchRequestService {
+				svc := &automock.FetchRequestService{}
+				svc.On("RefreshSpec", ctx, fr, mock.AnythingOfType("model.SpecValidator")).Return(&newData).Once()
+				return svc
+			},
+			RevisionSvcFn: func() *automock.SpecRevisionService {
+				svc := &automock.SpecRevisionService{}
+				svc.On("Record", ctx, updatedSpec, model.SpecRevisionSourceRefresh).Return(testErr).Once()
+				return svc
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			frRepo := testCase.FetchRequestRepoFn()
+			frSvc := testCase.FetchRequestSvcFn()
+
+			revisionSvc := &automock.SpecRevisionService{}
+			if testCase.RevisionSvcFn != nil {
+				revisionSvc = testCase.RevisionSvcFn()
+			}
+
+			svc := spec.NewService(repo, frRepo, nil, frSvc, revisionSvc)
+
+			// WHEN
+			updated, err := svc.RefreshSpec(ctx, specID, model.APISpecReference)
+
+			// then
+			assert.Equal(t, testCase.ExpectedUpdated, updated)
+
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.ExpectedErr.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			mock.AssertExpectationsForObjects(t, repo, frRepo, frSvc, revisionSvc)
+		})
+	}
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := spec.NewService(nil, nil, nil, nil, nil)
+		// WHEN
+		_, err := svc.RefreshSpec(context.TODO(), "", model.APISpecReference)
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
 func TestService_GetFetchRequest(t *testing.T) {
 	// GIVEN
 	ctx := context.TODO()
@@ -1062,7 +1378,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 			fetchRequestRepo := testCase.FetchRequestRepoFn()
-			svc := spec.NewService(repo, fetchRequestRepo, nil, nil)
+			svc := spec.NewService(repo, fetchRequestRepo, nil, nil, nil)
 
 			// WHEN
 			l, err := svc.GetFetchRequest(ctx, testCase.InputAPIDefID, model.APISpecReference)
@@ -1081,7 +1397,7 @@ func TestService_GetFetchRequest(t *testing.T) {
 		})
 	}
 	t.Run("Returns error on loading tenant", func(t *testing.T) {
-		svc := spec.NewService(nil, nil, nil, nil)
+		svc := spec.NewService(nil, nil, nil, nil, nil)
 		// WHEN
 		_, err := svc.GetFetchRequest(context.TODO(), "dd", model.APISpecReference)
 		assert.True(t, apperrors.IsCannotReadTenant(err))
@@ -1152,7 +1468,7 @@ func TestService_ListFetchRequestsByReferenceObjectIDs(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			repo := testCase.RepositoryFn()
 
-			svc := spec.NewService(nil, repo, nil, nil)
+			svc := spec.NewService(nil, repo, nil, nil, nil)
 
 			// WHEN
 			frs, err := svc.ListFetchRequestsByReferenceObjectIDs(ctx, tenant, specIDs, model.APISpecReference)
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	specrevision "github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+)
+
+// EntityConverter is an autogenerated mock type for the EntityConverter type
+type EntityConverter struct {
+	mock.Mock
+}
+
+// FromEntity provides a mock function with given fields: entity
+func (_m *EntityConverter) FromEntity(entity *specrevision.Entity) *model.SpecRevision {
+	ret := _m.Called(entity)
+
+	var r0 *model.SpecRevision
+	if rf, ok := ret.Get(0).(func(*specrevision.Entity) *model.SpecRevision); ok {
+		r0 = rf(entity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SpecRevision)
+		}
+	}
+
+	return r0
+}
+
+// ToEntity provides a mock function with given fields: in
+func (_m *EntityConverter) ToEntity(in *model.SpecRevision) *specrevision.Entity {
+	ret := _m.Called(in)
+
+	var r0 *specrevision.Entity
+	if rf, ok := ret.Get(0).(func(*model.SpecRevision) *specrevision.Entity); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*specrevision.Entity)
+		}
+	}
+
+	return r0
+}
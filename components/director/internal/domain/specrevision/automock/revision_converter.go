@@ -0,0 +1,47 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	graphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// RevisionConverter is an autogenerated mock type for the RevisionConverter type
+type RevisionConverter struct {
+	mock.Mock
+}
+
+// DiffToGraphQL provides a mock function with given fields: in
+func (_m *RevisionConverter) DiffToGraphQL(in *model.SpecDiff) *graphql.SpecDiff {
+	ret := _m.Called(in)
+
+	var r0 *graphql.SpecDiff
+	if rf, ok := ret.Get(0).(func(*model.SpecDiff) *graphql.SpecDiff); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*graphql.SpecDiff)
+		}
+	}
+
+	return r0
+}
+
+// MultipleToGraphQL provides a mock function with given fields: in
+func (_m *RevisionConverter) MultipleToGraphQL(in []*model.SpecRevision) []*graphql.SpecRevision {
+	ret := _m.Called(in)
+
+	var r0 []*graphql.SpecRevision
+	if rf, ok := ret.Get(0).(func([]*model.SpecRevision) []*graphql.SpecRevision); ok {
+		r0 = rf(in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*graphql.SpecRevision)
+		}
+	}
+
+	return r0
+}
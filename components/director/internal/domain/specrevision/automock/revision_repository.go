@@ -0,0 +1,137 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// RevisionRepository is an autogenerated mock type for the RevisionRepository type
+type RevisionRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, tenant, item
+func (_m *RevisionRepository) Create(ctx context.Context, tenant string, item *model.SpecRevision) error {
+	ret := _m.Called(ctx, tenant, item)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *model.SpecRevision) error); ok {
+		r0 = rf(ctx, tenant, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCreatedBefore provides a mock function with given fields: ctx, tenant, objectType, objectID, specType, createdAt
+func (_m *RevisionRepository) DeleteCreatedBefore(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID string, specType string, createdAt time.Time) error {
+	ret := _m.Called(ctx, tenant, objectType, objectID, specType, createdAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecReferenceObjectType, string, string, time.Time) error); ok {
+		r0 = rf(ctx, tenant, objectType, objectID, specType, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, tenant, id
+func (_m *RevisionRepository) GetByID(ctx context.Context, tenant string, id string) (*model.SpecRevision, error) {
+	ret := _m.Called(ctx, tenant, id)
+
+	var r0 *model.SpecRevision
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.SpecRevision); ok {
+		r0 = rf(ctx, tenant, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SpecRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatest provides a mock function with given fields: ctx, tenant, objectType, objectID, specType
+func (_m *RevisionRepository) GetLatest(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID string, specType string) (*model.SpecRevision, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectID, specType)
+
+	var r0 *model.SpecRevision
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecReferenceObjectType, string, string) *model.SpecRevision); ok {
+		r0 = rf(ctx, tenant, objectType, objectID, specType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SpecRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SpecReferenceObjectType, string, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectID, specType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByReferenceObjectID provides a mock function with given fields: ctx, tenant, objectType, objectID, specType
+func (_m *RevisionRepository) ListByReferenceObjectID(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID string, specType string) ([]*model.SpecRevision, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectID, specType)
+
+	var r0 []*model.SpecRevision
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecReferenceObjectType, string, string) []*model.SpecRevision); ok {
+		r0 = rf(ctx, tenant, objectType, objectID, specType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SpecRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SpecReferenceObjectType, string, string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectID, specType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByReferenceObjectIDs provides a mock function with given fields: ctx, tenant, objectType, objectIDs
+func (_m *RevisionRepository) ListByReferenceObjectIDs(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error) {
+	ret := _m.Called(ctx, tenant, objectType, objectIDs)
+
+	var r0 []*model.SpecRevision
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecReferenceObjectType, []string) []*model.SpecRevision); ok {
+		r0 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SpecRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SpecReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, tenant, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RevisionService is an autogenerated mock type for the RevisionService type
+type RevisionService struct {
+	mock.Mock
+}
+
+// Diff provides a mock function with given fields: ctx, fromID, toID
+func (_m *RevisionService) Diff(ctx context.Context, fromID string, toID string) (*model.SpecDiff, error) {
+	ret := _m.Called(ctx, fromID, toID)
+
+	var r0 *model.SpecDiff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.SpecDiff); ok {
+		r0 = rf(ctx, fromID, toID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SpecDiff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByReferenceObjectIDs provides a mock function with given fields: ctx, objectType, objectIDs
+func (_m *RevisionService) ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error) {
+	ret := _m.Called(ctx, objectType, objectIDs)
+
+	var r0 []*model.SpecRevision
+	if rf, ok := ret.Get(0).(func(context.Context, model.SpecReferenceObjectType, []string) []*model.SpecRevision); ok {
+		r0 = rf(ctx, objectType, objectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SpecRevision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.SpecReferenceObjectType, []string) error); ok {
+		r1 = rf(ctx, objectType, objectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,124 @@
+package specrevision
+
+import (
+	"database/sql"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+type converter struct{}
+
+// NewConverter returns a new converter for specification revisions.
+func NewConverter() *converter {
+	return &converter{}
+}
+
+// ToEntity converts the provided service-layer representation of a specification revision to the repository-layer one.
+func (c *converter) ToEntity(in *model.SpecRevision) *Entity {
+	if in == nil {
+		return nil
+	}
+
+	var apiDefID, eventDefID sql.NullString
+	switch in.ObjectType {
+	case model.APISpecReference:
+		apiDefID = repo.NewValidNullableString(in.ObjectID)
+	case model.EventSpecReference:
+		eventDefID = repo.NewValidNullableString(in.ObjectID)
+	}
+
+	return &Entity{
+		ID:            in.ID,
+		APIDefID:      apiDefID,
+		EventAPIDefID: eventDefID,
+		SpecType:      in.SpecType,
+		SpecFormat:    string(in.Format),
+		SpecData:      in.Data,
+		ContentHash:   in.ContentHash,
+		Source:        string(in.Source),
+		CreatedAt:     in.CreatedAt,
+	}
+}
+
+// FromEntity converts the provided repository-layer representation of a specification revision to the service-layer one.
+func (c *converter) FromEntity(entity *Entity) *model.SpecRevision {
+	if entity == nil {
+		return nil
+	}
+
+	objectType, objectID := model.EventSpecReference, entity.EventAPIDefID.String
+	if entity.APIDefID.Valid {
+		objectType, objectID = model.APISpecReference, entity.APIDefID.String
+	}
+
+	return &model.SpecRevision{
+		ID:          entity.ID,
+		ObjectType:  objectType,
+		ObjectID:    objectID,
+		SpecType:    entity.SpecType,
+		Format:      model.SpecFormat(entity.SpecFormat),
+		Data:        entity.SpecData,
+		ContentHash: entity.ContentHash,
+		Source:      model.SpecRevisionSource(entity.Source),
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+// ToGraphQL converts the provided service-layer representation of a specification revision to the graphql-layer one.
+func (c *converter) ToGraphQL(in *model.SpecRevision) *graphql.SpecRevision {
+	if in == nil {
+		return nil
+	}
+
+	data := graphql.CLOB(in.Data)
+	return &graphql.SpecRevision{
+		ID:          in.ID,
+		ContentHash: in.ContentHash,
+		Source:      graphql.SpecRevisionSource(in.Source),
+		CreatedAt:   graphql.Timestamp(in.CreatedAt),
+		Data:        &data,
+	}
+}
+
+// MultipleToGraphQL converts the provided service-layer representations of specification revisions to the graphql-layer ones.
+func (c *converter) MultipleToGraphQL(in []*model.SpecRevision) []*graphql.SpecRevision {
+	revisions := make([]*graphql.SpecRevision, 0, len(in))
+	for _, revision := range in {
+		if revision == nil {
+			continue
+		}
+		revisions = append(revisions, c.ToGraphQL(revision))
+	}
+	return revisions
+}
+
+// DiffToGraphQL converts the provided service-layer representation of a specification diff to the graphql-layer one.
+func (c *converter) DiffToGraphQL(in *model.SpecDiff) *graphql.SpecDiff {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.SpecDiff{
+		From:    in.FromID,
+		To:      in.ToID,
+		Added:   diffEntriesToGraphQL(in.Added),
+		Removed: diffEntriesToGraphQL(in.Removed),
+		Changed: diffEntriesToGraphQL(in.Changed),
+	}
+}
+
+func diffEntriesToGraphQL(in []*model.SpecDiffEntry) []*graphql.SpecDiffEntry {
+	entries := make([]*graphql.SpecDiffEntry, 0, len(in))
+	for _, entry := range in {
+		if entry == nil {
+			continue
+		}
+		entries = append(entries, &graphql.SpecDiffEntry{
+			Kind: graphql.SpecDiffEntryKind(entry.Kind),
+			Name: entry.Name,
+		})
+	}
+	return entries
+}
@@ -0,0 +1,99 @@
+package specrevision_test
+
+import (
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConverter_ToEntity(t *testing.T) {
+	t.Run("Success for API revision", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		entity := conv.ToEntity(fixAPIRevisionModel())
+
+		assert.Equal(t, fixAPIRevisionEntity(), entity)
+	})
+
+	t.Run("Success for Event revision", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		entity := conv.ToEntity(fixEventRevisionModel())
+
+		assert.Equal(t, fixEventRevisionEntity(), entity)
+	})
+
+	t.Run("Returns nil if model is nil", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		entity := conv.ToEntity(nil)
+
+		require.Nil(t, entity)
+	})
+}
+
+func TestConverter_FromEntity(t *testing.T) {
+	t.Run("Success for API revision", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		revision := conv.FromEntity(fixAPIRevisionEntity())
+
+		assert.Equal(t, fixAPIRevisionModel(), revision)
+	})
+
+	t.Run("Success for Event revision", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		revision := conv.FromEntity(fixEventRevisionEntity())
+
+		assert.Equal(t, fixEventRevisionModel(), revision)
+	})
+
+	t.Run("Returns nil if entity is nil", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		revision := conv.FromEntity(nil)
+
+		require.Nil(t, revision)
+	})
+}
+
+func TestConverter_MultipleToGraphQL(t *testing.T) {
+	conv := specrevision.NewConverter()
+
+	revisions := conv.MultipleToGraphQL([]*model.SpecRevision{fixAPIRevisionModel(), nil})
+
+	assert.Equal(t, []*graphql.SpecRevision{fixAPIRevisionGraphQL()}, revisions)
+}
+
+func TestConverter_DiffToGraphQL(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		diff := conv.DiffToGraphQL(&model.SpecDiff{
+			FromID:  "from",
+			ToID:    "to",
+			Added:   []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindOperation, Name: "GET /pets"}},
+			Removed: []*model.SpecDiffEntry{},
+			Changed: []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindOperation, Name: "POST /pets"}},
+		})
+
+		assert.Equal(t, &graphql.SpecDiff{
+			From:    "from",
+			To:      "to",
+			Added:   []*graphql.SpecDiffEntry{{Kind: graphql.SpecDiffEntryKindOperation, Name: "GET /pets"}},
+			Removed: []*graphql.SpecDiffEntry{},
+			Changed: []*graphql.SpecDiffEntry{{Kind: graphql.SpecDiffEntryKindOperation, Name: "POST /pets"}},
+		}, diff)
+	})
+
+	t.Run("Returns nil if diff is nil", func(t *testing.T) {
+		conv := specrevision.NewConverter()
+
+		require.Nil(t, conv.DiffToGraphQL(nil))
+	})
+}
@@ -0,0 +1,116 @@
+package specrevision
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+)
+
+var httpMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// diff compares the operations of OpenAPI revisions, or the channels of AsyncAPI revisions.
+func diff(from, to *model.SpecRevision) (*model.SpecDiff, error) {
+	fromDoc, err := parseDocument(from)
+	if err != nil {
+		return nil, err
+	}
+	toDoc, err := parseDocument(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var kind model.SpecDiffEntryKind
+	var fromElements, toElements map[string]interface{}
+	switch {
+	case isOpenAPI(fromDoc) && isOpenAPI(toDoc):
+		kind, fromElements, toElements = model.SpecDiffEntryKindOperation, operations(fromDoc), operations(toDoc)
+	case isAsyncAPI(fromDoc) && isAsyncAPI(toDoc):
+		kind, fromElements, toElements = model.SpecDiffEntryKindChannel, channels(fromDoc), channels(toDoc)
+	default:
+		return nil, apperrors.NewInvalidDataError("Diff is supported only between revisions of OpenAPI or AsyncAPI Specifications")
+	}
+
+	result := &model.SpecDiff{
+		FromID:  from.ID,
+		ToID:    to.ID,
+		Added:   []*model.SpecDiffEntry{},
+		Removed: []*model.SpecDiffEntry{},
+		Changed: []*model.SpecDiffEntry{},
+	}
+	for _, name := range sortedKeys(fromElements) {
+		toElement, ok := toElements[name]
+		switch {
+		case !ok:
+			result.Removed = append(result.Removed, &model.SpecDiffEntry{Kind: kind, Name: name})
+		case !reflect.DeepEqual(fromElements[name], toElement):
+			result.Changed = append(result.Changed, &model.SpecDiffEntry{Kind: kind, Name: name})
+		}
+	}
+	for _, name := range sortedKeys(toElements) {
+		if _, ok := fromElements[name]; !ok {
+			result.Added = append(result.Added, &model.SpecDiffEntry{Kind: kind, Name: name})
+		}
+	}
+
+	return result, nil
+}
+
+// parseDocument parses the data of the revision, which may be either JSON or YAML, as JSON is a subset of YAML.
+func parseDocument(revision *model.SpecRevision) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal([]byte(revision.Data), &doc); err != nil {
+		return nil, apperrors.NewInvalidDataError("Specification revision with id %s cannot be parsed: %s", revision.ID, err.Error())
+	}
+	return doc, nil
+}
+
+func isOpenAPI(doc map[string]interface{}) bool {
+	_, isV2 := doc["swagger"]
+	_, isV3 := doc["openapi"]
+	return isV2 || isV3
+}
+
+func isAsyncAPI(doc map[string]interface{}) bool {
+	_, ok := doc["asyncapi"]
+	return ok
+}
+
+// operations returns the operations of an OpenAPI document by their method and path, e.g. "GET /pets".
+func operations(doc map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	paths, _ := doc["paths"].(map[string]interface{})
+	for path, item := range paths {
+		pathItem, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range httpMethods {
+			if operation, ok := pathItem[method]; ok {
+				result[fmt.Sprintf("%s %s", strings.ToUpper(method), path)] = operation
+			}
+		}
+	}
+	return result
+}
+
+func channels(doc map[string]interface{}) map[string]interface{} {
+	result, _ := doc["channels"].(map[string]interface{})
+	if result == nil {
+		return map[string]interface{}{}
+	}
+	return result
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,45 @@
+package specrevision
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Entity represents a specification revision entity.
+type Entity struct {
+	ID            string         `db:"id"`
+	APIDefID      sql.NullString `db:"api_def_id"`
+	EventAPIDefID sql.NullString `db:"event_def_id"`
+	SpecType      string         `db:"spec_type"`
+	SpecFormat    string         `db:"spec_format"`
+	SpecData      string         `db:"spec_data"`
+	ContentHash   string         `db:"content_hash"`
+	Source        string         `db:"source"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+// GetID returns the ID of the entity.
+func (e *Entity) GetID() string {
+	return e.ID
+}
+
+// GetParent returns the parent type and the parent ID of the entity.
+func (e *Entity) GetParent(_ resource.Type) (resource.Type, string) {
+	if e.APIDefID.Valid {
+		return resource.API, e.APIDefID.String
+	}
+	return resource.EventDefinition, e.EventAPIDefID.String
+}
+
+// DecorateWithTenantID decorates the entity with the given tenant ID.
+func (e *Entity) DecorateWithTenantID(tenant string) interface{} {
+	return struct {
+		*Entity
+		TenantID string `db:"tenant_id"`
+	}{
+		Entity:   e,
+		TenantID: tenant,
+	}
+}
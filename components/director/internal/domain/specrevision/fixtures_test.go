@@ -0,0 +1,108 @@
+package specrevision_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+const (
+	revisionID       = "revisionID"
+	tenantID         = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	externalTenantID = "externalTenantID"
+	apiID            = "apiID"
+	eventID          = "eventID"
+	specData         = `{"openapi":"3.0.2","info":{"title":"Test API","version":"1.0.0"},"paths":{}}`
+	contentHash      = "883550950a46ae872c3e9627b35ec675cd6edd416ee6e56b9fa532eef9be9865"
+)
+
+var createdAt = time.Date(2022, 1, 20, 10, 0, 0, 0, time.UTC)
+
+func fixAPIRevisionModel() *model.SpecRevision {
+	return fixAPIRevisionModelWithID(revisionID)
+}
+
+func fixAPIRevisionModelWithID(id string) *model.SpecRevision {
+	return &model.SpecRevision{
+		ID:          id,
+		ObjectType:  model.APISpecReference,
+		ObjectID:    apiID,
+		SpecType:    string(model.APISpecTypeOpenAPIV3),
+		Format:      model.SpecFormatApplicationJSON,
+		Data:        specData,
+		ContentHash: contentHash,
+		Source:      model.SpecRevisionSourceORD,
+		CreatedAt:   createdAt,
+	}
+}
+
+func fixEventRevisionModel() *model.SpecRevision {
+	return &model.SpecRevision{
+		ID:          revisionID,
+		ObjectType:  model.EventSpecReference,
+		ObjectID:    eventID,
+		SpecType:    string(model.EventSpecTypeAsyncAPIV2),
+		Format:      model.SpecFormatApplicationJSON,
+		Data:        specData,
+		ContentHash: contentHash,
+		Source:      model.SpecRevisionSourceManual,
+		CreatedAt:   createdAt,
+	}
+}
+
+func fixAPIRevisionEntity() *specrevision.Entity {
+	return fixAPIRevisionEntityWithID(revisionID)
+}
+
+func fixAPIRevisionEntityWithID(id string) *specrevision.Entity {
+	return &specrevision.Entity{
+		ID:          id,
+		APIDefID:    sql.NullString{String: apiID, Valid: true},
+		SpecType:    string(model.APISpecTypeOpenAPIV3),
+		SpecFormat:  string(model.SpecFormatApplicationJSON),
+		SpecData:    specData,
+		ContentHash: contentHash,
+		Source:      string(model.SpecRevisionSourceORD),
+		CreatedAt:   createdAt,
+	}
+}
+
+func fixEventRevisionEntity() *specrevision.Entity {
+	return &specrevision.Entity{
+		ID:            revisionID,
+		EventAPIDefID: sql.NullString{String: eventID, Valid: true},
+		SpecType:      string(model.EventSpecTypeAsyncAPIV2),
+		SpecFormat:    string(model.SpecFormatApplicationJSON),
+		SpecData:      specData,
+		ContentHash:   contentHash,
+		Source:        string(model.SpecRevisionSourceManual),
+		CreatedAt:     createdAt,
+	}
+}
+
+func fixAPIRevisionGraphQL() *graphql.SpecRevision {
+	data := graphql.CLOB(specData)
+	return &graphql.SpecRevision{
+		ID:          revisionID,
+		ContentHash: contentHash,
+		Source:      graphql.SpecRevisionSourceOrd,
+		CreatedAt:   graphql.Timestamp(createdAt),
+		Data:        &data,
+	}
+}
+
+func fixRevisionColumns() []string {
+	return []string{"id", "api_def_id", "event_def_id", "spec_type", "spec_format", "spec_data", "content_hash", "source", "created_at"}
+}
+
+func fixAPIRevisionRowWithID(id string) []driver.Value {
+	return []driver.Value{id, apiID, nil, string(model.APISpecTypeOpenAPIV3), string(model.SpecFormatApplicationJSON), specData, contentHash, string(model.SpecRevisionSourceORD), createdAt}
+}
+
+func fixAPIRevisionRow() []driver.Value {
+	return fixAPIRevisionRowWithID(revisionID)
+}
@@ -0,0 +1,136 @@
+package specrevision
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const revisionTable string = `public.specification_revisions`
+
+var revisionColumns = []string{"id", "api_def_id", "event_def_id", "spec_type", "spec_format", "spec_data", "content_hash", "source", "created_at"}
+
+// EntityConverter converts between the service-layer and repository-layer representations of specification revisions.
+//go:generate mockery --name=EntityConverter --output=automock --outpkg=automock --case=underscore
+type EntityConverter interface {
+	ToEntity(in *model.SpecRevision) *Entity
+	FromEntity(entity *Entity) *model.SpecRevision
+}
+
+type pgRepository struct {
+	conv         EntityConverter
+	singleGetter repo.SingleGetter
+	lister       repo.Lister
+	creator      repo.Creator
+	deleter      repo.Deleter
+}
+
+// NewRepository returns a new repository for specification revisions.
+func NewRepository(conv EntityConverter) *pgRepository {
+	return &pgRepository{
+		conv:         conv,
+		singleGetter: repo.NewSingleGetter(revisionTable, revisionColumns),
+		lister:       repo.NewListerWithOrderBy(revisionTable, revisionColumns, repo.OrderByParams{repo.NewDescOrderBy("created_at")}),
+		creator:      repo.NewCreator(revisionTable, revisionColumns),
+		deleter:      repo.NewDeleter(revisionTable),
+	}
+}
+
+// Create persists a new specification revision.
+func (r *pgRepository) Create(ctx context.Context, tenant string, item *model.SpecRevision) error {
+	if item == nil {
+		return apperrors.NewInternalError("item can not be empty")
+	}
+
+	log.C(ctx).Debugf("Persisting revision with id %s of %s Specification of %s with id %s", item.ID, item.SpecType, item.ObjectType, item.ObjectID)
+	return r.creator.Create(ctx, resource.SpecRevision, tenant, r.conv.ToEntity(item))
+}
+
+// GetByID returns the specification revision with the given ID.
+func (r *pgRepository) GetByID(ctx context.Context, tenant, id string) (*model.SpecRevision, error) {
+	var entity Entity
+	if err := r.singleGetter.Get(ctx, resource.SpecRevision, tenant, repo.Conditions{repo.NewEqualCondition("id", id)}, repo.NoOrderBy, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// GetLatest returns the most recent revision of the Specification of the given type of the referenced object.
+func (r *pgRepository) GetLatest(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string) (*model.SpecRevision, error) {
+	var entity Entity
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(referenceColumn(objectType), objectID),
+		repo.NewEqualCondition("spec_type", specType),
+	}
+	if err := r.singleGetter.Get(ctx, resource.SpecRevision, tenant, conditions, repo.OrderByParams{repo.NewDescOrderBy("created_at")}, &entity); err != nil {
+		return nil, err
+	}
+
+	return r.conv.FromEntity(&entity), nil
+}
+
+// ListByReferenceObjectID returns the revisions of the Specification of the given type of the referenced object, the most recent first.
+func (r *pgRepository) ListByReferenceObjectID(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string) ([]*model.SpecRevision, error) {
+	var entities revisionCollection
+	conditions := []repo.Condition{
+		repo.NewEqualCondition(referenceColumn(objectType), objectID),
+		repo.NewEqualCondition("spec_type", specType),
+	}
+	if err := r.lister.List(ctx, resource.SpecRevision, tenant, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*model.SpecRevision, 0, len(entities))
+	for i := range entities {
+		revisions = append(revisions, r.conv.FromEntity(&entities[i]))
+	}
+	return revisions, nil
+}
+
+// ListByReferenceObjectIDs returns the revisions of the Specifications of the referenced objects of the given type, the most recent first.
+func (r *pgRepository) ListByReferenceObjectIDs(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error) {
+	if len(objectIDs) == 0 {
+		return nil, nil
+	}
+
+	var entities revisionCollection
+	if err := r.lister.List(ctx, resource.SpecRevision, tenant, &entities, repo.NewInConditionForStringValues(referenceColumn(objectType), objectIDs)); err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*model.SpecRevision, 0, len(entities))
+	for i := range entities {
+		revisions = append(revisions, r.conv.FromEntity(&entities[i]))
+	}
+	return revisions, nil
+}
+
+// DeleteCreatedBefore deletes the revisions of the Specification of the given type of the referenced object, which were created before the given time.
+func (r *pgRepository) DeleteCreatedBefore(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string, createdAt time.Time) error {
+	conditions := repo.Conditions{
+		repo.NewEqualCondition(referenceColumn(objectType), objectID),
+		repo.NewEqualCondition("spec_type", specType),
+		repo.NewLessThanCondition("created_at", createdAt),
+	}
+	return r.deleter.DeleteMany(ctx, resource.SpecRevision, tenant, conditions)
+}
+
+func referenceColumn(objectType model.SpecReferenceObjectType) string {
+	if objectType == model.EventSpecReference {
+		return "event_def_id"
+	}
+	return "api_def_id"
+}
+
+type revisionCollection []Entity
+
+// Len returns the length of the collection.
+func (c revisionCollection) Len() int {
+	return len(c)
+}
@@ -0,0 +1,192 @@
+package specrevision_test
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/repo/testdb"
+)
+
+func TestPgRepository_Create(t *testing.T) {
+	var nilRevisionModel *model.SpecRevision
+	suite := testdb.RepoCreateTestSuite{
+		Name: "Create Specification revision",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta("SELECT 1 FROM api_definitions_tenants WHERE tenant_id = $1 AND id = $2 AND owner = $3"),
+				Args:     []driver.Value{tenantID, apiID, true},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectExist()}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{testdb.RowWhenObjectDoesNotExist()}
+				},
+			},
+			{
+				Query:       `^INSERT INTO public.specification_revisions \(.+\) VALUES \(.+\)$`,
+				Args:        fixAPIRevisionRow(),
+				ValidResult: sqlmock.NewResult(-1, 1),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       specrevision.NewRepository,
+		ModelEntity:               fixAPIRevisionModel(),
+		DBEntity:                  fixAPIRevisionEntity(),
+		NilModelEntity:            nilRevisionModel,
+		TenantID:                  tenantID,
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetByID(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get Specification revision",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, api_def_id, event_def_id, spec_type, spec_format, spec_data, content_hash, source, created_at FROM public.specification_revisions WHERE id = $1 AND (id IN (SELECT id FROM specification_revisions_tenants WHERE tenant_id = $2))`),
+				Args:     []driver.Value{revisionID, tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns()).AddRow(fixAPIRevisionRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       specrevision.NewRepository,
+		ExpectedModelEntity:       fixAPIRevisionModel(),
+		ExpectedDBEntity:          fixAPIRevisionEntity(),
+		MethodArgs:                []interface{}{tenantID, revisionID},
+		MethodName:                "GetByID",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_GetLatest(t *testing.T) {
+	suite := testdb.RepoGetTestSuite{
+		Name: "Get latest Specification revision",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, api_def_id, event_def_id, spec_type, spec_format, spec_data, content_hash, source, created_at FROM public.specification_revisions WHERE api_def_id = $1 AND spec_type = $2 AND (id IN (SELECT id FROM specification_revisions_tenants WHERE tenant_id = $3)) ORDER BY created_at DESC`),
+				Args:     []driver.Value{apiID, string(model.APISpecTypeOpenAPIV3), tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns()).AddRow(fixAPIRevisionRow()...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       specrevision.NewRepository,
+		ExpectedModelEntity:       fixAPIRevisionModel(),
+		ExpectedDBEntity:          fixAPIRevisionEntity(),
+		MethodArgs:                []interface{}{tenantID, model.APISpecReference, apiID, string(model.APISpecTypeOpenAPIV3)},
+		MethodName:                "GetLatest",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByReferenceObjectID(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Specification revisions",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, api_def_id, event_def_id, spec_type, spec_format, spec_data, content_hash, source, created_at FROM public.specification_revisions WHERE api_def_id = $1 AND spec_type = $2 AND (id IN (SELECT id FROM specification_revisions_tenants WHERE tenant_id = $3)) ORDER BY created_at DESC`),
+				Args:     []driver.Value{apiID, string(model.APISpecTypeOpenAPIV3), tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns()).AddRow(fixAPIRevisionRowWithID("id1")...).AddRow(fixAPIRevisionRowWithID("id2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       specrevision.NewRepository,
+		ExpectedModelEntities:     []interface{}{fixAPIRevisionModelWithID("id1"), fixAPIRevisionModelWithID("id2")},
+		ExpectedDBEntities:        []interface{}{fixAPIRevisionEntityWithID("id1"), fixAPIRevisionEntityWithID("id2")},
+		MethodArgs:                []interface{}{tenantID, model.APISpecReference, apiID, string(model.APISpecTypeOpenAPIV3)},
+		MethodName:                "ListByReferenceObjectID",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_ListByReferenceObjectIDs(t *testing.T) {
+	suite := testdb.RepoListTestSuite{
+		Name: "List Specification revisions of multiple Specifications",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:    regexp.QuoteMeta(`SELECT id, api_def_id, event_def_id, spec_type, spec_format, spec_data, content_hash, source, created_at FROM public.specification_revisions WHERE api_def_id IN ($1, $2) AND (id IN (SELECT id FROM specification_revisions_tenants WHERE tenant_id = $3)) ORDER BY created_at DESC`),
+				Args:     []driver.Value{apiID, "apiID2", tenantID},
+				IsSelect: true,
+				ValidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns()).AddRow(fixAPIRevisionRowWithID("id1")...).AddRow(fixAPIRevisionRowWithID("id2")...)}
+				},
+				InvalidRowsProvider: func() []*sqlmock.Rows {
+					return []*sqlmock.Rows{sqlmock.NewRows(fixRevisionColumns())}
+				},
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc:       specrevision.NewRepository,
+		ExpectedModelEntities:     []interface{}{fixAPIRevisionModelWithID("id1"), fixAPIRevisionModelWithID("id2")},
+		ExpectedDBEntities:        []interface{}{fixAPIRevisionEntityWithID("id1"), fixAPIRevisionEntityWithID("id2")},
+		MethodArgs:                []interface{}{tenantID, model.APISpecReference, []string{apiID, "apiID2"}},
+		MethodName:                "ListByReferenceObjectIDs",
+		DisableConverterErrorTest: true,
+	}
+
+	suite.Run(t)
+}
+
+func TestPgRepository_DeleteCreatedBefore(t *testing.T) {
+	suite := testdb.RepoDeleteTestSuite{
+		Name: "Delete Specification revisions created before a given time",
+		SQLQueryDetails: []testdb.SQLQueryDetails{
+			{
+				Query:         regexp.QuoteMeta(`DELETE FROM public.specification_revisions WHERE api_def_id = $1 AND spec_type = $2 AND created_at < $3 AND (id IN (SELECT id FROM specification_revisions_tenants WHERE tenant_id = $4 AND owner = true))`),
+				Args:          []driver.Value{apiID, string(model.APISpecTypeOpenAPIV3), createdAt, tenantID},
+				ValidResult:   sqlmock.NewResult(-1, 1),
+				InvalidResult: sqlmock.NewResult(-1, 2),
+			},
+		},
+		ConverterMockProvider: func() testdb.Mock {
+			return &automock.EntityConverter{}
+		},
+		RepoConstructorFunc: specrevision.NewRepository,
+		MethodArgs:          []interface{}{tenantID, model.APISpecReference, apiID, string(model.APISpecTypeOpenAPIV3), createdAt},
+		MethodName:          "DeleteCreatedBefore",
+		IsDeleteMany:        true,
+	}
+
+	suite.Run(t)
+}
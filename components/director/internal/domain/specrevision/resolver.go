@@ -0,0 +1,159 @@
+package specrevision
+
+import (
+	"context"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// RevisionService is responsible for the service-layer specification revision operations.
+//go:generate mockery --name=RevisionService --output=automock --outpkg=automock --case=underscore
+type RevisionService interface {
+	ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error)
+	Diff(ctx context.Context, fromID, toID string) (*model.SpecDiff, error)
+}
+
+// RevisionConverter converts specification revisions and their diffs to their graphql-layer representation.
+//go:generate mockery --name=RevisionConverter --output=automock --outpkg=automock --case=underscore
+type RevisionConverter interface {
+	MultipleToGraphQL(in []*model.SpecRevision) []*graphql.SpecRevision
+	DiffToGraphQL(in *model.SpecDiff) *graphql.SpecDiff
+}
+
+// Resolver is an object responsible for resolver-layer specification revision operations.
+type Resolver struct {
+	transact    persistence.Transactioner
+	revisionSvc RevisionService
+	converter   RevisionConverter
+}
+
+// NewResolver returns a new object responsible for resolver-layer specification revision operations.
+func NewResolver(transact persistence.Transactioner, revisionSvc RevisionService, converter RevisionConverter) *Resolver {
+	return &Resolver{
+		transact:    transact,
+		revisionSvc: revisionSvc,
+		converter:   converter,
+	}
+}
+
+// APISpecRevisions returns the revisions of the given API specification, the most recent first, via dataloaders.
+func (r *Resolver) APISpecRevisions(ctx context.Context, obj *graphql.APISpec) ([]*graphql.SpecRevision, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("API Spec cannot be empty")
+	}
+
+	params := dataloader.ParamSpecRevisionAPIDef{ID: obj.DefinitionID, SpecType: string(obj.Type), Ctx: ctx}
+	return dataloader.ForSpecRevisionAPIDef(ctx).SpecRevisionAPIDefByID.Load(params)
+}
+
+// SpecRevisionAPIDefDataLoader is the dataloader implementation for the revisions of API specifications.
+func (r *Resolver) SpecRevisionAPIDefDataLoader(keys []dataloader.ParamSpecRevisionAPIDef) ([][]*graphql.SpecRevision, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No API specs found")}
+	}
+
+	specKeys := make([]revisionsKey, 0, len(keys))
+	for _, key := range keys {
+		specKeys = append(specKeys, revisionsKey{objectID: key.ID, specType: key.SpecType})
+	}
+
+	return r.revisionsDataLoader(keys[0].Ctx, model.APISpecReference, specKeys)
+}
+
+// EventSpecRevisions returns the revisions of the given Event specification, the most recent first, via dataloaders.
+func (r *Resolver) EventSpecRevisions(ctx context.Context, obj *graphql.EventSpec) ([]*graphql.SpecRevision, error) {
+	if obj == nil {
+		return nil, apperrors.NewInternalError("Event Spec cannot be empty")
+	}
+
+	params := dataloader.ParamSpecRevisionEventDef{ID: obj.DefinitionID, SpecType: string(obj.Type), Ctx: ctx}
+	return dataloader.ForSpecRevisionEventDef(ctx).SpecRevisionEventDefByID.Load(params)
+}
+
+// SpecRevisionEventDefDataLoader is the dataloader implementation for the revisions of Event specifications.
+func (r *Resolver) SpecRevisionEventDefDataLoader(keys []dataloader.ParamSpecRevisionEventDef) ([][]*graphql.SpecRevision, []error) {
+	if len(keys) == 0 {
+		return nil, []error{apperrors.NewInternalError("No Event specs found")}
+	}
+
+	specKeys := make([]revisionsKey, 0, len(keys))
+	for _, key := range keys {
+		specKeys = append(specKeys, revisionsKey{objectID: key.ID, specType: key.SpecType})
+	}
+
+	return r.revisionsDataLoader(keys[0].Ctx, model.EventSpecReference, specKeys)
+}
+
+// SpecDiff returns the difference between two revisions of the same specification.
+func (r *Resolver) SpecDiff(ctx context.Context, from, to string) (*graphql.SpecDiff, error) {
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening the transaction")
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	diff, err := r.revisionSvc.Diff(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "while committing the transaction")
+	}
+
+	return r.converter.DiffToGraphQL(diff), nil
+}
+
+type revisionsKey struct {
+	objectID string
+	specType string
+}
+
+func (r *Resolver) revisionsDataLoader(ctx context.Context, objectType model.SpecReferenceObjectType, keys []revisionsKey) ([][]*graphql.SpecRevision, []error) {
+	objectIDs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.objectID == "" {
+			return nil, []error{apperrors.NewInternalError("Cannot fetch Specification revisions. %s definition ID is empty", objectType)}
+		}
+		objectIDs = append(objectIDs, key.objectID)
+	}
+
+	tx, err := r.transact.Begin()
+	if err != nil {
+		return nil, []error{errors.Wrap(err, "while opening the transaction")}
+	}
+	defer r.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	revisions, err := r.revisionSvc.ListByReferenceObjectIDs(ctx, objectType, objectIDs)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, []error{errors.Wrap(err, "while committing the transaction")}
+	}
+
+	revisionsByKey := make(map[revisionsKey][]*model.SpecRevision, len(keys))
+	for _, revision := range revisions {
+		key := revisionsKey{objectID: revision.ObjectID, specType: revision.SpecType}
+		revisionsByKey[key] = append(revisionsByKey[key], revision)
+	}
+
+	gqlRevisions := make([][]*graphql.SpecRevision, 0, len(keys))
+	for _, key := range keys {
+		gqlRevisions = append(gqlRevisions, r.converter.MultipleToGraphQL(revisionsByKey[key]))
+	}
+
+	log.C(ctx).Infof("Successfully fetched revisions for %s Specifications %v", objectType, objectIDs)
+	return gqlRevisions, nil
+}
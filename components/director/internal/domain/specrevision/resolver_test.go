@@ -0,0 +1,322 @@
+package specrevision_test
+
+import (
+	"context"
+	"testing"
+
+	dataloader "github.com/kyma-incubator/compass/components/director/internal/dataloaders"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var contextParam = txtest.CtxWithDBMatcher()
+
+func TestResolver_APISpecRevisions(t *testing.T) {
+	t.Run("Returns error when API Spec is nil", func(t *testing.T) {
+		resolver := specrevision.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.APISpecRevisions(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "API Spec cannot be empty")
+	})
+}
+
+func TestResolver_SpecRevisionAPIDefDataLoader(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	secondAPIID := "apiID2"
+	specType := string(model.APISpecTypeOpenAPIV3)
+	firstRevision := fixAPIRevisionModelWithID("firstRevisionID")
+	secondRevision := fixAPIRevisionModelWithID("secondRevisionID")
+	otherAPIRevision := fixAPIRevisionModelWithID("otherRevisionID")
+	otherAPIRevision.ObjectID = secondAPIID
+	modelRevisions := []*model.SpecRevision{firstRevision, otherAPIRevision, secondRevision}
+	gqlRevisions := []*graphql.SpecRevision{fixAPIRevisionGraphQL()}
+	otherGQLRevisions := []*graphql.SpecRevision{fixAPIRevisionGraphQL()}
+
+	keys := []dataloader.ParamSpecRevisionAPIDef{
+		{ID: apiID, SpecType: specType, Ctx: context.TODO()},
+		{ID: secondAPIID, SpecType: specType, Ctx: context.TODO()},
+		{ID: "apiWithoutRevisionsID", SpecType: specType, Ctx: context.TODO()},
+	}
+	objectIDs := []string{apiID, secondAPIID, "apiWithoutRevisionsID"}
+
+	testCases := []struct {
+		Name              string
+		TransactionerFn   func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn         func() *automock.RevisionService
+		ConverterFn       func() *automock.RevisionConverter
+		Keys              []dataloader.ParamSpecRevisionAPIDef
+		ExpectedRevisions [][]*graphql.SpecRevision
+		ExpectedErr       error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("ListByReferenceObjectIDs", contextParam, model.APISpecReference, objectIDs).Return(modelRevisions, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				conv := &automock.RevisionConverter{}
+				conv.On("MultipleToGraphQL", []*model.SpecRevision{firstRevision, secondRevision}).Return(gqlRevisions).Once()
+				conv.On("MultipleToGraphQL", []*model.SpecRevision{otherAPIRevision}).Return(otherGQLRevisions).Once()
+				conv.On("MultipleToGraphQL", []*model.SpecRevision(nil)).Return([]*graphql.SpecRevision{}).Once()
+				return conv
+			},
+			Keys:              keys,
+			ExpectedRevisions: [][]*graphql.SpecRevision{gqlRevisions, otherGQLRevisions, {}},
+		},
+		{
+			Name:            "Returns error when there are no keys",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RevisionService {
+				return &automock.RevisionService{}
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			Keys:        []dataloader.ParamSpecRevisionAPIDef{},
+			ExpectedErr: errors.New("No API specs found"),
+		},
+		{
+			Name:            "Returns error when API definition ID is empty",
+			TransactionerFn: txGen.ThatDoesntStartTransaction,
+			ServiceFn: func() *automock.RevisionService {
+				return &automock.RevisionService{}
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			Keys:        []dataloader.ParamSpecRevisionAPIDef{{ID: "", SpecType: specType, Ctx: context.TODO()}},
+			ExpectedErr: errors.New("definition ID is empty"),
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.RevisionService {
+				return &automock.RevisionService{}
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			Keys:        keys,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when listing revisions fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("ListByReferenceObjectIDs", contextParam, model.APISpecReference, objectIDs).Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			Keys:        keys,
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("ListByReferenceObjectIDs", contextParam, model.APISpecReference, objectIDs).Return(modelRevisions, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			Keys:        keys,
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := specrevision.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, errs := resolver.SpecRevisionAPIDefDataLoader(testCase.Keys)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.Empty(t, errs)
+				assert.Equal(t, testCase.ExpectedRevisions, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
+
+func TestResolver_EventSpecRevisions(t *testing.T) {
+	t.Run("Returns error when Event Spec is nil", func(t *testing.T) {
+		resolver := specrevision.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, err := resolver.EventSpecRevisions(context.TODO(), nil)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Event Spec cannot be empty")
+	})
+}
+
+func TestResolver_SpecRevisionEventDefDataLoader(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelRevisions := []*model.SpecRevision{fixEventRevisionModel()}
+	gqlRevisions := []*graphql.SpecRevision{fixAPIRevisionGraphQL()}
+	keys := []dataloader.ParamSpecRevisionEventDef{{ID: eventID, SpecType: string(model.EventSpecTypeAsyncAPIV2), Ctx: context.TODO()}}
+
+	t.Run("Success", func(t *testing.T) {
+		persist, transact := txGen.ThatSucceeds()
+		svc := &automock.RevisionService{}
+		svc.On("ListByReferenceObjectIDs", contextParam, model.EventSpecReference, []string{eventID}).Return(modelRevisions, nil).Once()
+		conv := &automock.RevisionConverter{}
+		conv.On("MultipleToGraphQL", modelRevisions).Return(gqlRevisions).Once()
+
+		resolver := specrevision.NewResolver(transact, svc, conv)
+
+		// WHEN
+		result, errs := resolver.SpecRevisionEventDefDataLoader(keys)
+
+		// THEN
+		require.Empty(t, errs)
+		assert.Equal(t, [][]*graphql.SpecRevision{gqlRevisions}, result)
+		mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+	})
+
+	t.Run("Returns error when there are no keys", func(t *testing.T) {
+		resolver := specrevision.NewResolver(nil, nil, nil)
+
+		// WHEN
+		_, errs := resolver.SpecRevisionEventDefDataLoader([]dataloader.ParamSpecRevisionEventDef{})
+
+		// THEN
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Error(), "No Event specs found")
+	})
+}
+
+func TestResolver_SpecDiff(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	txGen := txtest.NewTransactionContextGenerator(testErr)
+
+	modelDiff := &model.SpecDiff{FromID: "from", ToID: "to"}
+	gqlDiff := &graphql.SpecDiff{From: "from", To: "to"}
+
+	testCases := []struct {
+		Name            string
+		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		ServiceFn       func() *automock.RevisionService
+		ConverterFn     func() *automock.RevisionConverter
+		ExpectedDiff    *graphql.SpecDiff
+		ExpectedErr     error
+	}{
+		{
+			Name:            "Success",
+			TransactionerFn: txGen.ThatSucceeds,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("Diff", contextParam, "from", "to").Return(modelDiff, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				conv := &automock.RevisionConverter{}
+				conv.On("DiffToGraphQL", modelDiff).Return(gqlDiff).Once()
+				return conv
+			},
+			ExpectedDiff: gqlDiff,
+		},
+		{
+			Name:            "Returns error when transaction begin fails",
+			TransactionerFn: txGen.ThatFailsOnBegin,
+			ServiceFn: func() *automock.RevisionService {
+				return &automock.RevisionService{}
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when diff fails",
+			TransactionerFn: txGen.ThatDoesntExpectCommit,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("Diff", contextParam, "from", "to").Return(nil, testErr).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name:            "Returns error when commit fails",
+			TransactionerFn: txGen.ThatFailsOnCommit,
+			ServiceFn: func() *automock.RevisionService {
+				svc := &automock.RevisionService{}
+				svc.On("Diff", contextParam, "from", "to").Return(modelDiff, nil).Once()
+				return svc
+			},
+			ConverterFn: func() *automock.RevisionConverter {
+				return &automock.RevisionConverter{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persist, transact := testCase.TransactionerFn()
+			svc := testCase.ServiceFn()
+			conv := testCase.ConverterFn()
+
+			resolver := specrevision.NewResolver(transact, svc, conv)
+
+			// WHEN
+			result, err := resolver.SpecDiff(context.TODO(), "from", "to")
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDiff, result)
+			}
+
+			mock.AssertExpectationsForObjects(t, persist, transact, svc, conv)
+		})
+	}
+}
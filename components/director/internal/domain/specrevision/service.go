@@ -0,0 +1,185 @@
+package specrevision
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+type key int
+
+// SourceContextKey is the key under which the source of the Specification changes is saved in a given context.Context.
+const SourceContextKey key = iota
+
+// SaveSourceToContext returns a context, in which the changes of Specifications are recorded as coming from the given source.
+func SaveSourceToContext(ctx context.Context, source model.SpecRevisionSource) context.Context {
+	return context.WithValue(ctx, SourceContextKey, source)
+}
+
+// LoadSourceFromContext returns the source of the Specification changes from the context. The changes are manual by default.
+func LoadSourceFromContext(ctx context.Context) model.SpecRevisionSource {
+	if source, ok := ctx.Value(SourceContextKey).(model.SpecRevisionSource); ok {
+		return source
+	}
+	return model.SpecRevisionSourceManual
+}
+
+// RetentionLimit is the number of the most recent revisions which are kept for every Specification.
+// The older revisions are deleted when a new revision is recorded.
+const RetentionLimit = 20
+
+// RevisionRepository is responsible for the repo-layer specification revision operations.
+//go:generate mockery --name=RevisionRepository --output=automock --outpkg=automock --case=underscore
+type RevisionRepository interface {
+	Create(ctx context.Context, tenant string, item *model.SpecRevision) error
+	GetByID(ctx context.Context, tenant, id string) (*model.SpecRevision, error)
+	GetLatest(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string) (*model.SpecRevision, error)
+	ListByReferenceObjectID(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string) ([]*model.SpecRevision, error)
+	ListByReferenceObjectIDs(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error)
+	DeleteCreatedBefore(ctx context.Context, tenant string, objectType model.SpecReferenceObjectType, objectID, specType string, createdAt time.Time) error
+}
+
+// UIDService is responsible for generating GUIDs, which will be used as internal specification revision IDs.
+//go:generate mockery --name=UIDService --output=automock --outpkg=automock --case=underscore
+type UIDService interface {
+	Generate() string
+}
+
+type service struct {
+	repo         RevisionRepository
+	uidService   UIDService
+	timestampGen timestamp.Generator
+}
+
+// NewService returns a new service for specification revisions.
+func NewService(repo RevisionRepository, uidService UIDService) *service {
+	return &service{
+		repo:         repo,
+		uidService:   uidService,
+		timestampGen: timestamp.DefaultGenerator,
+	}
+}
+
+// Record stores the data of the Specification as a new revision, unless the data is the same as in the latest revision.
+func (s *service) Record(ctx context.Context, spec *model.Spec, source model.SpecRevisionSource) error {
+	if spec == nil || spec.Data == nil {
+		return nil
+	}
+
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	specType := specTypeOf(spec)
+	contentHash := hash(*spec.Data)
+
+	latest, err := s.repo.GetLatest(ctx, tnt, spec.ObjectType, spec.ObjectID, specType)
+	if err != nil && !apperrors.IsNotFoundError(err) {
+		return errors.Wrapf(err, "while getting latest revision of %s Specification with id %s", spec.ObjectType, spec.ID)
+	}
+	if latest != nil && latest.ContentHash == contentHash {
+		log.C(ctx).Debugf("Data of %s Specification with id %s has not changed since revision with id %s", spec.ObjectType, spec.ID, latest.ID)
+		return nil
+	}
+
+	revision := &model.SpecRevision{
+		ID:          s.uidService.Generate(),
+		ObjectType:  spec.ObjectType,
+		ObjectID:    spec.ObjectID,
+		SpecType:    specType,
+		Format:      spec.Format,
+		Data:        *spec.Data,
+		ContentHash: contentHash,
+		Source:      source,
+		CreatedAt:   s.timestampGen(),
+	}
+	if err = s.repo.Create(ctx, tnt, revision); err != nil {
+		return errors.Wrapf(err, "while creating revision of %s Specification with id %s", spec.ObjectType, spec.ID)
+	}
+
+	log.C(ctx).Infof("Recorded revision with id %s of %s Specification with id %s from %s source", revision.ID, spec.ObjectType, spec.ID, source)
+
+	return s.deleteExpired(ctx, tnt, spec, specType)
+}
+
+// ListByReferenceObjectIDs returns the revisions of the Specifications of the referenced objects of the given type, the most recent first.
+func (s *service) ListByReferenceObjectIDs(ctx context.Context, objectType model.SpecReferenceObjectType, objectIDs []string) ([]*model.SpecRevision, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.repo.ListByReferenceObjectIDs(ctx, tnt, objectType, objectIDs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing revisions of Specifications of %s with ids %v", objectType, objectIDs)
+	}
+	return revisions, nil
+}
+
+
+// Diff returns the difference between two revisions of the same Specification.
+func (s *service) Diff(ctx context.Context, fromID, toID string) (*model.SpecDiff, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := s.repo.GetByID(ctx, tnt, fromID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Specification revision with id %s", fromID)
+	}
+
+	to, err := s.repo.GetByID(ctx, tnt, toID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting Specification revision with id %s", toID)
+	}
+
+	if from.ObjectType != to.ObjectType || from.ObjectID != to.ObjectID || from.SpecType != to.SpecType {
+		return nil, apperrors.NewInvalidDataError("Specification revisions with ids %s and %s belong to different Specifications", fromID, toID)
+	}
+
+	return diff(from, to)
+}
+
+// deleteExpired deletes the revisions of the Specification which are older than the ones within the RetentionLimit
+func (s *service) deleteExpired(ctx context.Context, tnt string, spec *model.Spec, specType string) error {
+	revisions, err := s.repo.ListByReferenceObjectID(ctx, tnt, spec.ObjectType, spec.ObjectID, specType)
+	if err != nil {
+		return errors.Wrapf(err, "while listing revisions of %s Specification with id %s", spec.ObjectType, spec.ID)
+	}
+	if len(revisions) <= RetentionLimit {
+		return nil
+	}
+
+	oldestRetained := revisions[RetentionLimit-1]
+	if err = s.repo.DeleteCreatedBefore(ctx, tnt, spec.ObjectType, spec.ObjectID, specType, oldestRetained.CreatedAt); err != nil {
+		return errors.Wrapf(err, "while deleting expired revisions of %s Specification with id %s", spec.ObjectType, spec.ID)
+	}
+
+	log.C(ctx).Infof("Deleted %d expired revisions of %s Specification with id %s", len(revisions)-RetentionLimit, spec.ObjectType, spec.ID)
+	return nil
+}
+
+func specTypeOf(spec *model.Spec) string {
+	switch {
+	case spec.APIType != nil:
+		return string(*spec.APIType)
+	case spec.EventType != nil:
+		return string(*spec.EventType)
+	}
+	return ""
+}
+
+func hash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,459 @@
+package specrevision_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision/automock"
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	openAPIFrom = `{"openapi":"3.0.2","info":{"title":"Test API","version":"1.0.0"},"paths":{
+"/pets":{"get":{"summary":"List pets"},"post":{"summary":"Create pet"}},
+"/pets/{id}":{"get":{"summary":"Get pet"},"parameters":[]}}}`
+	openAPITo = `openapi: 3.0.2
+info:
+  title: Test API
+  version: 1.0.1
+paths:
+  /pets:
+    get:
+      summary: List pets
+    post:
+      summary: Create a pet
+  /pets/{id}:
+    delete:
+      summary: Delete pet
+`
+	asyncAPIFrom = `{"asyncapi":"2.0.0","info":{"title":"Test Events","version":"1.0.0"},"channels":{"pet.created":{"subscribe":{}},"pet.deleted":{"subscribe":{}}}}`
+	asyncAPITo   = `{"asyncapi":"2.0.0","info":{"title":"Test Events","version":"1.0.0"},"channels":{"pet.created":{"subscribe":{"summary":"Pet created"}},"pet.updated":{"subscribe":{}}}}`
+)
+
+func TestSourceContext(t *testing.T) {
+	t.Run("Returns the saved source", func(t *testing.T) {
+		ctx := specrevision.SaveSourceToContext(context.TODO(), model.SpecRevisionSourceORD)
+
+		assert.Equal(t, model.SpecRevisionSourceORD, specrevision.LoadSourceFromContext(ctx))
+	})
+
+	t.Run("Returns manual source by default", func(t *testing.T) {
+		assert.Equal(t, model.SpecRevisionSourceManual, specrevision.LoadSourceFromContext(context.TODO()))
+	})
+}
+
+func TestService_Record(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+	notFoundErr := apperrors.NewNotFoundError(resource.SpecRevision, "")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	apiType := model.APISpecTypeOpenAPIV3
+	spec := &model.Spec{
+		ID:         "specID",
+		ObjectType: model.APISpecReference,
+		ObjectID:   apiID,
+		Data:       str.Ptr(specData),
+		Format:     model.SpecFormatApplicationJSON,
+		APIType:    &apiType,
+	}
+	specType := string(apiType)
+
+	changedRevision := fixAPIRevisionModel()
+	changedRevision.ContentHash = "previous"
+
+	retainedRevisions := make([]*model.SpecRevision, 0, specrevision.RetentionLimit+1)
+	for i := 0; i <= specrevision.RetentionLimit; i++ {
+		revision := fixAPIRevisionModel()
+		revision.CreatedAt = createdAt.Add(-time.Duration(i) * time.Hour)
+		retainedRevisions = append(retainedRevisions, revision)
+	}
+	oldestRetainedAt := retainedRevisions[specrevision.RetentionLimit-1].CreatedAt
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.RevisionRepository
+		UIDServiceFn func() *automock.UIDService
+		Input        *model.Spec
+		Context      context.Context
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success - creates first revision",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, tenantID, fixAPIRevisionModel()).Return(nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, tenantID, model.APISpecReference, apiID, specType).Return([]*model.SpecRevision{fixAPIRevisionModel()}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input: spec,
+		},
+		{
+			Name: "Success - creates revision when data has changed",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(changedRevision, nil).Once()
+				repo.On("Create", ctx, tenantID, fixAPIRevisionModel()).Return(nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, tenantID, model.APISpecReference, apiID, specType).Return([]*model.SpecRevision{fixAPIRevisionModel(), changedRevision}, nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input: spec,
+		},
+		{
+			Name: "Success - deletes revisions exceeding the retention limit",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(changedRevision, nil).Once()
+				repo.On("Create", ctx, tenantID, fixAPIRevisionModel()).Return(nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, tenantID, model.APISpecReference, apiID, specType).Return(retainedRevisions, nil).Once()
+				repo.On("DeleteCreatedBefore", ctx, tenantID, model.APISpecReference, apiID, specType, oldestRetainedAt).Return(nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input: spec,
+		},
+		{
+			Name: "Error - deleting revisions exceeding the retention limit",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(changedRevision, nil).Once()
+				repo.On("Create", ctx, tenantID, fixAPIRevisionModel()).Return(nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, tenantID, model.APISpecReference, apiID, specType).Return(retainedRevisions, nil).Once()
+				repo.On("DeleteCreatedBefore", ctx, tenantID, model.APISpecReference, apiID, specType, oldestRetainedAt).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input:       spec,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - listing revisions for the retention limit",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(changedRevision, nil).Once()
+				repo.On("Create", ctx, tenantID, fixAPIRevisionModel()).Return(nil).Once()
+				repo.On("ListByReferenceObjectID", ctx, tenantID, model.APISpecReference, apiID, specType).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input:       spec,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Success - does not create revision when data has not changed",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(fixAPIRevisionModel(), nil).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input: spec,
+		},
+		{
+			Name: "Success - does not create revision when spec has no data",
+			RepositoryFn: func() *automock.RevisionRepository {
+				return &automock.RevisionRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input: &model.Spec{ID: "specID", ObjectType: model.APISpecReference, ObjectID: apiID, APIType: &apiType},
+		},
+		{
+			Name: "Error - getting latest revision",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(nil, testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:       spec,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - creating revision",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetLatest", ctx, tenantID, model.APISpecReference, apiID, specType).Return(nil, notFoundErr).Once()
+				repo.On("Create", ctx, tenantID, mock.Anything).Return(testErr).Once()
+				return repo
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				svc := &automock.UIDService{}
+				svc.On("Generate").Return(revisionID).Once()
+				return svc
+			},
+			Input:       spec,
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error - missing tenant",
+			RepositoryFn: func() *automock.RevisionRepository {
+				return &automock.RevisionRepository{}
+			},
+			UIDServiceFn: func() *automock.UIDService {
+				return &automock.UIDService{}
+			},
+			Input:       spec,
+			Context:     context.TODO(),
+			ExpectedErr: errors.New("cannot read tenant from context"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			uidSvc := testCase.UIDServiceFn()
+
+			svc := specrevision.NewService(repo, uidSvc)
+			svc.SetTimestampGen(func() time.Time { return createdAt })
+
+			testCtx := ctx
+			if testCase.Context != nil {
+				testCtx = testCase.Context
+			}
+
+			// WHEN
+			err := svc.Record(testCtx, testCase.Input, model.SpecRevisionSourceORD)
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, repo, uidSvc)
+		})
+	}
+}
+
+func TestService_ListByReferenceObjectIDs(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	objectIDs := []string{apiID, "apiID2"}
+	revisions := []*model.SpecRevision{fixAPIRevisionModelWithID("id1"), fixAPIRevisionModelWithID("id2")}
+
+	t.Run("Success", func(t *testing.T) {
+		repo := &automock.RevisionRepository{}
+		repo.On("ListByReferenceObjectIDs", ctx, tenantID, model.APISpecReference, objectIDs).Return(revisions, nil).Once()
+		svc := specrevision.NewService(repo, nil)
+
+		// WHEN
+		result, err := svc.ListByReferenceObjectIDs(ctx, model.APISpecReference, objectIDs)
+
+		// THEN
+		require.NoError(t, err)
+		assert.Equal(t, revisions, result)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when listing fails", func(t *testing.T) {
+		repo := &automock.RevisionRepository{}
+		repo.On("ListByReferenceObjectIDs", ctx, tenantID, model.APISpecReference, objectIDs).Return(nil, testErr).Once()
+		svc := specrevision.NewService(repo, nil)
+
+		// WHEN
+		_, err := svc.ListByReferenceObjectIDs(ctx, model.APISpecReference, objectIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), testErr.Error())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Error when tenant not in context", func(t *testing.T) {
+		svc := specrevision.NewService(nil, nil)
+
+		// WHEN
+		_, err := svc.ListByReferenceObjectIDs(context.TODO(), model.APISpecReference, objectIDs)
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot read tenant from context")
+	})
+}
+
+func TestService_Diff(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, tenantID, externalTenantID)
+
+	fixRevision := func(id, specType, data string) *model.SpecRevision {
+		revision := fixAPIRevisionModelWithID(id)
+		revision.SpecType = specType
+		revision.Data = data
+		return revision
+	}
+	openAPIType := string(model.APISpecTypeOpenAPIV3)
+	asyncAPIType := string(model.EventSpecTypeAsyncAPIV2)
+
+	testCases := []struct {
+		Name         string
+		RepositoryFn func() *automock.RevisionRepository
+		ExpectedDiff *model.SpecDiff
+		ExpectedErr  error
+	}{
+		{
+			Name: "Success - OpenAPI operations",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", openAPIType, openAPIFrom), nil).Once()
+				repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", openAPIType, openAPITo), nil).Once()
+				return repo
+			},
+			ExpectedDiff: &model.SpecDiff{
+				FromID:  "from",
+				ToID:    "to",
+				Added:   []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindOperation, Name: "DELETE /pets/{id}"}},
+				Removed: []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindOperation, Name: "GET /pets/{id}"}},
+				Changed: []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindOperation, Name: "POST /pets"}},
+			},
+		},
+		{
+			Name: "Success - AsyncAPI channels",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", asyncAPIType, asyncAPIFrom), nil).Once()
+				repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", asyncAPIType, asyncAPITo), nil).Once()
+				return repo
+			},
+			ExpectedDiff: &model.SpecDiff{
+				FromID:  "from",
+				ToID:    "to",
+				Added:   []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindChannel, Name: "pet.updated"}},
+				Removed: []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindChannel, Name: "pet.deleted"}},
+				Changed: []*model.SpecDiffEntry{{Kind: model.SpecDiffEntryKindChannel, Name: "pet.created"}},
+			},
+		},
+		{
+			Name: "Success - no differences",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", openAPIType, openAPIFrom), nil).Once()
+				repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", openAPIType, openAPIFrom), nil).Once()
+				return repo
+			},
+			ExpectedDiff: &model.SpecDiff{
+				FromID:  "from",
+				ToID:    "to",
+				Added:   []*model.SpecDiffEntry{},
+				Removed: []*model.SpecDiffEntry{},
+				Changed: []*model.SpecDiffEntry{},
+			},
+		},
+		{
+			Name: "Error - revisions of different Specifications",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", openAPIType, openAPIFrom), nil).Once()
+				repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", asyncAPIType, asyncAPIFrom), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("belong to different Specifications"),
+		},
+		{
+			Name: "Error - revision cannot be parsed",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", string(model.APISpecTypeOdata), "<edmx:Edmx/>"), nil).Once()
+				repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", string(model.APISpecTypeOdata), "{}"), nil).Once()
+				return repo
+			},
+			ExpectedErr: errors.New("cannot be parsed"),
+		},
+		{
+			Name: "Error - getting revision",
+			RepositoryFn: func() *automock.RevisionRepository {
+				repo := &automock.RevisionRepository{}
+				repo.On("GetByID", ctx, tenantID, "from").Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			repo := testCase.RepositoryFn()
+			svc := specrevision.NewService(repo, nil)
+
+			// WHEN
+			diff, err := svc.Diff(ctx, "from", "to")
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedDiff, diff)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Error when Specification type is not supported", func(t *testing.T) {
+		repo := &automock.RevisionRepository{}
+		repo.On("GetByID", ctx, tenantID, "from").Return(fixRevision("from", string(model.APISpecTypeCustom), `{"custom":{}}`), nil).Once()
+		repo.On("GetByID", ctx, tenantID, "to").Return(fixRevision("to", string(model.APISpecTypeCustom), `{"custom":{}}`), nil).Once()
+		svc := specrevision.NewService(repo, nil)
+
+		// WHEN
+		_, err := svc.Diff(ctx, "from", "to")
+
+		// THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Diff is supported only between revisions of OpenAPI or AsyncAPI Specifications")
+		repo.AssertExpectations(t)
+	})
+}
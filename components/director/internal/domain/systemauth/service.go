@@ -169,6 +169,16 @@ func (s *service) ListForObject(ctx context.Context, objectType model.SystemAuth
 	return systemAuths, nil
 }
 
+// ListForObjectGlobal lists the System Auths of the given object regardless of the tenant
+func (s *service) ListForObjectGlobal(ctx context.Context, objectType model.SystemAuthReferenceObjectType, objectID string) ([]model.SystemAuth, error) {
+	systemAuths, err := s.repo.ListForObjectGlobal(ctx, objectType, objectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while listing System Auths for %s with reference ID '%s'", objectType, objectID)
+	}
+
+	return systemAuths, nil
+}
+
 // DeleteByIDForObject missing godoc
 func (s *service) DeleteByIDForObject(ctx context.Context, objectType model.SystemAuthReferenceObjectType, authID string) error {
 	tnt, err := tenant.LoadFromContext(ctx)
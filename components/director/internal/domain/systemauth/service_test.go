@@ -315,6 +315,38 @@ func TestService_ListForObject(t *testing.T) {
 	})
 }
 
+func TestService_ListForObjectGlobal(t *testing.T) {
+	objectID := "foo"
+
+	t.Run("success when system auths can be listed from repo", func(t *testing.T) {
+		// GIVEN
+		expected := []model.SystemAuth{{ID: "auth", RuntimeID: &objectID}}
+		repo := &automock.Repository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListForObjectGlobal", context.Background(), model.RuntimeReference, objectID).Return(expected, nil)
+		svc := systemauth.NewService(repo, nil)
+		// WHEN
+		items, err := svc.ListForObjectGlobal(context.Background(), model.RuntimeReference, objectID)
+		// THEN
+		assert.NoError(t, err)
+		assert.Equal(t, expected, items)
+	})
+
+	t.Run("error when system auths cannot be listed from repo", func(t *testing.T) {
+		// GIVEN
+		repo := &automock.Repository{}
+		defer repo.AssertExpectations(t)
+		repo.On("ListForObjectGlobal", context.Background(), model.RuntimeReference, objectID).Return(nil, errors.New("could not list"))
+		svc := systemauth.NewService(repo, nil)
+		// WHEN
+		items, err := svc.ListForObjectGlobal(context.Background(), model.RuntimeReference, objectID)
+		// THEN
+		assert.Nil(t, items)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "could not list")
+	})
+}
+
 func TestService_GetByIDForObject(t *testing.T) {
 	// GIVEN
 	ctx := tenant.SaveToContext(context.TODO(), testTenant, testExternalTenant)
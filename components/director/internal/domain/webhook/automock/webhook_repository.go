@@ -134,6 +134,29 @@ func (_m *WebhookRepository) ListByApplicationTemplateID(ctx context.Context, ap
 	return r0, r1
 }
 
+// ListByRuntimeID provides a mock function with given fields: ctx, tenant, runtimeID
+func (_m *WebhookRepository) ListByRuntimeID(ctx context.Context, tenant string, runtimeID string) ([]*model.Webhook, error) {
+	ret := _m.Called(ctx, tenant, runtimeID)
+
+	var r0 []*model.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*model.Webhook); ok {
+		r0 = rf(ctx, tenant, runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Webhook)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, tenant, runtimeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, tenant, item
 func (_m *WebhookRepository) Update(ctx context.Context, tenant string, item *model.Webhook) error {
 	ret := _m.Called(ctx, tenant, item)
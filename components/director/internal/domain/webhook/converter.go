@@ -80,6 +80,7 @@ func (c *converter) ToGraphQL(in *model.Webhook) (*graphql.Webhook, error) {
 		HeaderTemplate:        in.HeaderTemplate,
 		OutputTemplate:        in.OutputTemplate,
 		StatusTemplate:        in.StatusTemplate,
+		RetryPolicy:           retryPolicyToGraphQL(in.RetryPolicy),
 	}, nil
 }
 
@@ -132,6 +133,7 @@ func (c *converter) InputFromGraphQL(in *graphql.WebhookInput) (*model.WebhookIn
 		HeaderTemplate:   in.HeaderTemplate,
 		OutputTemplate:   in.OutputTemplate,
 		StatusTemplate:   in.StatusTemplate,
+		RetryPolicy:      retryPolicyInputFromGraphQL(in.RetryPolicy),
 	}, nil
 }
 
@@ -160,6 +162,11 @@ func (c *converter) ToEntity(in *model.Webhook) (*Entity, error) {
 		return nil, err
 	}
 
+	optionalRetryPolicy, err := c.toRetryPolicyEntity(*in)
+	if err != nil {
+		return nil, err
+	}
+
 	var webhookMode sql.NullString
 	if in.Mode != nil {
 		webhookMode.String = string(*in.Mode)
@@ -199,6 +206,7 @@ func (c *converter) ToEntity(in *model.Webhook) (*Entity, error) {
 		HeaderTemplate:        repo.NewNullableString(in.HeaderTemplate),
 		OutputTemplate:        repo.NewNullableString(in.OutputTemplate),
 		StatusTemplate:        repo.NewNullableString(in.StatusTemplate),
+		RetryPolicy:           optionalRetryPolicy,
 	}, nil
 }
 
@@ -219,6 +227,23 @@ func (c *converter) toAuthEntity(in model.Webhook) (sql.NullString, error) {
 	return optionalAuth, nil
 }
 
+func (c *converter) toRetryPolicyEntity(in model.Webhook) (sql.NullString, error) {
+	var optionalRetryPolicy sql.NullString
+	if in.RetryPolicy == nil {
+		return optionalRetryPolicy, nil
+	}
+
+	b, err := json.Marshal(in.RetryPolicy)
+	if err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while marshalling RetryPolicy")
+	}
+
+	if err := optionalRetryPolicy.Scan(b); err != nil {
+		return sql.NullString{}, errors.Wrap(err, "while scanning optional RetryPolicy")
+	}
+	return optionalRetryPolicy, nil
+}
+
 // FromEntity missing godoc
 func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 	auth, err := c.fromEntityAuth(*in)
@@ -226,6 +251,11 @@ func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 		return nil, err
 	}
 
+	retryPolicy, err := c.fromEntityRetryPolicy(*in)
+	if err != nil {
+		return nil, err
+	}
+
 	var webhookMode *model.WebhookMode
 	if in.Mode.Valid {
 		webhookModeStr := model.WebhookMode(in.Mode.String)
@@ -253,6 +283,7 @@ func (c *converter) FromEntity(in *Entity) (*model.Webhook, error) {
 		HeaderTemplate:   repo.StringPtrFromNullableString(in.HeaderTemplate),
 		OutputTemplate:   repo.StringPtrFromNullableString(in.OutputTemplate),
 		StatusTemplate:   repo.StringPtrFromNullableString(in.StatusTemplate),
+		RetryPolicy:      retryPolicy,
 	}, nil
 }
 
@@ -278,6 +309,47 @@ func (c *converter) fromEntityAuth(in Entity) (*model.Auth, error) {
 	return auth, nil
 }
 
+func (c *converter) fromEntityRetryPolicy(in Entity) (*model.WebhookRetryPolicy, error) {
+	if !in.RetryPolicy.Valid {
+		return nil, nil
+	}
+
+	retryPolicy := &model.WebhookRetryPolicy{}
+	if err := json.Unmarshal([]byte(in.RetryPolicy.String), retryPolicy); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling RetryPolicy")
+	}
+
+	return retryPolicy, nil
+}
+
+func retryPolicyToGraphQL(in *model.WebhookRetryPolicy) *graphql.WebhookRetryPolicy {
+	if in == nil {
+		return nil
+	}
+
+	return &graphql.WebhookRetryPolicy{
+		MaxAttempts:          in.MaxAttempts,
+		BackoffMultiplier:    in.BackoffMultiplier,
+		MaxInterval:          in.MaxInterval,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
+func retryPolicyInputFromGraphQL(in *graphql.WebhookRetryPolicyInput) *model.WebhookRetryPolicy {
+	if in == nil {
+		return nil
+	}
+
+	return &model.WebhookRetryPolicy{
+		MaxAttempts:          in.MaxAttempts,
+		BackoffMultiplier:    in.BackoffMultiplier,
+		MaxInterval:          in.MaxInterval,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
 func (c *converter) objectReferenceFromEntity(in Entity) (string, model.WebhookReferenceObjectType, error) {
 	if in.ApplicationID.Valid {
 		return in.ApplicationID.String, model.ApplicationWebhookReference, nil
@@ -35,6 +35,11 @@ func TestConverter_ToGraphQL(t *testing.T) {
 			Input:    fixApplicationModelWebhook("1", "foo", "", "bar"),
 			Expected: fixGQLWebhook("1", "foo", "bar"),
 		},
+		{
+			Name:     "Retry policy given",
+			Input:    &model.Webhook{RetryPolicy: fixModelRetryPolicy()},
+			Expected: &graphql.Webhook{RetryPolicy: fixGQLRetryPolicy()},
+		},
 		{
 			Name:     "Empty",
 			Input:    &model.Webhook{},
@@ -107,6 +112,12 @@ func TestConverter_InputFromGraphQL(t *testing.T) {
 			Expected: fixModelWebhookInput("https://test-domain.com"),
 			Error:    nil,
 		},
+		{
+			Name:     "Retry policy given",
+			Input:    &graphql.WebhookInput{RetryPolicy: fixGQLRetryPolicyInput()},
+			Expected: &model.WebhookInput{RetryPolicy: fixModelRetryPolicy()},
+			Error:    nil,
+		},
 		{
 			Name:     "Empty",
 			Input:    &graphql.WebhookInput{},
@@ -213,6 +224,14 @@ func TestConverter_ToEntity(t *testing.T) {
 				Auth: sql.NullString{Valid: true, String: expectedBasicAuthAsString},
 			},
 		},
+		"success when RetryPolicy provided": {
+			in: &model.Webhook{
+				RetryPolicy: fixModelRetryPolicy(),
+			},
+			expected: &webhook.Entity{
+				RetryPolicy: sql.NullString{Valid: true, String: fixRetryPolicyAsAString(t)},
+			},
+		},
 	}
 
 	for tn, tc := range testCases {
@@ -279,6 +298,31 @@ func TestConverter_FromEntity(t *testing.T) {
 				Auth:       fixBasicAuth(),
 			},
 		},
+		"success when RetryPolicy provided": {
+			inEntity: &webhook.Entity{
+				ID:            "givenID",
+				ApplicationID: repo.NewValidNullableString("appID"),
+				RetryPolicy: sql.NullString{
+					Valid:  true,
+					String: fixRetryPolicyAsAString(t),
+				},
+			},
+			expectedModel: &model.Webhook{
+				ID:          "givenID",
+				ObjectID:    "appID",
+				ObjectType:  model.ApplicationWebhookReference,
+				RetryPolicy: fixModelRetryPolicy(),
+			},
+		},
+		"got error on unmarshaling RetryPolicy JSON": {
+			inEntity: &webhook.Entity{
+				RetryPolicy: sql.NullString{
+					Valid:  true,
+					String: "it is not even a proper JSON!",
+				},
+			},
+			expectedErr: errors.New("while unmarshaling RetryPolicy: invalid character 'i' looking for beginning of value"),
+		},
 		"got error on unmarshaling JSON": {
 			inEntity: &webhook.Entity{
 				Auth: sql.NullString{
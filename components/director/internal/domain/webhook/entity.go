@@ -25,6 +25,7 @@ type Entity struct {
 	HeaderTemplate        sql.NullString `db:"header_template"`
 	OutputTemplate        sql.NullString `db:"output_template"`
 	StatusTemplate        sql.NullString `db:"status_template"`
+	RetryPolicy           sql.NullString `db:"retry_policy"`
 }
 
 // GetID returns the ID of the entity.
@@ -14,7 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
 )
 
-var fixColumns = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
+var fixColumns = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}
 
 var emptyTemplate = `{}`
 
@@ -54,6 +54,22 @@ func fixApplicationTemplateModelWebhook(id, appTemplateID, url string) *model.We
 	}
 }
 
+func fixRuntimeModelWebhook(id, runtimeID, url string) *model.Webhook {
+	return &model.Webhook{
+		ID:             id,
+		ObjectID:       runtimeID,
+		ObjectType:     model.RuntimeWebhookReference,
+		Type:           model.WebhookTypeConfigurationChanged,
+		URL:            &url,
+		Auth:           fixBasicAuth(),
+		Mode:           &modelWebhookMode,
+		URLTemplate:    &emptyTemplate,
+		InputTemplate:  &emptyTemplate,
+		HeaderTemplate: &emptyTemplate,
+		OutputTemplate: &emptyTemplate,
+	}
+}
+
 func fixGQLWebhook(id, appID, url string) *graphql.Webhook {
 	return &graphql.Webhook{
 		ID:             id,
@@ -118,6 +134,48 @@ func fixBasicAuth() *model.Auth {
 	}
 }
 
+func fixModelRetryPolicy() *model.WebhookRetryPolicy {
+	maxAttempts := 5
+	backoffMultiplier := 2.0
+	maxInterval := 300
+	jitter := 0.2
+	return &model.WebhookRetryPolicy{
+		MaxAttempts:          &maxAttempts,
+		BackoffMultiplier:    &backoffMultiplier,
+		MaxInterval:          &maxInterval,
+		Jitter:               &jitter,
+		RetryableStatusCodes: []int{429, 503},
+	}
+}
+
+func fixGQLRetryPolicy() *graphql.WebhookRetryPolicy {
+	in := fixModelRetryPolicy()
+	return &graphql.WebhookRetryPolicy{
+		MaxAttempts:          in.MaxAttempts,
+		BackoffMultiplier:    in.BackoffMultiplier,
+		MaxInterval:          in.MaxInterval,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
+func fixGQLRetryPolicyInput() *graphql.WebhookRetryPolicyInput {
+	in := fixModelRetryPolicy()
+	return &graphql.WebhookRetryPolicyInput{
+		MaxAttempts:          in.MaxAttempts,
+		BackoffMultiplier:    in.BackoffMultiplier,
+		MaxInterval:          in.MaxInterval,
+		Jitter:               in.Jitter,
+		RetryableStatusCodes: in.RetryableStatusCodes,
+	}
+}
+
+func fixRetryPolicyAsAString(t *testing.T) string {
+	b, err := json.Marshal(fixModelRetryPolicy())
+	require.NoError(t, err)
+	return string(b)
+}
+
 func fixAuthAsAString(t *testing.T) string {
 	b, err := json.Marshal(fixBasicAuth())
 	require.NoError(t, err)
@@ -143,6 +201,21 @@ func fixApplicationWebhookEntityWithID(t *testing.T, id string) *webhook.Entity
 	}
 }
 
+func fixRuntimeWebhookEntityWithID(t *testing.T, id string) *webhook.Entity {
+	return &webhook.Entity{
+		ID:             id,
+		RuntimeID:      repo.NewValidNullableString(givenRuntimeID()),
+		Type:           string(model.WebhookTypeConfigurationChanged),
+		URL:            repo.NewValidNullableString("http://kyma.io"),
+		Mode:           repo.NewValidNullableString(string(model.WebhookModeSync)),
+		Auth:           sql.NullString{Valid: true, String: fixAuthAsAString(t)},
+		URLTemplate:    repo.NewValidNullableString(emptyTemplate),
+		InputTemplate:  repo.NewValidNullableString(emptyTemplate),
+		HeaderTemplate: repo.NewValidNullableString(emptyTemplate),
+		OutputTemplate: repo.NewValidNullableString(emptyTemplate),
+	}
+}
+
 func fixApplicationTemplateWebhookEntity(t *testing.T) *webhook.Entity {
 	return &webhook.Entity{
 		ID:                    givenID(),
@@ -178,6 +251,10 @@ func givenApplicationID() string {
 	return "cccccccc-cccc-cccc-cccc-cccccccccccc"
 }
 
+func givenRuntimeID() string {
+	return "bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb"
+}
+
 func givenApplicationTemplateID() string {
 	return "ffffffff-ffff-ffff-ffff-ffffffffffff"
 }
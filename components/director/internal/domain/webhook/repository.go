@@ -20,8 +20,8 @@ const (
 )
 
 var (
-	webhookColumns         = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
-	updatableColumns       = []string{"type", "url", "auth", "mode", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template"}
+	webhookColumns         = []string{"id", "app_id", "app_template_id", "type", "url", "auth", "runtime_id", "integration_system_id", "mode", "correlation_id_key", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}
+	updatableColumns       = []string{"type", "url", "auth", "mode", "retry_interval", "timeout", "url_template", "input_template", "header_template", "output_template", "status_template", "retry_policy"}
 	missingInputModelError = apperrors.NewInternalError("model has to be provided")
 )
 
@@ -57,7 +57,7 @@ func NewRepository(conv EntityConverter) *repository {
 		updaterGlobal:      repo.NewUpdaterGlobal(resource.Webhook, tableName, updatableColumns, []string{"id", "app_template_id"}),
 		deleterGlobal:      repo.NewDeleterGlobal(resource.Webhook, tableName),
 		deleter:            repo.NewDeleter(tableName),
-		lister:             repo.NewLister(tableName, webhookColumns),
+		lister:             repo.NewListerWithOrderBy(tableName, webhookColumns, repo.OrderByParams{repo.NewAscOrderBy("id")}),
 		listerGlobal:       repo.NewListerGlobal(resource.Webhook, tableName, webhookColumns),
 		conv:               conv,
 	}
@@ -113,6 +113,30 @@ func (r *repository) ListByApplicationID(ctx context.Context, tenant, applicatio
 	return out, nil
 }
 
+// ListByRuntimeID lists all webhooks of the runtime with the given ID.
+func (r *repository) ListByRuntimeID(ctx context.Context, tenant, runtimeID string) ([]*model.Webhook, error) {
+	var entities Collection
+
+	conditions := repo.Conditions{
+		repo.NewEqualCondition("runtime_id", runtimeID),
+	}
+
+	if err := r.lister.List(ctx, resource.RuntimeWebhook, tenant, &entities, conditions...); err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.Webhook, 0, len(entities))
+	for _, ent := range entities {
+		w, err := r.conv.FromEntity(&ent)
+		if err != nil {
+			return nil, errors.Wrap(err, "while converting Webhook to model")
+		}
+		out = append(out, w)
+	}
+
+	return out, nil
+}
+
 // ListByApplicationTemplateID missing godoc
 func (r *repository) ListByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error) {
 	var entities Collection
@@ -26,6 +26,7 @@ func TestResolver_AddWebhook(t *testing.T) {
 
 	givenAppID := "foo"
 	givenAppTemplateID := "test_app_template"
+	givenRuntimeID := "test_runtime"
 	id := "bar"
 	gqlWebhookInput := fixGQLWebhookInput("foo")
 	modelWebhookInput := fixModelWebhookInput("foo")
@@ -40,6 +41,7 @@ func TestResolver_AddWebhook(t *testing.T) {
 		ServiceFn            func() *automock.WebhookService
 		AppServiceFn         func() *automock.ApplicationService
 		AppTemplateServiceFn func() *automock.ApplicationTemplateService
+		RuntimeServiceFn     func() *automock.RuntimeService
 		ConverterFn          func() *automock.WebhookConverter
 		ExpectedWebhook      *graphql.Webhook
 		ExpectedErr          error
@@ -68,6 +70,50 @@ func TestResolver_AddWebhook(t *testing.T) {
 			ExpectedWebhook: gqlWebhook,
 			ExpectedErr:     nil,
 		},
+		{
+			Name:            "Success for runtime webhook",
+			PersistenceFn:   txtest.PersistenceContextThatExpectsCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.WebhookService {
+				svc := &automock.WebhookService{}
+				svc.On("Create", txtest.CtxWithDBMatcher(), givenRuntimeID, *modelWebhookInput, model.RuntimeWebhookReference).Return(id, nil).Once()
+				svc.On("Get", txtest.CtxWithDBMatcher(), id, model.RuntimeWebhookReference).Return(modelWebhook, nil).Once()
+				return svc
+			},
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				runtimeSvc := &automock.RuntimeService{}
+				runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), givenRuntimeID).Return(true, nil).Once()
+				return runtimeSvc
+			},
+			ConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+				conv.On("ToGraphQL", modelWebhook).Return(gqlWebhook, nil).Once()
+				return conv
+			},
+			ExpectedWebhook: gqlWebhook,
+			ExpectedErr:     nil,
+		},
+		{
+			Name:            "Returns error when runtime does not exist",
+			PersistenceFn:   txtest.PersistenceContextThatDoesntExpectCommit,
+			TransactionerFn: txtest.TransactionerThatSucceeds,
+			ServiceFn: func() *automock.WebhookService {
+				return &automock.WebhookService{}
+			},
+			RuntimeServiceFn: func() *automock.RuntimeService {
+				runtimeSvc := &automock.RuntimeService{}
+				runtimeSvc.On("Exist", txtest.CtxWithDBMatcher(), givenRuntimeID).Return(false, nil).Once()
+				return runtimeSvc
+			},
+			ConverterFn: func() *automock.WebhookConverter {
+				conv := &automock.WebhookConverter{}
+				conv.On("InputFromGraphQL", gqlWebhookInput).Return(modelWebhookInput, nil).Once()
+				return conv
+			},
+			ExpectedWebhook: nil,
+			ExpectedErr:     errors.New("cannot add RuntimeWebhook due to not existing reference entity"),
+		},
 		{
 			Name:          "Returns error on starting transaction",
 			PersistenceFn: txtest.PersistenceContextThatDoesntExpectCommit,
@@ -227,6 +273,7 @@ func TestResolver_AddWebhook(t *testing.T) {
 		t.Run(testCase.Name, func(t *testing.T) {
 			var appSvc *automock.ApplicationService
 			var appTemplateSvc *automock.ApplicationTemplateService
+			var runtimeSvc *automock.RuntimeService
 			svc := testCase.ServiceFn()
 			if testCase.AppServiceFn != nil {
 				appSvc = testCase.AppServiceFn()
@@ -234,22 +281,28 @@ func TestResolver_AddWebhook(t *testing.T) {
 			if testCase.AppTemplateServiceFn != nil {
 				appTemplateSvc = testCase.AppTemplateServiceFn()
 			}
+			if testCase.RuntimeServiceFn != nil {
+				runtimeSvc = testCase.RuntimeServiceFn()
+			}
 
 			converter := testCase.ConverterFn()
 
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, appSvc, appTemplateSvc, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, appSvc, appTemplateSvc, runtimeSvc, converter)
 
 			// WHEN
 			var err error
 			var result *graphql.Webhook
 			if testCase.AppServiceFn != nil {
-				result, err = resolver.AddWebhook(context.TODO(), stringPtr(givenAppID), nil, *gqlWebhookInput)
+				result, err = resolver.AddWebhook(context.TODO(), stringPtr(givenAppID), nil, nil, *gqlWebhookInput)
 			}
 			if testCase.AppTemplateServiceFn != nil {
-				result, err = resolver.AddWebhook(context.TODO(), nil, stringPtr(givenAppTemplateID), *gqlWebhookInput)
+				result, err = resolver.AddWebhook(context.TODO(), nil, stringPtr(givenAppTemplateID), nil, *gqlWebhookInput)
+			}
+			if testCase.RuntimeServiceFn != nil {
+				result, err = resolver.AddWebhook(context.TODO(), nil, nil, stringPtr(givenRuntimeID), *gqlWebhookInput)
 			}
 
 			// THEN
@@ -267,6 +320,9 @@ func TestResolver_AddWebhook(t *testing.T) {
 			if testCase.AppTemplateServiceFn != nil {
 				appTemplateSvc.AssertExpectations(t)
 			}
+			if testCase.RuntimeServiceFn != nil {
+				runtimeSvc.AssertExpectations(t)
+			}
 			converter.AssertExpectations(t)
 			persistTxMock.AssertExpectations(t)
 			transactionerMock.AssertExpectations(t)
@@ -395,7 +451,7 @@ func TestResolver_UpdateWebhook(t *testing.T) {
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, nil, converter)
 
 			// WHEN
 			result, err := resolver.UpdateWebhook(context.TODO(), givenWebhookID, *gqlWebhookInput)
@@ -530,7 +586,7 @@ func TestResolver_DeleteWebhook(t *testing.T) {
 			persistTxMock := testCase.PersistenceFn()
 			transactionerMock := testCase.TransactionerFn(persistTxMock)
 
-			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, converter)
+			resolver := webhook.NewResolver(transactionerMock, svc, nil, nil, nil, converter)
 
 			// WHEN
 			result, err := resolver.DeleteWebhook(context.TODO(), givenWebhookID)
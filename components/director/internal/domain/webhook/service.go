@@ -22,6 +22,7 @@ type WebhookRepository interface {
 	GetByIDGlobal(ctx context.Context, id string) (*model.Webhook, error)
 	ListByApplicationID(ctx context.Context, tenant, applicationID string) ([]*model.Webhook, error)
 	ListByApplicationTemplateID(ctx context.Context, applicationTemplateID string) ([]*model.Webhook, error)
+	ListByRuntimeID(ctx context.Context, tenant, runtimeID string) ([]*model.Webhook, error)
 	Create(ctx context.Context, tenant string, item *model.Webhook) error
 	Update(ctx context.Context, tenant string, item *model.Webhook) error
 	Delete(ctx context.Context, id string) error
@@ -86,6 +87,15 @@ func (s *service) ListForApplicationTemplate(ctx context.Context, applicationTem
 	return s.webhookRepo.ListByApplicationTemplateID(ctx, applicationTemplateID)
 }
 
+// ListForRuntime lists all webhooks of the runtime with the given ID.
+func (s *service) ListForRuntime(ctx context.Context, runtimeID string) ([]*model.Webhook, error) {
+	tnt, err := tenant.LoadFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.webhookRepo.ListByRuntimeID(ctx, tnt, runtimeID)
+}
+
 // ListAllApplicationWebhooks missing godoc
 func (s *service) ListAllApplicationWebhooks(ctx context.Context, applicationID string) ([]*model.Webhook, error) {
 	application, err := s.appRepo.GetGlobalByID(ctx, applicationID)
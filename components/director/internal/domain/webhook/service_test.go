@@ -249,6 +249,74 @@ func TestService_ListForApplication(t *testing.T) {
 	})
 }
 
+func TestService_ListForRuntime(t *testing.T) {
+	// GIVEN
+	testErr := errors.New("Test error")
+
+	modelWebhooks := []*model.Webhook{
+		fixRuntimeModelWebhook("1", givenRuntimeID(), "Foo"),
+		fixRuntimeModelWebhook("2", givenRuntimeID(), "Bar"),
+	}
+
+	ctx := context.TODO()
+	ctx = tenant.SaveToContext(ctx, givenTenant(), givenExternalTenant())
+
+	testCases := []struct {
+		Name               string
+		RepositoryFn       func() *automock.WebhookRepository
+		ExpectedResult     []*model.Webhook
+		ExpectedErrMessage string
+	}{
+		{
+			Name: "Success",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByRuntimeID", ctx, givenTenant(), givenRuntimeID()).Return(modelWebhooks, nil).Once()
+				return repo
+			},
+			ExpectedResult:     modelWebhooks,
+			ExpectedErrMessage: "",
+		},
+		{
+			Name: "Returns error when webhook listing failed",
+			RepositoryFn: func() *automock.WebhookRepository {
+				repo := &automock.WebhookRepository{}
+				repo.On("ListByRuntimeID", ctx, givenTenant(), givenRuntimeID()).Return(nil, testErr).Once()
+				return repo
+			},
+			ExpectedResult:     nil,
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			repo := testCase.RepositoryFn()
+			svc := webhook.NewService(repo, nil, nil)
+
+			// WHEN
+			webhooks, err := svc.ListForRuntime(ctx, givenRuntimeID())
+
+			// THEN
+			if testCase.ExpectedErrMessage == "" {
+				require.NoError(t, err)
+				assert.Equal(t, testCase.ExpectedResult, webhooks)
+			} else {
+				assert.Contains(t, err.Error(), testCase.ExpectedErrMessage)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Returns error on loading tenant", func(t *testing.T) {
+		svc := webhook.NewService(nil, nil, nil)
+		// WHEN
+		_, err := svc.ListForRuntime(context.TODO(), givenRuntimeID())
+		assert.True(t, apperrors.IsCannotReadTenant(err))
+	})
+}
+
 func TestService_ListForApplicationTemplate(t *testing.T) {
 	// GIVEN
 	testErr := errors.New("Test error")
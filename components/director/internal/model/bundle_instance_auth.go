@@ -3,6 +3,8 @@ package model
 import (
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
 	"github.com/pkg/errors"
 )
 
@@ -17,6 +19,84 @@ type BundleInstanceAuth struct {
 	InputParams      *string
 	Auth             *Auth
 	Status           *BundleInstanceAuthStatus
+	Ready            bool
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	DeletedAt        *time.Time
+	Error            *string
+}
+
+// GetID returns the ID of the bundle instance auth
+func (a *BundleInstanceAuth) GetID() string {
+	return a.ID
+}
+
+// GetType returns the resource type of the bundle instance auth
+func (a *BundleInstanceAuth) GetType() resource.Type {
+	return resource.BundleInstanceAuth
+}
+
+// GetReady returns the ready value of the bundle instance auth
+func (a *BundleInstanceAuth) GetReady() bool {
+	return a.Ready
+}
+
+// SetReady sets the ready value of the bundle instance auth
+func (a *BundleInstanceAuth) SetReady(ready bool) {
+	a.Ready = ready
+}
+
+// GetCreatedAt returns the created_at value of the bundle instance auth
+func (a *BundleInstanceAuth) GetCreatedAt() time.Time {
+	if a.CreatedAt == nil {
+		return time.Time{}
+	}
+	return *a.CreatedAt
+}
+
+// SetCreatedAt sets the created_at value of the bundle instance auth
+func (a *BundleInstanceAuth) SetCreatedAt(t time.Time) {
+	a.CreatedAt = &t
+}
+
+// GetUpdatedAt returns the updated_at value of the bundle instance auth
+func (a *BundleInstanceAuth) GetUpdatedAt() time.Time {
+	if a.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *a.UpdatedAt
+}
+
+// SetUpdatedAt sets the updated_at value of the bundle instance auth
+func (a *BundleInstanceAuth) SetUpdatedAt(t time.Time) {
+	a.UpdatedAt = &t
+}
+
+// GetDeletedAt returns the deleted_at value of the bundle instance auth
+func (a *BundleInstanceAuth) GetDeletedAt() time.Time {
+	if a.DeletedAt == nil {
+		return time.Time{}
+	}
+	return *a.DeletedAt
+}
+
+// SetDeletedAt sets the deleted_at value of the bundle instance auth
+func (a *BundleInstanceAuth) SetDeletedAt(t time.Time) {
+	a.DeletedAt = &t
+}
+
+// GetError returns the error of the last operation on the bundle instance auth
+func (a *BundleInstanceAuth) GetError() *string {
+	return a.Error
+}
+
+// SetError sets the error of the last operation on the bundle instance auth
+func (a *BundleInstanceAuth) SetError(err string) {
+	if err == "" {
+		a.Error = nil
+	} else {
+		a.Error = &err
+	}
 }
 
 // SetDefaultStatus missing godoc
@@ -16,6 +16,9 @@ type FetchRequest struct {
 	Status     *FetchRequestStatus
 	ObjectType FetchRequestReferenceObjectType
 	ObjectID   string
+	// ETag and LastModified are the HTTP cache validators of the last successfully fetched specification, used for conditional requests.
+	ETag         *string
+	LastModified *string
 }
 
 // FetchRequestReferenceObjectType represents the type of the object that the fetch request is referencing.
@@ -43,22 +46,37 @@ func (obj FetchRequestReferenceObjectType) GetResourceType() resource.Type {
 	return ""
 }
 
+// MaxFetchRequestStatusHistory is the maximum number of past statuses kept for a fetch request.
+const MaxFetchRequestStatusHistory = 10
+
+// SpecValidator validates the content of a specification fetched by a fetch request.
+type SpecValidator func(spec string) error
+
 // FetchRequestStatus is the status of an executed fetch request.
 type FetchRequestStatus struct {
 	Condition FetchRequestStatusCondition
 	Message   *string
 	Timestamp time.Time
+	// History contains the past statuses of the fetch request, starting with the most recent one.
+	History []*FetchRequestStatusHistoryEntry
+}
+
+// FetchRequestStatusHistoryEntry is a past status of a fetch request.
+type FetchRequestStatusHistoryEntry struct {
+	Condition FetchRequestStatusCondition `json:"condition"`
+	Message   *string                     `json:"message,omitempty"`
+	Timestamp time.Time                   `json:"timestamp"`
 }
 
-// FetchMode is a legacy never delivered feature.
+// FetchMode defines how the specification is retrieved from the response of a fetch request.
 type FetchMode string
 
 const (
-	// FetchModeSingle is a legacy never delivered feature.
+	// FetchModeSingle represents a fetch request, which response is the specification itself.
 	FetchModeSingle FetchMode = "SINGLE"
-	// FetchModeBundle is a legacy never delivered feature.
+	// FetchModeBundle represents a fetch request, which response is a zip, tar, or tar.gz archive containing the specification.
 	FetchModeBundle FetchMode = "BUNDLE"
-	// FetchModeIndex is a legacy never delivered feature.
+	// FetchModeIndex represents a fetch request, which response is an index document referencing the specification.
 	FetchModeIndex FetchMode = "INDEX"
 )
 
@@ -0,0 +1,14 @@
+package model
+
+import "time"
+
+// ORDAggregationRequest represents a pending on-demand ORD aggregation of a single Application.
+// There is at most one pending request per Application, so that concurrent requests for the same Application are de-duplicated.
+// Attempts is the number of failed aggregations of the request, and NextAttemptAt is the time before which it is not processed again.
+type ORDAggregationRequest struct {
+	ID            string
+	ApplicationID string
+	RequestedAt   time.Time
+	Attempts      int
+	NextAttemptAt *time.Time
+}
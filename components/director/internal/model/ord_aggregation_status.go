@@ -0,0 +1,90 @@
+package model
+
+import "time"
+
+// ORDAggregationStatus represents the outcome of the ORD aggregation of a single Application webhook.
+type ORDAggregationStatus struct {
+	ID               string
+	ApplicationID    string
+	WebhookID        string
+	LastAttemptAt    time.Time
+	LastSuccessAt    *time.Time
+	DocumentsCount   int
+	Error            *string
+	ValidationErrors []*ORDValidationError
+	DocumentsHash    *string
+	FetchCache       *ORDFetchCache
+}
+
+// ORDValidationError represents a single violation of the ORD validation rules in the ORD documents of an Application.
+// ResourceType and OrdID are empty if the violation is not related to a single resource.
+// Path is the JSON path of the invalid field within the document, and Rule is the identifier of the violated validation rule.
+type ORDValidationError struct {
+	DocumentIndex *int   `json:"documentIndex,omitempty"`
+	ResourceType  string `json:"resourceType"`
+	OrdID         string `json:"ordId"`
+	Path          string `json:"path,omitempty"`
+	Rule          string `json:"rule,omitempty"`
+	Message       string `json:"message"`
+}
+
+// ORDFetchCache holds the data needed for conditionally fetching the ORD configuration and documents of an Application webhook.
+type ORDFetchCache struct {
+	Config    HTTPCacheValidators      `json:"config"`
+	BaseURL   string                   `json:"baseUrl"`
+	Documents []*ORDDocumentCacheEntry `json:"documents"`
+}
+
+// ORDDocumentCacheEntry holds the data needed for conditionally fetching a single ORD document.
+type ORDDocumentCacheEntry struct {
+	URL            string `json:"url"`
+	AccessStrategy string `json:"accessStrategy"`
+	HTTPCacheValidators
+}
+
+// HTTPCacheValidators holds the HTTP cache validators returned by an ORD provider for a single resource.
+type HTTPCacheValidators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+// IsEmpty returns true if the ORD provider did not return any cache validators.
+func (v HTTPCacheValidators) IsEmpty() bool {
+	return len(v.ETag) == 0 && len(v.LastModified) == 0
+}
+
+// ORDAggregationResult represents the result of a single ORD aggregation attempt of an Application webhook.
+// InvalidResourcesSkipped is true if the ORD resources with ValidationErrors were skipped and the valid ones were persisted.
+type ORDAggregationResult struct {
+	DocumentsCount          int
+	Error                   *string
+	ValidationErrors        []*ORDValidationError
+	InvalidResourcesSkipped bool
+	DocumentsHash           *string
+	FetchCache              *ORDFetchCache
+}
+
+// Succeeded returns true if the aggregation attempt persisted the ORD resources of the documents.
+// An attempt which skipped the invalid ORD resources is successful as well, as the same documents would be processed the same way again.
+func (r ORDAggregationResult) Succeeded() bool {
+	return r.Error == nil && (len(r.ValidationErrors) == 0 || r.InvalidResourcesSkipped)
+}
+
+// IsFailed returns true if the last ORD aggregation attempt of the Application webhook failed.
+func (s *ORDAggregationStatus) IsFailed() bool {
+	return s.Error != nil || len(s.ValidationErrors) > 0
+}
+
+// SetFromResult updates the status with the result of an ORD aggregation attempt made at the given time.
+// The documents hash and the fetch cache are updated only on success, so that a failed attempt is retried in full.
+func (s *ORDAggregationStatus) SetFromResult(result ORDAggregationResult, attemptedAt time.Time) {
+	s.LastAttemptAt = attemptedAt
+	s.DocumentsCount = result.DocumentsCount
+	s.Error = result.Error
+	s.ValidationErrors = result.ValidationErrors
+	if result.Succeeded() {
+		s.LastSuccessAt = &attemptedAt
+		s.DocumentsHash = result.DocumentsHash
+		s.FetchCache = result.FetchCache
+	}
+}
@@ -3,6 +3,8 @@ package model
 import (
 	"time"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+
 	"github.com/kyma-incubator/compass/components/director/pkg/pagination"
 )
 
@@ -13,6 +15,80 @@ type Runtime struct {
 	Description       *string
 	Status            *RuntimeStatus
 	CreationTimestamp time.Time
+	Ready             bool
+	UpdatedAt         *time.Time
+	DeletedAt         *time.Time
+	Error             *string
+}
+
+// GetID returns the ID of the runtime
+func (r *Runtime) GetID() string {
+	return r.ID
+}
+
+// GetType returns the resource type of the runtime
+func (r *Runtime) GetType() resource.Type {
+	return resource.Runtime
+}
+
+// GetReady returns the ready value of the runtime
+func (r *Runtime) GetReady() bool {
+	return r.Ready
+}
+
+// SetReady sets the ready value of the runtime
+func (r *Runtime) SetReady(ready bool) {
+	r.Ready = ready
+}
+
+// GetCreatedAt returns the creation timestamp of the runtime
+func (r *Runtime) GetCreatedAt() time.Time {
+	return r.CreationTimestamp
+}
+
+// SetCreatedAt sets the creation timestamp of the runtime
+func (r *Runtime) SetCreatedAt(t time.Time) {
+	r.CreationTimestamp = t
+}
+
+// GetUpdatedAt returns the updated_at value of the runtime
+func (r *Runtime) GetUpdatedAt() time.Time {
+	if r.UpdatedAt == nil {
+		return time.Time{}
+	}
+	return *r.UpdatedAt
+}
+
+// SetUpdatedAt sets the updated_at value of the runtime
+func (r *Runtime) SetUpdatedAt(t time.Time) {
+	r.UpdatedAt = &t
+}
+
+// GetDeletedAt returns the deleted_at value of the runtime
+func (r *Runtime) GetDeletedAt() time.Time {
+	if r.DeletedAt == nil {
+		return time.Time{}
+	}
+	return *r.DeletedAt
+}
+
+// SetDeletedAt sets the deleted_at value of the runtime
+func (r *Runtime) SetDeletedAt(t time.Time) {
+	r.DeletedAt = &t
+}
+
+// GetError returns the error of the last operation on the runtime
+func (r *Runtime) GetError() *string {
+	return r.Error
+}
+
+// SetError sets the error of the last operation on the runtime
+func (r *Runtime) SetError(err string) {
+	if err == "" {
+		r.Error = nil
+	} else {
+		r.Error = &err
+	}
 }
 
 // RuntimeStatus missing godoc
@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+// SpecRevisionSource is the origin of a change of the data of a Specification.
+type SpecRevisionSource string
+
+const (
+	// SpecRevisionSourceManual is a change through the Director API.
+	SpecRevisionSourceManual SpecRevisionSource = "MANUAL"
+	// SpecRevisionSourceORD is a change during the ORD aggregation.
+	SpecRevisionSourceORD SpecRevisionSource = "ORD"
+	// SpecRevisionSourceRefetch is a change on an explicit re-fetch of the Specification.
+	SpecRevisionSourceRefetch SpecRevisionSource = "REFETCH"
+	// SpecRevisionSourceRefresh is a change on a scheduled refresh of the Specification.
+	SpecRevisionSourceRefresh SpecRevisionSource = "REFRESH"
+)
+
+// SpecRevision is an immutable revision of the data of a Specification.
+// The revisions belong to the API or Event definition of the Specification, so that they are kept when the Specification is recreated.
+type SpecRevision struct {
+	ID          string
+	ObjectType  SpecReferenceObjectType
+	ObjectID    string
+	SpecType    string
+	Format      SpecFormat
+	Data        string
+	ContentHash string
+	Source      SpecRevisionSource
+	CreatedAt   time.Time
+}
+
+// SpecDiffEntryKind is the kind of the element of a Specification, which is compared between revisions.
+type SpecDiffEntryKind string
+
+const (
+	// SpecDiffEntryKindOperation is an operation of an OpenAPI Specification.
+	SpecDiffEntryKindOperation SpecDiffEntryKind = "OPERATION"
+	// SpecDiffEntryKindChannel is a channel of an AsyncAPI Specification.
+	SpecDiffEntryKindChannel SpecDiffEntryKind = "CHANNEL"
+)
+
+// SpecDiffEntry is an element of a Specification, which differs between two revisions.
+type SpecDiffEntry struct {
+	Kind SpecDiffEntryKind
+	Name string
+}
+
+// SpecDiff is the difference between two revisions of a Specification.
+type SpecDiff struct {
+	FromID  string
+	ToID    string
+	Added   []*SpecDiffEntry
+	Removed []*SpecDiffEntry
+	Changed []*SpecDiffEntry
+}
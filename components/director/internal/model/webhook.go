@@ -19,6 +19,7 @@ type Webhook struct {
 	HeaderTemplate   *string
 	OutputTemplate   *string
 	StatusTemplate   *string
+	RetryPolicy      *WebhookRetryPolicy
 }
 
 // WebhookInput represents a webhook input for creating/updating webhooks.
@@ -35,6 +36,17 @@ type WebhookInput struct {
 	HeaderTemplate   *string
 	OutputTemplate   *string
 	StatusTemplate   *string
+	RetryPolicy      *WebhookRetryPolicy
+}
+
+// WebhookRetryPolicy represents how failed calls to a webhook are retried.
+// The webhook's RetryInterval is used as the initial delay between attempts.
+type WebhookRetryPolicy struct {
+	MaxAttempts          *int
+	BackoffMultiplier    *float64
+	MaxInterval          *int
+	Jitter               *float64
+	RetryableStatusCodes []int
 }
 
 // WebhookType represents the type of the webhook.
@@ -51,6 +63,14 @@ const (
 	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
 	// WebhookTypeUnpairApplication represents a webhook that is called when an application is unpaired.
 	WebhookTypeUnpairApplication WebhookType = "UNPAIR_APPLICATION"
+	// WebhookTypeRegisterRuntime represents a webhook that is called when a runtime is registered.
+	WebhookTypeRegisterRuntime WebhookType = "REGISTER_RUNTIME"
+	// WebhookTypeUnregisterRuntime represents a webhook that is called when a runtime is unregistered.
+	WebhookTypeUnregisterRuntime WebhookType = "UNREGISTER_RUNTIME"
+	// WebhookTypeBundleInstanceAuthCreation represents a webhook that is called when credentials for a bundle are requested.
+	WebhookTypeBundleInstanceAuthCreation WebhookType = "BUNDLE_INSTANCE_AUTH_CREATION"
+	// WebhookTypeBundleInstanceAuthDeletion represents a webhook that is called when the deletion of bundle credentials is requested.
+	WebhookTypeBundleInstanceAuthDeletion WebhookType = "BUNDLE_INSTANCE_AUTH_DELETION"
 )
 
 // WebhookMode represents the mode of the webhook.
@@ -116,5 +136,6 @@ func (i *WebhookInput) ToWebhook(id, objID string, objectType WebhookReferenceOb
 		HeaderTemplate:   i.HeaderTemplate,
 		OutputTemplate:   i.OutputTemplate,
 		StatusTemplate:   i.StatusTemplate,
+		RetryPolicy:      i.RetryPolicy,
 	}
 }
@@ -15,6 +15,8 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 	template := `{}`
 	webhookMode := model.WebhookModeSync
 	webhookURL := "foourl"
+	maxAttempts := 3
+	retryPolicy := &model.WebhookRetryPolicy{MaxAttempts: &maxAttempts, RetryableStatusCodes: []int{503}}
 	testCases := []struct {
 		Name     string
 		Input    *model.WebhookInput
@@ -36,6 +38,7 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 				InputTemplate:  &template,
 				HeaderTemplate: &template,
 				OutputTemplate: &template,
+				RetryPolicy:    retryPolicy,
 			},
 			Expected: &model.Webhook{
 				ObjectID:   applicationID,
@@ -54,6 +57,7 @@ func TestWebhookInput_ToApplicationWebhook(t *testing.T) {
 				InputTemplate:  &template,
 				HeaderTemplate: &template,
 				OutputTemplate: &template,
+				RetryPolicy:    retryPolicy,
 			},
 		},
 		{
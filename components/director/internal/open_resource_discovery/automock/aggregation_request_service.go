@@ -0,0 +1,68 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AggregationRequestService is an autogenerated mock type for the AggregationRequestService type
+type AggregationRequestService struct {
+	mock.Mock
+}
+
+// DeleteProcessedGlobal provides a mock function with given fields: ctx, request
+func (_m *AggregationRequestService) DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ORDAggregationRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListDueGlobal provides a mock function with given fields: ctx
+func (_m *AggregationRequestService) ListDueGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.ORDAggregationRequest
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.ORDAggregationRequest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ORDAggregationRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordFailedAttemptGlobal provides a mock function with given fields: ctx, request, retryAfter
+func (_m *AggregationRequestService) RecordFailedAttemptGlobal(ctx context.Context, request *model.ORDAggregationRequest, retryAfter time.Duration) error {
+	ret := _m.Called(ctx, request, retryAfter)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ORDAggregationRequest, time.Duration) error); ok {
+		r0 = rf(ctx, request, retryAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+)
+
+// AggregationStatusService is an autogenerated mock type for the AggregationStatusService type
+type AggregationStatusService struct {
+	mock.Mock
+}
+
+// GetForWebhook provides a mock function with given fields: ctx, appID, webhookID
+func (_m *AggregationStatusService) GetForWebhook(ctx context.Context, appID string, webhookID string) (*model.ORDAggregationStatus, error) {
+	ret := _m.Called(ctx, appID, webhookID)
+
+	var r0 *model.ORDAggregationStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ORDAggregationStatus); ok {
+		r0 = rf(ctx, appID, webhookID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ORDAggregationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, appID, webhookID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Record provides a mock function with given fields: ctx, appID, webhookID, result
+func (_m *AggregationStatusService) Record(ctx context.Context, appID string, webhookID string, result model.ORDAggregationResult) error {
+	ret := _m.Called(ctx, appID, webhookID, result)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, model.ORDAggregationResult) error); ok {
+		r0 = rf(ctx, appID, webhookID, result)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
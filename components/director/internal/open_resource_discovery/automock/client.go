@@ -16,32 +16,25 @@ type Client struct {
 	mock.Mock
 }
 
-// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, app, webhook
-func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (ord.Documents, string, error) {
-	ret := _m.Called(ctx, app, webhook)
+// FetchOpenResourceDiscoveryDocuments provides a mock function with given fields: ctx, app, webhook, cache
+func (_m *Client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *model.ORDFetchCache) (*ord.FetchResult, error) {
+	ret := _m.Called(ctx, app, webhook, cache)
 
-	var r0 ord.Documents
-	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, *model.Webhook) ord.Documents); ok {
-		r0 = rf(ctx, app, webhook)
+	var r0 *ord.FetchResult
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Application, *model.Webhook, *model.ORDFetchCache) *ord.FetchResult); ok {
+		r0 = rf(ctx, app, webhook, cache)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(ord.Documents)
+			r0 = ret.Get(0).(*ord.FetchResult)
 		}
 	}
 
-	var r1 string
-	if rf, ok := ret.Get(1).(func(context.Context, *model.Application, *model.Webhook) string); ok {
-		r1 = rf(ctx, app, webhook)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Application, *model.Webhook, *model.ORDFetchCache) error); ok {
+		r1 = rf(ctx, app, webhook, cache)
 	} else {
-		r1 = ret.Get(1).(string)
+		r1 = ret.Error(1)
 	}
 
-	var r2 error
-	if rf, ok := ret.Get(2).(func(context.Context, *model.Application, *model.Webhook) error); ok {
-		r2 = rf(ctx, app, webhook)
-	} else {
-		r2 = ret.Error(2)
-	}
-
-	return r0, r1, r2
+	return r0, r1
 }
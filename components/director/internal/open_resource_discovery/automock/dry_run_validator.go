@@ -0,0 +1,48 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+)
+
+// DryRunValidator is an autogenerated mock type for the DryRunValidator type
+type DryRunValidator struct {
+	mock.Mock
+}
+
+// ValidateDocuments provides a mock function with given fields: ctx, config, docs
+func (_m *DryRunValidator) ValidateDocuments(ctx context.Context, config *ord.WellKnownConfig, docs ord.Documents) *ord.ValidationReport {
+	ret := _m.Called(ctx, config, docs)
+
+	var r0 *ord.ValidationReport
+	if rf, ok := ret.Get(0).(func(context.Context, *ord.WellKnownConfig, ord.Documents) *ord.ValidationReport); ok {
+		r0 = rf(ctx, config, docs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ord.ValidationReport)
+		}
+	}
+
+	return r0
+}
+
+// ValidateWellKnownURL provides a mock function with given fields: ctx, wellKnownURL
+func (_m *DryRunValidator) ValidateWellKnownURL(ctx context.Context, wellKnownURL string) *ord.ValidationReport {
+	ret := _m.Called(ctx, wellKnownURL)
+
+	var r0 *ord.ValidationReport
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ord.ValidationReport); ok {
+		r0 = rf(ctx, wellKnownURL)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ord.ValidationReport)
+		}
+	}
+
+	return r0
+}
@@ -22,9 +22,25 @@ import (
 // Client represents ORD documents client
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore
 type Client interface {
-	FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (Documents, string, error)
+	FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *model.ORDFetchCache) (*FetchResult, error)
 }
 
+// FetchResult represents the result of fetching the ORD documents of a single ORD .well-known endpoint.
+// If NotModified is true, neither the configuration nor the documents changed since they were cached and Documents is empty.
+type FetchResult struct {
+	Documents   Documents
+	BaseURL     string
+	Cache       *model.ORDFetchCache
+	NotModified bool
+}
+
+const (
+	etagHeader            = "ETag"
+	lastModifiedHeader    = "Last-Modified"
+	ifNoneMatchHeader     = "If-None-Match"
+	ifModifiedSinceHeader = "If-Modified-Since"
+)
+
 type client struct {
 	*http.Client
 	accessStrategyExecutorProvider accessstrategy.ExecutorProvider
@@ -38,73 +54,199 @@ func NewClient(httpClient *http.Client, accessStrategyExecutorProvider accessstr
 	}
 }
 
-// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint
-func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook) (Documents, string, error) {
-	config, err := c.fetchConfig(ctx, app, webhook)
+// FetchOpenResourceDiscoveryDocuments fetches all the documents for a single ORD .well-known endpoint.
+// If a cache from a previous fetch is provided, conditional requests are used and the unchanged documents are reported as not modified.
+func (c *client) FetchOpenResourceDiscoveryDocuments(ctx context.Context, app *model.Application, webhook *model.Webhook, cache *model.ORDFetchCache) (*FetchResult, error) {
+	var cachedConfigValidators model.HTTPCacheValidators
+	if cache != nil {
+		cachedConfigValidators = cache.Config
+	}
+
+	config, configValidators, err := c.fetchConfig(ctx, app, webhook, cachedConfigValidators)
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+
+	var baseURL string
+	var docEntries []*model.ORDDocumentCacheEntry
+	if config == nil {
+		if cache == nil {
+			return nil, errors.New("received not modified open resource discovery well-known configuration without a cached one")
+		}
+		log.C(ctx).Info("ORD well-known configuration is not modified")
+		baseURL = cache.BaseURL
+		docEntries = cache.Documents
+	} else {
+		baseURL, docEntries, err = documentEntriesFromConfig(ctx, *webhook.URL, *config)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cachedDocValidators := make(map[string]model.HTTPCacheValidators)
+	if cache != nil && documentEntriesEqual(cache.Documents, docEntries) {
+		for _, entry := range cache.Documents {
+			cachedDocValidators[entry.URL] = entry.HTTPCacheValidators
+		}
+	}
+
+	newCache := &model.ORDFetchCache{
+		Config:    configValidators,
+		BaseURL:   baseURL,
+		Documents: make([]*model.ORDDocumentCacheEntry, 0, len(docEntries)),
+	}
+
+	docs := make([]*Document, len(docEntries))
+	notModifiedDocs := make([]int, 0)
+	for i, entry := range docEntries {
+		doc, validators, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, entry.URL, accessstrategy.Type(entry.AccessStrategy), cachedDocValidators[entry.URL])
+		if err != nil {
+			return nil, errors.Wrapf(err, "error fetching ORD document from: %s", entry.URL)
+		}
+		if doc == nil {
+			notModifiedDocs = append(notModifiedDocs, i)
+		}
+
+		docs[i] = doc
+		newCache.Documents = append(newCache.Documents, &model.ORDDocumentCacheEntry{
+			URL:                 entry.URL,
+			AccessStrategy:      entry.AccessStrategy,
+			HTTPCacheValidators: validators,
+		})
+	}
+
+	if len(docEntries) > 0 && len(notModifiedDocs) == len(docEntries) {
+		return &FetchResult{BaseURL: baseURL, Cache: newCache, NotModified: true}, nil
+	}
+
+	// Some of the documents changed, so the ones which did not are fetched again to process all the documents together.
+	for _, i := range notModifiedDocs {
+		entry := docEntries[i]
+		doc, validators, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, entry.URL, accessstrategy.Type(entry.AccessStrategy), model.HTTPCacheValidators{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error fetching ORD document from: %s", entry.URL)
+		}
+		if doc == nil {
+			return nil, errors.Errorf("received not modified ORD document from %s for an unconditional request", entry.URL)
+		}
+
+		docs[i] = doc
+		newCache.Documents[i].HTTPCacheValidators = validators
 	}
 
-	baseURL, err := calculateBaseURL(*webhook.URL, *config)
+	return &FetchResult{Documents: docs, BaseURL: baseURL, Cache: newCache}, nil
+}
+
+func documentEntriesFromConfig(ctx context.Context, webhookURL string, config WellKnownConfig) (string, []*model.ORDDocumentCacheEntry, error) {
+	baseURL, err := calculateBaseURL(webhookURL, config)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while calculating baseURL")
+		return "", nil, errors.Wrap(err, "while calculating baseURL")
 	}
 
 	err = config.Validate(baseURL)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "while validating ORD config")
+		return "", nil, errors.Wrap(err, "while validating ORD config")
 	}
 
-	docs := make([]*Document, 0)
+	entries := make([]*model.ORDDocumentCacheEntry, 0, len(config.OpenResourceDiscoveryV1.Documents))
 	for _, docDetails := range config.OpenResourceDiscoveryV1.Documents {
 		documentURL, err := buildDocumentURL(docDetails.URL, baseURL)
 		if err != nil {
-			return nil, "", errors.Wrap(err, "error building document URL")
+			return "", nil, errors.Wrap(err, "error building document URL")
 		}
 		strategy, ok := docDetails.AccessStrategies.GetSupported()
 		if !ok {
 			log.C(ctx).Warnf("Unsupported access strategies for ORD Document %q", documentURL)
 			continue
 		}
-		doc, err := c.fetchOpenDiscoveryDocumentWithAccessStrategy(ctx, documentURL, strategy)
-		if err != nil {
-			return nil, "", errors.Wrapf(err, "error fetching ORD document from: %s", documentURL)
-		}
 
-		docs = append(docs, doc)
+		entries = append(entries, &model.ORDDocumentCacheEntry{
+			URL:            documentURL,
+			AccessStrategy: string(strategy),
+		})
 	}
 
-	return docs, baseURL, nil
+	return baseURL, entries, nil
+}
+
+func documentEntriesEqual(cached, current []*model.ORDDocumentCacheEntry) bool {
+	if len(cached) != len(current) {
+		return false
+	}
+	for i := range cached {
+		if cached[i].URL != current[i].URL || cached[i].AccessStrategy != current[i].AccessStrategy {
+			return false
+		}
+	}
+	return true
 }
 
-func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type) (*Document, error) {
+// fetchOpenDiscoveryDocumentWithAccessStrategy returns a nil document if it is not modified according to the provided cache validators.
+func (c *client) fetchOpenDiscoveryDocumentWithAccessStrategy(ctx context.Context, documentURL string, accessStrategy accessstrategy.Type, cachedValidators model.HTTPCacheValidators) (*Document, model.HTTPCacheValidators, error) {
 	log.C(ctx).Infof("Fetching ORD Document %q with Access Strategy %q", documentURL, accessStrategy)
 	executor, err := c.accessStrategyExecutorProvider.Provide(accessStrategy)
 	if err != nil {
-		return nil, err
+		return nil, model.HTTPCacheValidators{}, err
 	}
 
-	resp, err := executor.Execute(c.Client, documentURL)
+	resp, err := executor.Execute(c.Client, documentURL, conditionalRequestHeaders(cachedValidators))
 	if err != nil {
-		return nil, err
+		return nil, model.HTTPCacheValidators{}, err
 	}
 
 	defer closeBody(ctx, resp.Body)
 
+	if resp.StatusCode == http.StatusNotModified && !cachedValidators.IsEmpty() {
+		log.C(ctx).Infof("ORD Document %q is not modified", documentURL)
+		return nil, validatorsFromResponse(resp, cachedValidators), nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
+		return nil, model.HTTPCacheValidators{}, errors.Errorf("error while fetching open resource discovery document %q: status code %d", documentURL, resp.StatusCode)
 	}
 
 	resp.Body = http.MaxBytesReader(nil, resp.Body, 2097152)
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "error reading document body")
+		return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error reading document body")
 	}
 	result := &Document{}
 	if err := json.Unmarshal(bodyBytes, &result); err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling document")
+		return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error unmarshaling document")
 	}
-	return result, nil
+	return result, validatorsFromResponse(resp, model.HTTPCacheValidators{}), nil
+}
+
+// conditionalRequestHeaders returns the headers of a conditional request for a resource with the given cache validators.
+func conditionalRequestHeaders(validators model.HTTPCacheValidators) http.Header {
+	if validators.IsEmpty() {
+		return nil
+	}
+
+	headers := http.Header{}
+	if len(validators.ETag) > 0 {
+		headers.Set(ifNoneMatchHeader, validators.ETag)
+	}
+	if len(validators.LastModified) > 0 {
+		headers.Set(ifModifiedSinceHeader, validators.LastModified)
+	}
+	return headers
+}
+
+// validatorsFromResponse returns the cache validators of the response, falling back to the given ones for the missing validators.
+// A Not Modified response is not required to contain the validators of the resource.
+func validatorsFromResponse(resp *http.Response, fallback model.HTTPCacheValidators) model.HTTPCacheValidators {
+	validators := model.HTTPCacheValidators{
+		ETag:         resp.Header.Get(etagHeader),
+		LastModified: resp.Header.Get(lastModifiedHeader),
+	}
+	if len(validators.ETag) == 0 {
+		validators.ETag = fallback.ETag
+	}
+	if len(validators.LastModified) == 0 {
+		validators.LastModified = fallback.LastModified
+	}
+	return validators
 }
 
 func closeBody(ctx context.Context, body io.ReadCloser) {
@@ -113,50 +255,58 @@ func closeBody(ctx context.Context, body io.ReadCloser) {
 	}
 }
 
-func (c *client) fetchConfig(ctx context.Context, app *model.Application, webhook *model.Webhook) (*WellKnownConfig, error) {
+// fetchConfig returns a nil config if it is not modified according to the provided cache validators.
+func (c *client) fetchConfig(ctx context.Context, app *model.Application, webhook *model.Webhook, cachedValidators model.HTTPCacheValidators) (*WellKnownConfig, model.HTTPCacheValidators, error) {
 	var resp *http.Response
 	var err error
+	headers := conditionalRequestHeaders(cachedValidators)
 	if webhook.Auth != nil && webhook.Auth.AccessStrategy != nil && len(*webhook.Auth.AccessStrategy) > 0 {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) ORD webhook is configured with %q access strategy.", app.Name, app.ID, app.Type, *webhook.Auth.AccessStrategy)
 		executor, err := c.accessStrategyExecutorProvider.Provide(accessstrategy.Type(*webhook.Auth.AccessStrategy))
 		if err != nil {
-			return nil, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
+			return nil, model.HTTPCacheValidators{}, errors.Wrapf(err, "cannot find executor for access strategy %q as part of webhook processing", *webhook.Auth.AccessStrategy)
 		}
-		resp, err = executor.Execute(c.Client, *webhook.URL)
+		resp, err = executor.Execute(c.Client, *webhook.URL, headers)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
+			return nil, model.HTTPCacheValidators{}, errors.Wrapf(err, "error while fetching open resource discovery well-known configuration with access strategy %q", *webhook.Auth.AccessStrategy)
 		}
 	} else if webhook.Auth != nil {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) configuration endpoint is secured and webhook credentials will be used", app.Name, app.ID, app.Type)
-		resp, err = httputil.GetRequestWithCredentials(ctx, c.Client, *webhook.URL, webhook.Auth)
+		resp, err = httputil.GetRequestWithCredentialsAndHeaders(ctx, c.Client, *webhook.URL, headers, webhook.Auth)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
+			return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error while fetching open resource discovery well-known configuration with webhook credentials")
 		}
 	} else {
 		log.C(ctx).Infof("Application %q (id = %q, type = %q) configuration endpoint is not secured", app.Name, app.ID, app.Type)
-		resp, err = httputil.GetRequestWithoutCredentials(c.Client, *webhook.URL)
+		resp, err = httputil.GetRequestWithoutCredentialsAndHeaders(c.Client, *webhook.URL, headers)
 		if err != nil {
-			return nil, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
+			return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error while fetching open resource discovery well-known configuration")
 		}
 	}
 
 	defer closeBody(ctx, resp.Body)
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "error reading response body")
+	if resp.StatusCode == http.StatusNotModified && !cachedValidators.IsEmpty() {
+		return nil, validatorsFromResponse(resp, cachedValidators), nil
 	}
 
+	// The response body is not part of the error, as the error is returned to the callers of the ORD dry-run validation
 	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d Body: %s", resp.StatusCode, string(bodyBytes))
+		return nil, model.HTTPCacheValidators{}, errors.Errorf("error while fetching open resource discovery well-known configuration: status code %d", resp.StatusCode)
+	}
+
+	resp.Body = http.MaxBytesReader(nil, resp.Body, 2097152)
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error reading response body")
 	}
 
 	config := WellKnownConfig{}
 	if err := json.Unmarshal(bodyBytes, &config); err != nil {
-		return nil, errors.Wrap(err, "error unmarshaling json body")
+		return nil, model.HTTPCacheValidators{}, errors.Wrap(err, "error unmarshaling json body")
 	}
 
-	return &config, nil
+	return &config, validatorsFromResponse(resp, model.HTTPCacheValidators{}), nil
 }
 
 func buildDocumentURL(docURL, baseURL string) (string, error) {
@@ -92,6 +92,40 @@ var successfulRoundTripFunc = func(t *testing.T, bothBaseURLsProvided, noBaseURL
 	}
 }
 
+// conditionalRoundTripFunc serves the ORD config and document with the given ETags and responds with Not Modified to matching conditional requests
+var conditionalRoundTripFunc = func(t *testing.T, configETag, docETag string) func(req *http.Request) *http.Response {
+	return func(req *http.Request) *http.Response {
+		var data []byte
+		var err error
+		var etag string
+		if strings.Contains(req.URL.String(), ord.WellKnownEndpoint) {
+			etag = configETag
+			data, err = json.Marshal(fixWellKnownConfig())
+			require.NoError(t, err)
+		} else if strings.Contains(req.URL.String(), ordDocURI) {
+			etag = docETag
+			data, err = json.Marshal(fixORDDocument())
+			require.NoError(t, err)
+		} else {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+			}
+		}
+
+		statusCode := http.StatusOK
+		if req.Header.Get("If-None-Match") == etag {
+			statusCode = http.StatusNotModified
+			data = nil
+		}
+		return &http.Response{
+			StatusCode: statusCode,
+			Header:     http.Header{"Etag": []string{etag}},
+			Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
+		}
+	}
+}
+
 func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 	testErr := errors.New("test")
 
@@ -105,6 +139,9 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 		ExpectedBaseURL      string
 		ExpectedErr          error
 		WebhookURL           string
+		Cache                *model.ORDFetchCache
+		ExpectedNotModified  bool
+		ExpectedCache        *model.ORDFetchCache
 	}{
 		{
 			Name:          "Success when webhookURL contains /well-known suffix",
@@ -180,7 +217,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				require.NoError(t, err)
 
 				executor := &automock.Executor{}
-				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint).Return(&http.Response{
+				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint, http.Header(nil)).Return(&http.Response{
 					StatusCode: http.StatusOK,
 					Body:       ioutil.NopCloser(bytes.NewBuffer(data)),
 				}, nil).Once()
@@ -212,7 +249,7 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			Name: "Well-known config fetch with access strategy fails when access strategy executor returns error",
 			ExecutorProviderFunc: func() accessstrategy.ExecutorProvider {
 				executor := &automock.Executor{}
-				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint).Return(nil, testErr).Once()
+				executor.On("Execute", mock.Anything, baseURL+ord.WellKnownEndpoint, http.Header(nil)).Return(nil, testErr).Once()
 
 				executorProvider := &automock.ExecutorProvider{}
 				executorProvider.On("Provide", accessstrategy.Type(testAccessStrategy)).Return(executor, nil).Once()
@@ -358,6 +395,52 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 			ExpectedResult: ord.Documents{},
 			ExpectedErr:    errors.New("error unmarshaling document"),
 		},
+		{
+			Name:            "Success when documents are fetched without cache should return their cache validators",
+			RoundTripFunc:   conditionalRoundTripFunc(t, configETag, docETag),
+			ExpectedResult:  ord.Documents{fixORDDocument()},
+			ExpectedBaseURL: baseURL,
+			ExpectedCache:   fixORDFetchCache(docETag),
+		},
+		{
+			Name:                "Success when neither config nor documents are modified should return not modified",
+			RoundTripFunc:       conditionalRoundTripFunc(t, configETag, docETag),
+			Cache:               fixORDFetchCache(docETag),
+			ExpectedResult:      nil,
+			ExpectedBaseURL:     baseURL,
+			ExpectedNotModified: true,
+			ExpectedCache:       fixORDFetchCache(docETag),
+		},
+		{
+			Name:            "Success when config is not modified but a document is modified should return the documents",
+			RoundTripFunc:   conditionalRoundTripFunc(t, configETag, `"modified-doc-etag"`),
+			Cache:           fixORDFetchCache(docETag),
+			ExpectedResult:  ord.Documents{fixORDDocument()},
+			ExpectedBaseURL: baseURL,
+			ExpectedCache:   fixORDFetchCache(`"modified-doc-etag"`),
+		},
+		{
+			Name:          "Success when the cached documents differ from the config should fetch the documents unconditionally",
+			RoundTripFunc: conditionalRoundTripFunc(t, `"modified-config-etag"`, docETag),
+			Cache: &model.ORDFetchCache{
+				Config:  model.HTTPCacheValidators{ETag: configETag},
+				BaseURL: baseURL,
+				Documents: []*model.ORDDocumentCacheEntry{
+					{
+						URL:                 baseURL + "/removed-document",
+						AccessStrategy:      string(accessstrategy.OpenAccessStrategy),
+						HTTPCacheValidators: model.HTTPCacheValidators{ETag: docETag},
+					},
+				},
+			},
+			ExpectedResult:  ord.Documents{fixORDDocument()},
+			ExpectedBaseURL: baseURL,
+			ExpectedCache: func() *model.ORDFetchCache {
+				cache := fixORDFetchCache(docETag)
+				cache.Config.ETag = `"modified-config-etag"`
+				return cache
+			}(),
+		},
 	}
 
 	for _, test := range testCases {
@@ -390,16 +473,22 @@ func TestClient_FetchOpenResourceDiscoveryDocuments(t *testing.T) {
 				testWebhook.Auth.AccessStrategy = &test.AccessStrategy
 			}
 
-			docs, actualBaseURL, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook)
+			result, err := client.FetchOpenResourceDiscoveryDocuments(context.TODO(), testApp, testWebhook, test.Cache)
 
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), test.ExpectedErr.Error())
 			} else {
 				require.NoError(t, err)
-				require.Len(t, docs, len(test.ExpectedResult))
-				require.Equal(t, test.ExpectedBaseURL, actualBaseURL)
-				require.Equal(t, test.ExpectedResult, docs)
+				require.Len(t, result.Documents, len(test.ExpectedResult))
+				require.Equal(t, test.ExpectedBaseURL, result.BaseURL)
+				require.Equal(t, test.ExpectedNotModified, result.NotModified)
+				if len(test.ExpectedResult) > 0 {
+					require.Equal(t, test.ExpectedResult, result.Documents)
+				}
+				if test.ExpectedCache != nil {
+					require.Equal(t, test.ExpectedCache, result.Cache)
+				}
 			}
 
 			if test.ExecutorProviderFunc != nil {
@@ -0,0 +1,128 @@
+package ord
+
+import (
+	"context"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	dryRunApplicationName = "ord-dry-run"
+	configResourceType    = "config"
+)
+
+// ValidationReport is the result of a dry-run validation of ORD documents.
+type ValidationReport struct {
+	Valid  bool                        `json:"valid"`
+	Errors []*model.ORDValidationError `json:"errors"`
+}
+
+// DryRunValidator validates ORD documents the same way the aggregator does, without persisting anything.
+//go:generate mockery --name=DryRunValidator --output=automock --outpkg=automock --case=underscore
+type DryRunValidator interface {
+	ValidateDocuments(ctx context.Context, config *WellKnownConfig, docs Documents) *ValidationReport
+	ValidateWellKnownURL(ctx context.Context, wellKnownURL string) *ValidationReport
+}
+
+type dryRunValidator struct {
+	client Client
+}
+
+// NewDryRunValidator creates a new DryRunValidator, which uses the provided Client for fetching the ORD documents of a .well-known URL.
+func NewDryRunValidator(client Client) *dryRunValidator {
+	return &dryRunValidator{
+		client: client,
+	}
+}
+
+// ValidateDocuments validates the provided ORD documents and the optional well-known configuration they are served with.
+// The baseURL of the configuration, if any, is used for the validation of the documents.
+func (v *dryRunValidator) ValidateDocuments(ctx context.Context, config *WellKnownConfig, docs Documents) *ValidationReport {
+	report := newValidationReport()
+
+	var baseURL string
+	if config != nil {
+		baseURL = config.BaseURL
+		if err := config.Validate(baseURL); err != nil {
+			report.add(configResourceType, err)
+		}
+	}
+
+	v.validateDocuments(ctx, report, baseURL, docs)
+	return report
+}
+
+// ValidateWellKnownURL fetches the well-known configuration and the ORD documents of the provided URL and validates them.
+// Only ORD documents served with the open access strategy can be fetched.
+func (v *dryRunValidator) ValidateWellKnownURL(ctx context.Context, wellKnownURL string) *ValidationReport {
+	report := newValidationReport()
+
+	app := &model.Application{
+		Name:       dryRunApplicationName,
+		BaseEntity: &model.BaseEntity{},
+	}
+	webhook := &model.Webhook{
+		Type: model.WebhookTypeOpenResourceDiscovery,
+		URL:  &wellKnownURL,
+	}
+
+	result, err := v.client.FetchOpenResourceDiscoveryDocuments(ctx, app, webhook, nil)
+	if err != nil {
+		log.C(ctx).WithError(err).Infof("Dry-run fetch of ORD documents from %q failed: %v", wellKnownURL, err)
+		report.add("", err)
+		return report
+	}
+
+	v.validateDocuments(ctx, report, result.BaseURL, result.Documents)
+	return report
+}
+
+// validateDocuments runs the validation and sanitization pipeline of the aggregator on the documents.
+// As nothing is persisted, the documents are validated as if none of their resources were aggregated before.
+func (v *dryRunValidator) validateDocuments(ctx context.Context, report *ValidationReport, baseURL string, docs Documents) {
+	assignSAPVendor(docs)
+
+	resourceHashes, err := hashResources(docs)
+	if err != nil {
+		report.add("", errors.Wrap(err, "while hashing ORD resources"))
+		return
+	}
+
+	if err := docs.Validate(baseURL, map[string]*model.APIDefinition{}, map[string]*model.EventDefinition{}, map[string]*model.Package{}, resourceHashes); err != nil {
+		report.add("", err)
+		return
+	}
+
+	if err := docs.Sanitize(baseURL); err != nil {
+		report.add("", errors.Wrap(err, "while sanitizing ORD documents"))
+		return
+	}
+
+	log.C(ctx).Infof("Dry-run validation of %d ORD documents succeeded", len(docs))
+}
+
+func newValidationReport() *ValidationReport {
+	return &ValidationReport{
+		Valid:  true,
+		Errors: make([]*model.ORDValidationError, 0),
+	}
+}
+
+// add adds the violations of the provided error to the report. The validation errors of the documents are reported
+// with their document index, ORD ID, and field path, while any other error is reported with its message only.
+func (r *ValidationReport) add(resourceType string, err error) {
+	r.Valid = false
+
+	var validationErrs ValidationErrors
+	if errors.As(err, &validationErrs) {
+		r.Errors = append(r.Errors, validationErrs.Violations()...)
+		return
+	}
+
+	r.Errors = append(r.Errors, &model.ORDValidationError{
+		ResourceType: resourceType,
+		Message:      err.Error(),
+	})
+}
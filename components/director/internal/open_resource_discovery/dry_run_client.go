@@ -0,0 +1,86 @@
+package ord
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
+	"github.com/pkg/errors"
+)
+
+// defaultBlockedCIDRs are the non-public networks which are not covered by the checks of the net package.
+// The shared address space is often used for the pod and service networks of Kubernetes clusters.
+var defaultBlockedCIDRs = []string{
+	"0.0.0.0/8",
+	"100.64.0.0/10",
+}
+
+// NewDryRunHTTPClient returns an HTTP client for fetching the ORD documents of the URLs provided in dry-run validation requests.
+// As the URLs are chosen by the caller, the client refuses to connect to loopback, link-local, private and the given blocked addresses,
+// so that the dry-run validation can not be used for reaching the services in the cluster network.
+// The addresses are checked when connecting, so host names resolving to such addresses and redirects to them are refused as well.
+func NewDryRunHTTPClient(timeout time.Duration, blockedCIDRs []string) (*http.Client, error) {
+	blockedNetworks, err := parseCIDRs(append(defaultBlockedCIDRs, blockedCIDRs...))
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			return verifyPublicAddress(address, blockedNetworks)
+		},
+	}
+
+	transport := &http.Transport{
+		DialContext:           dialer.DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: httputil.NewCorrelationIDTransport(transport),
+	}, nil
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing blocked CIDR %q", cidr)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+func verifyPublicAddress(address string, blockedNetworks []*net.IPNet) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("address %q is not an IP address", host)
+	}
+
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return errors.Errorf("connections to non-public address %s are not allowed", ip)
+	}
+
+	for _, network := range blockedNetworks {
+		if network.Contains(ip) {
+			return errors.Errorf("connections to non-public address %s are not allowed", ip)
+		}
+	}
+
+	return nil
+}
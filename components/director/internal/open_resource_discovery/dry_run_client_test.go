@@ -0,0 +1,72 @@
+package ord_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDryRunHTTPClient(t *testing.T) {
+	t.Run("refuses to connect to non-public addresses", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+			writer.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client, err := ord.NewDryRunHTTPClient(time.Second, nil)
+		require.NoError(t, err)
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		if resp != nil {
+			require.NoError(t, resp.Body.Close())
+		}
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "connections to non-public address 127.0.0.1 are not allowed")
+	})
+
+	t.Run("returns error when a blocked CIDR is not valid", func(t *testing.T) {
+		_, err := ord.NewDryRunHTTPClient(time.Second, []string{"10.0.0.0"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while parsing blocked CIDR")
+	})
+}
+
+func TestVerifyPublicAddress(t *testing.T) {
+	blockedNetworks := ord.ParseBlockedCIDRs(t, "203.0.113.0/24")
+
+	testCases := map[string]bool{
+		"127.0.0.1:80":         false,
+		"[::1]:80":             false,
+		"10.1.2.3:443":         false,
+		"172.16.0.1:443":       false,
+		"192.168.1.1:443":      false,
+		"169.254.169.254:80":   false,
+		"[fe80::1]:80":         false,
+		"[fd00::1]:80":         false,
+		"100.64.0.1:80":        false,
+		"0.0.0.0:80":           false,
+		"[::ffff:10.0.0.1]:80": false,
+		"203.0.113.10:443":     false,
+		"93.184.216.34:443":    true,
+		"[2606:2800::1]:443":   true,
+	}
+
+	for address, allowed := range testCases {
+		t.Run(address, func(t *testing.T) {
+			err := ord.VerifyPublicAddress(address, blockedNetworks)
+			if allowed {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package ord
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/httputils"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+)
+
+// DryRunRequiredScopesPath is the path in the scopes configuration of the scopes required for the ORD dry-run validation.
+// The validation fetches the documents of arbitrary URLs, so it is protected by a dedicated scope.
+const DryRunRequiredScopesPath = "ord.validate"
+
+// maxDryRunRequestSize is the maximum size of a dry-run validation request body. It allows a few ORD documents of the maximum size accepted by the aggregator.
+const maxDryRunRequestSize = 5 * 2097152
+
+// DryRunRequest is the expected request body of the ORD dry-run validation endpoint.
+// Either WellKnownURL, or Documents with an optional well-known Config must be provided.
+type DryRunRequest struct {
+	WellKnownURL string           `json:"wellKnownURL,omitempty"`
+	Config       *WellKnownConfig `json:"config,omitempty"`
+	Documents    Documents        `json:"documents,omitempty"`
+}
+
+// Validate validates the ORD dry-run validation request.
+func (r DryRunRequest) Validate() error {
+	isURLProvided := len(r.WellKnownURL) > 0
+	return validation.ValidateStruct(&r,
+		validation.Field(&r.WellKnownURL, is.URL),
+		validation.Field(&r.Config, validation.When(isURLProvided, validation.Nil)),
+		validation.Field(&r.Documents, validation.When(isURLProvided, validation.Empty).Else(validation.Required)),
+	)
+}
+
+// ScopesGetter is responsible for getting the scopes required for the ORD dry-run validation.
+//go:generate mockery --name=ScopesGetter --output=automock --outpkg=automock --case=underscore
+type ScopesGetter interface {
+	GetRequiredScopes(scopesDefinition string) ([]string, error)
+}
+
+type dryRunHandler struct {
+	validator    DryRunValidator
+	scopesGetter ScopesGetter
+}
+
+// NewDryRunHandler returns a new HTTP handler which validates ORD documents without aggregating them.
+func NewDryRunHandler(validator DryRunValidator, scopesGetter ScopesGetter) *dryRunHandler {
+	return &dryRunHandler{
+		validator:    validator,
+		scopesGetter: scopesGetter,
+	}
+}
+
+// ServeHTTP handles the ORD dry-run validation requests. The validation report is returned with status OK, regardless of whether the documents are valid.
+func (h *dryRunHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	if request.Method != http.MethodPost {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.verifyScopes(ctx); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while verifying scopes: %v", err)
+		apperrors.WriteAppError(ctx, writer, err, http.StatusForbidden)
+		return
+	}
+
+	var dryRunRequest DryRunRequest
+	if err := json.NewDecoder(http.MaxBytesReader(writer, request.Body, maxDryRunRequestSize)).Decode(&dryRunRequest); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while decoding request body: %v", err)
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unable to decode request body"), http.StatusBadRequest)
+		return
+	}
+
+	if err := dryRunRequest.Validate(); err != nil {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid dry-run request: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	var report *ValidationReport
+	if len(dryRunRequest.WellKnownURL) > 0 {
+		report = h.validator.ValidateWellKnownURL(ctx, dryRunRequest.WellKnownURL)
+	} else {
+		report = h.validator.ValidateDocuments(ctx, dryRunRequest.Config, dryRunRequest.Documents)
+	}
+
+	httputils.RespondWithBody(ctx, writer, http.StatusOK, report)
+}
+
+func (h *dryRunHandler) verifyScopes(ctx context.Context) error {
+	actualScopes, err := scope.LoadFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	requiredScopes, err := h.scopesGetter.GetRequiredScopes(DryRunRequiredScopesPath)
+	if err != nil {
+		return apperrors.InternalErrorFrom(err, "while getting required scopes")
+	}
+
+	if !str.Matches(actualScopes, requiredScopes) {
+		return apperrors.NewInsufficientScopesError(requiredScopes, actualScopes)
+	}
+	return nil
+}
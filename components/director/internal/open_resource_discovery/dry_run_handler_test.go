@@ -0,0 +1,128 @@
+package ord_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/scope"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const dryRunScope = "ord:validate"
+
+func TestDryRunHandler_ServeHTTP(t *testing.T) {
+	wellKnownURL := baseURL + ord.WellKnownEndpoint
+	validReport := &ord.ValidationReport{Valid: true, Errors: []*model.ORDValidationError{}}
+	invalidReport := &ord.ValidationReport{
+		Errors: []*model.ORDValidationError{fixPackageViolation("title")},
+	}
+
+	t.Run("when request method is not POST it should return method not allowed", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
+		require.NoError(t, err)
+
+		handler := ord.NewDryRunHandler(nil, nil)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Method not allowed")
+		require.Equal(t, http.StatusMethodNotAllowed, writer.Code)
+	})
+
+	t.Run("when the caller does not have the required scopes it should return forbidden", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req, err := http.NewRequestWithContext(scope.SaveToContext(context.Background(), []string{"application:read"}), http.MethodPost, "/", bytes.NewReader(marshalDryRunRequest(t, ord.DryRunRequest{WellKnownURL: wellKnownURL})))
+		require.NoError(t, err)
+
+		handler := ord.NewDryRunHandler(nil, fixScopesGetter())
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "insufficient scopes provided")
+		require.Equal(t, http.StatusForbidden, writer.Code)
+	})
+
+	t.Run("when request body is not valid JSON it should return bad request", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixDryRunRequest(t, []byte(`{"wellKnownURL": 1}`))
+
+		handler := ord.NewDryRunHandler(nil, fixScopesGetter())
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unable to decode request body")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+
+	invalidRequests := map[string]ord.DryRunRequest{
+		"neither URL nor documents are provided": {},
+		"both URL and documents are provided":    {WellKnownURL: wellKnownURL, Documents: ord.Documents{fixORDDocument()}},
+		"both URL and config are provided":       {WellKnownURL: wellKnownURL, Config: fixWellKnownConfig()},
+		"URL is not valid":                       {WellKnownURL: "not a url"},
+	}
+	for name, dryRunRequest := range invalidRequests {
+		t.Run("when "+name+" it should return bad request", func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			req := fixDryRunRequest(t, marshalDryRunRequest(t, dryRunRequest))
+
+			handler := ord.NewDryRunHandler(nil, fixScopesGetter())
+			handler.ServeHTTP(writer, req)
+
+			require.Contains(t, writer.Body.String(), "Invalid dry-run request")
+			require.Equal(t, http.StatusBadRequest, writer.Code)
+		})
+	}
+
+	t.Run("when documents are provided it should return their validation report", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixDryRunRequest(t, marshalDryRunRequest(t, ord.DryRunRequest{Config: fixWellKnownConfig(), Documents: ord.Documents{fixORDDocument()}}))
+		validator := &automock.DryRunValidator{}
+		validator.On("ValidateDocuments", mock.Anything, mock.AnythingOfType("*ord.WellKnownConfig"), mock.AnythingOfType("ord.Documents")).Return(invalidReport).Once()
+		defer validator.AssertExpectations(t)
+
+		handler := ord.NewDryRunHandler(validator, fixScopesGetter())
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.JSONEq(t, `{"valid": false, "errors": [{"documentIndex": 0, "resourceType": "package", "ordId": "`+packageORDID+`", "path": "packages[0].title", "rule": "validation_required", "message": "cannot be blank"}]}`, writer.Body.String())
+	})
+
+	t.Run("when well-known URL is provided it should return the validation report of its documents", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixDryRunRequest(t, marshalDryRunRequest(t, ord.DryRunRequest{WellKnownURL: wellKnownURL}))
+		validator := &automock.DryRunValidator{}
+		validator.On("ValidateWellKnownURL", mock.Anything, wellKnownURL).Return(validReport).Once()
+		defer validator.AssertExpectations(t)
+
+		handler := ord.NewDryRunHandler(validator, fixScopesGetter())
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.JSONEq(t, `{"valid": true, "errors": []}`, writer.Body.String())
+	})
+}
+
+func fixDryRunRequest(t *testing.T, body []byte) *http.Request {
+	ctx := scope.SaveToContext(context.Background(), []string{dryRunScope})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", bytes.NewReader(body))
+	require.NoError(t, err)
+	return req
+}
+
+func fixScopesGetter() *automock.ScopesGetter {
+	scopesGetter := &automock.ScopesGetter{}
+	scopesGetter.On("GetRequiredScopes", ord.DryRunRequiredScopesPath).Return([]string{dryRunScope}, nil)
+	return scopesGetter
+}
+
+func marshalDryRunRequest(t *testing.T, dryRunRequest ord.DryRunRequest) []byte {
+	body, err := json.Marshal(dryRunRequest)
+	require.NoError(t, err)
+	return body
+}
@@ -0,0 +1,197 @@
+package ord_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
+	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunValidator_ValidateDocuments(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		ConfigProvider func() *ord.WellKnownConfig
+		DocsProvider   func() ord.Documents
+		ExpectedValid  bool
+		ExpectedErrors []*model.ORDValidationError
+	}{
+		{
+			Name:           "Valid documents with config",
+			ConfigProvider: fixWellKnownConfig,
+			DocsProvider: func() ord.Documents {
+				return ord.Documents{fixORDDocument()}
+			},
+			ExpectedValid:  true,
+			ExpectedErrors: []*model.ORDValidationError{},
+		},
+		{
+			Name: "Valid documents without config",
+			ConfigProvider: func() *ord.WellKnownConfig {
+				return nil
+			},
+			DocsProvider: func() ord.Documents {
+				return ord.Documents{fixORDDocumentWithBaseURL(baseURL)}
+			},
+			ExpectedValid:  true,
+			ExpectedErrors: []*model.ORDValidationError{},
+		},
+		{
+			Name:           "Field errors of invalid resource are reported with their field path",
+			ConfigProvider: fixWellKnownConfig,
+			DocsProvider: func() ord.Documents {
+				doc := fixORDDocument()
+				doc.Packages[0].Title = ""
+				doc.Packages[0].ShortDescription = ""
+				return ord.Documents{doc}
+			},
+			ExpectedErrors: []*model.ORDValidationError{
+				fixPackageViolation("shortDescription"),
+				fixPackageViolation("title"),
+			},
+		},
+		{
+			Name: "Errors not related to a single resource are reported with their message",
+			ConfigProvider: func() *ord.WellKnownConfig {
+				return nil
+			},
+			DocsProvider: func() ord.Documents {
+				doc := fixORDDocument()
+				doc.DescribedSystemInstance = nil
+				return ord.Documents{doc}
+			},
+			ExpectedErrors: []*model.ORDValidationError{
+				{
+					Message: "no baseURL was provided neither from /well-known URL, nor from config, nor from describedSystemInstance",
+				},
+			},
+		},
+		{
+			Name: "Config errors are reported together with document errors",
+			ConfigProvider: func() *ord.WellKnownConfig {
+				config := fixWellKnownConfig()
+				config.OpenResourceDiscoveryV1.Documents = nil
+				return config
+			},
+			DocsProvider: func() ord.Documents {
+				doc := fixORDDocument()
+				doc.Packages[0].Title = ""
+				return ord.Documents{doc}
+			},
+			ExpectedErrors: []*model.ORDValidationError{
+				{
+					ResourceType: "config",
+					Message:      "cannot be blank",
+				},
+				fixPackageViolation("title"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := &automock.Client{}
+			validator := ord.NewDryRunValidator(client)
+
+			// WHEN
+			report := validator.ValidateDocuments(context.TODO(), testCase.ConfigProvider(), testCase.DocsProvider())
+
+			// THEN
+			require.NotNil(t, report)
+			assert.Equal(t, testCase.ExpectedValid, report.Valid)
+			assert.Equal(t, testCase.ExpectedErrors, report.Errors)
+
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func TestDryRunValidator_ValidateWellKnownURL(t *testing.T) {
+	wellKnownURL := baseURL + ord.WellKnownEndpoint
+	expectedApp := &model.Application{
+		Name:       "ord-dry-run",
+		BaseEntity: &model.BaseEntity{},
+	}
+	expectedWebhook := &model.Webhook{
+		Type: model.WebhookTypeOpenResourceDiscovery,
+		URL:  &wellKnownURL,
+	}
+
+	testCases := []struct {
+		Name           string
+		ClientFn       func() *automock.Client
+		ExpectedValid  bool
+		ExpectedErrors []*model.ORDValidationError
+	}{
+		{
+			Name: "Valid documents",
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), expectedApp, expectedWebhook, (*model.ORDFetchCache)(nil)).Return(&ord.FetchResult{Documents: ord.Documents{fixORDDocument()}, BaseURL: baseURL}, nil).Once()
+				return client
+			},
+			ExpectedValid:  true,
+			ExpectedErrors: []*model.ORDValidationError{},
+		},
+		{
+			Name: "Invalid documents",
+			ClientFn: func() *automock.Client {
+				doc := fixORDDocument()
+				doc.Packages[0].Title = ""
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), expectedApp, expectedWebhook, (*model.ORDFetchCache)(nil)).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: baseURL}, nil).Once()
+				return client
+			},
+			ExpectedErrors: []*model.ORDValidationError{
+				fixPackageViolation("title"),
+			},
+		},
+		{
+			Name: "Fetch errors are reported with their message",
+			ClientFn: func() *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", context.TODO(), expectedApp, expectedWebhook, (*model.ORDFetchCache)(nil)).Return(nil, errors.New("test error")).Once()
+				return client
+			},
+			ExpectedErrors: []*model.ORDValidationError{
+				{
+					Message: "test error",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := testCase.ClientFn()
+			validator := ord.NewDryRunValidator(client)
+
+			// WHEN
+			report := validator.ValidateWellKnownURL(context.TODO(), wellKnownURL)
+
+			// THEN
+			require.NotNil(t, report)
+			assert.Equal(t, testCase.ExpectedValid, report.Valid)
+			assert.Equal(t, testCase.ExpectedErrors, report.Errors)
+
+			mock.AssertExpectationsForObjects(t, client)
+		})
+	}
+}
+
+func fixPackageViolation(field string) *model.ORDValidationError {
+	docIdx := 0
+	return &model.ORDValidationError{
+		DocumentIndex: &docIdx,
+		ResourceType:  "package",
+		OrdID:         packageORDID,
+		Path:          "packages[0]." + field,
+		Rule:          "validation_required",
+		Message:       "cannot be blank",
+	}
+}
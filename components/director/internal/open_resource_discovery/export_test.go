@@ -0,0 +1,21 @@
+package ord
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// HashDocuments exposes hashDocuments for testing.
+var HashDocuments = hashDocuments
+
+// VerifyPublicAddress exposes verifyPublicAddress for testing.
+var VerifyPublicAddress = verifyPublicAddress
+
+// ParseBlockedCIDRs parses the given CIDRs together with the default blocked ones for testing.
+func ParseBlockedCIDRs(t *testing.T, cidrs ...string) []*net.IPNet {
+	networks, err := parseCIDRs(append(defaultBlockedCIDRs, cidrs...))
+	require.NoError(t, err)
+	return networks
+}
@@ -20,6 +20,8 @@ const (
 	baseURL                = "http://test.com:8080"
 	baseURL2               = "http://second.com"
 	customWebhookConfigURL = "http://custom.com/config/endpoint"
+	configETag             = `"config-etag"`
+	docETag                = `"doc-etag"`
 	packageORDID           = "ns:package:PACKAGE_ID:v1"
 	productORDID           = "sap:product:id:"
 	product2ORDID          = "ns:product:id2:"
@@ -186,6 +188,20 @@ func fixResourceHashes() map[string]uint64 {
 	}
 }
 
+func fixORDFetchCache(docETag string) *model.ORDFetchCache {
+	return &model.ORDFetchCache{
+		Config:  model.HTTPCacheValidators{ETag: configETag},
+		BaseURL: baseURL,
+		Documents: []*model.ORDDocumentCacheEntry{
+			{
+				URL:                 baseURL + ordDocURI,
+				AccessStrategy:      string(accessstrategy.OpenAccessStrategy),
+				HTTPCacheValidators: model.HTTPCacheValidators{ETag: docETag},
+			},
+		},
+	}
+}
+
 func fixWellKnownConfig() *ord.WellKnownConfig {
 	return &ord.WellKnownConfig{
 		Schema:  "../spec/v1/generated/Configuration.schema.json",
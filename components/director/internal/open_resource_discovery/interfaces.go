@@ -2,6 +2,7 @@ package ord
 
 import (
 	"context"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -23,6 +24,7 @@ type WebhookService interface {
 //go:generate mockery --name=ApplicationService --output=automock --outpkg=automock --case=underscore
 type ApplicationService interface {
 	ListGlobal(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error)
+	GetGlobalByID(ctx context.Context, id string) (*model.Application, error)
 }
 
 // BundleService is responsible for the service-layer Bundle operations.
@@ -109,3 +111,18 @@ type TombstoneService interface {
 type TenantService interface {
 	GetLowestOwnerForResource(ctx context.Context, resourceType resource.Type, objectID string) (string, error)
 }
+
+// AggregationStatusService is responsible for the service-layer ORD aggregation status operations.
+//go:generate mockery --name=AggregationStatusService --output=automock --outpkg=automock --case=underscore
+type AggregationStatusService interface {
+	GetForWebhook(ctx context.Context, appID, webhookID string) (*model.ORDAggregationStatus, error)
+	Record(ctx context.Context, appID, webhookID string, result model.ORDAggregationResult) error
+}
+
+// AggregationRequestService is responsible for the service-layer on-demand ORD aggregation request operations.
+//go:generate mockery --name=AggregationRequestService --output=automock --outpkg=automock --case=underscore
+type AggregationRequestService interface {
+	ListDueGlobal(ctx context.Context) ([]*model.ORDAggregationRequest, error)
+	DeleteProcessedGlobal(ctx context.Context, request *model.ORDAggregationRequest) error
+	RecordFailedAttemptGlobal(ctx context.Context, request *model.ORDAggregationRequest, retryAfter time.Duration) error
+}
@@ -90,8 +90,16 @@ func (c WellKnownConfig) Validate(baseURL string) error {
 // Documents is a slice of Document objects
 type Documents []*Document
 
-// Validate validates all the documents for a system instance
+// Validate validates all the documents for a system instance.
+// If only some of the documents or ORD resources are invalid, ValidationErrors with the violations of all of them are returned.
+// Any other error means that the documents can not be validated at all.
 func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*model.APIDefinition, eventsFromDB map[string]*model.EventDefinition, packagesFromDB map[string]*model.Package, resourceHashes map[string]uint64) error {
+	return docs.validate(calculatedBaseURL, apisFromDB, eventsFromDB, packagesFromDB, resourceHashes, nil)
+}
+
+// validate validates all the documents for a system instance as if the given skipped resources were not part of them.
+// The resources are identified by their indices in the documents, so the reported violations refer to the resources of the documents as they were provided.
+func (docs Documents) validate(calculatedBaseURL string, apisFromDB map[string]*model.APIDefinition, eventsFromDB map[string]*model.EventDefinition, packagesFromDB map[string]*model.Package, resourceHashes map[string]uint64, skipped map[resourceKey]bool) error {
 	baseURL := calculatedBaseURL
 	isBaseURLConfigured := len(calculatedBaseURL) > 0
 	for _, doc := range docs {
@@ -113,6 +121,16 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 		}
 	}
 
+	validationErrs := make(ValidationErrors, 0)
+	invalidResources := make(map[resourceKey]bool, len(skipped))
+	for key := range skipped {
+		invalidResources[key] = true
+	}
+	addValidationErr := func(validationErr *ValidationError) {
+		validationErrs = append(validationErrs, validationErr)
+		invalidResources[validationErr.key()] = true
+	}
+
 	packageIDs := make(map[string]bool)
 	packagePolicyLevels := make(map[string]string)
 	bundleIDs := make(map[string]bool)
@@ -121,143 +139,242 @@ func (docs Documents) Validate(calculatedBaseURL string, apisFromDB map[string]*
 	eventIDs := make(map[string]bool)
 	vendorIDs := make(map[string]bool)
 
-	for _, doc := range docs {
-		for _, pkg := range doc.Packages {
+	for docIdx, doc := range docs {
+		for i, pkg := range doc.Packages {
+			if skipped[resourceKey{docIdx, packageResourceType, i}] {
+				continue
+			}
 			if _, ok := packageIDs[pkg.OrdID]; ok {
-				return errors.Errorf("found duplicate package with ord id %q", pkg.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, packageResourceType, i, pkg.OrdID))
+				continue
 			}
 			packageIDs[pkg.OrdID] = true
 			packagePolicyLevels[pkg.OrdID] = pkg.PolicyLevel
 		}
 	}
 
-	for _, doc := range docs {
+	for docIdx, doc := range docs {
 		if err := validateDocumentInput(doc); err != nil {
-			return errors.Wrap(err, "error validating document")
+			addValidationErr(&ValidationError{DocumentIndex: docIdx, ResourceType: documentResourceType, Err: err})
 		}
 
-		for _, pkg := range doc.Packages {
+		for i, pkg := range doc.Packages {
+			if skipped[resourceKey{docIdx, packageResourceType, i}] {
+				continue
+			}
 			if err := validatePackageInput(pkg, packagesFromDB, resourceHashes); err != nil {
-				return errors.Wrapf(err, "error validating package with ord id %q", pkg.OrdID)
+				addValidationErr(newValidationError(docIdx, packageResourceType, i, pkg.OrdID, err))
 			}
 		}
-		for _, bndl := range doc.ConsumptionBundles {
+		for i, bndl := range doc.ConsumptionBundles {
+			if skipped[resourceKey{docIdx, bundleResourceType, i}] {
+				continue
+			}
 			if err := validateBundleInput(bndl); err != nil {
-				return errors.Wrapf(err, "error validating bundle with ord id %q", stringPtrToString(bndl.OrdID))
+				addValidationErr(newValidationError(docIdx, bundleResourceType, i, stringPtrToString(bndl.OrdID), err))
+				continue
 			}
 			if _, ok := bundleIDs[*bndl.OrdID]; ok {
-				return errors.Errorf("found duplicate bundle with ord id %q", *bndl.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, bundleResourceType, i, *bndl.OrdID))
+				continue
 			}
 			bundleIDs[*bndl.OrdID] = true
 		}
-		for _, product := range doc.Products {
+		for i, product := range doc.Products {
+			if skipped[resourceKey{docIdx, productResourceType, i}] {
+				continue
+			}
 			if err := validateProductInput(product); err != nil {
-				return errors.Wrapf(err, "error validating product with ord id %q", product.OrdID)
+				addValidationErr(newValidationError(docIdx, productResourceType, i, product.OrdID, err))
+				continue
 			}
 			if _, ok := productIDs[product.OrdID]; ok {
-				return errors.Errorf("found duplicate product with ord id %q", product.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, productResourceType, i, product.OrdID))
+				continue
 			}
 			productIDs[product.OrdID] = true
 		}
-		for _, api := range doc.APIResources {
+		for i, api := range doc.APIResources {
+			if skipped[resourceKey{docIdx, apiResourceType, i}] {
+				continue
+			}
 			if err := validateAPIInput(api, packagePolicyLevels, apisFromDB, resourceHashes); err != nil {
-				return errors.Wrapf(err, "error validating api with ord id %q", stringPtrToString(api.OrdID))
+				addValidationErr(newValidationError(docIdx, apiResourceType, i, stringPtrToString(api.OrdID), err))
+				continue
 			}
 			if _, ok := apiIDs[*api.OrdID]; ok {
-				return errors.Errorf("found duplicate api with ord id %q", *api.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, apiResourceType, i, *api.OrdID))
+				continue
 			}
 			apiIDs[*api.OrdID] = true
 		}
-		for _, event := range doc.EventResources {
+		for i, event := range doc.EventResources {
+			if skipped[resourceKey{docIdx, eventResourceType, i}] {
+				continue
+			}
 			if err := validateEventInput(event, packagePolicyLevels, eventsFromDB, resourceHashes); err != nil {
-				return errors.Wrapf(err, "error validating event with ord id %q", stringPtrToString(event.OrdID))
+				addValidationErr(newValidationError(docIdx, eventResourceType, i, stringPtrToString(event.OrdID), err))
+				continue
 			}
 			if _, ok := eventIDs[*event.OrdID]; ok {
-				return errors.Errorf("found duplicate event with ord id %q", *event.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, eventResourceType, i, *event.OrdID))
+				continue
 			}
 			eventIDs[*event.OrdID] = true
 		}
-		for _, vendor := range doc.Vendors {
+		for i, vendor := range doc.Vendors {
+			if skipped[resourceKey{docIdx, vendorResourceType, i}] {
+				continue
+			}
 			if err := validateVendorInput(vendor); err != nil {
-				return errors.Wrapf(err, "error validating vendor with ord id %q", vendor.OrdID)
+				addValidationErr(newValidationError(docIdx, vendorResourceType, i, vendor.OrdID, err))
+				continue
 			}
 			if _, ok := vendorIDs[vendor.OrdID]; ok {
-				return errors.Errorf("found duplicate vendor with ord id %q", vendor.OrdID)
+				addValidationErr(newDuplicateValidationError(docIdx, vendorResourceType, i, vendor.OrdID))
+				continue
 			}
 			vendorIDs[vendor.OrdID] = true
 		}
-		for _, tombstone := range doc.Tombstones {
+		for i, tombstone := range doc.Tombstones {
+			if skipped[resourceKey{docIdx, tombstoneResourceType, i}] {
+				continue
+			}
 			if err := validateTombstoneInput(tombstone); err != nil {
-				return errors.Wrapf(err, "error validating tombstone with ord id %q", tombstone.OrdID)
+				addValidationErr(newValidationError(docIdx, tombstoneResourceType, i, tombstone.OrdID, err))
 			}
 		}
 	}
 
-	// Validate entity relations
-	for _, doc := range docs {
-		for _, pkg := range doc.Packages {
+	// Validate entity relations of the resources, which are valid on their own
+	for docIdx, doc := range docs {
+		for i, pkg := range doc.Packages {
+			if invalidResources[resourceKey{docIdx, packageResourceType, i}] {
+				continue
+			}
+			refErrs := validation.Errors{}
 			if !vendorIDs[*pkg.Vendor] {
-				return errors.Errorf("package with id %q has a reference to unknown vendor %q", pkg.OrdID, *pkg.Vendor)
+				refErrs["vendor"] = newUnknownReferenceError(vendorResourceType, *pkg.Vendor)
 			}
-			ordIDs := gjson.ParseBytes(pkg.PartOfProducts).Array()
-			for _, productID := range ordIDs {
-				if !productIDs[productID.String()] {
-					return errors.Errorf("package with id %q has a reference to unknown product %q", pkg.OrdID, productID.String())
-				}
+			if productID, ok := findUnknownReference(gjson.ParseBytes(pkg.PartOfProducts).Array(), productIDs); !ok {
+				refErrs["partOfProducts"] = newUnknownReferenceError(productResourceType, productID)
+			}
+			if len(refErrs) > 0 {
+				addValidationErr(newValidationError(docIdx, packageResourceType, i, pkg.OrdID, refErrs))
 			}
 		}
-		for _, product := range doc.Products {
+		for i, product := range doc.Products {
+			if invalidResources[resourceKey{docIdx, productResourceType, i}] {
+				continue
+			}
 			if !vendorIDs[product.Vendor] {
-				return errors.Errorf("product with id %q has a reference to unknown vendor %q", product.OrdID, product.Vendor)
+				addValidationErr(newValidationError(docIdx, productResourceType, i, product.OrdID, validation.Errors{
+					"vendor": newUnknownReferenceError(vendorResourceType, product.Vendor),
+				}))
 			}
 		}
-		for _, api := range doc.APIResources {
+		for i, api := range doc.APIResources {
+			if invalidResources[resourceKey{docIdx, apiResourceType, i}] {
+				continue
+			}
+			refErrs := validation.Errors{}
 			if !packageIDs[*api.OrdPackageID] {
-				return errors.Errorf("api with id %q has a reference to unknown package %q", *api.OrdID, *api.OrdPackageID)
+				refErrs["partOfPackage"] = newUnknownReferenceError(packageResourceType, *api.OrdPackageID)
 			}
-			if api.PartOfConsumptionBundles != nil {
-				for _, apiBndlRef := range api.PartOfConsumptionBundles {
-					if !bundleIDs[apiBndlRef.BundleOrdID] {
-						return errors.Errorf("api with id %q has a reference to unknown bundle %q", *api.OrdID, apiBndlRef.BundleOrdID)
-					}
+			for _, apiBndlRef := range api.PartOfConsumptionBundles {
+				if !bundleIDs[apiBndlRef.BundleOrdID] {
+					refErrs["partOfConsumptionBundles"] = newUnknownReferenceError(bundleResourceType, apiBndlRef.BundleOrdID)
+					break
 				}
 			}
-
-			ordIDs := gjson.ParseBytes(api.PartOfProducts).Array()
-			for _, productID := range ordIDs {
-				if !productIDs[productID.String()] {
-					return errors.Errorf("api with id %q has a reference to unknown product %q", *api.OrdID, productID.String())
-				}
+			if productID, ok := findUnknownReference(gjson.ParseBytes(api.PartOfProducts).Array(), productIDs); !ok {
+				refErrs["partOfProducts"] = newUnknownReferenceError(productResourceType, productID)
+			}
+			if len(refErrs) > 0 {
+				addValidationErr(newValidationError(docIdx, apiResourceType, i, *api.OrdID, refErrs))
 			}
 		}
-		for _, event := range doc.EventResources {
+		for i, event := range doc.EventResources {
+			if invalidResources[resourceKey{docIdx, eventResourceType, i}] {
+				continue
+			}
+			refErrs := validation.Errors{}
 			if !packageIDs[*event.OrdPackageID] {
-				return errors.Errorf("event with id %q has a reference to unknown package %q", *event.OrdID, *event.OrdPackageID)
+				refErrs["partOfPackage"] = newUnknownReferenceError(packageResourceType, *event.OrdPackageID)
 			}
-			if event.PartOfConsumptionBundles != nil {
-				for _, eventBndlRef := range event.PartOfConsumptionBundles {
-					if !bundleIDs[eventBndlRef.BundleOrdID] {
-						return errors.Errorf("event with id %q has a reference to unknown bundle %q", *event.OrdID, eventBndlRef.BundleOrdID)
-					}
+			for _, eventBndlRef := range event.PartOfConsumptionBundles {
+				if !bundleIDs[eventBndlRef.BundleOrdID] {
+					refErrs["partOfConsumptionBundles"] = newUnknownReferenceError(bundleResourceType, eventBndlRef.BundleOrdID)
+					break
 				}
 			}
-
-			ordIDs := gjson.ParseBytes(event.PartOfProducts).Array()
-			for _, productID := range ordIDs {
-				if !productIDs[productID.String()] {
-					return errors.Errorf("event with id %q has a reference to unknown product %q", *event.OrdID, productID.String())
-				}
+			if productID, ok := findUnknownReference(gjson.ParseBytes(event.PartOfProducts).Array(), productIDs); !ok {
+				refErrs["partOfProducts"] = newUnknownReferenceError(productResourceType, productID)
+			}
+			if len(refErrs) > 0 {
+				addValidationErr(newValidationError(docIdx, eventResourceType, i, *event.OrdID, refErrs))
 			}
 		}
 	}
 
+	if len(validationErrs) > 0 {
+		return validationErrs
+	}
 	return nil
 }
 
+// ValidateSkippingInvalidResources validates all the documents for a system instance and removes the invalid ORD resources from them.
+// As removing a resource may invalidate the ones referencing it, the documents are validated until the remaining resources are valid.
+// The invalid resources are removed only after that, so the returned validation errors of all the removed resources
+// refer to the documents as they were provided. An error is returned if the documents can not be validated at all,
+// or if some of the documents are invalid as a whole.
+func (docs Documents) ValidateSkippingInvalidResources(calculatedBaseURL string, apisFromDB map[string]*model.APIDefinition, eventsFromDB map[string]*model.EventDefinition, packagesFromDB map[string]*model.Package, resourceHashes map[string]uint64) (ValidationErrors, error) {
+	skippedErrs := make(ValidationErrors, 0)
+	skippedResources := make(map[resourceKey]bool)
+	for {
+		err := docs.validate(calculatedBaseURL, apisFromDB, eventsFromDB, packagesFromDB, resourceHashes, skippedResources)
+		if err == nil {
+			break
+		}
+
+		var validationErrs ValidationErrors
+		if !errors.As(err, &validationErrs) || !validationErrs.AreSkippable() {
+			return nil, err
+		}
+
+		for _, validationErr := range validationErrs {
+			skippedResources[validationErr.key()] = true
+		}
+		skippedErrs = append(skippedErrs, validationErrs...)
+	}
+
+	docs.removeResources(skippedResources)
+	return skippedErrs, nil
+}
+
+// removeResources removes the given ORD resources from the documents.
+func (docs Documents) removeResources(toRemove map[resourceKey]bool) {
+	keep := func(docIdx int, resourceType string) func(i int) bool {
+		return func(i int) bool {
+			return !toRemove[resourceKey{docIdx, resourceType, i}]
+		}
+	}
+
+	for docIdx, doc := range docs {
+		doc.Packages = filterPackages(doc.Packages, keep(docIdx, packageResourceType))
+		doc.ConsumptionBundles = filterBundles(doc.ConsumptionBundles, keep(docIdx, bundleResourceType))
+		doc.Products = filterProducts(doc.Products, keep(docIdx, productResourceType))
+		doc.APIResources = filterAPIs(doc.APIResources, keep(docIdx, apiResourceType))
+		doc.EventResources = filterEvents(doc.EventResources, keep(docIdx, eventResourceType))
+		doc.Vendors = filterVendors(doc.Vendors, keep(docIdx, vendorResourceType))
+		doc.Tombstones = filterTombstones(doc.Tombstones, keep(docIdx, tombstoneResourceType))
+	}
+}
+
 // Sanitize performs all the merging and rewriting rules defined in ORD. This method should be invoked after Documents are validated with the Validate method.
-//  - Rewrite all relative URIs using the baseURL from the Described System Instance. If the Described System Instance baseURL is missing the provider baseURL (from the webhook) is used.
-//  - Package's partOfProducts, tags, countries, industry, lineOfBusiness, labels are inherited by the resources in the package.
-//  - Ensure to assign `defaultEntryPoint` if missing and there are available `entryPoints` to API's `PartOfConsumptionBundles`
+//   - Rewrite all relative URIs using the baseURL from the Described System Instance. If the Described System Instance baseURL is missing the provider baseURL (from the webhook) is used.
+//   - Package's partOfProducts, tags, countries, industry, lineOfBusiness, labels are inherited by the resources in the package.
+//   - Ensure to assign `defaultEntryPoint` if missing and there are available `entryPoints` to API's `PartOfConsumptionBundles`
 func (docs Documents) Sanitize(baseURL string) error {
 	var err error
 
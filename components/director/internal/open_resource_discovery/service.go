@@ -2,6 +2,9 @@ package ord
 
 import (
 	"context"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
@@ -9,17 +12,57 @@ import (
 
 	"github.com/tidwall/gjson"
 
+	"github.com/kyma-incubator/compass/components/director/internal/domain/specrevision"
 	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
 	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	"github.com/pkg/errors"
 )
 
-const applicationTypeLabel = "applicationType"
+const (
+	applicationTypeLabel = "applicationType"
+
+	// failedAggregationRecordingTimeout limits the recording of the ORD aggregation status of an application whose processing has failed
+	failedAggregationRecordingTimeout = 30 * time.Second
+)
+
+// ServiceConfig contains configuration for the ORD aggregator service.
+type ServiceConfig struct {
+	MaxParallelApplicationProcessors int                    `envconfig:"default=4,APP_MAX_PARALLEL_APPLICATION_PROCESSORS"`
+	ApplicationProcessingTimeout     time.Duration          `envconfig:"default=5m,APP_APPLICATION_PROCESSING_TIMEOUT"`
+	IncrementalSyncEnabled           bool                   `envconfig:"default=true,APP_INCREMENTAL_SYNC_ENABLED"`
+	InvalidResourcesPolicy           InvalidResourcesPolicy `envconfig:"default=fail,APP_INVALID_RESOURCES_POLICY"`
+
+	// The on-demand aggregation of an application which has failed is retried with an exponential backoff starting at AggregationRequestRetryInterval
+	// and limited to AggregationRequestMaxRetryInterval. The request is dropped after AggregationRequestMaxAttempts failed aggregations.
+	AggregationRequestMaxAttempts      int           `envconfig:"default=10,APP_AGGREGATION_REQUEST_MAX_ATTEMPTS"`
+	AggregationRequestRetryInterval    time.Duration `envconfig:"default=30s,APP_AGGREGATION_REQUEST_RETRY_INTERVAL"`
+	AggregationRequestMaxRetryInterval time.Duration `envconfig:"default=1h,APP_AGGREGATION_REQUEST_MAX_RETRY_INTERVAL"`
+}
+
+// InvalidResourcesPolicy defines how the aggregation handles the invalid ORD resources in the documents of an Application.
+type InvalidResourcesPolicy string
+
+const (
+	// InvalidResourcesPolicyFail fails the aggregation of the whole Application if any of its ORD resources is invalid.
+	InvalidResourcesPolicyFail InvalidResourcesPolicy = "fail"
+	// InvalidResourcesPolicySkip skips the invalid ORD resources and aggregates the valid ones.
+	InvalidResourcesPolicySkip InvalidResourcesPolicy = "skip"
+)
+
+// Validate validates the ORD aggregation service configuration.
+func (c ServiceConfig) Validate() error {
+	if c.InvalidResourcesPolicy != InvalidResourcesPolicyFail && c.InvalidResourcesPolicy != InvalidResourcesPolicySkip {
+		return errors.Errorf("invalid resources policy should be one of %q and %q, got %q", InvalidResourcesPolicyFail, InvalidResourcesPolicySkip, c.InvalidResourcesPolicy)
+	}
+	return nil
+}
 
 // Service consists of various resource services responsible for service-layer ORD operations.
 type Service struct {
+	config   ServiceConfig
 	transact persistence.Transactioner
 
 	labelRepo labelRepository
@@ -37,12 +80,16 @@ type Service struct {
 	tombstoneSvc       TombstoneService
 	tenantSvc          TenantService
 
+	aggregationStatusSvc  AggregationStatusService
+	aggregationRequestSvc AggregationRequestService
+
 	ordClient Client
 }
 
 // NewAggregatorService returns a new object responsible for service-layer ORD operations.
-func NewAggregatorService(transact persistence.Transactioner, labelRepo labelRepository, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, client Client) *Service {
+func NewAggregatorService(config ServiceConfig, transact persistence.Transactioner, labelRepo labelRepository, appSvc ApplicationService, webhookSvc WebhookService, bundleSvc BundleService, bundleReferenceSvc BundleReferenceService, apiSvc APIService, eventSvc EventService, specSvc SpecService, packageSvc PackageService, productSvc ProductService, vendorSvc VendorService, tombstoneSvc TombstoneService, tenantSvc TenantService, aggregationStatusSvc AggregationStatusService, aggregationRequestSvc AggregationRequestService, client Client) *Service {
 	return &Service{
+		config:             config,
 		transact:           transact,
 		appSvc:             appSvc,
 		labelRepo:          labelRepo,
@@ -57,12 +104,87 @@ func NewAggregatorService(transact persistence.Transactioner, labelRepo labelRep
 		vendorSvc:          vendorSvc,
 		tombstoneSvc:       tombstoneSvc,
 		tenantSvc:          tenantSvc,
-		ordClient:          client,
+
+		aggregationStatusSvc:  aggregationStatusSvc,
+		aggregationRequestSvc: aggregationRequestSvc,
+
+		ordClient: client,
 	}
 }
 
-// SyncORDDocuments performs resync of ORD information provided via ORD documents for each application
+// SyncORDDocuments performs resync of ORD information provided via ORD documents for each application.
+// Applications are processed in parallel by a bounded pool of workers, each application in its own transaction,
+// so that a slow or failing ORD provider does not block or abort the aggregation of the other applications.
 func (s *Service) SyncORDDocuments(ctx context.Context) error {
+	return s.processApps(ctx, s.enqueueApps, nil)
+}
+
+// ProcessAggregationRequests performs resync of ORD information for each application with a pending on-demand aggregation request.
+// A request is deleted only after the application has been processed successfully, so that the requests of the applications
+// whose processing has failed or has been interrupted are processed again by a later run. A request for the same application
+// arriving while it is being processed is kept as well, so that it results in another aggregation, which picks up any changes made in the meantime.
+// A failed request is retried with an exponential backoff, and it is dropped after the configured number of attempts.
+func (s *Service) ProcessAggregationRequests(ctx context.Context) error {
+	apps, requests, err := s.listRequestedApps(ctx)
+	if err != nil {
+		return errors.Wrap(err, "error while listing the requested applications")
+	}
+
+	enqueueFunc := func(ctx context.Context, appsQueue chan<- *model.Application) error {
+		for _, app := range apps {
+			appsQueue <- app
+		}
+		return nil
+	}
+
+	processedFunc := func(ctx context.Context, app *model.Application, result appProcessingResult, processingErr error) {
+		if processingErr != nil {
+			if err := s.handleFailedRequest(ctx, app.ID, requests[app.ID], result, processingErr); err != nil {
+				log.C(ctx).WithError(err).Errorf("Error while recording the failed ORD aggregation request for application with id %q: %v", app.ID, err)
+			}
+			return
+		}
+
+		if err := s.deleteProcessedRequest(ctx, requests[app.ID]); err != nil {
+			log.C(ctx).WithError(err).Errorf("Error while deleting the processed ORD aggregation request for application with id %q: %v", app.ID, err)
+		}
+	}
+
+	return s.processApps(ctx, enqueueFunc, processedFunc)
+}
+
+func (s *Service) processApps(ctx context.Context, enqueueFunc func(ctx context.Context, appsQueue chan<- *model.Application) error, processedFunc func(ctx context.Context, app *model.Application, result appProcessingResult, err error)) error {
+	workersCount := s.config.MaxParallelApplicationProcessors
+	if workersCount < 1 {
+		workersCount = 1
+	}
+
+	summary := &syncSummary{}
+	appsQueue := make(chan *model.Application)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < workersCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for app := range appsQueue {
+				result, err := s.processAppWithTimeout(ctx, app, summary)
+				if processedFunc != nil {
+					processedFunc(ctx, app, result, err)
+				}
+			}
+		}()
+	}
+
+	err := enqueueFunc(ctx, appsQueue)
+	close(appsQueue)
+	wg.Wait()
+
+	summary.log(ctx)
+	return err
+}
+
+func (s *Service) enqueueApps(ctx context.Context, appsQueue chan<- *model.Application) error {
 	pageCount := 1
 	pageSize := 200
 
@@ -75,9 +197,7 @@ func (s *Service) SyncORDDocuments(ctx context.Context) error {
 			return errors.Wrapf(err, "error while fetching application page number %d", pageCount)
 		}
 		for _, app := range page.Data {
-			if err := s.processApp(ctx, app); err != nil {
-				return errors.Wrapf(err, "error while processing app %q", app.ID)
-			}
+			appsQueue <- app
 		}
 		pageCursor = page.PageInfo.EndCursor
 		hasNextPage = page.PageInfo.HasNextPage
@@ -86,6 +206,106 @@ func (s *Service) SyncORDDocuments(ctx context.Context) error {
 	return nil
 }
 
+// listRequestedApps returns the applications with a pending on-demand aggregation request, together with the requests mapped by application ID
+func (s *Service) listRequestedApps(ctx context.Context) ([]*model.Application, map[string]*model.ORDAggregationRequest, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	requests, err := s.aggregationRequestSvc.ListDueGlobal(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	apps := make([]*model.Application, 0, len(requests))
+	requestsByAppID := make(map[string]*model.ORDAggregationRequest, len(requests))
+	for _, request := range requests {
+		app, err := s.appSvc.GetGlobalByID(ctx, request.ApplicationID)
+		if err != nil {
+			return nil, nil, err
+		}
+		apps = append(apps, app)
+		requestsByAppID[app.ID] = request
+	}
+
+	if err := s.setAppTypes(ctx, apps); err != nil {
+		return nil, nil, err
+	}
+
+	return apps, requestsByAppID, tx.Commit()
+}
+
+func (s *Service) deleteProcessedRequest(ctx context.Context, request *model.ORDAggregationRequest) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.aggregationRequestSvc.DeleteProcessedGlobal(ctx, request); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// handleFailedRequest postpones the next attempt of an on-demand aggregation request whose processing has failed.
+// If the request has failed for the configured number of times, it is dropped, and the failure is recorded in the ORD aggregation status.
+func (s *Service) handleFailedRequest(ctx context.Context, appID string, request *model.ORDAggregationRequest, result appProcessingResult, processingErr error) error {
+	maxAttempts := s.config.AggregationRequestMaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	attempts := request.Attempts + 1
+	if attempts < maxAttempts {
+		retryAfter := s.aggregationRequestRetryInterval(attempts)
+		log.C(ctx).Infof("ORD aggregation request for application with id %q has failed %d times, it will be retried in %s", appID, attempts, retryAfter)
+		return s.recordFailedRequestAttempt(ctx, request, retryAfter)
+	}
+
+	log.C(ctx).Warnf("ORD aggregation request for application with id %q has failed %d times, dropping it", appID, attempts)
+	if err := s.deleteProcessedRequest(ctx, request); err != nil {
+		return err
+	}
+
+	if len(result.webhookID) == 0 {
+		return nil
+	}
+	return s.recordFailedAggregation(ctx, appID, result, errors.Wrapf(processingErr, "ORD aggregation request dropped after %d failed attempts", attempts))
+}
+
+// aggregationRequestRetryInterval returns the time after which an on-demand aggregation request which has failed the given number of times is retried.
+func (s *Service) aggregationRequestRetryInterval(attempts int) time.Duration {
+	interval := s.config.AggregationRequestRetryInterval
+	for i := 1; i < attempts && interval < s.config.AggregationRequestMaxRetryInterval; i++ {
+		interval *= 2
+	}
+	if interval > s.config.AggregationRequestMaxRetryInterval {
+		interval = s.config.AggregationRequestMaxRetryInterval
+	}
+	return interval
+}
+
+func (s *Service) recordFailedRequestAttempt(ctx context.Context, request *model.ORDAggregationRequest, retryAfter time.Duration) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	if err := s.aggregationRequestSvc.RecordFailedAttemptGlobal(ctx, request, retryAfter); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (s *Service) listAppPage(ctx context.Context, pageSize int, cursor string) (*model.ApplicationPage, error) {
 	tx, err := s.transact.Begin()
 	if err != nil {
@@ -98,37 +318,89 @@ func (s *Service) listAppPage(ctx context.Context, pageSize int, cursor string)
 		return nil, err
 	}
 
-	if len(page.Data) > 0 {
-		applicationIDs := make([]string, 0, page.TotalCount)
-		for _, app := range page.Data {
-			applicationIDs = append(applicationIDs, app.ID)
-		}
+	if err := s.setAppTypes(ctx, page.Data); err != nil {
+		return nil, err
+	}
 
-		labels, err := s.labelRepo.ListGlobalByKeyAndObjects(ctx, model.ApplicationLabelableObject, applicationIDs, applicationTypeLabel)
-		if err != nil {
-			return nil, err
-		}
+	return page, tx.Commit()
+}
 
-		appLabelsMap := make(map[string]interface{}, page.TotalCount)
-		for i := range labels {
-			appLabelsMap[labels[i].ObjectID] = labels[i].Value
+func (s *Service) setAppTypes(ctx context.Context, apps []*model.Application) error {
+	if len(apps) == 0 {
+		return nil
+	}
+
+	applicationIDs := make([]string, 0, len(apps))
+	for _, app := range apps {
+		applicationIDs = append(applicationIDs, app.ID)
+	}
+
+	labels, err := s.labelRepo.ListGlobalByKeyAndObjects(ctx, model.ApplicationLabelableObject, applicationIDs, applicationTypeLabel)
+	if err != nil {
+		return err
+	}
+
+	appLabelsMap := make(map[string]interface{}, len(apps))
+	for i := range labels {
+		appLabelsMap[labels[i].ObjectID] = labels[i].Value
+	}
+
+	for i := range apps {
+		appType, ok := appLabelsMap[apps[i].ID].(string)
+		if ok {
+			apps[i].Type = appType
 		}
+	}
+	return nil
+}
 
-		for i := range page.Data {
-			appType, ok := appLabelsMap[page.Data[i].ID].(string)
-			if ok {
-				page.Data[i].Type = appType
-			}
+// appProcessingResult holds the details of the processing of a single application needed for recording its ORD aggregation status.
+// An empty webhookID means that the application does not have an ORD webhook and was skipped.
+type appProcessingResult struct {
+	tenantID       string
+	webhookID      string
+	documentsCount int
+}
+
+func (s *Service) processAppWithTimeout(ctx context.Context, app *model.Application, summary *syncSummary) (appProcessingResult, error) {
+	ctx = addFieldToLogger(ctx, "app_id", app.ID)
+	processingCtx := ctx
+	if s.config.ApplicationProcessingTimeout > 0 {
+		var cancel context.CancelFunc
+		processingCtx, cancel = context.WithTimeout(ctx, s.config.ApplicationProcessingTimeout)
+		defer cancel()
+	}
+
+	result, err := s.processApp(processingCtx, app)
+	if err != nil && len(result.webhookID) > 0 {
+		// The processing context is already cancelled if the application processing has timed out,
+		// so the failure is recorded in a context which is not bound to it
+		statusCtx, cancel := context.WithTimeout(detachedContext(ctx), failedAggregationRecordingTimeout)
+		defer cancel()
+
+		if statusErr := s.recordFailedAggregation(statusCtx, app.ID, result, err); statusErr != nil {
+			log.C(ctx).WithError(statusErr).Errorf("Error while recording ORD aggregation status for application with id %q: %v", app.ID, statusErr)
 		}
 	}
 
-	return page, tx.Commit()
+	switch {
+	case err != nil:
+		log.C(ctx).WithError(err).Errorf("Error while processing application with id %q: %v", app.ID, err)
+		summary.addFailed(app.ID)
+	case len(result.webhookID) > 0:
+		summary.addSucceeded(app.ID)
+	default:
+		summary.addSkipped(app.ID)
+	}
+	return result, err
 }
 
-func (s *Service) processApp(ctx context.Context, app *model.Application) error {
+func (s *Service) processApp(ctx context.Context, app *model.Application) (appProcessingResult, error) {
+	result := appProcessingResult{}
+
 	tx, err := s.transact.Begin()
 	if err != nil {
-		return err
+		return result, err
 	}
 
 	defer s.transact.RollbackUnlessCommitted(ctx, tx)
@@ -137,62 +409,163 @@ func (s *Service) processApp(ctx context.Context, app *model.Application) error
 
 	tnt, err := s.tenantSvc.GetLowestOwnerForResource(ctx, resource.Application, app.ID)
 	if err != nil {
-		return err
+		return result, errors.Wrapf(err, "error while getting lowest owner of app with id %q", app.ID)
 	}
+	result.tenantID = tnt
 
 	ctx = tenant.SaveToContext(ctx, tnt, "")
 
 	webhooks, err := s.webhookSvc.ListForApplication(ctx, app.ID)
 	if err != nil {
-		return errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
+		return result, errors.Wrapf(err, "error fetching webhooks for app with id %q", app.ID)
 	}
-	var documents Documents
-	var baseURL string
+
+	var ordWebhook *model.Webhook
 	for _, wh := range webhooks {
 		if wh.Type == model.WebhookTypeOpenResourceDiscovery && wh.URL != nil {
-			ctx = addFieldToLogger(ctx, "app_id", app.ID)
-			documents, baseURL, err = s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, wh)
-			if err != nil {
-				log.C(ctx).WithError(err).Errorf("error fetching ORD document for webhook with id %q: %v", wh.ID, err)
-			}
+			ordWebhook = wh
 			break
 		}
 	}
-	if len(documents) > 0 {
-		log.C(ctx).Info("Processing ORD documents")
-		if err := s.processDocuments(ctx, app.ID, baseURL, documents); err != nil {
-			log.C(ctx).WithError(err).Errorf("error processing ORD documents: %v", err)
-		} else {
-			log.C(ctx).Info("Successfully processed ORD documents")
-			return tx.Commit()
+	if ordWebhook == nil {
+		return result, nil
+	}
+	result.webhookID = ordWebhook.ID
+
+	var previousStatus *model.ORDAggregationStatus
+	var fetchCache *model.ORDFetchCache
+	if s.config.IncrementalSyncEnabled {
+		previousStatus, err = s.aggregationStatusSvc.GetForWebhook(ctx, app.ID, ordWebhook.ID)
+		if err != nil {
+			return result, errors.Wrapf(err, "error getting ORD aggregation status for webhook with id %q", ordWebhook.ID)
+		}
+		if previousStatus != nil {
+			fetchCache = previousStatus.FetchCache
 		}
 	}
-	return nil
+
+	fetchResult, err := s.ordClient.FetchOpenResourceDiscoveryDocuments(ctx, app, ordWebhook, fetchCache)
+	if err != nil {
+		return result, errors.Wrapf(err, "error fetching ORD document for webhook with id %q", ordWebhook.ID)
+	}
+
+	aggregationResult := model.ORDAggregationResult{
+		FetchCache: fetchResult.Cache,
+	}
+	if fetchResult.NotModified && previousStatus != nil {
+		log.C(ctx).Info("ORD documents are not modified since the last successful aggregation, skipping their processing")
+		aggregationResult.DocumentsCount = len(fetchResult.Cache.Documents)
+		aggregationResult.DocumentsHash = previousStatus.DocumentsHash
+		keepSkippedResources(&aggregationResult, previousStatus)
+	} else {
+		documents := fetchResult.Documents
+		result.documentsCount = len(documents)
+
+		documentsHash, err := hashDocuments(documents, fetchResult.BaseURL)
+		if err != nil {
+			return result, errors.Wrap(err, "error hashing ORD documents")
+		}
+
+		aggregationResult.DocumentsCount = len(documents)
+		aggregationResult.DocumentsHash = &documentsHash
+
+		if previousStatus != nil && previousStatus.DocumentsHash != nil && *previousStatus.DocumentsHash == documentsHash {
+			log.C(ctx).Info("ORD documents are the same as in the last successful aggregation, skipping their processing")
+			keepSkippedResources(&aggregationResult, previousStatus)
+		} else if len(documents) > 0 {
+			log.C(ctx).Info("Processing ORD documents")
+			skipped, err := s.processDocuments(ctx, app.ID, fetchResult.BaseURL, documents)
+			if err != nil {
+				return result, errors.Wrap(err, "error processing ORD documents")
+			}
+			if len(skipped) > 0 {
+				log.C(ctx).Warnf("Skipped %d invalid ORD resources: %v", len(skipped), skipped)
+				aggregationResult.ValidationErrors = skipped.Violations()
+				aggregationResult.InvalidResourcesSkipped = true
+			}
+		}
+	}
+
+	if err := s.aggregationStatusSvc.Record(ctx, app.ID, ordWebhook.ID, aggregationResult); err != nil {
+		return result, errors.Wrapf(err, "error recording ORD aggregation status for webhook with id %q", ordWebhook.ID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return result, err
+	}
+	log.C(ctx).Info("Successfully processed ORD documents")
+	return result, nil
 }
 
-func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, documents Documents) error {
+// keepSkippedResources carries over the violations of the ORD resources skipped by the last successful aggregation
+// to the result of an aggregation which did not process the same documents again
+func keepSkippedResources(result *model.ORDAggregationResult, previousStatus *model.ORDAggregationStatus) {
+	lastAttemptSucceeded := previousStatus.LastSuccessAt != nil && previousStatus.LastSuccessAt.Equal(previousStatus.LastAttemptAt)
+	if lastAttemptSucceeded && len(previousStatus.ValidationErrors) > 0 {
+		result.ValidationErrors = previousStatus.ValidationErrors
+		result.InvalidResourcesSkipped = true
+	}
+}
+
+// recordFailedAggregation persists the ORD aggregation status of a failed application processing in a separate transaction,
+// as the transaction in which the application was processed is rolled back.
+func (s *Service) recordFailedAggregation(ctx context.Context, appID string, result appProcessingResult, processingErr error) error {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	ctx = tenant.SaveToContext(ctx, result.tenantID, "")
+
+	aggregationResult := model.ORDAggregationResult{
+		DocumentsCount: result.documentsCount,
+	}
+
+	var validationErrs ValidationErrors
+	if errors.As(processingErr, &validationErrs) {
+		aggregationResult.ValidationErrors = validationErrs.Violations()
+	} else {
+		errMsg := processingErr.Error()
+		aggregationResult.Error = &errMsg
+	}
+
+	if err := s.aggregationStatusSvc.Record(ctx, appID, result.webhookID, aggregationResult); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// processDocuments validates and persists the ORD documents of an Application.
+// If the invalid resources are skipped according to the configured policy, their validation errors are returned.
+func (s *Service) processDocuments(ctx context.Context, appID string, baseURL string, documents Documents) (ValidationErrors, error) {
 	// TODO: Currently it isn't mandatory Vendor resources to be declared in the Documents because there is a concept of a central registry from where Vendors will be fetched once.
 	// However, until this registry concept is production ready, we should make sure that if no SAP Vendor resource is explicitly declared across all Documents of a System Instance to
 	// assign it once for it so that all resources referencing that SAP Vendor will not fail.
 	// NOTE: to be deleted once the concept of central registry for Vendors fetching is productive
 	assignSAPVendor(documents)
 
+	ctx = specrevision.SaveSourceToContext(ctx, model.SpecRevisionSourceORD)
+
 	apiDataFromDB, eventDataFromDB, packageDataFromDB, err := s.fetchResources(ctx, appID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resourceHashes, err := hashResources(documents)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := documents.Validate(baseURL, apiDataFromDB, eventDataFromDB, packageDataFromDB, resourceHashes); err != nil {
-		return errors.Wrap(err, "invalid documents")
+	skipped, err := s.validateDocuments(baseURL, documents, apiDataFromDB, eventDataFromDB, packageDataFromDB, resourceHashes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid documents")
 	}
 
 	if err := documents.Sanitize(baseURL); err != nil {
-		return errors.Wrap(err, "while sanitizing ORD documents")
+		return nil, errors.Wrap(err, "while sanitizing ORD documents")
 	}
 
 	vendorsInput := make([]*model.VendorInput, 0)
@@ -214,37 +587,37 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 
 	vendorsFromDB, err := s.processVendors(ctx, appID, vendorsInput)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	productsFromDB, err := s.processProducts(ctx, appID, productsInput)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	packagesFromDB, err := s.processPackages(ctx, appID, packagesInput, resourceHashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	bundlesFromDB, err := s.processBundles(ctx, appID, bundlesInput)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	apisFromDB, err := s.processAPIs(ctx, appID, bundlesFromDB, packagesFromDB, apisInput, resourceHashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	eventsFromDB, err := s.processEvents(ctx, appID, bundlesFromDB, packagesFromDB, eventsInput, resourceHashes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tombstonesFromDB, err := s.processTombstones(ctx, appID, tombstonesInput)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, ts := range tombstonesFromDB {
@@ -252,47 +625,56 @@ func (s *Service) processDocuments(ctx context.Context, appID string, baseURL st
 			return packagesFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.packageSvc.Delete(ctx, packagesFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(apisFromDB), func(i int) bool {
 			return equalStrings(apisFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.apiSvc.Delete(ctx, apisFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(eventsFromDB), func(i int) bool {
 			return equalStrings(eventsFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.eventSvc.Delete(ctx, eventsFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(bundlesFromDB), func(i int) bool {
 			return equalStrings(bundlesFromDB[i].OrdID, &ts.OrdID)
 		}); found {
 			if err := s.bundleSvc.Delete(ctx, bundlesFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(vendorsFromDB), func(i int) bool {
 			return vendorsFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.vendorSvc.Delete(ctx, vendorsFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 		if i, found := searchInSlice(len(productsFromDB), func(i int) bool {
 			return productsFromDB[i].OrdID == ts.OrdID
 		}); found {
 			if err := s.productSvc.Delete(ctx, productsFromDB[i].ID); err != nil {
-				return errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
+				return nil, errors.Wrapf(err, "error while deleting resource with ORD ID %q based on its tombstone", ts.OrdID)
 			}
 		}
 	}
 
-	return nil
+	return skipped, nil
+}
+
+// validateDocuments validates the ORD documents according to the configured invalid resources policy.
+// With the skip policy, the invalid resources are removed from the documents and their validation errors are returned.
+func (s *Service) validateDocuments(baseURL string, documents Documents, apisFromDB map[string]*model.APIDefinition, eventsFromDB map[string]*model.EventDefinition, packagesFromDB map[string]*model.Package, resourceHashes map[string]uint64) (ValidationErrors, error) {
+	if s.config.InvalidResourcesPolicy == InvalidResourcesPolicySkip {
+		return documents.ValidateSkippingInvalidResources(baseURL, apisFromDB, eventsFromDB, packagesFromDB, resourceHashes)
+	}
+	return nil, documents.Validate(baseURL, apisFromDB, eventsFromDB, packagesFromDB, resourceHashes)
 }
 
 func (s *Service) processVendors(ctx context.Context, appID string, vendors []*model.VendorInput) ([]*model.Vendor, error) {
@@ -682,6 +1064,22 @@ func (s *Service) fetchResources(ctx context.Context, appID string) (map[string]
 	return apiDataFromDB, eventDataFromDB, packageDataFromDB, nil
 }
 
+// hashDocuments returns the combined hash of the ORD documents, which must be calculated before the documents are sanitized.
+func hashDocuments(docs Documents, baseURL string) (string, error) {
+	hash, err := HashObject(struct {
+		BaseURL   string
+		Documents Documents
+	}{
+		BaseURL:   baseURL,
+		Documents: docs,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatUint(hash, 10), nil
+}
+
 func hashResources(docs Documents) (map[string]uint64, error) {
 	resourceHashes := make(map[string]uint64)
 
@@ -835,6 +1233,16 @@ func searchInSlice(length int, f func(i int) bool) (int, bool) {
 	return -1, false
 }
 
+// detachedContext returns a context which carries the logger and the correlation headers of the given context,
+// but is neither cancelled nor timed out together with it
+func detachedContext(ctx context.Context) context.Context {
+	detachedCtx := log.ContextWithLogger(context.Background(), log.C(ctx))
+	if headers := correlation.HeadersFromContext(ctx); headers != nil {
+		detachedCtx = correlation.SaveToContext(detachedCtx, headers)
+	}
+	return detachedCtx
+}
+
 func addFieldToLogger(ctx context.Context, fieldName, fieldValue string) context.Context {
 	logger := log.LoggerFromContext(ctx)
 	logger = logger.WithField(fieldName, fieldValue)
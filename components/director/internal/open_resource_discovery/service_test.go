@@ -2,13 +2,16 @@ package ord_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 	ord "github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery"
 	"github.com/kyma-incubator/compass/components/director/internal/open_resource_discovery/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
 	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
@@ -29,6 +32,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 	sanitizedDoc := fixSanitizedORDDocument()
 	var nilSpecInput *model.SpecInput
 	var nilBundleID *string
+	var nilFetchCache *model.ORDFetchCache
 
 	testApplication := fixApplicationPage().Data[0]
 	testWebhook := fixWebhooks()[0]
@@ -58,6 +62,48 @@ func TestService_SyncORDDocuments(t *testing.T) {
 		return persistTx, transact
 	}
 
+	failedAggregationStatusRecordedInSeparateTransaction := func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+		persistTx := &persistenceautomock.PersistenceTx{}
+		persistTx.On("Commit").Return(nil).Twice()
+
+		transact := &persistenceautomock.Transactioner{}
+		transact.On("Begin").Return(persistTx, nil).Times(3)
+		transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(3)
+		return persistTx, transact
+	}
+
+	successfulAggregationStatusRecord := func() *automock.AggregationStatusService {
+		statusSvc := &automock.AggregationStatusService{}
+		statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+			return result.Succeeded() && result.DocumentsCount == 1 && result.DocumentsHash != nil
+		})).Return(nil).Once()
+		return statusSvc
+	}
+
+	failedAggregationStatusRecord := func() *automock.AggregationStatusService {
+		statusSvc := &automock.AggregationStatusService{}
+		statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+			return result.Error != nil && len(result.ValidationErrors) == 0
+		})).Return(nil).Once()
+		return statusSvc
+	}
+
+	invalidDocumentsAggregationStatusRecord := func() *automock.AggregationStatusService {
+		statusSvc := &automock.AggregationStatusService{}
+		statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+			return result.Error == nil && len(result.ValidationErrors) == 1 && result.ValidationErrors[0].ResourceType == "vendor" && result.DocumentsCount == 1 && !result.Succeeded()
+		})).Return(nil).Once()
+		return statusSvc
+	}
+
+	skippedResourcesAggregationStatusRecord := func() *automock.AggregationStatusService {
+		statusSvc := &automock.AggregationStatusService{}
+		statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+			return len(result.ValidationErrors) == 1 && result.ValidationErrors[0].ResourceType == "vendor" && result.InvalidResourcesSkipped && result.Succeeded() && result.DocumentsHash != nil
+		})).Return(nil).Once()
+		return statusSvc
+	}
+
 	successfulLabelRepo := func() *automock.LabelRepository {
 		labelRepo := &automock.LabelRepository{}
 		labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, mock.MatchedBy(func(objectIDs []string) bool {
@@ -342,40 +388,43 @@ func TestService_SyncORDDocuments(t *testing.T) {
 
 	successfulClientFetch := func() *automock.Client {
 		client := &automock.Client{}
-		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{fixORDDocument()}, baseURL, nil)
+		client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{fixORDDocument()}, BaseURL: baseURL}, nil)
 		return client
 	}
 
 	testCases := []struct {
-		Name            string
-		TransactionerFn func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
-		labelRepoFn     func() *automock.LabelRepository
-		appSvcFn        func() *automock.ApplicationService
-		webhookSvcFn    func() *automock.WebhookService
-		bundleSvcFn     func() *automock.BundleService
-		bundleRefSvcFn  func() *automock.BundleReferenceService
-		apiSvcFn        func() *automock.APIService
-		eventSvcFn      func() *automock.EventService
-		specSvcFn       func() *automock.SpecService
-		packageSvcFn    func() *automock.PackageService
-		productSvcFn    func() *automock.ProductService
-		vendorSvcFn     func() *automock.VendorService
-		tombstoneSvcFn  func() *automock.TombstoneService
-		tenantSvcFn     func() *automock.TenantService
-		clientFn        func() *automock.Client
-		ExpectedErr     error
+		Name                   string
+		TransactionerFn        func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		labelRepoFn            func() *automock.LabelRepository
+		appSvcFn               func() *automock.ApplicationService
+		webhookSvcFn           func() *automock.WebhookService
+		bundleSvcFn            func() *automock.BundleService
+		bundleRefSvcFn         func() *automock.BundleReferenceService
+		apiSvcFn               func() *automock.APIService
+		eventSvcFn             func() *automock.EventService
+		specSvcFn              func() *automock.SpecService
+		packageSvcFn           func() *automock.PackageService
+		productSvcFn           func() *automock.ProductService
+		vendorSvcFn            func() *automock.VendorService
+		tombstoneSvcFn         func() *automock.TombstoneService
+		tenantSvcFn            func() *automock.TenantService
+		aggregationStatusSvcFn func() *automock.AggregationStatusService
+		clientFn               func() *automock.Client
+		invalidResourcesPolicy ord.InvalidResourcesPolicy
+		ExpectedErr            error
 	}{
 		{
 			Name: "Success when resources are already in db and APIs/Events versions are incremented should Update them and resync API/Event specs",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				return txGen.ThatSucceedsMultipleTimes(2)
 			},
-			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppList,
-			tenantSvcFn:    successfulTenantSvc,
-			webhookSvcFn:   successfulWebhookList,
-			bundleSvcFn:    successfulBundleUpdate,
-			bundleRefSvcFn: successfulBundleReferenceFetchingOfBundleIDs,
+			aggregationStatusSvcFn: successfulAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
@@ -404,12 +453,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				return txGen.ThatSucceedsMultipleTimes(2)
 			},
-			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppList,
-			tenantSvcFn:    successfulTenantSvc,
-			webhookSvcFn:   successfulWebhookList,
-			bundleSvcFn:    successfulBundleUpdate,
-			bundleRefSvcFn: successfulBundleReferenceFetchingOfBundleIDs,
+			aggregationStatusSvcFn: successfulAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIsNoVersionBump(), nil).Once()
@@ -445,11 +495,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				return txGen.ThatSucceedsMultipleTimes(2)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppList,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			aggregationStatusSvcFn: successfulAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -473,6 +524,54 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 			clientFn: successfulClientFetch,
 		},
+		{
+			Name: "Success when invalid resources are skipped should resync only the valid ones",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txGen.ThatSucceedsMultipleTimes(2)
+			},
+			aggregationStatusSvcFn: skippedResourcesAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn: func() *automock.APIService {
+				apiSvc := &automock.APIService{}
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
+				apiSvc.On("UpdateInManyBundles", txtest.CtxWithDBMatcher(), api1ID, *sanitizedDoc.APIResources[0], nilSpecInput, map[string]string{bundleID: sanitizedDoc.APIResources[0].PartOfConsumptionBundles[0].DefaultTargetURL}, map[string]string{}, []string{}, api1PreSanitizedHash, "").Return(nil).Once()
+				apiSvc.On("UpdateInManyBundles", txtest.CtxWithDBMatcher(), api2ID, *sanitizedDoc.APIResources[1], nilSpecInput, map[string]string{bundleID: "http://localhost:8080/some-api/v1"}, map[string]string{}, []string{}, api2PreSanitizedHash, "").Return(nil).Once()
+				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Twice()
+				apiSvc.On("Delete", txtest.CtxWithDBMatcher(), api2ID).Return(nil).Once()
+				return apiSvc
+			},
+			eventSvcFn:   successfulEventUpdate,
+			specSvcFn:    successfulSpecUpdate,
+			packageSvcFn: successfulPackageUpdate,
+			productSvcFn: successfulProductUpdate,
+			vendorSvcFn: func() *automock.VendorService {
+				vendorSvc := &automock.VendorService{}
+				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixVendors(), nil).Once()
+				vendorSvc.On("Update", txtest.CtxWithDBMatcher(), vendorID, *sanitizedDoc.Vendors[0]).Return(nil).Once()
+				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixVendors(), nil).Once()
+				return vendorSvc
+			},
+			tombstoneSvcFn: func() *automock.TombstoneService {
+				tombstoneSvc := &automock.TombstoneService{}
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
+				tombstoneSvc.On("Update", txtest.CtxWithDBMatcher(), tombstoneID, *sanitizedDoc.Tombstones[0]).Return(nil).Once()
+				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
+				return tombstoneSvc
+			},
+			clientFn: func() *automock.Client {
+				client := &automock.Client{}
+				doc := fixORDDocument()
+				doc.Vendors[1].Title = "" // invalid resource
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: baseURL}, nil)
+				return client
+			},
+			invalidResourcesPolicy: ord.InvalidResourcesPolicySkip,
+		},
 		{
 			Name:            "Returns error when transaction opening fails",
 			TransactionerFn: txGen.ThatFailsOnBegin,
@@ -500,7 +599,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			ExpectedErr: testErr,
 		},
 		{
-			Name:            "Returns error when get tenant fails",
+			Name:            "Skips app when get tenant fails",
 			TransactionerFn: secondTransactionNotCommited,
 			labelRepoFn:     successfulLabelRepo,
 			appSvcFn:        successfulAppList,
@@ -509,16 +608,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
 				return tenantSvc
 			},
-			ExpectedErr: testErr,
 		},
 		{
-			Name:            "Does not resync resources when event list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			webhookSvcFn:    successfulWebhookList,
-			clientFn:        successfulClientFetch,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
+			Name:                   "Does not resync resources when event list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			webhookSvcFn:           successfulWebhookList,
+			clientFn:               successfulClientFetch,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -531,13 +630,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 		},
 		{
-			Name:            "Does not resync resources when api list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			webhookSvcFn:    successfulWebhookList,
-			clientFn:        successfulClientFetch,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
+			Name:                   "Does not resync resources when api list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			webhookSvcFn:           successfulWebhookList,
+			clientFn:               successfulClientFetch,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -545,7 +645,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			},
 		},
 		{
-			Name: "Returns error when webhook list fails",
+			Name: "Skips app when webhook list fails",
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				persistTx := &persistenceautomock.PersistenceTx{}
 				persistTx.On("Commit").Return(nil).Once()
@@ -563,33 +663,34 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
 				return whSvc
 			},
-			ExpectedErr: testErr,
 		},
 		{
-			Name:            "Skips app when ORD documents fetch fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Skips app when ORD documents fetch fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(nil, "", testErr)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(nil, testErr)
 				return client
 			},
 		},
 		{
-			Name:            "Does not resync resources for invalid ORD documents",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Does not resync resources for invalid ORD documents",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: invalidDocumentsAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			clientFn: func() *automock.Client {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors[0].OrdID = "" // invalid document
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 			apiSvcFn:     successfulEmptyAPIList,
@@ -597,12 +698,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if vendor list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Does not resync resources if vendor list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
 				vendorSvc := &automock.VendorService{}
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -614,12 +716,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if vendor update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Does not resync resources if vendor update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
 				vendorSvc := &automock.VendorService{}
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixVendors(), nil).Once()
@@ -632,12 +735,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if vendor create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Does not resync resources if vendor create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			vendorSvcFn: func() *automock.VendorService {
 				vendorSvc := &automock.VendorService{}
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -650,13 +754,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if product list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			vendorSvcFn:     successfulVendorUpdate,
+			Name:                   "Does not resync resources if product list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			vendorSvcFn:            successfulVendorUpdate,
 			productSvcFn: func() *automock.ProductService {
 				productSvc := &automock.ProductService{}
 				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -668,13 +773,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if product update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			vendorSvcFn:     successfulVendorUpdate,
+			Name:                   "Does not resync resources if product update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			vendorSvcFn:            successfulVendorUpdate,
 			productSvcFn: func() *automock.ProductService {
 				productSvc := &automock.ProductService{}
 				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixProducts(), nil).Once()
@@ -687,13 +793,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if product create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			vendorSvcFn:     successfulVendorUpdate,
+			Name:                   "Does not resync resources if product create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			vendorSvcFn:            successfulVendorUpdate,
 			productSvcFn: func() *automock.ProductService {
 				productSvc := &automock.ProductService{}
 				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -706,14 +813,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			packageSvcFn: successfulEmptyPackageList,
 		},
 		{
-			Name:            "Does not resync resources if package list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
+			Name:                   "Does not resync resources if package list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
 			packageSvcFn: func() *automock.PackageService {
 				packagesSvc := &automock.PackageService{}
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -725,14 +833,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if package update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
+			Name:                   "Does not resync resources if package update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
 			packageSvcFn: func() *automock.PackageService {
 				packagesSvc := &automock.PackageService{}
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixPackages(), nil).Once()
@@ -745,14 +854,15 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if package create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductCreate,
-			vendorSvcFn:     successfulVendorCreate,
+			Name:                   "Does not resync resources if package create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductCreate,
+			vendorSvcFn:            successfulVendorCreate,
 			packageSvcFn: func() *automock.PackageService {
 				packagesSvc := &automock.PackageService{}
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -765,15 +875,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if bundle list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
+			Name:                   "Does not resync resources if bundle list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
 			bundleSvcFn: func() *automock.BundleService {
 				bundlesSvc := &automock.BundleService{}
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -784,15 +895,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if bundle update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
+			Name:                   "Does not resync resources if bundle update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
 			bundleSvcFn: func() *automock.BundleService {
 				bundlesSvc := &automock.BundleService{}
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(fixBundles(), nil).Once()
@@ -804,15 +916,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if bundle create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductCreate,
-			vendorSvcFn:     successfulVendorCreate,
-			packageSvcFn:    successfulPackageCreate,
+			Name:                   "Does not resync resources if bundle create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductCreate,
+			vendorSvcFn:            successfulVendorCreate,
+			packageSvcFn:           successfulPackageCreate,
 			bundleSvcFn: func() *automock.BundleService {
 				bundlesSvc := &automock.BundleService{}
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -824,16 +937,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if api list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
+			Name:                   "Does not resync resources if api list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -844,16 +958,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if fetching bundle ids for api fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
+			Name:                   "Does not resync resources if fetching bundle ids for api fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
 			bundleRefSvcFn: func() *automock.BundleReferenceService {
 				bundleRefSvc := &automock.BundleReferenceService{}
 				firstAPIID := api1ID
@@ -871,17 +986,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
@@ -893,16 +1009,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductCreate,
-			vendorSvcFn:     successfulVendorCreate,
-			packageSvcFn:    successfulPackageCreate,
-			bundleSvcFn:     successfulBundleCreate,
+			Name:                   "Does not resync resources if api create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductCreate,
+			vendorSvcFn:            successfulVendorCreate,
+			packageSvcFn:           successfulPackageCreate,
+			bundleSvcFn:            successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -914,17 +1031,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api spec delete fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api spec delete fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
@@ -941,17 +1059,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if api spec create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api spec create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
@@ -969,17 +1088,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			eventSvcFn: successfulEmptyEventList,
 		},
 		{
-			Name:            "Does not resync resources if api spec list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api spec list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIsNoVersionBump(), nil).Twice()
@@ -995,17 +1115,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api spec get fetch request fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api spec get fetch request fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIsNoVersionBump(), nil).Twice()
@@ -1022,17 +1143,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api spec refetch fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
+			Name:                   "Does not resync resources if api spec refetch fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIsNoVersionBump(), nil).Twice()
@@ -1050,19 +1172,20 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn:   successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
-			specSvcFn:       successfulAPISpecUpdate,
+			Name:                   "Does not resync resources if event list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
+			specSvcFn:              successfulAPISpecUpdate,
 			eventSvcFn: func() *automock.EventService {
 				eventSvc := &automock.EventService{}
 				eventSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixEvents(), nil).Once()
@@ -1072,16 +1195,17 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if fetching bundle ids for event fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
+			Name:                   "Does not resync resources if fetching bundle ids for event fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
 			bundleRefSvcFn: func() *automock.BundleReferenceService {
 				bundleRefSvc := &automock.BundleReferenceService{}
 				firstAPIID := api1ID
@@ -1103,19 +1227,20 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
-			specSvcFn:       successfulAPISpecUpdate,
+			Name:                   "Does not resync resources if event update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
+			specSvcFn:              successfulAPISpecUpdate,
 			eventSvcFn: func() *automock.EventService {
 				eventSvc := &automock.EventService{}
 				eventSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixEvents(), nil).Once()
@@ -1126,18 +1251,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductCreate,
-			vendorSvcFn:     successfulVendorCreate,
-			packageSvcFn:    successfulPackageCreate,
-			bundleSvcFn:     successfulBundleCreate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfAPIBundleIDs,
-			apiSvcFn:        successfulAPICreate,
+			Name:                   "Does not resync resources if event create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductCreate,
+			vendorSvcFn:            successfulVendorCreate,
+			packageSvcFn:           successfulPackageCreate,
+			bundleSvcFn:            successfulBundleCreate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfAPIBundleIDs,
+			apiSvcFn:               successfulAPICreate,
 			eventSvcFn: func() *automock.EventService {
 				eventSvc := &automock.EventService{}
 				eventSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1148,18 +1274,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec delete fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
+			Name:                   "Does not resync resources if event spec delete fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 
@@ -1183,18 +1310,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
+			Name:                   "Does not resync resources if event spec create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 
@@ -1221,18 +1349,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
+			Name:                   "Does not resync resources if event spec list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
@@ -1256,18 +1385,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec get fetch request fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
+			Name:                   "Does not resync resources if event spec get fetch request fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
@@ -1292,18 +1422,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if event spec refetch fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
+			Name:                   "Does not resync resources if event spec refetch fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
 			specSvcFn: func() *automock.SpecService {
 				specSvc := &automock.SpecService{}
 				specSvc.On("DeleteByReferenceObjectID", txtest.CtxWithDBMatcher(), model.APISpecReference, api1ID).Return(nil).Once()
@@ -1330,20 +1461,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if tombstone list fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
-			eventSvcFn:      successfulEventUpdate,
-			specSvcFn:       successfulSpecUpdate,
+			Name:                   "Does not resync resources if tombstone list fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
+			eventSvcFn:             successfulEventUpdate,
+			specSvcFn:              successfulSpecUpdate,
 			tombstoneSvcFn: func() *automock.TombstoneService {
 				tombstoneSvc := &automock.TombstoneService{}
 				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
@@ -1352,20 +1484,21 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if tombstone update fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductUpdate,
-			vendorSvcFn:     successfulVendorUpdate,
-			packageSvcFn:    successfulPackageUpdate,
-			bundleSvcFn:     successfulBundleUpdate,
-			bundleRefSvcFn:  successfulBundleReferenceFetchingOfBundleIDs,
-			apiSvcFn:        successfulAPIUpdate,
-			eventSvcFn:      successfulEventUpdate,
-			specSvcFn:       successfulSpecUpdate,
+			Name:                   "Does not resync resources if tombstone update fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductUpdate,
+			vendorSvcFn:            successfulVendorUpdate,
+			packageSvcFn:           successfulPackageUpdate,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
+			apiSvcFn:               successfulAPIUpdate,
+			eventSvcFn:             successfulEventUpdate,
+			specSvcFn:              successfulSpecUpdate,
 			tombstoneSvcFn: func() *automock.TombstoneService {
 				tombstoneSvc := &automock.TombstoneService{}
 				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixTombstones(), nil).Once()
@@ -1375,18 +1508,19 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if tombstone create fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			productSvcFn:    successfulProductCreate,
-			vendorSvcFn:     successfulVendorCreate,
-			packageSvcFn:    successfulPackageCreate,
-			bundleSvcFn:     successfulBundleCreate,
-			apiSvcFn:        successfulAPICreate,
-			eventSvcFn:      successfulEventCreate,
+			Name:                   "Does not resync resources if tombstone create fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			productSvcFn:           successfulProductCreate,
+			vendorSvcFn:            successfulVendorCreate,
+			packageSvcFn:           successfulPackageCreate,
+			bundleSvcFn:            successfulBundleCreate,
+			apiSvcFn:               successfulAPICreate,
+			eventSvcFn:             successfulEventCreate,
 			tombstoneSvcFn: func() *automock.TombstoneService {
 				tombstoneSvc := &automock.TombstoneService{}
 				tombstoneSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1396,13 +1530,14 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if api resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			bundleSvcFn:     successfulBundleCreate,
+			Name:                   "Does not resync resources if api resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1427,15 +1562,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			clientFn: successfulClientFetch,
 		},
 		{
-			Name:            "Does not resync resources if package resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			bundleSvcFn:     successfulBundleCreate,
-			apiSvcFn:        successfulAPICreate,
-			eventSvcFn:      successfulEventCreate,
+			Name:                   "Does not resync resources if package resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
+			apiSvcFn:               successfulAPICreate,
+			eventSvcFn:             successfulEventCreate,
 			packageSvcFn: func() *automock.PackageService {
 				packagesSvc := &automock.PackageService{}
 				packagesSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1462,19 +1598,20 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = packageORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
 		{
-			Name:            "Does not resync resources if event resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			bundleSvcFn:     successfulBundleCreate,
-			apiSvcFn:        successfulAPICreate,
+			Name:                   "Does not resync resources if event resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
+			apiSvcFn:               successfulAPICreate,
 			eventSvcFn: func() *automock.EventService {
 				eventSvc := &automock.EventService{}
 				eventSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1503,22 +1640,23 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = event1ORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
 		{
-			Name:            "Does not resync resources if vendor resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			bundleSvcFn:     successfulBundleCreate,
-			apiSvcFn:        successfulAPICreate,
-			eventSvcFn:      successfulEventCreate,
-			packageSvcFn:    successfulPackageCreate,
-			productSvcFn:    successfulProductCreate,
+			Name:                   "Does not resync resources if vendor resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
+			apiSvcFn:               successfulAPICreate,
+			eventSvcFn:             successfulEventCreate,
+			packageSvcFn:           successfulPackageCreate,
+			productSvcFn:           successfulProductCreate,
 			vendorSvcFn: func() *automock.VendorService {
 				vendorSvc := &automock.VendorService{}
 				vendorSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1543,21 +1681,22 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = vendorORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
 		{
-			Name:            "Does not resync resources if product resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
-			bundleSvcFn:     successfulBundleCreate,
-			apiSvcFn:        successfulAPICreate,
-			eventSvcFn:      successfulEventCreate,
-			packageSvcFn:    successfulPackageCreate,
+			Name:                   "Does not resync resources if product resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
+			apiSvcFn:               successfulAPICreate,
+			eventSvcFn:             successfulEventCreate,
+			packageSvcFn:           successfulPackageCreate,
 			productSvcFn: func() *automock.ProductService {
 				productSvc := &automock.ProductService{}
 				productSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1582,17 +1721,18 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = productORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
 		{
-			Name:            "Does not resync resources if bundle resource deletion due to tombstone fails",
-			TransactionerFn: secondTransactionNotCommited,
-			labelRepoFn:     successfulLabelRepo,
-			appSvcFn:        successfulAppList,
-			tenantSvcFn:     successfulTenantSvc,
-			webhookSvcFn:    successfulWebhookList,
+			Name:                   "Does not resync resources if bundle resource deletion due to tombstone fails",
+			TransactionerFn:        failedAggregationStatusRecordedInSeparateTransaction,
+			aggregationStatusSvcFn: failedAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
 			bundleSvcFn: func() *automock.BundleService {
 				bundlesSvc := &automock.BundleService{}
 				bundlesSvc.On("ListByApplicationIDNoPaging", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1621,7 +1761,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Tombstones[0].OrdID = bundleORDID
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
@@ -1631,11 +1771,12 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				return txGen.ThatSucceedsMultipleTimes(2)
 			},
-			labelRepoFn:  successfulLabelRepo,
-			appSvcFn:     successfulAppList,
-			tenantSvcFn:  successfulTenantSvc,
-			webhookSvcFn: successfulWebhookList,
-			bundleSvcFn:  successfulBundleCreate,
+			aggregationStatusSvcFn: successfulAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleCreate,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
@@ -1661,7 +1802,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
@@ -1670,12 +1811,13 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
 				return txGen.ThatSucceedsMultipleTimes(2)
 			},
-			labelRepoFn:    successfulLabelRepo,
-			appSvcFn:       successfulAppList,
-			tenantSvcFn:    successfulTenantSvc,
-			webhookSvcFn:   successfulWebhookList,
-			bundleSvcFn:    successfulBundleUpdate,
-			bundleRefSvcFn: successfulBundleReferenceFetchingOfBundleIDs,
+			aggregationStatusSvcFn: successfulAggregationStatusRecord,
+			labelRepoFn:            successfulLabelRepo,
+			appSvcFn:               successfulAppList,
+			tenantSvcFn:            successfulTenantSvc,
+			webhookSvcFn:           successfulWebhookList,
+			bundleSvcFn:            successfulBundleUpdate,
+			bundleRefSvcFn:         successfulBundleReferenceFetchingOfBundleIDs,
 			apiSvcFn: func() *automock.APIService {
 				apiSvc := &automock.APIService{}
 				apiSvc.On("ListByApplicationID", txtest.CtxWithDBMatcher(), appID).Return(fixAPIs(), nil).Once()
@@ -1702,7 +1844,7 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				client := &automock.Client{}
 				doc := fixORDDocument()
 				doc.Vendors = nil
-				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook).Return(ord.Documents{doc}, *doc.DescribedSystemInstance.BaseURL, nil)
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, nilFetchCache).Return(&ord.FetchResult{Documents: ord.Documents{doc}, BaseURL: *doc.DescribedSystemInstance.BaseURL}, nil)
 				return client
 			},
 		},
@@ -1763,12 +1905,16 @@ func TestService_SyncORDDocuments(t *testing.T) {
 			if test.tenantSvcFn != nil {
 				tenantSvc = test.tenantSvcFn()
 			}
+			aggregationStatusSvc := &automock.AggregationStatusService{}
+			if test.aggregationStatusSvcFn != nil {
+				aggregationStatusSvc = test.aggregationStatusSvcFn()
+			}
 			client := &automock.Client{}
 			if test.clientFn != nil {
 				client = test.clientFn()
 			}
 
-			svc := ord.NewAggregatorService(tx, labelRepo, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, client)
+			svc := ord.NewAggregatorService(ord.ServiceConfig{MaxParallelApplicationProcessors: 1, InvalidResourcesPolicy: test.invalidResourcesPolicy}, tx, labelRepo, appSvc, whSvc, bndlSvc, bndlRefSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, aggregationStatusSvc, &automock.AggregationRequestService{}, client)
 			err := svc.SyncORDDocuments(context.TODO())
 			if test.ExpectedErr != nil {
 				require.Error(t, err)
@@ -1777,7 +1923,560 @@ func TestService_SyncORDDocuments(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			mock.AssertExpectationsForObjects(t, tx, labelRepo, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, client)
+			mock.AssertExpectationsForObjects(t, tx, labelRepo, appSvc, whSvc, bndlSvc, apiSvc, eventSvc, specSvc, packageSvc, productSvc, vendorSvc, tombstoneSvc, tenantSvc, aggregationStatusSvc, client)
+		})
+	}
+}
+
+func TestService_SyncORDDocumentsIsolatesApplicationFailures(t *testing.T) {
+	testErr := errors.New("Test error")
+	secondAppID := "secondApp"
+
+	page := fixApplicationPage()
+	page.Data = append(page.Data, &model.Application{
+		Name: "secondTestApp",
+		BaseEntity: &model.BaseEntity{
+			ID:    secondAppID,
+			Ready: true,
+		},
+	})
+	page.TotalCount = 2
+
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Once()
+
+	tx := &persistenceautomock.Transactioner{}
+	tx.On("Begin").Return(persistTx, nil).Times(3)
+	tx.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(3)
+
+	appSvc := &automock.ApplicationService{}
+	appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(page, nil).Once()
+
+	labelRepo := &automock.LabelRepository{}
+	labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID, secondAppID}, applicationTypeLabel).Return(nil, nil).Once()
+
+	tenantSvc := &automock.TenantService{}
+	tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+	tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, secondAppID).Return(tenantID, nil).Once()
+
+	whSvc := &automock.WebhookService{}
+	whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), secondAppID).Return(nil, nil).Once()
+
+	svc := ord.NewAggregatorService(ord.ServiceConfig{MaxParallelApplicationProcessors: 2}, tx, labelRepo, appSvc, whSvc, &automock.BundleService{}, &automock.BundleReferenceService{}, &automock.APIService{}, &automock.EventService{}, &automock.SpecService{}, &automock.PackageService{}, &automock.ProductService{}, &automock.VendorService{}, &automock.TombstoneService{}, tenantSvc, &automock.AggregationStatusService{}, &automock.AggregationRequestService{}, &automock.Client{})
+	err := svc.SyncORDDocuments(context.TODO())
+	require.NoError(t, err)
+
+	mock.AssertExpectationsForObjects(t, tx, persistTx, labelRepo, appSvc, whSvc, tenantSvc)
+}
+
+func TestService_SyncORDDocumentsRecordsTimedOutApplications(t *testing.T) {
+	testApplication := fixApplicationPage().Data[0]
+	testWebhook := fixWebhooks()[0]
+
+	persistTx := &persistenceautomock.PersistenceTx{}
+	persistTx.On("Commit").Return(nil).Twice()
+
+	tx := &persistenceautomock.Transactioner{}
+	tx.On("Begin").Return(persistTx, nil).Times(3)
+	tx.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(3)
+
+	appSvc := &automock.ApplicationService{}
+	appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+
+	labelRepo := &automock.LabelRepository{}
+	labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+
+	tenantSvc := &automock.TenantService{}
+	tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+
+	whSvc := &automock.WebhookService{}
+	whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+
+	client := &automock.Client{}
+	client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, (*model.ORDFetchCache)(nil)).Run(func(args mock.Arguments) {
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.DeadlineExceeded).Once()
+
+	activeCtxMatcher := mock.MatchedBy(func(ctx context.Context) bool {
+		_, err := persistence.FromCtx(ctx)
+		return err == nil && ctx.Err() == nil
+	})
+	statusSvc := &automock.AggregationStatusService{}
+	statusSvc.On("Record", activeCtxMatcher, appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+		return result.Error != nil
+	})).Return(nil).Once()
+
+	svc := ord.NewAggregatorService(ord.ServiceConfig{MaxParallelApplicationProcessors: 1, ApplicationProcessingTimeout: time.Millisecond}, tx, labelRepo, appSvc, whSvc, &automock.BundleService{}, &automock.BundleReferenceService{}, &automock.APIService{}, &automock.EventService{}, &automock.SpecService{}, &automock.PackageService{}, &automock.ProductService{}, &automock.VendorService{}, &automock.TombstoneService{}, tenantSvc, statusSvc, &automock.AggregationRequestService{}, client)
+	err := svc.SyncORDDocuments(context.TODO())
+	require.NoError(t, err)
+
+	mock.AssertExpectationsForObjects(t, tx, persistTx, labelRepo, appSvc, whSvc, tenantSvc, statusSvc, client)
+}
+
+func TestService_SyncORDDocumentsSkipsUnchangedDocuments(t *testing.T) {
+	testApplication := fixApplicationPage().Data[0]
+	testWebhook := fixWebhooks()[0]
+
+	documentsHash, err := ord.HashDocuments(ord.Documents{fixORDDocument()}, baseURL)
+	require.NoError(t, err)
+
+	previousCache := fixORDFetchCache(docETag)
+	lastSuccessAt := time.Now()
+	skippedViolations := []*model.ORDValidationError{{ResourceType: "vendor", OrdID: "sap:vendor:SAP:", Message: "ordId: must be in a valid format."}}
+
+	testCases := []struct {
+		Name                     string
+		FetchResult              *ord.FetchResult
+		PreviousValidationErrors []*model.ORDValidationError
+	}{
+		{
+			Name:        "Skips processing when the provider reports the documents as not modified",
+			FetchResult: &ord.FetchResult{BaseURL: baseURL, Cache: previousCache, NotModified: true},
+		},
+		{
+			Name:        "Skips processing when the documents hash matches the last successful aggregation",
+			FetchResult: &ord.FetchResult{Documents: ord.Documents{fixORDDocument()}, BaseURL: baseURL, Cache: fixORDFetchCache(`"new-doc-etag"`)},
+		},
+		{
+			Name:                     "Keeps the violations of the resources skipped by the last successful aggregation",
+			FetchResult:              &ord.FetchResult{Documents: ord.Documents{fixORDDocument()}, BaseURL: baseURL, Cache: fixORDFetchCache(`"new-doc-etag"`)},
+			PreviousValidationErrors: skippedViolations,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			previousStatus := &model.ORDAggregationStatus{
+				ID:               "statusID",
+				ApplicationID:    appID,
+				WebhookID:        testWebhook.ID,
+				LastAttemptAt:    lastSuccessAt,
+				LastSuccessAt:    &lastSuccessAt,
+				DocumentsCount:   1,
+				ValidationErrors: test.PreviousValidationErrors,
+				DocumentsHash:    &documentsHash,
+				FetchCache:       previousCache,
+			}
+
+			_, tx := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(2)
+
+			appSvc := &automock.ApplicationService{}
+			appSvc.On("ListGlobal", txtest.CtxWithDBMatcher(), 200, "").Return(fixApplicationPage(), nil).Once()
+
+			labelRepo := &automock.LabelRepository{}
+			labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+
+			tenantSvc := &automock.TenantService{}
+			tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+
+			whSvc := &automock.WebhookService{}
+			whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+
+			statusSvc := &automock.AggregationStatusService{}
+			statusSvc.On("GetForWebhook", txtest.CtxWithDBMatcher(), appID, testWebhook.ID).Return(previousStatus, nil).Once()
+			statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, model.ORDAggregationResult{
+				DocumentsCount:          1,
+				ValidationErrors:        test.PreviousValidationErrors,
+				InvalidResourcesSkipped: len(test.PreviousValidationErrors) > 0,
+				DocumentsHash:           &documentsHash,
+				FetchCache:              test.FetchResult.Cache,
+			}).Return(nil).Once()
+
+			client := &automock.Client{}
+			client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), testApplication, testWebhook, previousCache).Return(test.FetchResult, nil).Once()
+
+			svc := ord.NewAggregatorService(ord.ServiceConfig{MaxParallelApplicationProcessors: 1, IncrementalSyncEnabled: true}, tx, labelRepo, appSvc, whSvc, &automock.BundleService{}, &automock.BundleReferenceService{}, &automock.APIService{}, &automock.EventService{}, &automock.SpecService{}, &automock.PackageService{}, &automock.ProductService{}, &automock.VendorService{}, &automock.TombstoneService{}, tenantSvc, statusSvc, &automock.AggregationRequestService{}, client)
+			err := svc.SyncORDDocuments(context.TODO())
+			require.NoError(t, err)
+
+			mock.AssertExpectationsForObjects(t, tx, labelRepo, appSvc, whSvc, tenantSvc, statusSvc, client)
+		})
+	}
+}
+
+func TestService_ProcessAggregationRequests(t *testing.T) {
+	testErr := errors.New("test error")
+	requestID := "requestID"
+	requests := []*model.ORDAggregationRequest{{ID: requestID, ApplicationID: appID}}
+	fixFailedRequests := func(attempts int) []*model.ORDAggregationRequest {
+		return []*model.ORDAggregationRequest{{ID: requestID, ApplicationID: appID, Attempts: attempts}}
+	}
+	config := ord.ServiceConfig{
+		MaxParallelApplicationProcessors:   1,
+		AggregationRequestMaxAttempts:      5,
+		AggregationRequestRetryInterval:    time.Minute,
+		AggregationRequestMaxRetryInterval: 3 * time.Minute,
+	}
+	testWebhook := fixWebhooks()[0]
+
+	fixRequestedApp := func() *model.Application {
+		return &model.Application{
+			Name: "testApp",
+			BaseEntity: &model.BaseEntity{
+				ID:    appID,
+				Ready: true,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name               string
+		BeginCount         int
+		CommitCount        int
+		RequestSvcFn       func() *automock.AggregationRequestService
+		AppSvcFn           func(app *model.Application) *automock.ApplicationService
+		LabelRepoFn        func() *automock.LabelRepository
+		TenantSvcFn        func() *automock.TenantService
+		WebhookSvcFn       func() *automock.WebhookService
+		ClientFn           func(app *model.Application) *automock.Client
+		StatusSvcFn        func() *automock.AggregationStatusService
+		ExpectedAppType    string
+		ExpectedErrMessage string
+	}{
+		{
+			Name:        "Processes the requested applications and deletes their requests",
+			BeginCount:  3,
+			CommitCount: 2,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(requests, nil).Once()
+				requestSvc.On("DeleteProcessedGlobal", txtest.CtxWithDBMatcher(), requests[0]).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return([]*model.Label{{ObjectID: appID, Value: testApplicationType}}, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
+			WebhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				return whSvc
+			},
+			ExpectedAppType: testApplicationType,
+		},
+		{
+			Name:        "Does nothing when there are no pending requests",
+			BeginCount:  1,
+			CommitCount: 1,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return([]*model.ORDAggregationRequest{}, nil).Once()
+				return requestSvc
+			},
+		},
+		{
+			Name:       "Returns error when listing the requests fails",
+			BeginCount: 1,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(nil, testErr).Once()
+				return requestSvc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+		{
+			Name:        "Postpones the request when processing the requested application fails",
+			BeginCount:  3,
+			CommitCount: 2,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(requests, nil).Once()
+				requestSvc.On("RecordFailedAttemptGlobal", txtest.CtxWithDBMatcher(), requests[0], time.Minute).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+		},
+		{
+			Name:        "Doubles the retry interval of a request which has failed before",
+			BeginCount:  3,
+			CommitCount: 2,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				failedRequests := fixFailedRequests(1)
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(failedRequests, nil).Once()
+				requestSvc.On("RecordFailedAttemptGlobal", txtest.CtxWithDBMatcher(), failedRequests[0], 2*time.Minute).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+		},
+		{
+			Name:        "Limits the retry interval of a request which has failed before",
+			BeginCount:  3,
+			CommitCount: 2,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				failedRequests := fixFailedRequests(3)
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(failedRequests, nil).Once()
+				requestSvc.On("RecordFailedAttemptGlobal", txtest.CtxWithDBMatcher(), failedRequests[0], 3*time.Minute).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+		},
+		{
+			Name:        "Does not return error when recording the failed attempt of the request fails",
+			BeginCount:  3,
+			CommitCount: 1,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(requests, nil).Once()
+				requestSvc.On("RecordFailedAttemptGlobal", txtest.CtxWithDBMatcher(), requests[0], time.Minute).Return(testErr).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+		},
+		{
+			Name:        "Drops the request which has failed for the maximum number of attempts",
+			BeginCount:  3,
+			CommitCount: 2,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				failedRequests := fixFailedRequests(4)
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(failedRequests, nil).Once()
+				requestSvc.On("DeleteProcessedGlobal", txtest.CtxWithDBMatcher(), failedRequests[0]).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return("", testErr).Once()
+				return tenantSvc
+			},
+		},
+		{
+			Name:        "Records the failure in the aggregation status when dropping the request",
+			BeginCount:  5,
+			CommitCount: 4,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				failedRequests := fixFailedRequests(4)
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(failedRequests, nil).Once()
+				requestSvc.On("DeleteProcessedGlobal", txtest.CtxWithDBMatcher(), failedRequests[0]).Return(nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
+			WebhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(fixWebhooks(), nil).Once()
+				return whSvc
+			},
+			ClientFn: func(app *model.Application) *automock.Client {
+				client := &automock.Client{}
+				client.On("FetchOpenResourceDiscoveryDocuments", txtest.CtxWithDBMatcher(), app, testWebhook, (*model.ORDFetchCache)(nil)).Return(nil, testErr).Once()
+				return client
+			},
+			StatusSvcFn: func() *automock.AggregationStatusService {
+				statusSvc := &automock.AggregationStatusService{}
+				statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+					return result.Error != nil && !strings.Contains(*result.Error, "dropped")
+				})).Return(nil).Once()
+				statusSvc.On("Record", txtest.CtxWithDBMatcher(), appID, testWebhook.ID, mock.MatchedBy(func(result model.ORDAggregationResult) bool {
+					return result.Error != nil && strings.Contains(*result.Error, "ORD aggregation request dropped after 5 failed attempts") && strings.Contains(*result.Error, testErr.Error())
+				})).Return(nil).Once()
+				return statusSvc
+			},
+		},
+		{
+			Name:        "Does not return error when deleting the processed request fails",
+			BeginCount:  3,
+			CommitCount: 1,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(requests, nil).Once()
+				requestSvc.On("DeleteProcessedGlobal", txtest.CtxWithDBMatcher(), requests[0]).Return(testErr).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(app *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(app, nil).Once()
+				return appSvc
+			},
+			LabelRepoFn: func() *automock.LabelRepository {
+				labelRepo := &automock.LabelRepository{}
+				labelRepo.On("ListGlobalByKeyAndObjects", txtest.CtxWithDBMatcher(), model.ApplicationLabelableObject, []string{appID}, applicationTypeLabel).Return(nil, nil).Once()
+				return labelRepo
+			},
+			TenantSvcFn: func() *automock.TenantService {
+				tenantSvc := &automock.TenantService{}
+				tenantSvc.On("GetLowestOwnerForResource", txtest.CtxWithDBMatcher(), resource.Application, appID).Return(tenantID, nil).Once()
+				return tenantSvc
+			},
+			WebhookSvcFn: func() *automock.WebhookService {
+				whSvc := &automock.WebhookService{}
+				whSvc.On("ListForApplication", txtest.CtxWithDBMatcher(), appID).Return(nil, nil).Once()
+				return whSvc
+			},
+		},
+		{
+			Name:       "Returns error when getting the requested application fails",
+			BeginCount: 1,
+			RequestSvcFn: func() *automock.AggregationRequestService {
+				requestSvc := &automock.AggregationRequestService{}
+				requestSvc.On("ListDueGlobal", txtest.CtxWithDBMatcher()).Return(requests, nil).Once()
+				return requestSvc
+			},
+			AppSvcFn: func(_ *model.Application) *automock.ApplicationService {
+				appSvc := &automock.ApplicationService{}
+				appSvc.On("GetGlobalByID", txtest.CtxWithDBMatcher(), appID).Return(nil, testErr).Once()
+				return appSvc
+			},
+			ExpectedErrMessage: testErr.Error(),
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.Name, func(t *testing.T) {
+			app := fixRequestedApp()
+
+			persistTx := &persistenceautomock.PersistenceTx{}
+			if test.CommitCount > 0 {
+				persistTx.On("Commit").Return(nil).Times(test.CommitCount)
+			}
+
+			tx := &persistenceautomock.Transactioner{}
+			tx.On("Begin").Return(persistTx, nil).Times(test.BeginCount)
+			tx.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(true).Times(test.BeginCount)
+
+			requestSvc := test.RequestSvcFn()
+			appSvc := &automock.ApplicationService{}
+			if test.AppSvcFn != nil {
+				appSvc = test.AppSvcFn(app)
+			}
+			labelRepo := &automock.LabelRepository{}
+			if test.LabelRepoFn != nil {
+				labelRepo = test.LabelRepoFn()
+			}
+			tenantSvc := &automock.TenantService{}
+			if test.TenantSvcFn != nil {
+				tenantSvc = test.TenantSvcFn()
+			}
+			whSvc := &automock.WebhookService{}
+			if test.WebhookSvcFn != nil {
+				whSvc = test.WebhookSvcFn()
+			}
+			client := &automock.Client{}
+			if test.ClientFn != nil {
+				client = test.ClientFn(app)
+			}
+			statusSvc := &automock.AggregationStatusService{}
+			if test.StatusSvcFn != nil {
+				statusSvc = test.StatusSvcFn()
+			}
+
+			svc := ord.NewAggregatorService(config, tx, labelRepo, appSvc, whSvc, &automock.BundleService{}, &automock.BundleReferenceService{}, &automock.APIService{}, &automock.EventService{}, &automock.SpecService{}, &automock.PackageService{}, &automock.ProductService{}, &automock.VendorService{}, &automock.TombstoneService{}, tenantSvc, statusSvc, requestSvc, client)
+			err := svc.ProcessAggregationRequests(context.TODO())
+			if test.ExpectedErrMessage != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), test.ExpectedErrMessage)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, test.ExpectedAppType, app.Type)
+
+			mock.AssertExpectationsForObjects(t, tx, persistTx, requestSvc, appSvc, labelRepo, tenantSvc, whSvc, client, statusSvc)
 		})
 	}
 }
+
+func TestServiceConfig_Validate(t *testing.T) {
+	t.Run("Succeeds for the known invalid resources policies", func(t *testing.T) {
+		for _, policy := range []ord.InvalidResourcesPolicy{ord.InvalidResourcesPolicyFail, ord.InvalidResourcesPolicySkip} {
+			require.NoError(t, ord.ServiceConfig{InvalidResourcesPolicy: policy}.Validate())
+		}
+	})
+
+	t.Run("Fails for unknown invalid resources policy", func(t *testing.T) {
+		err := ord.ServiceConfig{InvalidResourcesPolicy: "ignore"}.Validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `got "ignore"`)
+	})
+}
@@ -0,0 +1,47 @@
+package ord
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+)
+
+// syncSummary collects the outcome of the processing of each application during a single ORD aggregation run.
+// It is safe for concurrent use by multiple workers.
+type syncSummary struct {
+	mutex sync.Mutex
+
+	succeeded []string
+	failed    []string
+	skipped   []string
+}
+
+func (s *syncSummary) addSucceeded(appID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.succeeded = append(s.succeeded, appID)
+}
+
+func (s *syncSummary) addFailed(appID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failed = append(s.failed, appID)
+}
+
+func (s *syncSummary) addSkipped(appID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.skipped = append(s.skipped, appID)
+}
+
+func (s *syncSummary) log(ctx context.Context) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	log.C(ctx).Infof("ORD aggregation finished: %d applications succeeded, %d failed, %d skipped", len(s.succeeded), len(s.failed), len(s.skipped))
+	if len(s.failed) > 0 {
+		log.C(ctx).Warnf("ORD aggregation failed for applications with IDs: %s", strings.Join(s.failed, ", "))
+	}
+}
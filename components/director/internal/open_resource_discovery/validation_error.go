@@ -0,0 +1,264 @@
+package ord
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	documentResourceType  = "document"
+	packageResourceType   = "package"
+	bundleResourceType    = "bundle"
+	productResourceType   = "product"
+	apiResourceType       = "api"
+	eventResourceType     = "event"
+	vendorResourceType    = "vendor"
+	tombstoneResourceType = "tombstone"
+
+	// RuleDuplicateOrdID is the rule violated by an ORD resource with the same ORD ID as another resource of the same type.
+	RuleDuplicateOrdID = "duplicate_ord_id"
+	// RuleUnknownReference is the rule violated by an ORD resource referencing a resource that is not present in the documents.
+	RuleUnknownReference = "unknown_reference"
+	// RuleInvalid is the rule reported for the violations which are not identified by a more specific rule.
+	RuleInvalid = "validation_invalid"
+)
+
+// resourceCollections maps the ORD resource types to the fields of the document in which they are declared.
+var resourceCollections = map[string]string{
+	packageResourceType:   "packages",
+	bundleResourceType:    "consumptionBundles",
+	productResourceType:   "products",
+	apiResourceType:       "apiResources",
+	eventResourceType:     "eventResources",
+	vendorResourceType:    "vendors",
+	tombstoneResourceType: "tombstones",
+}
+
+// ValidationError represents a failed validation of a single ORD resource, or of a whole document if ResourceType is "document".
+type ValidationError struct {
+	DocumentIndex int
+	ResourceType  string
+	OrdID         string
+	Err           error
+
+	resourceIndex int
+}
+
+type resourceKey struct {
+	documentIndex int
+	resourceType  string
+	resourceIndex int
+}
+
+func newValidationError(docIdx int, resourceType string, resourceIdx int, ordID string, err error) *ValidationError {
+	return &ValidationError{
+		DocumentIndex: docIdx,
+		ResourceType:  resourceType,
+		OrdID:         ordID,
+		Err:           err,
+		resourceIndex: resourceIdx,
+	}
+}
+
+func newDuplicateValidationError(docIdx int, resourceType string, resourceIdx int, ordID string) *ValidationError {
+	return newValidationError(docIdx, resourceType, resourceIdx, ordID, validation.Errors{
+		"ordId": validation.NewError(RuleDuplicateOrdID, fmt.Sprintf("found duplicate %s with ord id %q", resourceType, ordID)),
+	})
+}
+
+func newUnknownReferenceError(resourceType, ordID string) error {
+	return validation.NewError(RuleUnknownReference, fmt.Sprintf("has a reference to unknown %s %q", resourceType, ordID))
+}
+
+// Error returns the message of the validation error
+func (e *ValidationError) Error() string {
+	if e.ResourceType == documentResourceType {
+		return fmt.Sprintf("error validating document with index %d: %s", e.DocumentIndex, e.Err)
+	}
+	return fmt.Sprintf("error validating %s with ord id %q: %s", e.ResourceType, e.OrdID, e.Err)
+}
+
+// Unwrap returns the underlying validation error
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Violations returns the violations of the validation error, one for each invalid field of the resource.
+func (e *ValidationError) Violations() []*model.ORDValidationError {
+	violations := make([]*model.ORDValidationError, 0)
+	e.addViolations(&violations, e.path(), e.Err)
+	return violations
+}
+
+func (e *ValidationError) addViolations(violations *[]*model.ORDValidationError, path string, err error) {
+	var fieldErrs validation.Errors
+	if !errors.As(err, &fieldErrs) {
+		docIdx := e.DocumentIndex
+		*violations = append(*violations, &model.ORDValidationError{
+			DocumentIndex: &docIdx,
+			ResourceType:  e.ResourceType,
+			OrdID:         e.OrdID,
+			Path:          path,
+			Rule:          ruleOf(err),
+			Message:       err.Error(),
+		})
+		return
+	}
+
+	fields := make([]string, 0, len(fieldErrs))
+	for field := range fieldErrs {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		fieldPath := field
+		if len(path) > 0 {
+			fieldPath = path + "." + field
+		}
+		e.addViolations(violations, fieldPath, fieldErrs[field])
+	}
+}
+
+// path returns the JSON path of the invalid resource within its document.
+func (e *ValidationError) path() string {
+	collection, ok := resourceCollections[e.ResourceType]
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%s[%d]", collection, e.resourceIndex)
+}
+
+func (e *ValidationError) key() resourceKey {
+	return resourceKey{
+		documentIndex: e.DocumentIndex,
+		resourceType:  e.ResourceType,
+		resourceIndex: e.resourceIndex,
+	}
+}
+
+// ValidationErrors represents the failed validations of all the invalid documents and ORD resources of a system instance
+type ValidationErrors []*ValidationError
+
+// Error returns the messages of all the validation errors
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, validationErr := range e {
+		messages = append(messages, validationErr.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Violations returns the violations of all the validation errors.
+func (e ValidationErrors) Violations() []*model.ORDValidationError {
+	violations := make([]*model.ORDValidationError, 0, len(e))
+	for _, validationErr := range e {
+		violations = append(violations, validationErr.Violations()...)
+	}
+	return violations
+}
+
+// AreSkippable returns true if only single ORD resources are invalid, so that they can be skipped while the valid ones are processed.
+func (e ValidationErrors) AreSkippable() bool {
+	for _, validationErr := range e {
+		if validationErr.ResourceType == documentResourceType {
+			return false
+		}
+	}
+	return true
+}
+
+// ruleOf returns the code of the violated validation rule, if the error is a validation error with a code.
+func ruleOf(err error) string {
+	var ruleErr validation.Error
+	if errors.As(err, &ruleErr) && len(ruleErr.Code()) > 0 {
+		return ruleErr.Code()
+	}
+	return RuleInvalid
+}
+
+// findUnknownReference returns the first of the referenced ORD IDs which is not present in the known ones, if there is such.
+func findUnknownReference(ordIDs []gjson.Result, knownIDs map[string]bool) (string, bool) {
+	for _, ordID := range ordIDs {
+		if !knownIDs[ordID.String()] {
+			return ordID.String(), false
+		}
+	}
+	return "", true
+}
+
+func filterPackages(packages []*model.PackageInput, keep func(i int) bool) []*model.PackageInput {
+	filtered := make([]*model.PackageInput, 0, len(packages))
+	for i, pkg := range packages {
+		if keep(i) {
+			filtered = append(filtered, pkg)
+		}
+	}
+	return filtered
+}
+
+func filterBundles(bundles []*model.BundleCreateInput, keep func(i int) bool) []*model.BundleCreateInput {
+	filtered := make([]*model.BundleCreateInput, 0, len(bundles))
+	for i, bndl := range bundles {
+		if keep(i) {
+			filtered = append(filtered, bndl)
+		}
+	}
+	return filtered
+}
+
+func filterProducts(products []*model.ProductInput, keep func(i int) bool) []*model.ProductInput {
+	filtered := make([]*model.ProductInput, 0, len(products))
+	for i, product := range products {
+		if keep(i) {
+			filtered = append(filtered, product)
+		}
+	}
+	return filtered
+}
+
+func filterAPIs(apis []*model.APIDefinitionInput, keep func(i int) bool) []*model.APIDefinitionInput {
+	filtered := make([]*model.APIDefinitionInput, 0, len(apis))
+	for i, api := range apis {
+		if keep(i) {
+			filtered = append(filtered, api)
+		}
+	}
+	return filtered
+}
+
+func filterEvents(events []*model.EventDefinitionInput, keep func(i int) bool) []*model.EventDefinitionInput {
+	filtered := make([]*model.EventDefinitionInput, 0, len(events))
+	for i, event := range events {
+		if keep(i) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func filterVendors(vendors []*model.VendorInput, keep func(i int) bool) []*model.VendorInput {
+	filtered := make([]*model.VendorInput, 0, len(vendors))
+	for i, vendor := range vendors {
+		if keep(i) {
+			filtered = append(filtered, vendor)
+		}
+	}
+	return filtered
+}
+
+func filterTombstones(tombstones []*model.TombstoneInput, keep func(i int) bool) []*model.TombstoneInput {
+	filtered := make([]*model.TombstoneInput, 0, len(tombstones))
+	for i, tombstone := range tombstones {
+		if keep(i) {
+			filtered = append(filtered, tombstone)
+		}
+	}
+	return filtered
+}
@@ -4922,3 +4922,139 @@ func TestDocuments_ValidateTombstone(t *testing.T) {
 		})
 	}
 }
+
+func TestDocuments_ValidateReportsViolations(t *testing.T) {
+	var tests = []struct {
+		Name               string
+		DocumentProvider   func() []*ord.Document
+		ExpectedViolations []*model.ORDValidationError
+	}{
+		{
+			Name: "Violations of all invalid resources are reported",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.Packages[0].Title = ""
+				doc.Tombstones[0].RemovalDate = ""
+
+				return []*ord.Document{doc}
+			},
+			ExpectedViolations: []*model.ORDValidationError{
+				fixViolation("package", packageORDID, "packages[0].title", "validation_required", "cannot be blank"),
+				fixViolation("tombstone", api2ORDID, "tombstones[0].removalDate", "validation_required", "cannot be blank"),
+			},
+		}, {
+			Name: "Duplicate resources are reported",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.Vendors[1].OrdID = vendorORDID
+
+				return []*ord.Document{doc}
+			},
+			ExpectedViolations: []*model.ORDValidationError{
+				fixViolation("vendor", vendorORDID, "vendors[1].ordId", ord.RuleDuplicateOrdID, fmt.Sprintf("found duplicate vendor with ord id %q", vendorORDID)),
+			},
+		}, {
+			Name: "References to unknown resources are reported",
+			DocumentProvider: func() []*ord.Document {
+				doc := fixORDDocument()
+				doc.APIResources[0].OrdPackageID = str.Ptr(unknownPackageOrdID)
+
+				return []*ord.Document{doc}
+			},
+			ExpectedViolations: []*model.ORDValidationError{
+				fixViolation("api", api1ORDID, "apiResources[0].partOfPackage", ord.RuleUnknownReference, fmt.Sprintf("has a reference to unknown package %q", unknownPackageOrdID)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			docs := ord.Documents(test.DocumentProvider())
+			err := docs.Validate(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes)
+			require.Error(t, err)
+
+			validationErrs, ok := err.(ord.ValidationErrors)
+			require.True(t, ok)
+			require.Equal(t, test.ExpectedViolations, validationErrs.Violations())
+		})
+	}
+}
+
+func TestDocuments_ValidateSkippingInvalidResources(t *testing.T) {
+	t.Run("Resources referencing skipped resources are skipped too", func(t *testing.T) {
+		doc := fixORDDocument()
+		doc.Packages[0].Title = ""
+		docs := ord.Documents{doc}
+
+		skipped, err := docs.ValidateSkippingInvalidResources(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes)
+		require.NoError(t, err)
+
+		skippedIDs := make([]string, 0, len(skipped))
+		for _, validationErr := range skipped {
+			skippedIDs = append(skippedIDs, validationErr.OrdID)
+		}
+		require.Equal(t, []string{packageORDID, api1ORDID, api2ORDID, event1ORDID, event2ORDID}, skippedIDs)
+
+		require.Empty(t, doc.Packages)
+		require.Empty(t, doc.APIResources)
+		require.Empty(t, doc.EventResources)
+		require.Len(t, doc.ConsumptionBundles, 1)
+		require.Len(t, doc.Products, 1)
+		require.Len(t, doc.Vendors, 2)
+		require.Len(t, doc.Tombstones, 1)
+	})
+
+	t.Run("Violations of resources skipped in later passes refer to the provided documents", func(t *testing.T) {
+		doc := fixORDDocument()
+		doc.APIResources[0].Name = ""
+		doc.Packages[0].Title = ""
+		docs := ord.Documents{doc}
+
+		skipped, err := docs.ValidateSkippingInvalidResources(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes)
+		require.NoError(t, err)
+
+		paths := make(map[string]string, len(skipped))
+		for _, violation := range skipped.Violations() {
+			paths[violation.OrdID] = violation.Path
+		}
+		require.Equal(t, "apiResources[0].title", paths[api1ORDID])
+		require.Equal(t, "apiResources[1].partOfPackage", paths[api2ORDID])
+		require.Equal(t, "eventResources[1].partOfPackage", paths[event2ORDID])
+		require.Empty(t, doc.APIResources)
+	})
+
+	t.Run("Valid documents are not changed", func(t *testing.T) {
+		doc := fixORDDocument()
+		docs := ord.Documents{doc}
+
+		skipped, err := docs.ValidateSkippingInvalidResources(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes)
+		require.NoError(t, err)
+		require.Empty(t, skipped)
+		require.Equal(t, fixORDDocument(), doc)
+	})
+
+	t.Run("Invalid documents are not skipped", func(t *testing.T) {
+		doc := fixORDDocument()
+		doc.OpenResourceDiscovery = ""
+		doc.Packages[0].Title = ""
+		docs := ord.Documents{doc}
+
+		skipped, err := docs.ValidateSkippingInvalidResources(baseURL, apisFromDB, eventsFromDB, pkgsFromDB, resourceHashes)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "error validating document with index 0")
+		require.Nil(t, skipped)
+		require.Len(t, doc.Packages, 1)
+	})
+}
+
+func fixViolation(resourceType, ordID, path, rule, message string) *model.ORDValidationError {
+	docIdx := 0
+	return &model.ORDValidationError{
+		DocumentIndex: &docIdx,
+		ResourceType:  resourceType,
+		OrdID:         ordID,
+		Path:          path,
+		Rule:          rule,
+		Message:       message,
+	}
+}
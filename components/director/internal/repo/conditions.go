@@ -158,6 +158,29 @@ func (c *notEqualCondition) GetQueryArgs() ([]interface{}, bool) {
 	return []interface{}{c.val}, true
 }
 
+// NewLessThanCondition represents less than SQL condition (field < val)
+func NewLessThanCondition(field string, val interface{}) Condition {
+	return &lessThanCondition{
+		field: field,
+		val:   val,
+	}
+}
+
+type lessThanCondition struct {
+	field string
+	val   interface{}
+}
+
+// GetQueryPart returns formatted string that will be included in the SQL query for a given condition
+func (c *lessThanCondition) GetQueryPart() string {
+	return fmt.Sprintf("%s < ?", c.field)
+}
+
+// GetQueryArgs returns a boolean flag if the condition contain arguments and the actual arguments
+func (c *lessThanCondition) GetQueryArgs() ([]interface{}, bool) {
+	return []interface{}{c.val}, true
+}
+
 // NewNotNullCondition represents SQL not null condition (field IS NOT NULL)
 func NewNotNullCondition(field string) Condition {
 	return &notNullCondition{
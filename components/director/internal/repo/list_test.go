@@ -234,6 +234,23 @@ func TestListGlobal(t *testing.T) {
 		assert.Len(t, dest, 1)
 	})
 
+	t.Run("lists all items successfully with less than condition", func(t *testing.T) {
+		db, mock := testdb.MockDatabase(t)
+		defer mock.AssertExpectations(t)
+
+		rows := sqlmock.NewRows([]string{"id", "first_name", "last_name", "age"}).
+			AddRow(peterRow...)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT id, first_name, last_name, age FROM users WHERE age < $1")).
+			WithArgs(50).WillReturnRows(rows)
+		ctx := persistence.SaveToContext(context.TODO(), db)
+		var dest UserCollection
+
+		err := sut.ListGlobal(ctx, &dest, repo.NewLessThanCondition("age", 50))
+		require.NoError(t, err)
+		assert.Len(t, dest, 1)
+		assert.Contains(t, dest, peter)
+	})
+
 	t.Run("returns error if missing persistence context", func(t *testing.T) {
 		ctx := context.TODO()
 		err := sut.ListGlobal(ctx, nil)
@@ -0,0 +1,41 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+
+	time "time"
+)
+
+// FetchRequestRepository is an autogenerated mock type for the FetchRequestRepository type
+type FetchRequestRepository struct {
+	mock.Mock
+}
+
+// ListStaleByTenant provides a mock function with given fields: ctx, objectType, staleBefore
+func (_m *FetchRequestRepository) ListStaleByTenant(ctx context.Context, objectType model.FetchRequestReferenceObjectType, staleBefore time.Time) (map[string][]*model.FetchRequest, error) {
+	ret := _m.Called(ctx, objectType, staleBefore)
+
+	var r0 map[string][]*model.FetchRequest
+	if rf, ok := ret.Get(0).(func(context.Context, model.FetchRequestReferenceObjectType, time.Time) map[string][]*model.FetchRequest); ok {
+		r0 = rf(ctx, objectType, staleBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*model.FetchRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, model.FetchRequestReferenceObjectType, time.Time) error); ok {
+		r1 = rf(ctx, objectType, staleBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,36 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	model "github.com/kyma-incubator/compass/components/director/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SpecService is an autogenerated mock type for the SpecService type
+type SpecService struct {
+	mock.Mock
+}
+
+// RefreshSpec provides a mock function with given fields: ctx, id, objectType
+func (_m *SpecService) RefreshSpec(ctx context.Context, id string, objectType model.SpecReferenceObjectType) (bool, error) {
+	ret := _m.Called(ctx, id, objectType)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.SpecReferenceObjectType) bool); ok {
+		r0 = rf(ctx, id, objectType)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.SpecReferenceObjectType) error); ok {
+		r1 = rf(ctx, id, objectType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
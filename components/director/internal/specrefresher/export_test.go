@@ -0,0 +1,7 @@
+package specrefresher
+
+import "time"
+
+func (s *Service) SetTimestampGen(timestampGen func() time.Time) {
+	s.timestampGen = timestampGen
+}
@@ -0,0 +1,172 @@
+package specrefresher
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/timestamp"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence"
+	"github.com/pkg/errors"
+)
+
+// Config configures the refreshing of the specifications fetched from fetch requests.
+type Config struct {
+	// StaleAfter is the time after which a fetched specification is fetched again.
+	StaleAfter time.Duration `envconfig:"default=24h,APP_STALE_AFTER"`
+	// SpecProcessingTimeout is the maximum time for refreshing a single specification. No timeout is applied if it is zero.
+	SpecProcessingTimeout time.Duration `envconfig:"default=0,APP_SPEC_PROCESSING_TIMEOUT"`
+}
+
+// Validate validates the spec refresher config.
+func (c Config) Validate() error {
+	if c.StaleAfter <= 0 {
+		return errors.Errorf("stale after duration must be positive, got %s", c.StaleAfter)
+	}
+	if c.SpecProcessingTimeout < 0 {
+		return errors.Errorf("spec processing timeout must not be negative, got %s", c.SpecProcessingTimeout)
+	}
+	return nil
+}
+
+// FetchRequestRepository is responsible for the repo-layer fetch request operations.
+//go:generate mockery --name=FetchRequestRepository --output=automock --outpkg=automock --case=underscore
+type FetchRequestRepository interface {
+	ListStaleByTenant(ctx context.Context, objectType model.FetchRequestReferenceObjectType, staleBefore time.Time) (map[string][]*model.FetchRequest, error)
+}
+
+// SpecService is responsible for the service-layer specification operations.
+//go:generate mockery --name=SpecService --output=automock --outpkg=automock --case=underscore
+type SpecService interface {
+	RefreshSpec(ctx context.Context, id string, objectType model.SpecReferenceObjectType) (bool, error)
+}
+
+// Service refreshes the specifications, which have been fetched from fetch requests, once they become stale.
+type Service struct {
+	config           Config
+	transact         persistence.Transactioner
+	fetchRequestRepo FetchRequestRepository
+	specSvc          SpecService
+	timestampGen     timestamp.Generator
+}
+
+// NewService creates a new spec refresher Service.
+func NewService(config Config, transact persistence.Transactioner, fetchRequestRepo FetchRequestRepository, specSvc SpecService) *Service {
+	return &Service{
+		config:           config,
+		transact:         transact,
+		fetchRequestRepo: fetchRequestRepo,
+		specSvc:          specSvc,
+		timestampGen:     timestamp.DefaultGenerator,
+	}
+}
+
+// staleSpec is a specification, which should be refreshed, together with a tenant owning it.
+type staleSpec struct {
+	id         string
+	objectType model.SpecReferenceObjectType
+	tenantID   string
+}
+
+// refreshSummary holds the outcome of a single refresh of the stale specifications.
+type refreshSummary struct {
+	updated   int
+	unchanged int
+	failed    int
+}
+
+// RefreshStaleSpecs re-fetches all API and Event specifications, which have not been fetched for the configured stale period.
+// The specifications are fetched with conditional requests, and they are updated only if their content has changed.
+// A failure of a single specification is recorded in the status of its fetch request and does not stop the refresh of the others.
+func (s *Service) RefreshStaleSpecs(ctx context.Context) error {
+	staleBefore := s.timestampGen().Add(-s.config.StaleAfter)
+
+	specs, err := s.listStaleSpecs(ctx, staleBefore)
+	if err != nil {
+		return errors.Wrap(err, "while listing stale specifications")
+	}
+	log.C(ctx).Infof("Found %d specifications fetched before %s", len(specs), staleBefore.Format(time.RFC3339))
+
+	summary := refreshSummary{}
+	for _, spec := range specs {
+		updated, err := s.refreshSpecWithTimeout(ctx, spec)
+		switch {
+		case err != nil:
+			log.C(ctx).WithError(err).Errorf("Error while refreshing %s with id %q: %v", spec.objectType, spec.id, err)
+			summary.failed++
+		case updated:
+			summary.updated++
+		default:
+			summary.unchanged++
+		}
+	}
+
+	log.C(ctx).Infof("Refreshed %d specifications: %d updated, %d unchanged, %d failed", len(specs), summary.updated, summary.unchanged, summary.failed)
+	return nil
+}
+
+func (s *Service) listStaleSpecs(ctx context.Context, staleBefore time.Time) ([]staleSpec, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx = persistence.SaveToContext(ctx, tx)
+
+	specs := make([]staleSpec, 0)
+	for _, objectType := range []model.SpecReferenceObjectType{model.APISpecReference, model.EventSpecReference} {
+		fetchRequestsByTenant, err := s.fetchRequestRepo.ListStaleByTenant(ctx, fetchRequestObjectType(objectType), staleBefore)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while listing stale fetch requests of %s", objectType)
+		}
+
+		for tnt, fetchRequests := range fetchRequestsByTenant {
+			for _, fr := range fetchRequests {
+				specs = append(specs, staleSpec{
+					id:         fr.ObjectID,
+					objectType: objectType,
+					tenantID:   tnt,
+				})
+			}
+		}
+	}
+
+	return specs, tx.Commit()
+}
+
+func (s *Service) refreshSpecWithTimeout(ctx context.Context, spec staleSpec) (bool, error) {
+	if s.config.SpecProcessingTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.config.SpecProcessingTimeout)
+		defer cancel()
+	}
+
+	return s.refreshSpec(ctx, spec)
+}
+
+func (s *Service) refreshSpec(ctx context.Context, spec staleSpec) (bool, error) {
+	tx, err := s.transact.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer s.transact.RollbackUnlessCommitted(ctx, tx)
+
+	ctx = persistence.SaveToContext(ctx, tx)
+	ctx = tenant.SaveToContext(ctx, spec.tenantID, "")
+
+	updated, err := s.specSvc.RefreshSpec(ctx, spec.id, spec.objectType)
+	if err != nil {
+		return false, err
+	}
+
+	return updated, tx.Commit()
+}
+
+func fetchRequestObjectType(objectType model.SpecReferenceObjectType) model.FetchRequestReferenceObjectType {
+	if objectType == model.EventSpecReference {
+		return model.EventSpecFetchRequestReference
+	}
+	return model.APISpecFetchRequestReference
+}
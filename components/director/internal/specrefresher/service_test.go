@@ -0,0 +1,194 @@
+package specrefresher_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/internal/domain/tenant"
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefresher"
+	"github.com/kyma-incubator/compass/components/director/internal/specrefresher/automock"
+	persistenceautomock "github.com/kyma-incubator/compass/components/director/pkg/persistence/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	apiSpecID     = "api-spec-id"
+	eventSpecID   = "event-spec-id"
+	firstTenantID = "b91b59f7-2563-40b2-aba9-fef726037aa3"
+	otherTenantID = "ede0241d-caa1-4ee4-b8bf-f733e180fbf9"
+)
+
+func TestService_RefreshStaleSpecs(t *testing.T) {
+	testErr := errors.New("test error")
+	timestamp := time.Now()
+	staleAfter := 12 * time.Hour
+	staleBefore := timestamp.Add(-staleAfter)
+
+	apiFetchRequests := map[string][]*model.FetchRequest{
+		firstTenantID: {{ID: "api-fr-id", ObjectID: apiSpecID, ObjectType: model.APISpecFetchRequestReference}},
+	}
+	eventFetchRequests := map[string][]*model.FetchRequest{
+		otherTenantID: {{ID: "event-fr-id", ObjectID: eventSpecID, ObjectType: model.EventSpecFetchRequestReference}},
+	}
+
+	ctxWithTenant := func(tnt string) interface{} {
+		return mock.MatchedBy(func(ctx context.Context) bool {
+			tntFromCtx, err := tenant.LoadFromContext(ctx)
+			return err == nil && tntFromCtx == tnt
+		})
+	}
+
+	successfulFetchRequestRepo := func() *automock.FetchRequestRepository {
+		repo := &automock.FetchRequestRepository{}
+		repo.On("ListStaleByTenant", txtest.CtxWithDBMatcher(), model.APISpecFetchRequestReference, staleBefore).Return(apiFetchRequests, nil).Once()
+		repo.On("ListStaleByTenant", txtest.CtxWithDBMatcher(), model.EventSpecFetchRequestReference, staleBefore).Return(eventFetchRequests, nil).Once()
+		return repo
+	}
+
+	testCases := []struct {
+		Name               string
+		TransactionerFn    func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner)
+		FetchRequestRepoFn func() *automock.FetchRequestRepository
+		SpecSvcFn          func() *automock.SpecService
+		ExpectedErr        error
+	}{
+		{
+			Name: "Success",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(3)
+			},
+			FetchRequestRepoFn: successfulFetchRequestRepo,
+			SpecSvcFn: func() *automock.SpecService {
+				svc := &automock.SpecService{}
+				svc.On("RefreshSpec", ctxWithTenant(firstTenantID), apiSpecID, model.APISpecReference).Return(true, nil).Once()
+				svc.On("RefreshSpec", ctxWithTenant(otherTenantID), eventSpecID, model.EventSpecReference).Return(false, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Success when refreshing a single spec fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				persistTx := &persistenceautomock.PersistenceTx{}
+				persistTx.On("Commit").Return(nil).Twice()
+
+				transact := &persistenceautomock.Transactioner{}
+				transact.On("Begin").Return(persistTx, nil).Times(3)
+				transact.On("RollbackUnlessCommitted", mock.Anything, persistTx).Return(false).Times(3)
+				return persistTx, transact
+			},
+			FetchRequestRepoFn: successfulFetchRequestRepo,
+			SpecSvcFn: func() *automock.SpecService {
+				svc := &automock.SpecService{}
+				svc.On("RefreshSpec", ctxWithTenant(firstTenantID), apiSpecID, model.APISpecReference).Return(false, testErr).Once()
+				svc.On("RefreshSpec", ctxWithTenant(otherTenantID), eventSpecID, model.EventSpecReference).Return(true, nil).Once()
+				return svc
+			},
+		},
+		{
+			Name: "Error when listing stale fetch requests fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatDoesntExpectCommit()
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				repo := &automock.FetchRequestRepository{}
+				repo.On("ListStaleByTenant", txtest.CtxWithDBMatcher(), model.APISpecFetchRequestReference, staleBefore).Return(nil, testErr).Once()
+				return repo
+			},
+			SpecSvcFn: func() *automock.SpecService {
+				return &automock.SpecService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when beginning transaction fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnBegin()
+			},
+			FetchRequestRepoFn: func() *automock.FetchRequestRepository {
+				return &automock.FetchRequestRepository{}
+			},
+			SpecSvcFn: func() *automock.SpecService {
+				return &automock.SpecService{}
+			},
+			ExpectedErr: testErr,
+		},
+		{
+			Name: "Error when committing the listing transaction fails",
+			TransactionerFn: func() (*persistenceautomock.PersistenceTx, *persistenceautomock.Transactioner) {
+				return txtest.NewTransactionContextGenerator(testErr).ThatFailsOnCommit()
+			},
+			FetchRequestRepoFn: successfulFetchRequestRepo,
+			SpecSvcFn: func() *automock.SpecService {
+				return &automock.SpecService{}
+			},
+			ExpectedErr: testErr,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			persistTx, transact := testCase.TransactionerFn()
+			frRepo := testCase.FetchRequestRepoFn()
+			specSvc := testCase.SpecSvcFn()
+
+			svc := specrefresher.NewService(specrefresher.Config{StaleAfter: staleAfter}, transact, frRepo, specSvc)
+			svc.SetTimestampGen(func() time.Time { return timestamp })
+
+			// WHEN
+			err := svc.RefreshStaleSpecs(context.TODO())
+
+			// THEN
+			if testCase.ExpectedErr != nil {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			mock.AssertExpectationsForObjects(t, persistTx, transact, frRepo, specSvc)
+		})
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      specrefresher.Config
+		ExpectedErr string
+	}{
+		{
+			Name:   "Valid config",
+			Config: specrefresher.Config{StaleAfter: time.Hour, SpecProcessingTimeout: time.Minute},
+		},
+		{
+			Name:        "Zero stale after duration",
+			Config:      specrefresher.Config{},
+			ExpectedErr: "stale after duration must be positive",
+		},
+		{
+			Name:        "Negative spec processing timeout",
+			Config:      specrefresher.Config{StaleAfter: time.Hour, SpecProcessingTimeout: -time.Minute},
+			ExpectedErr: "spec processing timeout must not be negative",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := testCase.Config.Validate()
+
+			if len(testCase.ExpectedErr) > 0 {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), testCase.ExpectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
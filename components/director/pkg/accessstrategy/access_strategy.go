@@ -73,5 +73,5 @@ func (a Type) isSupported() bool {
 // Executor defines an interface for execution of different access strategies
 //go:generate mockery --name=Executor --output=automock --outpkg=automock --case=underscore
 type Executor interface {
-	Execute(client *http.Client, url string) (*http.Response, error)
+	Execute(client *http.Client, url string, headers http.Header) (*http.Response, error)
 }
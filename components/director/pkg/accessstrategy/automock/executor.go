@@ -13,13 +13,13 @@ type Executor struct {
 	mock.Mock
 }
 
-// Execute provides a mock function with given fields: client, url
-func (_m *Executor) Execute(client *http.Client, url string) (*http.Response, error) {
-	ret := _m.Called(client, url)
+// Execute provides a mock function with given fields: client, url, headers
+func (_m *Executor) Execute(client *http.Client, url string, headers http.Header) (*http.Response, error) {
+	ret := _m.Called(client, url, headers)
 
 	var r0 *http.Response
-	if rf, ok := ret.Get(0).(func(*http.Client, string) *http.Response); ok {
-		r0 = rf(client, url)
+	if rf, ok := ret.Get(0).(func(*http.Client, string, http.Header) *http.Response); ok {
+		r0 = rf(client, url, headers)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*http.Response)
@@ -27,8 +27,8 @@ func (_m *Executor) Execute(client *http.Client, url string) (*http.Response, er
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*http.Client, string) error); ok {
-		r1 = rf(client, url)
+	if rf, ok := ret.Get(1).(func(*http.Client, string, http.Header) error); ok {
+		r1 = rf(client, url, headers)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -21,7 +21,7 @@ func NewCMPmTLSAccessStrategyExecutor(certCache certloader.Cache) *cmpMTLSAccess
 }
 
 // Execute performs the access strategy's specific execution logic
-func (as *cmpMTLSAccessStrategyExecutor) Execute(baseClient *http.Client, documentURL string) (*http.Response, error) {
+func (as *cmpMTLSAccessStrategyExecutor) Execute(baseClient *http.Client, documentURL string, headers http.Header) (*http.Response, error) {
 	clientCert := as.certCache.Get()
 	if clientCert == nil {
 		return nil, errors.New("did not find client certificate in the cache")
@@ -39,5 +39,5 @@ func (as *cmpMTLSAccessStrategyExecutor) Execute(baseClient *http.Client, docume
 		Transport: tr,
 	}
 
-	return client.Get(documentURL)
+	return get(client, documentURL, headers)
 }
@@ -12,6 +12,21 @@ func NewOpenAccessStrategyExecutor() *openAccessStrategyExecutor {
 }
 
 // Execute performs the access strategy's specific execution logic
-func (*openAccessStrategyExecutor) Execute(client *http.Client, documentURL string) (*http.Response, error) {
-	return client.Get(documentURL)
+func (*openAccessStrategyExecutor) Execute(client *http.Client, documentURL string, headers http.Header) (*http.Response, error) {
+	return get(client, documentURL, headers)
+}
+
+func get(client *http.Client, url string, headers http.Header) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return client.Do(req)
 }
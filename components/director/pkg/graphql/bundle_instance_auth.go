@@ -0,0 +1,16 @@
+package graphql
+
+import "github.com/kyma-incubator/compass/components/director/pkg/resource"
+
+// GetID returns the ID of the bundle instance auth
+func (e *BundleInstanceAuth) GetID() string {
+	return e.ID
+}
+
+// GetType returns the resource type of the bundle instance auth
+func (e *BundleInstanceAuth) GetType() resource.Type {
+	return resource.BundleInstanceAuth
+}
+
+// Sentinel marks the bundle instance auth as a resource which can be part of a webhook's request data
+func (e *BundleInstanceAuth) Sentinel() {}
@@ -166,6 +166,13 @@ func (fp *GqlFieldsProvider) ForWebhooks() string {
 		headerTemplate
 		outputTemplate
 		statusTemplate
+		retryPolicy {
+		  maxAttempts
+		  backoffMultiplier
+		  maxInterval
+		  jitter
+		  retryableStatusCodes
+		}
 		auth {
 		  %s
 		}`, fp.ForAuth())
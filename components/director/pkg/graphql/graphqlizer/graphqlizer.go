@@ -299,6 +299,30 @@ func (g *Graphqlizer) WebhookInputToGQL(in *graphql.WebhookInput) (string, error
 		{{- if .StatusTemplate }} 
 		statusTemplate: "{{.StatusTemplate }}",
 		{{- end }}
+		{{- if .RetryPolicy }} 
+		retryPolicy: {{- WebhookRetryPolicyInputToGQL .RetryPolicy }},
+		{{- end }}
+	}`)
+}
+
+// WebhookRetryPolicyInputToGQL converts the webhook retry policy input to its GraphQL representation
+func (g *Graphqlizer) WebhookRetryPolicyInputToGQL(in *graphql.WebhookRetryPolicyInput) (string, error) {
+	return g.genericToGQL(in, `{
+		{{- if .MaxAttempts }}
+		maxAttempts: {{.MaxAttempts }},
+		{{- end }}
+		{{- if .BackoffMultiplier }}
+		backoffMultiplier: {{.BackoffMultiplier }},
+		{{- end }}
+		{{- if .MaxInterval }}
+		maxInterval: {{.MaxInterval }},
+		{{- end }}
+		{{- if .Jitter }}
+		jitter: {{.Jitter }},
+		{{- end }}
+		{{- if .RetryableStatusCodes }}
+		retryableStatusCodes: [{{ range $i, $e := .RetryableStatusCodes }}{{ if $i }}, {{ end }}{{ $e }}{{ end }}],
+		{{- end }}
 	}`)
 }
 
@@ -662,6 +686,7 @@ func (g *Graphqlizer) genericToGQL(obj interface{}, tmpl string) (string, error)
 	fm["AuthInputToGQL"] = g.AuthInputToGQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
 	fm["WebhookInputToGQL"] = g.WebhookInputToGQL
+	fm["WebhookRetryPolicyInputToGQL"] = g.WebhookRetryPolicyInputToGQL
 	fm["APIDefinitionInputToGQL"] = g.APIDefinitionInputToGQL
 	fm["EventDefinitionInputToGQL"] = g.EventDefinitionInputToGQL
 	fm["APISpecInputToGQL"] = g.APISpecInputToGQL
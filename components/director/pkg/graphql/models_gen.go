@@ -229,6 +229,10 @@ type BundleInstanceAuth struct {
 	Status           *BundleInstanceAuthStatus `json:"status"`
 	RuntimeID        *string                   `json:"runtimeID"`
 	RuntimeContextID *string                   `json:"runtimeContextID"`
+	CreatedAt        *Timestamp                `json:"createdAt"`
+	UpdatedAt        *Timestamp                `json:"updatedAt"`
+	DeletedAt        *Timestamp                `json:"deletedAt"`
+	Error            *string                   `json:"error"`
 }
 
 type BundleInstanceAuthRequestInput struct {
@@ -404,10 +408,12 @@ type FetchRequestInput struct {
 	URL string `json:"url"`
 	// Currently unsupported, providing it will result in a failure
 	Auth *AuthInput `json:"auth"`
-	// Currently unsupported, providing it will result in a failure
+	// SINGLE fetches the spec itself. BUNDLE fetches a zip, tar, or tar.gz archive containing the spec.
+	// INDEX fetches an index document in the swagger-ui configuration format, which references the spec with its URL.
 	Mode *FetchMode `json:"mode"`
 	// **Validation:** max=256
-	// Currently unsupported, providing it will result in a failure
+	// Glob pattern selecting the spec file in the archive of BUNDLE mode, or the spec in the index of INDEX mode. A pattern without a slash is matched against the file name only.
+	// Required if the archive or the index contains more than one spec. Not supported for SINGLE mode.
 	Filter *string `json:"filter"`
 }
 
@@ -415,6 +421,14 @@ type FetchRequestStatus struct {
 	Condition FetchRequestStatusCondition `json:"condition"`
 	Message   *string                     `json:"message"`
 	Timestamp Timestamp                   `json:"timestamp"`
+	// The past statuses of the Fetch Request, starting with the most recent one.
+	History []*FetchRequestStatusHistoryEntry `json:"history"`
+}
+
+type FetchRequestStatusHistoryEntry struct {
+	Condition FetchRequestStatusCondition `json:"condition"`
+	Message   *string                     `json:"message"`
+	Timestamp Timestamp                   `json:"timestamp"`
 }
 
 type FormationInput struct {
@@ -510,6 +524,51 @@ type OAuthCredentialDataInput struct {
 	URL string `json:"url"`
 }
 
+type ORDAggregationStatus struct {
+	ApplicationID    string                `json:"applicationID"`
+	WebhookID        string                `json:"webhookID"`
+	LastAttemptAt    Timestamp             `json:"lastAttemptAt"`
+	LastSuccessAt    *Timestamp            `json:"lastSuccessAt"`
+	DocumentsCount   int                   `json:"documentsCount"`
+	Error            *string               `json:"error"`
+	ValidationErrors []*ORDValidationError `json:"validationErrors"`
+}
+
+type ORDValidationError struct {
+	DocumentIndex *int    `json:"documentIndex"`
+	ResourceType  string  `json:"resourceType"`
+	OrdID         string  `json:"ordID"`
+	Path          *string `json:"path"`
+	Rule          *string `json:"rule"`
+	Message       string  `json:"message"`
+}
+
+type Operation struct {
+	ID                string              `json:"id"`
+	OperationType     OperationType       `json:"operationType"`
+	OperationCategory *string             `json:"operationCategory"`
+	ResourceType      string              `json:"resourceType"`
+	ResourceID        string              `json:"resourceID"`
+	CorrelationID     *string             `json:"correlationID"`
+	State             OperationState      `json:"state"`
+	Error             *string             `json:"error"`
+	Webhooks          []*OperationWebhook `json:"webhooks"`
+	CreatedAt         *Timestamp          `json:"createdAt"`
+	InitializedAt     *Timestamp          `json:"initializedAt"`
+	CancelledBy       *string             `json:"cancelledBy"`
+	RetriedBy         *string             `json:"retriedBy"`
+}
+
+type OperationWebhook struct {
+	WebhookID          string         `json:"webhookID"`
+	State              OperationState `json:"state"`
+	RetriesCount       int            `json:"retriesCount"`
+	LastPollTimestamp  *string        `json:"lastPollTimestamp"`
+	FailedAttempts     int            `json:"failedAttempts"`
+	LastError          *string        `json:"lastError"`
+	NextRetryTimestamp *string        `json:"nextRetryTimestamp"`
+}
+
 type PageInfo struct {
 	StartCursor PageCursor `json:"startCursor"`
 	EndCursor   PageCursor `json:"endCursor"`
@@ -582,6 +641,31 @@ type RuntimeSystemAuth struct {
 
 func (RuntimeSystemAuth) IsSystemAuth() {}
 
+// The difference between two revisions of a specification. Operations are compared for OpenAPI specifications, and channels for AsyncAPI specifications.
+type SpecDiff struct {
+	From    string           `json:"from"`
+	To      string           `json:"to"`
+	Added   []*SpecDiffEntry `json:"added"`
+	Removed []*SpecDiffEntry `json:"removed"`
+	Changed []*SpecDiffEntry `json:"changed"`
+}
+
+type SpecDiffEntry struct {
+	Kind SpecDiffEntryKind `json:"kind"`
+	// The method and path of an operation, for example "GET /pets", or the name of a channel.
+	Name string `json:"name"`
+}
+
+// An immutable copy of the data of a specification, stored whenever the data changes.
+type SpecRevision struct {
+	ID string `json:"id"`
+	// The SHA-256 hash of the data, in hexadecimal encoding.
+	ContentHash string             `json:"contentHash"`
+	Source      SpecRevisionSource `json:"source"`
+	CreatedAt   Timestamp          `json:"createdAt"`
+	Data        *CLOB              `json:"data"`
+}
+
 type TemplateValueInput struct {
 	// **Validation:**  Up to 36 characters long. Cannot start with a digit. The characters allowed in names are: digits (0-9), lower case letters (a-z),-, and .
 	Placeholder string `json:"placeholder"`
@@ -621,39 +705,62 @@ type Viewer struct {
 }
 
 type Webhook struct {
-	ID                    string       `json:"id"`
-	ApplicationID         *string      `json:"applicationID"`
-	ApplicationTemplateID *string      `json:"applicationTemplateID"`
-	RuntimeID             *string      `json:"runtimeID"`
-	IntegrationSystemID   *string      `json:"integrationSystemID"`
-	Type                  WebhookType  `json:"type"`
-	Mode                  *WebhookMode `json:"mode"`
-	CorrelationIDKey      *string      `json:"correlationIdKey"`
-	RetryInterval         *int         `json:"retryInterval"`
-	Timeout               *int         `json:"timeout"`
-	URL                   *string      `json:"url"`
-	Auth                  *Auth        `json:"auth"`
-	URLTemplate           *string      `json:"urlTemplate"`
-	InputTemplate         *string      `json:"inputTemplate"`
-	HeaderTemplate        *string      `json:"headerTemplate"`
-	OutputTemplate        *string      `json:"outputTemplate"`
-	StatusTemplate        *string      `json:"statusTemplate"`
+	ID                    string              `json:"id"`
+	ApplicationID         *string             `json:"applicationID"`
+	ApplicationTemplateID *string             `json:"applicationTemplateID"`
+	RuntimeID             *string             `json:"runtimeID"`
+	IntegrationSystemID   *string             `json:"integrationSystemID"`
+	Type                  WebhookType         `json:"type"`
+	Mode                  *WebhookMode        `json:"mode"`
+	CorrelationIDKey      *string             `json:"correlationIdKey"`
+	RetryInterval         *int                `json:"retryInterval"`
+	Timeout               *int                `json:"timeout"`
+	URL                   *string             `json:"url"`
+	Auth                  *Auth               `json:"auth"`
+	URLTemplate           *string             `json:"urlTemplate"`
+	InputTemplate         *string             `json:"inputTemplate"`
+	HeaderTemplate        *string             `json:"headerTemplate"`
+	OutputTemplate        *string             `json:"outputTemplate"`
+	StatusTemplate        *string             `json:"statusTemplate"`
+	RetryPolicy           *WebhookRetryPolicy `json:"retryPolicy"`
 }
 
 type WebhookInput struct {
 	Type WebhookType `json:"type"`
 	// **Validation:** valid URL, max=256
-	URL              *string      `json:"url"`
-	Auth             *AuthInput   `json:"auth"`
-	Mode             *WebhookMode `json:"mode"`
-	CorrelationIDKey *string      `json:"correlationIdKey"`
-	RetryInterval    *int         `json:"retryInterval"`
-	Timeout          *int         `json:"timeout"`
-	URLTemplate      *string      `json:"urlTemplate"`
-	InputTemplate    *string      `json:"inputTemplate"`
-	HeaderTemplate   *string      `json:"headerTemplate"`
-	OutputTemplate   *string      `json:"outputTemplate"`
-	StatusTemplate   *string      `json:"statusTemplate"`
+	URL              *string                  `json:"url"`
+	Auth             *AuthInput               `json:"auth"`
+	Mode             *WebhookMode             `json:"mode"`
+	CorrelationIDKey *string                  `json:"correlationIdKey"`
+	RetryInterval    *int                     `json:"retryInterval"`
+	Timeout          *int                     `json:"timeout"`
+	URLTemplate      *string                  `json:"urlTemplate"`
+	InputTemplate    *string                  `json:"inputTemplate"`
+	HeaderTemplate   *string                  `json:"headerTemplate"`
+	OutputTemplate   *string                  `json:"outputTemplate"`
+	StatusTemplate   *string                  `json:"statusTemplate"`
+	RetryPolicy      *WebhookRetryPolicyInput `json:"retryPolicy"`
+}
+
+type WebhookRetryPolicy struct {
+	MaxAttempts          *int     `json:"maxAttempts"`
+	BackoffMultiplier    *float64 `json:"backoffMultiplier"`
+	MaxInterval          *int     `json:"maxInterval"`
+	Jitter               *float64 `json:"jitter"`
+	RetryableStatusCodes []int    `json:"retryableStatusCodes"`
+}
+
+type WebhookRetryPolicyInput struct {
+	// **Validation:** min=1
+	MaxAttempts *int `json:"maxAttempts"`
+	// **Validation:** min=1
+	BackoffMultiplier *float64 `json:"backoffMultiplier"`
+	// Upper bound in seconds for the delay between two attempts. **Validation:** min=1
+	MaxInterval *int `json:"maxInterval"`
+	// Fraction of the delay which is randomized. **Validation:** min=0, max=1
+	Jitter *float64 `json:"jitter"`
+	// HTTP status codes which are retried. If not provided, all unsuccessful status codes are retried. **Validation:** each value between 100 and 599
+	RetryableStatusCodes []int `json:"retryableStatusCodes"`
 }
 
 type APISpecType string
@@ -1215,6 +1322,51 @@ func (e OperationMode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type OperationState string
+
+const (
+	OperationStateSucceeded  OperationState = "SUCCEEDED"
+	OperationStateFailed     OperationState = "FAILED"
+	OperationStateInProgress OperationState = "IN_PROGRESS"
+	OperationStatePending    OperationState = "PENDING"
+)
+
+var AllOperationState = []OperationState{
+	OperationStateSucceeded,
+	OperationStateFailed,
+	OperationStateInProgress,
+	OperationStatePending,
+}
+
+func (e OperationState) IsValid() bool {
+	switch e {
+	case OperationStateSucceeded, OperationStateFailed, OperationStateInProgress, OperationStatePending:
+		return true
+	}
+	return false
+}
+
+func (e OperationState) String() string {
+	return string(e)
+}
+
+func (e *OperationState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OperationState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OperationState", str)
+	}
+	return nil
+}
+
+func (e OperationState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type OperationType string
 
 const (
@@ -1303,6 +1455,47 @@ func (e RuntimeStatusCondition) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type SpecDiffEntryKind string
+
+const (
+	SpecDiffEntryKindOperation SpecDiffEntryKind = "OPERATION"
+	SpecDiffEntryKindChannel   SpecDiffEntryKind = "CHANNEL"
+)
+
+var AllSpecDiffEntryKind = []SpecDiffEntryKind{
+	SpecDiffEntryKindOperation,
+	SpecDiffEntryKindChannel,
+}
+
+func (e SpecDiffEntryKind) IsValid() bool {
+	switch e {
+	case SpecDiffEntryKindOperation, SpecDiffEntryKindChannel:
+		return true
+	}
+	return false
+}
+
+func (e SpecDiffEntryKind) String() string {
+	return string(e)
+}
+
+func (e *SpecDiffEntryKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpecDiffEntryKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpecDiffEntryKind", str)
+	}
+	return nil
+}
+
+func (e SpecDiffEntryKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SpecFormat string
 
 const (
@@ -1346,6 +1539,51 @@ func (e SpecFormat) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type SpecRevisionSource string
+
+const (
+	SpecRevisionSourceManual  SpecRevisionSource = "MANUAL"
+	SpecRevisionSourceOrd     SpecRevisionSource = "ORD"
+	SpecRevisionSourceRefetch SpecRevisionSource = "REFETCH"
+	SpecRevisionSourceRefresh SpecRevisionSource = "REFRESH"
+)
+
+var AllSpecRevisionSource = []SpecRevisionSource{
+	SpecRevisionSourceManual,
+	SpecRevisionSourceOrd,
+	SpecRevisionSourceRefetch,
+	SpecRevisionSourceRefresh,
+}
+
+func (e SpecRevisionSource) IsValid() bool {
+	switch e {
+	case SpecRevisionSourceManual, SpecRevisionSourceOrd, SpecRevisionSourceRefetch, SpecRevisionSourceRefresh:
+		return true
+	}
+	return false
+}
+
+func (e SpecRevisionSource) String() string {
+	return string(e)
+}
+
+func (e *SpecRevisionSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SpecRevisionSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SpecRevisionSource", str)
+	}
+	return nil
+}
+
+func (e SpecRevisionSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ViewerType string
 
 const (
@@ -1391,6 +1629,47 @@ func (e ViewerType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type WebhookExecutionPolicy string
+
+const (
+	WebhookExecutionPolicySequential WebhookExecutionPolicy = "SEQUENTIAL"
+	WebhookExecutionPolicyParallel   WebhookExecutionPolicy = "PARALLEL"
+)
+
+var AllWebhookExecutionPolicy = []WebhookExecutionPolicy{
+	WebhookExecutionPolicySequential,
+	WebhookExecutionPolicyParallel,
+}
+
+func (e WebhookExecutionPolicy) IsValid() bool {
+	switch e {
+	case WebhookExecutionPolicySequential, WebhookExecutionPolicyParallel:
+		return true
+	}
+	return false
+}
+
+func (e WebhookExecutionPolicy) String() string {
+	return string(e)
+}
+
+func (e *WebhookExecutionPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookExecutionPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookExecutionPolicy", str)
+	}
+	return nil
+}
+
+func (e WebhookExecutionPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type WebhookMode string
 
 const (
@@ -1435,11 +1714,15 @@ func (e WebhookMode) MarshalGQL(w io.Writer) {
 type WebhookType string
 
 const (
-	WebhookTypeConfigurationChanged  WebhookType = "CONFIGURATION_CHANGED"
-	WebhookTypeRegisterApplication   WebhookType = "REGISTER_APPLICATION"
-	WebhookTypeUnregisterApplication WebhookType = "UNREGISTER_APPLICATION"
-	WebhookTypeOpenResourceDiscovery WebhookType = "OPEN_RESOURCE_DISCOVERY"
-	WebhookTypeUnpairApplication     WebhookType = "UNPAIR_APPLICATION"
+	WebhookTypeConfigurationChanged       WebhookType = "CONFIGURATION_CHANGED"
+	WebhookTypeRegisterApplication        WebhookType = "REGISTER_APPLICATION"
+	WebhookTypeUnregisterApplication      WebhookType = "UNREGISTER_APPLICATION"
+	WebhookTypeOpenResourceDiscovery      WebhookType = "OPEN_RESOURCE_DISCOVERY"
+	WebhookTypeUnpairApplication          WebhookType = "UNPAIR_APPLICATION"
+	WebhookTypeRegisterRuntime            WebhookType = "REGISTER_RUNTIME"
+	WebhookTypeUnregisterRuntime          WebhookType = "UNREGISTER_RUNTIME"
+	WebhookTypeBundleInstanceAuthCreation WebhookType = "BUNDLE_INSTANCE_AUTH_CREATION"
+	WebhookTypeBundleInstanceAuthDeletion WebhookType = "BUNDLE_INSTANCE_AUTH_DELETION"
 )
 
 var AllWebhookType = []WebhookType{
@@ -1448,11 +1731,15 @@ var AllWebhookType = []WebhookType{
 	WebhookTypeUnregisterApplication,
 	WebhookTypeOpenResourceDiscovery,
 	WebhookTypeUnpairApplication,
+	WebhookTypeRegisterRuntime,
+	WebhookTypeUnregisterRuntime,
+	WebhookTypeBundleInstanceAuthCreation,
+	WebhookTypeBundleInstanceAuthDeletion,
 }
 
 func (e WebhookType) IsValid() bool {
 	switch e {
-	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication:
+	case WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication, WebhookTypeRegisterRuntime, WebhookTypeUnregisterRuntime, WebhookTypeBundleInstanceAuthCreation, WebhookTypeBundleInstanceAuthDeletion:
 		return true
 	}
 	return false
@@ -1,5 +1,7 @@
 package graphql
 
+import "github.com/kyma-incubator/compass/components/director/pkg/resource"
+
 // Runtime missing godoc
 type Runtime struct {
 	ID                    string                        `json:"id"`
@@ -8,8 +10,24 @@ type Runtime struct {
 	Status                *RuntimeStatus                `json:"status"`
 	Metadata              *RuntimeMetadata              `json:"metadata"`
 	EventingConfiguration *RuntimeEventingConfiguration `json:"eventingConfiguration"`
+	UpdatedAt             *Timestamp                    `json:"updatedAt"`
+	DeletedAt             *Timestamp                    `json:"deletedAt"`
+	Error                 *string                       `json:"error"`
+}
+
+// GetID returns the ID of the runtime
+func (e *Runtime) GetID() string {
+	return e.ID
 }
 
+// GetType returns the resource type of the runtime
+func (e *Runtime) GetType() resource.Type {
+	return resource.Runtime
+}
+
+// Sentinel marks the runtime as a resource which can be part of a webhook's request data
+func (e *Runtime) Sentinel() {}
+
 // RuntimePageExt is an extended types used by external API
 type RuntimePageExt struct {
 	RuntimePage
@@ -21,5 +39,6 @@ type RuntimeExt struct {
 	Runtime
 	Labels Labels `json:"labels"`
 	// Returns array of authentication details for Runtime. For now at most one element in array will be returned.
-	Auths []*RuntimeSystemAuth `json:"auths"`
+	Auths    []*RuntimeSystemAuth `json:"auths"`
+	Webhooks []Webhook            `json:"webhooks"`
 }
@@ -26,7 +26,11 @@ func (i WebhookInput) Validate() error {
 		}
 	}
 
-	requestObject := webhook.RequestObject{Application: &Application{BaseEntity: &BaseEntity{}}}
+	requestObject := webhook.RequestObject{
+		Application:        &Application{BaseEntity: &BaseEntity{}},
+		Runtime:            &Runtime{},
+		BundleInstanceAuth: &BundleInstanceAuth{},
+	}
 	if i.URLTemplate != nil {
 		if _, err := requestObject.ParseURLTemplate(i.URLTemplate); err != nil {
 			return apperrors.NewInvalidDataError("failed to parse webhook url template: %s", err)
@@ -67,13 +71,25 @@ func (i WebhookInput) Validate() error {
 	}
 
 	return validation.ValidateStruct(&i,
-		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication)),
+		validation.Field(&i.Type, validation.Required, validation.In(WebhookTypeConfigurationChanged, WebhookTypeRegisterApplication, WebhookTypeUnregisterApplication, WebhookTypeOpenResourceDiscovery, WebhookTypeUnpairApplication, WebhookTypeRegisterRuntime, WebhookTypeUnregisterRuntime, WebhookTypeBundleInstanceAuthCreation, WebhookTypeBundleInstanceAuthDeletion)),
 		validation.Field(&i.URL, is.URL, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.CorrelationIDKey, validation.RuneLength(0, longStringLengthLimit)),
 		validation.Field(&i.Mode, validation.In(WebhookModeSync, WebhookModeAsync)),
 		validation.Field(&i.RetryInterval, validation.Min(0)),
 		validation.Field(&i.Timeout, validation.Min(0)),
 		validation.Field(&i.Auth),
+		validation.Field(&i.RetryPolicy),
+	)
+}
+
+// Validate validates the webhook retry policy input
+func (i WebhookRetryPolicyInput) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.MaxAttempts, validation.NilOrNotEmpty, validation.Min(1)),
+		validation.Field(&i.BackoffMultiplier, validation.NilOrNotEmpty, validation.Min(1.0)),
+		validation.Field(&i.MaxInterval, validation.NilOrNotEmpty, validation.Min(1)),
+		validation.Field(&i.Jitter, validation.Min(0.0), validation.Max(1.0)),
+		validation.Field(&i.RetryableStatusCodes, validation.Each(validation.Min(100), validation.Max(599))),
 	)
 }
 
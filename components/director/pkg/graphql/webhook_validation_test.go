@@ -266,6 +266,82 @@ func TestWebhookInput_Validate_RetryInterval(t *testing.T) {
 	}
 }
 
+func TestWebhookInput_Validate_RetryPolicy(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Value         *graphql.WebhookRetryPolicyInput
+		ExpectedValid bool
+	}{
+		{
+			Name: "ExpectedValid",
+			Value: &graphql.WebhookRetryPolicyInput{
+				MaxAttempts:          intPtr(5),
+				BackoffMultiplier:    floatPtr(2),
+				MaxInterval:          intPtr(300),
+				Jitter:               floatPtr(0.2),
+				RetryableStatusCodes: []int{429, 502, 503},
+			},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Empty",
+			Value:         &graphql.WebhookRetryPolicyInput{},
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Nil",
+			Value:         nil,
+			ExpectedValid: true,
+		},
+		{
+			Name:          "Invalid - zero max attempts",
+			Value:         &graphql.WebhookRetryPolicyInput{MaxAttempts: intPtr(0)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - backoff multiplier lower than 1",
+			Value:         &graphql.WebhookRetryPolicyInput{BackoffMultiplier: floatPtr(0.5)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - zero max interval",
+			Value:         &graphql.WebhookRetryPolicyInput{MaxInterval: intPtr(0)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - negative jitter",
+			Value:         &graphql.WebhookRetryPolicyInput{Jitter: floatPtr(-0.1)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - jitter greater than 1",
+			Value:         &graphql.WebhookRetryPolicyInput{Jitter: floatPtr(1.5)},
+			ExpectedValid: false,
+		},
+		{
+			Name:          "Invalid - status code out of range",
+			Value:         &graphql.WebhookRetryPolicyInput{RetryableStatusCodes: []int{503, 600}},
+			ExpectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			sut := fixValidWebhookInput(inputvalidationtest.ValidURL)
+			sut.RetryPolicy = testCase.Value
+			// WHEN
+			err := sut.Validate()
+			// THEN
+			if testCase.ExpectedValid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestWebhookInput_Validate_Timeout(t *testing.T) {
 	testCases := []struct {
 		Name          string
@@ -768,6 +844,10 @@ func stringPtr(s string) *string {
 	return &s
 }
 
+func floatPtr(f float64) *float64 {
+	return &f
+}
+
 func intPtr(n int) *int {
 	return &n
 }
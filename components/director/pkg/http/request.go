@@ -13,11 +13,16 @@ import (
 
 // GetRequestWithCredentials executes a GET http request to the given url with the provided auth credentials
 func GetRequestWithCredentials(ctx context.Context, client *http.Client, url string, auth *model.Auth) (*http.Response, error) {
+	return GetRequestWithCredentialsAndHeaders(ctx, client, url, nil, auth)
+}
+
+// GetRequestWithCredentialsAndHeaders executes a GET http request with the given headers to the given url with the provided auth credentials
+func GetRequestWithCredentialsAndHeaders(ctx context.Context, client *http.Client, url string, headers http.Header, auth *model.Auth) (*http.Response, error) {
 	if auth == nil || (auth.Credential.Basic == nil && auth.Credential.Oauth == nil) {
 		return nil, apperrors.NewInvalidDataError("Credentials not provided")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	req, err := newGetRequest(url, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -55,10 +60,30 @@ func secureClient(ctx context.Context, client *http.Client, auth *model.Auth) *h
 
 // GetRequestWithoutCredentials executes a GET http request to the given url
 func GetRequestWithoutCredentials(client *http.Client, url string) (*http.Response, error) {
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+	return GetRequestWithoutCredentialsAndHeaders(client, url, nil)
+}
+
+// GetRequestWithoutCredentialsAndHeaders executes a GET http request with the given headers to the given url
+func GetRequestWithoutCredentialsAndHeaders(client *http.Client, url string, headers http.Header) (*http.Response, error) {
+	req, err := newGetRequest(url, headers)
 	if err != nil {
 		return nil, err
 	}
 
 	return client.Do(req)
 }
+
+func newGetRequest(url string, headers http.Header) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, values := range headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return req, nil
+}
@@ -53,6 +53,18 @@ func TestRequestWithoutCredentials_Success(t *testing.T) {
 	require.Equal(t, resp, expectedResp)
 }
 
+func TestRequestWithoutCredentialsAndHeaders_Success(t *testing.T) {
+	client := newTestClient(func(req *http.Request) *http.Response {
+		require.Empty(t, req.Header.Get("Authorization"))
+		require.Equal(t, "\"etag\"", req.Header.Get("If-None-Match"))
+		return expectedResp
+	})
+
+	resp, err := httputil.GetRequestWithoutCredentialsAndHeaders(client, testURL, http.Header{"If-None-Match": []string{"\"etag\""}})
+	require.NoError(t, err)
+	require.Equal(t, resp, expectedResp)
+}
+
 func TestRequestWithoutCredentials_FailedRequest(t *testing.T) {
 	client := newTestClient(func(req *http.Request) *http.Response {
 		return nil
@@ -83,14 +83,14 @@ func TestServeHTTP(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, writer.Code)
 	})
 
-	t.Run("when resource type is not application it should return bad request", func(t *testing.T) {
+	t.Run("when resource type does not support operations it should return bad request", func(t *testing.T) {
 		ctx := tenant.SaveToContext(context.Background(), tenantID, tenantID)
 
 		writer := httptest.NewRecorder()
-		req := fixEmptyRequest(t, ctx, string(resource.Runtime), resourceID)
+		req := fixEmptyRequest(t, ctx, string(resource.Bundle), resourceID)
 
 		queryValues := req.URL.Query()
-		queryValues.Add(operation.ResourceTypeParam, "runtime")
+		queryValues.Add(operation.ResourceTypeParam, "bundle")
 
 		req.URL.RawQuery = queryValues.Encode()
 
@@ -101,6 +101,19 @@ func TestServeHTTP(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, writer.Code)
 	})
 
+	t.Run("when no resource fetcher is registered for the resource type it should return bad request", func(t *testing.T) {
+		ctx := tenant.SaveToContext(context.Background(), tenantID, tenantID)
+
+		writer := httptest.NewRecorder()
+		req := fixEmptyRequest(t, ctx, string(resource.Runtime), resourceID)
+
+		handler := operation.NewHandler(nil, map[resource.Type]operation.ResourceFetcherFunc{}, loadTenantFunc)
+		handler.ServeHTTP(writer, req)
+
+		require.Contains(t, writer.Body.String(), "Unsupported resource type runtime")
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+
 	t.Run("when transaction fails to begin it should return internal server error", func(t *testing.T) {
 		ctx := tenant.SaveToContext(context.Background(), tenantID, tenantID)
 
@@ -111,7 +124,7 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, nil, loadTenantFunc)
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: nil}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -134,8 +147,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, apperrors.NewNotFoundError(resource.Application, resourceID)
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -159,8 +174,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, mockedError()
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, mockedError()
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -184,8 +201,10 @@ func TestServeHTTP(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-			return nil, nil
+		handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+			resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+				return nil, nil
+			},
 		}, loadTenantFunc)
 		handler.ServeHTTP(writer, req)
 
@@ -335,8 +354,10 @@ func TestServeHTTP(t *testing.T) {
 
 		for _, testCase := range cases {
 			t.Run(testCase.Name, func(t *testing.T) {
-				handler := operation.NewHandler(mockedTransactioner, func(_ context.Context, _, _ string) (model.Entity, error) {
-					return testCase.Application, nil
+				handler := operation.NewHandler(mockedTransactioner, map[resource.Type]operation.ResourceFetcherFunc{
+					resource.Application: func(_ context.Context, _, _ string) (model.Entity, error) {
+						return testCase.Application, nil
+					},
 				}, loadTenantFunc)
 
 				writer := httptest.NewRecorder()
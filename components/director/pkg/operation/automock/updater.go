@@ -0,0 +1,29 @@
+// Code generated by mockery v2.9.4. DO NOT EDIT.
+
+package automock
+
+import (
+	context "context"
+
+	operation "github.com/kyma-incubator/compass/components/director/pkg/operation"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Updater is an autogenerated mock type for the Updater type
+type Updater struct {
+	mock.Mock
+}
+
+// Update provides a mock function with given fields: ctx, op, annotations
+func (_m *Updater) Update(ctx context.Context, op *operation.Operation, annotations map[string]string) error {
+	ret := _m.Called(ctx, op, annotations)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *operation.Operation, map[string]string) error); ok {
+		r0 = rf(ctx, op, annotations)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
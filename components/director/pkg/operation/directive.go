@@ -44,27 +44,43 @@ import (
 // ModeParam missing godoc
 const ModeParam = "mode"
 
+// WebhookExecutionPolicyParam is the name of the mutation argument which defines how the webhooks of the operation are executed
+const WebhookExecutionPolicyParam = "webhookExecutionPolicy"
+
 // WebhookFetcherFunc defines a function which fetches the webhooks for a specific resource ID
 type WebhookFetcherFunc func(ctx context.Context, resourceID string) ([]*model.Webhook, error)
 
+// OwnerFetcherFunc defines a function which fetches the application owning a specific resource ID.
+// It is used for resources whose webhooks are defined on the owning application, e.g. bundle instance auths.
+type OwnerFetcherFunc func(ctx context.Context, resourceID string) (webhook.Resource, error)
+
+// resourceTypes maps the GraphQL types returned by async mutations to the resource types supporting async operations
+var resourceTypes = map[string]resource.Type{
+	"Application":        resource.Application,
+	"Runtime":            resource.Runtime,
+	"BundleInstanceAuth": resource.BundleInstanceAuth,
+}
+
 type directive struct {
-	transact            persistence.Transactioner
-	webhookFetcherFunc  WebhookFetcherFunc
-	resourceFetcherFunc ResourceFetcherFunc
-	resourceUpdaterFunc ResourceUpdaterFunc
-	tenantLoaderFunc    TenantLoaderFunc
-	scheduler           Scheduler
+	transact             persistence.Transactioner
+	webhookFetcherFuncs  map[resource.Type]WebhookFetcherFunc
+	resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc
+	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
+	ownerFetcherFuncs    map[resource.Type]OwnerFetcherFunc
+	tenantLoaderFunc     TenantLoaderFunc
+	scheduler            Scheduler
 }
 
 // NewDirective creates a new handler struct responsible for the Async directive business logic
-func NewDirective(transact persistence.Transactioner, webhookFetcherFunc WebhookFetcherFunc, resourceFetcherFunc ResourceFetcherFunc, resourceUpdaterFunc ResourceUpdaterFunc, tenantLoaderFunc TenantLoaderFunc, scheduler Scheduler) *directive {
+func NewDirective(transact persistence.Transactioner, webhookFetcherFuncs map[resource.Type]WebhookFetcherFunc, resourceFetcherFuncs map[resource.Type]ResourceFetcherFunc, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, ownerFetcherFuncs map[resource.Type]OwnerFetcherFunc, tenantLoaderFunc TenantLoaderFunc, scheduler Scheduler) *directive {
 	return &directive{
-		transact:            transact,
-		webhookFetcherFunc:  webhookFetcherFunc,
-		resourceFetcherFunc: resourceFetcherFunc,
-		resourceUpdaterFunc: resourceUpdaterFunc,
-		tenantLoaderFunc:    tenantLoaderFunc,
-		scheduler:           scheduler,
+		transact:             transact,
+		webhookFetcherFuncs:  webhookFetcherFuncs,
+		resourceFetcherFuncs: resourceFetcherFuncs,
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		ownerFetcherFuncs:    ownerFetcherFuncs,
+		tenantLoaderFunc:     tenantLoaderFunc,
+		scheduler:            scheduler,
 	}
 }
 
@@ -83,7 +99,12 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 		log.C(ctx).WithError(err).Errorf("An error occurred while opening database transaction: %s", err.Error())
 		return nil, apperrors.NewInternalError("Unable to initialize database operation")
 	}
-	defer d.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx, hooks := SaveHooksToContext(ctx)
+	defer func() {
+		if didRollback := d.transact.RollbackUnlessCommitted(ctx, tx); didRollback {
+			hooks.RolledBack(ctx)
+		}
+	}()
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
@@ -92,13 +113,24 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 	}
 
 	if *mode == graphql.OperationModeSync {
-		return executeSyncOperation(ctx, next, tx)
+		resp, err := executeSyncOperation(ctx, next, tx)
+		if err != nil {
+			return nil, err
+		}
+		hooks.Committed(ctx)
+		return resp, nil
+	}
+
+	executionPolicy, err := getWebhookExecutionPolicy(resCtx)
+	if err != nil {
+		return nil, err
 	}
 
 	operation := &Operation{
-		OperationType:     OperationType(str.Title(operationType.String())),
-		OperationCategory: resCtx.Field.Name,
-		CorrelationID:     log.C(ctx).Data[log.FieldRequestID].(string),
+		OperationType:          OperationType(str.Title(operationType.String())),
+		OperationCategory:      resCtx.Field.Name,
+		CorrelationID:          log.C(ctx).Data[log.FieldRequestID].(string),
+		WebhookExecutionPolicy: WebhookExecutionPolicy(str.Title(executionPolicy.String())),
 	}
 
 	ctx = SaveToContext(ctx, &[]*Operation{operation})
@@ -130,7 +162,13 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 		return nil, err
 	}
 
-	if err := d.resourceUpdaterFunc(ctx, entity.GetID(), false, nil, *appConditionStatus); err != nil {
+	resourceUpdaterFunc, ok := d.resourceUpdaterFuncs[entity.GetType()]
+	if !ok {
+		log.C(ctx).Errorf("No resource updater is registered for resource type %s", entity.GetType())
+		return nil, apperrors.NewInternalError("Unsupported resource type %s", entity.GetType())
+	}
+
+	if err := resourceUpdaterFunc(ctx, entity.GetID(), false, nil, *appConditionStatus); err != nil {
 		log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s and status condition %v: %v", entity.GetType(), entity.GetID(), appConditionStatus, err)
 		return nil, apperrors.NewInternalError("Unable to update resource %s with id %s", entity.GetType(), entity.GetID())
 	}
@@ -170,6 +208,7 @@ func (d *directive) HandleOperation(ctx context.Context, _ interface{}, next gql
 		return nil, apperrors.NewInternalError("Unable to finalize database operation")
 	}
 	committed = true
+	hooks.Committed(ctx)
 
 	return resp, nil
 }
@@ -188,12 +227,22 @@ func (d *directive) concurrencyCheck(ctx context.Context, op graphql.OperationTy
 		return apperrors.NewInternalError(fmt.Sprintf("could not get idField: %q from request context", *idField))
 	}
 
+	resourceType, err := resourceTypeFromContext(resCtx)
+	if err != nil {
+		return err
+	}
+
+	resourceFetcherFunc, ok := d.resourceFetcherFuncs[resourceType]
+	if !ok {
+		return apperrors.NewInternalError("no resource fetcher is registered for resource type %s", resourceType)
+	}
+
 	tenant, err := d.tenantLoaderFunc(ctx)
 	if err != nil {
 		return apperrors.NewTenantRequiredError()
 	}
 
-	app, err := d.resourceFetcherFunc(ctx, tenant, resourceID)
+	app, err := resourceFetcherFunc(ctx, tenant, resourceID)
 	if err != nil {
 		if apperrors.IsNotFoundError(err) {
 			return err
@@ -242,9 +291,24 @@ func (d *directive) prepareRequestObject(ctx context.Context, err error, res int
 	}
 
 	requestObject := &webhook.RequestObject{
-		Application: resource,
-		TenantID:    tenantID,
-		Headers:     headers,
+		TenantID: tenantID,
+		Headers:  headers,
+	}
+
+	switch operationResource := res.(type) {
+	case *graphql.Runtime:
+		requestObject.Runtime = operationResource
+	case *graphql.BundleInstanceAuth:
+		requestObject.BundleInstanceAuth = operationResource
+		if ownerFetcherFunc, ok := d.ownerFetcherFuncs[operationResource.GetType()]; ok {
+			owner, err := ownerFetcherFunc(ctx, operationResource.GetID())
+			if err != nil {
+				return "", errors.Wrapf(err, "while fetching the owner of %s with id %s", operationResource.GetType(), operationResource.GetID())
+			}
+			requestObject.Application = owner
+		}
+	default:
+		requestObject.Application = resource
 	}
 
 	data, err := json.Marshal(requestObject)
@@ -260,23 +324,17 @@ func (d *directive) prepareWebhookIDs(ctx context.Context, err error, operation
 		return nil, err
 	}
 
-	webhooks, err := d.webhookFetcherFunc(ctx, operation.ResourceID)
-	if err != nil {
-		return nil, err
-	}
-
-	webhookIDs := make([]string, 0)
-	for _, currWebhook := range webhooks {
-		if graphql.WebhookType(currWebhook.Type) == webhookType {
-			webhookIDs = append(webhookIDs, currWebhook.ID)
-		}
+	webhookFetcherFunc, ok := d.webhookFetcherFuncs[operation.ResourceType]
+	if !ok {
+		return nil, errors.Errorf("no webhook fetcher is registered for resource type %s", operation.ResourceType)
 	}
 
-	if len(webhookIDs) > 1 {
-		return nil, errors.New("multiple webhooks per operation are not supported")
+	webhooks, err := webhookFetcherFunc(ctx, operation.ResourceID)
+	if err != nil {
+		return nil, err
 	}
 
-	return webhookIDs, nil
+	return webhookIDsOfType(webhooks, webhookType), nil
 }
 
 func getOperationMode(resCtx *gqlgen.FieldContext) (*graphql.OperationMode, error) {
@@ -294,6 +352,32 @@ func getOperationMode(resCtx *gqlgen.FieldContext) (*graphql.OperationMode, erro
 	return &mode, nil
 }
 
+func getWebhookExecutionPolicy(resCtx *gqlgen.FieldContext) (*graphql.WebhookExecutionPolicy, error) {
+	policy := graphql.WebhookExecutionPolicySequential
+	if policyArg, found := resCtx.Args[WebhookExecutionPolicyParam]; found && policyArg != nil {
+		policyPointer, ok := policyArg.(*graphql.WebhookExecutionPolicy)
+		if !ok {
+			return nil, apperrors.NewInternalError(fmt.Sprintf("could not get %s parameter", WebhookExecutionPolicyParam))
+		}
+		policy = *policyPointer
+	}
+
+	return &policy, nil
+}
+
+func resourceTypeFromContext(resCtx *gqlgen.FieldContext) (resource.Type, error) {
+	if resCtx.Field.Field == nil || resCtx.Field.Definition == nil || resCtx.Field.Definition.Type == nil {
+		return "", apperrors.NewInternalError("could not determine the resource type of the operation")
+	}
+
+	resourceType, ok := resourceTypes[resCtx.Field.Definition.Type.Name()]
+	if !ok {
+		return "", apperrors.NewInternalError("unsupported resource type %s", resCtx.Field.Definition.Type.Name())
+	}
+
+	return resourceType, nil
+}
+
 func executeSyncOperation(ctx context.Context, next gqlgen.Resolver, tx persistence.PersistenceTx) (interface{}, error) {
 	resp, err := next(ctx)
 	if err != nil {
@@ -50,6 +50,8 @@ const (
 )
 
 var (
+	applicationFieldDefinition = &ast.FieldDefinition{Type: ast.NamedType("Application", nil)}
+
 	resourceIDField             = "id"
 	whTypeApplicationRegister   = graphql.WebhookTypeRegisterApplication
 	whTypeApplicationUnregister = graphql.WebhookTypeUnregisterApplication
@@ -73,7 +75,7 @@ func TestHandleOperation(t *testing.T) {
 		}
 		ctx = gqlgen.WithFieldContext(ctx, rCtx)
 
-		directive := operation.NewDirective(nil, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(nil, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, nil, graphql.OperationTypeCreate, &whTypeApplicationRegister, &resourceIDField)
@@ -81,6 +83,34 @@ func TestHandleOperation(t *testing.T) {
 		require.Error(t, err, fmt.Sprintf("could not get %s parameter", operation.ModeParam))
 	})
 
+	t.Run("invalid webhook execution policy param causes internal server error", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		rCtx := &gqlgen.FieldContext{
+			Object: "RegisterApplication",
+			Field:  gqlgen.CollectedField{},
+			Args: map[string]interface{}{
+				operation.ModeParam:                   &operationMode,
+				operation.WebhookExecutionPolicyParam: "notWebhookExecutionPolicyParam",
+			},
+			IsMethod: false,
+		}
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
+
+		// WHEN
+		_, err := directive.HandleOperation(ctx, nil, nil, graphql.OperationTypeCreate, &whTypeApplicationRegister, nil)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), fmt.Sprintf("could not get %s parameter", operation.WebhookExecutionPolicyParam))
+	})
+
 	t.Run("when mutation is in SYNC mode there is no operation in context but transaction fails to begin", func(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
@@ -97,7 +127,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		res, err := directive.HandleOperation(ctx, nil, nil, graphql.OperationTypeCreate, &whTypeApplicationRegister, &resourceIDField)
@@ -122,7 +152,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -150,7 +180,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -178,7 +208,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -190,6 +220,75 @@ func TestHandleOperation(t *testing.T) {
 		require.Equal(t, graphql.OperationModeSync, dummyResolver.finalCtx.Value(operation.OpModeKey))
 	})
 
+	t.Run("when mutation is in SYNC mode and finishes successfully the commit hooks should be executed after commit", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeSync
+		rCtx := &gqlgen.FieldContext{
+			Object:   "RegisterApplication",
+			Field:    gqlgen.CollectedField{},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
+			IsMethod: false,
+		}
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
+
+		var committed, rolledBack bool
+		resolve := func(ctx context.Context) (interface{}, error) {
+			operation.OnCommit(ctx, func(context.Context) {
+				mockedTx.AssertCalled(t, "Commit")
+				committed = true
+			})
+			operation.OnRollback(ctx, func(context.Context) { rolledBack = true })
+			return mockedNextResponse(), nil
+		}
+
+		// WHEN
+		_, err := directive.HandleOperation(ctx, nil, resolve, graphql.OperationTypeCreate, &whTypeApplicationRegister, nil)
+		// THEN
+		require.NoError(t, err)
+		require.True(t, committed)
+		require.False(t, rolledBack)
+	})
+
+	t.Run("when mutation is in SYNC mode and request fails the rollback hooks should be executed", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeSync
+		rCtx := &gqlgen.FieldContext{
+			Object:   "RegisterApplication",
+			Field:    gqlgen.CollectedField{},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
+			IsMethod: false,
+		}
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
+
+		var committed, rolledBack bool
+		resolve := func(ctx context.Context) (interface{}, error) {
+			operation.OnCommit(ctx, func(context.Context) { committed = true })
+			operation.OnRollback(ctx, func(context.Context) { rolledBack = true })
+			return nil, mockedError()
+		}
+
+		// WHEN
+		_, err := directive.HandleOperation(ctx, nil, resolve, graphql.OperationTypeCreate, &whTypeApplicationRegister, nil)
+		// THEN
+		require.Error(t, err)
+		require.False(t, committed)
+		require.True(t, rolledBack)
+	})
+
 	t.Run("when mutation is in ASYNC mode, there is operation in context but request fails should roll-back", func(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
@@ -198,7 +297,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -210,7 +310,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -233,7 +333,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -245,7 +346,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -268,7 +369,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -280,9 +382,9 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 			return nil, mockedError()
-		}, nil, nil, nil, nil)
+		}}, nil, nil, nil, nil, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -305,7 +407,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -317,7 +420,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, func(_ context.Context) (string, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, func(_ context.Context) (string, error) {
 			return "", mockedError()
 		}, nil)
 
@@ -342,7 +445,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -354,7 +458,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -377,7 +481,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -389,7 +494,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -412,7 +517,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -424,47 +530,9 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 			return nil, mockedError()
-		}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
-
-		dummyResolver := &dummyResolver{}
-
-		// WHEN
-		res, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, graphql.OperationTypeCreate, &whTypeApplicationRegister, &resourceIDField)
-		// THEN
-		require.Error(t, err, "Unable to prepare webhooks")
-		require.Empty(t, res)
-		require.Equal(t, graphql.OperationModeAsync, dummyResolver.finalCtx.Value(operation.OpModeKey))
-	})
-
-	t.Run("when mutation is in ASYNC mode, there is operation in context but Director fails to prepare operation request due multiple webhooks found", func(t *testing.T) {
-		// GIVEN
-		ctx := context.Background()
-		operationMode := graphql.OperationModeAsync
-		rCtx := &gqlgen.FieldContext{
-			Object: "RegisterApplication",
-			Field: gqlgen.CollectedField{
-				Field: &ast.Field{
-					Name: "registerApplication",
-				},
-			},
-			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
-			IsMethod: false,
-		}
-		ctx = gqlgen.WithFieldContext(ctx, rCtx)
-
-		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
-		defer mockedTx.AssertExpectations(t)
-		defer mockedTransactioner.AssertExpectations(t)
-
-		directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
-			return []*model.Webhook{
-				{ID: webhookID1, Type: model.WebhookTypeRegisterApplication},
-				{ID: webhookID2, Type: model.WebhookTypeRegisterApplication},
-				{ID: webhookID3, Type: model.WebhookTypeRegisterApplication},
-			}, nil
-		}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -484,7 +552,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -500,7 +569,7 @@ func TestHandleOperation(t *testing.T) {
 		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return("", mockedError())
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -523,7 +592,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -541,7 +611,7 @@ func TestHandleOperation(t *testing.T) {
 		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(testID, nil)
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -567,7 +637,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -581,7 +652,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTransactioner.AssertExpectations(t)
 		dummyResolver := &dummyResolver{}
 		scheduler := &operation.DisabledScheduler{}
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, scheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, scheduler)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, operationType, nil, nil)
@@ -602,7 +673,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -617,14 +689,10 @@ func TestHandleOperation(t *testing.T) {
 
 		dummyResolver := &dummyResolver{}
 
-		errorWebhooksResponse := func(_ context.Context, _ string) ([]*model.Webhook, error) {
-			return nil, errors.New("fail")
-		}
-
 		mockedScheduler := &automock.Scheduler{}
 		defer mockedScheduler.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, errorWebhooksResponse, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: errorWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, operationType, &whTypeApplicationRegister, nil)
@@ -644,7 +712,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -680,6 +749,15 @@ func TestHandleOperation(t *testing.T) {
 				},
 				ExpectedWebhookIDs: []string{webhookID1},
 			},
+			{
+				Name: "when multiple webhooks match their IDs should be present in the operation in the order of the webhooks",
+				Webhooks: []*model.Webhook{
+					{ID: webhookID1, Type: model.WebhookType(webhookType)},
+					{ID: webhookID2, Type: model.WebhookType(graphql.WebhookTypeUnregisterApplication)},
+					{ID: webhookID3, Type: model.WebhookType(webhookType)},
+				},
+				ExpectedWebhookIDs: []string{webhookID1, webhookID3},
+			},
 		}
 
 		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceedsMultipleTimes(len(testCases))
@@ -688,9 +766,9 @@ func TestHandleOperation(t *testing.T) {
 
 		for _, testCase := range testCases {
 			t.Run(testCase.Name, func(t *testing.T) {
-				directive := operation.NewDirective(mockedTransactioner, func(_ context.Context, _ string) ([]*model.Webhook, error) {
+				directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
 					return testCase.Webhooks, nil
-				}, nil, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, mockedScheduler)
+				}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 				dummyResolver := &dummyResolver{}
 
@@ -711,6 +789,7 @@ func TestHandleOperation(t *testing.T) {
 				require.Equal(t, operationID, op.OperationID)
 				require.Equal(t, operationType, op.OperationType)
 				require.Equal(t, operationCategory, op.OperationCategory)
+				require.Equal(t, operation.WebhookExecutionPolicySequential, op.WebhookExecutionPolicy)
 
 				headers := make(map[string]string)
 				for key, value := range mockedHeaders {
@@ -734,6 +813,59 @@ func TestHandleOperation(t *testing.T) {
 		}
 	})
 
+	t.Run("when mutation is in ASYNC mode with parallel webhook execution policy the policy should be present in the operation", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		executionPolicy := graphql.WebhookExecutionPolicyParallel
+		rCtx := &gqlgen.FieldContext{
+			Object: "RegisterApplication",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
+				},
+			},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode, operation.WebhookExecutionPolicyParam: &executionPolicy},
+			IsMethod: false,
+		}
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+		ctx = context.WithValue(ctx, header.ContextKey, mockedHeaders)
+
+		mockedScheduler := &automock.Scheduler{}
+		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(operationID, nil)
+		defer mockedScheduler.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		webhookType := whTypeApplicationRegister
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
+			return []*model.Webhook{
+				{ID: webhookID1, Type: model.WebhookType(webhookType)},
+				{ID: webhookID2, Type: model.WebhookType(webhookType)},
+			}, nil
+		}}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, mockedScheduler)
+
+		dummyResolver := &dummyResolver{}
+
+		// WHEN
+		_, err := directive.HandleOperation(ctx, nil, dummyResolver.SuccessResolve, graphql.OperationTypeCreate, &webhookType, nil)
+
+		// THEN
+		require.NoError(t, err)
+
+		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
+		operations, ok := opsFromCtx.(*[]*operation.Operation)
+		require.True(t, ok)
+		require.Len(t, *operations, 1)
+
+		op := (*operations)[0]
+		require.Equal(t, []string{webhookID1, webhookID2}, op.WebhookIDs)
+		require.Equal(t, operation.WebhookExecutionPolicyParallel, op.WebhookExecutionPolicy)
+	})
+
 	t.Run("when mutation is in ASYNC mode, there is operation in context and resource updater func fails should return error", func(t *testing.T) {
 		// GIVEN
 		ctx := context.Background()
@@ -742,7 +874,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: "registerApplication",
+					Name:       "registerApplication",
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
@@ -754,7 +887,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, nil, mockedResourceUpdaterFuncWithError, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedResourceUpdaterFuncWithError}, nil, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -779,7 +912,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -797,14 +931,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionCreating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -855,7 +989,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -873,14 +1008,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedEmptyWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedEmptyWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionCreating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -928,7 +1063,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -946,14 +1082,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionUpdating, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1004,7 +1140,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -1022,14 +1159,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionDeleting, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1080,7 +1217,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -1098,14 +1236,14 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedEmptyWebhooksResponse, mockedResourceFetcherFunc, func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedEmptyWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
 			require.NotNil(t, ctx)
 			require.Equal(t, resourceID, id)
 			require.Equal(t, false, ready)
 			require.Nil(t, errorMsg)
 			require.Equal(t, model.ApplicationStatusConditionDeleting, appStatusCondition)
 			return nil
-		}, mockedTenantLoaderFunc, mockedScheduler)
+		}}, nil, mockedTenantLoaderFunc, mockedScheduler)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1153,7 +1291,8 @@ func TestHandleOperation(t *testing.T) {
 			Object: "RegisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
@@ -1166,7 +1305,7 @@ func TestHandleOperation(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, mockedWebhooksResponse, mockedResourceFetcherFunc, mockedEmptyResourceUpdaterFunc, mockedTenantLoaderFunc, nil)
+		directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: mockedWebhooksResponse}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: mockedResourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, nil)
 
 		dummyResolver := &dummyResolver{}
 
@@ -1179,6 +1318,192 @@ func TestHandleOperation(t *testing.T) {
 		require.Nil(t, res)
 		require.Equal(t, graphql.OperationModeAsync, dummyResolver.finalCtx.Value(operation.OpModeKey))
 
+		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
+		assertNoOperationsInCtx(t, opsFromCtx)
+	})
+	t.Run("when mutation for a runtime is in ASYNC mode it should use the runtime specific functions and finish successfully", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		operationCategory := "unregisterRuntime"
+		rCtx := &gqlgen.FieldContext{
+			Object: "UnregisterRuntime",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       operationCategory,
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Runtime", nil)},
+				},
+			},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode, resourceIDField: resourceID},
+			IsMethod: false,
+		}
+
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+		ctx = context.WithValue(ctx, header.ContextKey, mockedHeaders)
+
+		mockedScheduler := &automock.Scheduler{}
+		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(operationID, nil)
+		defer mockedScheduler.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		whTypeRuntimeUnregister := graphql.WebhookTypeUnregisterRuntime
+		directive := operation.NewDirective(mockedTransactioner,
+			map[resource.Type]operation.WebhookFetcherFunc{
+				resource.Application: errorWebhooksResponse,
+				resource.Runtime: func(_ context.Context, _ string) ([]*model.Webhook, error) {
+					return []*model.Webhook{{ID: webhookID1, Type: model.WebhookTypeUnregisterRuntime}}, nil
+				},
+			},
+			map[resource.Type]operation.ResourceFetcherFunc{resource.Runtime: mockedRuntimeFetcherFunc},
+			map[resource.Type]operation.ResourceUpdaterFunc{
+				resource.Application: mockedResourceUpdaterFuncWithError,
+				resource.Runtime:     mockedEmptyResourceUpdaterFunc,
+			}, nil, mockedTenantLoaderFunc, mockedScheduler)
+
+		dummyResolver := &dummyResolver{}
+
+		// WHEN
+		res, err := directive.HandleOperation(ctx, nil, dummyResolver.RuntimeResolve, graphql.OperationTypeDelete, &whTypeRuntimeUnregister, &resourceIDField)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, &graphql.Runtime{ID: resourceID}, res)
+
+		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
+		operations, ok := opsFromCtx.(*[]*operation.Operation)
+		require.True(t, ok)
+		require.Len(t, *operations, 1)
+
+		op := (*operations)[0]
+		require.Equal(t, resource.Runtime, op.ResourceType)
+		require.Equal(t, []string{webhookID1}, op.WebhookIDs)
+
+		headers := make(map[string]string)
+		for key, value := range mockedHeaders {
+			headers[key] = value[0]
+		}
+
+		expectedObj, err := json.Marshal(&webhook.RequestObject{
+			Runtime:  &graphql.Runtime{ID: resourceID},
+			TenantID: tenantID,
+			Headers:  headers,
+		})
+		require.NoError(t, err)
+		require.Equal(t, string(expectedObj), op.RequestObject)
+	})
+
+	t.Run("when mutation for a bundle instance auth is in ASYNC mode it should add the owning application to the request object", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		rCtx := &gqlgen.FieldContext{
+			Object: "RequestBundleInstanceAuthCreation",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       "requestBundleInstanceAuthCreation",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("BundleInstanceAuth", nil)},
+				},
+			},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
+			IsMethod: false,
+		}
+
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+		ctx = context.WithValue(ctx, header.ContextKey, mockedHeaders)
+
+		mockedScheduler := &automock.Scheduler{}
+		mockedScheduler.On("Schedule", mock.Anything, mock.Anything).Return(operationID, nil)
+		defer mockedScheduler.AssertExpectations(t)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		owner := &graphql.Application{Name: "owner", BaseEntity: &graphql.BaseEntity{ID: "owner-id"}}
+		whTypeBundleInstanceAuthCreation := graphql.WebhookTypeBundleInstanceAuthCreation
+		directive := operation.NewDirective(mockedTransactioner,
+			map[resource.Type]operation.WebhookFetcherFunc{
+				resource.BundleInstanceAuth: func(_ context.Context, _ string) ([]*model.Webhook, error) {
+					return []*model.Webhook{{ID: webhookID1, Type: model.WebhookTypeBundleInstanceAuthCreation}}, nil
+				},
+			}, nil,
+			map[resource.Type]operation.ResourceUpdaterFunc{resource.BundleInstanceAuth: mockedEmptyResourceUpdaterFunc},
+			map[resource.Type]operation.OwnerFetcherFunc{
+				resource.BundleInstanceAuth: func(_ context.Context, id string) (webhook.Resource, error) {
+					require.Equal(t, resourceID, id)
+					return owner, nil
+				},
+			}, mockedTenantLoaderFunc, mockedScheduler)
+
+		dummyResolver := &dummyResolver{}
+
+		// WHEN
+		res, err := directive.HandleOperation(ctx, nil, dummyResolver.BundleInstanceAuthResolve, graphql.OperationTypeCreate, &whTypeBundleInstanceAuthCreation, nil)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, &graphql.BundleInstanceAuth{ID: resourceID}, res)
+
+		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
+		operations, ok := opsFromCtx.(*[]*operation.Operation)
+		require.True(t, ok)
+		require.Len(t, *operations, 1)
+
+		op := (*operations)[0]
+		require.Equal(t, resource.BundleInstanceAuth, op.ResourceType)
+		require.Equal(t, []string{webhookID1}, op.WebhookIDs)
+
+		headers := make(map[string]string)
+		for key, value := range mockedHeaders {
+			headers[key] = value[0]
+		}
+
+		expectedObj, err := json.Marshal(&webhook.RequestObject{
+			Application:        owner,
+			BundleInstanceAuth: &graphql.BundleInstanceAuth{ID: resourceID},
+			TenantID:           tenantID,
+			Headers:            headers,
+		})
+		require.NoError(t, err)
+		require.Equal(t, string(expectedObj), op.RequestObject)
+	})
+
+	t.Run("when mutation is in ASYNC mode and no resource updater is registered for the resource type it should return error", func(t *testing.T) {
+		// GIVEN
+		ctx := context.Background()
+		operationMode := graphql.OperationModeAsync
+		rCtx := &gqlgen.FieldContext{
+			Object: "RegisterRuntime",
+			Field: gqlgen.CollectedField{
+				Field: &ast.Field{
+					Name:       "registerRuntime",
+					Definition: &ast.FieldDefinition{Type: ast.NamedType("Runtime", nil)},
+				},
+			},
+			Args:     map[string]interface{}{operation.ModeParam: &operationMode},
+			IsMethod: false,
+		}
+		ctx = gqlgen.WithFieldContext(ctx, rCtx)
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, mockedTenantLoaderFunc, nil)
+
+		dummyResolver := &dummyResolver{}
+
+		// WHEN
+		res, err := directive.HandleOperation(ctx, nil, dummyResolver.RuntimeResolve, graphql.OperationTypeCreate, nil, nil)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unsupported resource type runtime")
+		require.Empty(t, res)
+
 		opsFromCtx := dummyResolver.finalCtx.Value(operation.OpCtxKey)
 		assertNoOperationsInCtx(t, opsFromCtx)
 	})
@@ -1332,7 +1657,8 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 				Object: test.mutation,
 				Field: gqlgen.CollectedField{
 					Field: &ast.Field{
-						Name: test.mutation,
+						Name:       test.mutation,
+						Definition: applicationFieldDefinition,
 					},
 				},
 				Args:     test.resolverCtxArgs,
@@ -1349,9 +1675,9 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 				defer test.scheduler.AssertExpectations(t)
 			}
 
-			directive := operation.NewDirective(mockedTransactioner, func(ctx context.Context, resourceID string) ([]*model.Webhook, error) {
+			directive := operation.NewDirective(mockedTransactioner, map[resource.Type]operation.WebhookFetcherFunc{resource.Application: func(ctx context.Context, resourceID string) ([]*model.Webhook, error) {
 				return nil, nil
-			}, test.resourceFetcherFunc, mockedEmptyResourceUpdaterFunc, test.tenantLoaderFunc, test.scheduler)
+			}}, map[resource.Type]operation.ResourceFetcherFunc{resource.Application: test.resourceFetcherFunc}, map[resource.Type]operation.ResourceUpdaterFunc{resource.Application: mockedEmptyResourceUpdaterFunc}, nil, test.tenantLoaderFunc, test.scheduler)
 
 			// WHEN
 			res, err := directive.HandleOperation(ctx, nil, test.resolverFunc, graphql.OperationTypeDelete, nil, &resourceIDField)
@@ -1368,7 +1694,8 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 			Object: "UnregisterApplication",
 			Field: gqlgen.CollectedField{
 				Field: &ast.Field{
-					Name: operationCategory,
+					Name:       operationCategory,
+					Definition: applicationFieldDefinition,
 				},
 			},
 			Args:     resolverContextArgs(graphql.OperationModeAsync, resourceID),
@@ -1380,7 +1707,7 @@ func TestHandleOperation_ConcurrencyCheck(t *testing.T) {
 		defer mockedTx.AssertExpectations(t)
 		defer mockedTransactioner.AssertExpectations(t)
 
-		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil)
+		directive := operation.NewDirective(mockedTransactioner, nil, nil, nil, nil, nil, nil)
 
 		// WHEN
 		_, err := directive.HandleOperation(ctx, nil, nil, graphql.OperationTypeDelete, &whTypeApplicationUnregister, nil)
@@ -1406,7 +1733,17 @@ func (d *dummyResolver) ErrorResolve(ctx context.Context) (res interface{}, err
 
 func (d *dummyResolver) NonEntityResolve(ctx context.Context) (res interface{}, err error) {
 	d.finalCtx = ctx
-	return &graphql.Runtime{}, nil
+	return &graphql.Label{}, nil
+}
+
+func (d *dummyResolver) RuntimeResolve(ctx context.Context) (res interface{}, err error) {
+	d.finalCtx = ctx
+	return &graphql.Runtime{ID: resourceID}, nil
+}
+
+func (d *dummyResolver) BundleInstanceAuthResolve(ctx context.Context) (res interface{}, err error) {
+	d.finalCtx = ctx
+	return &graphql.BundleInstanceAuth{ID: resourceID}, nil
 }
 
 func (d *dummyResolver) NonWebhookProviderResolve(ctx context.Context) (res interface{}, err error) {
@@ -1428,6 +1765,10 @@ func mockedEmptyWebhooksResponse(_ context.Context, _ string) ([]*model.Webhook,
 	return nil, nil
 }
 
+func errorWebhooksResponse(_ context.Context, _ string) ([]*model.Webhook, error) {
+	return nil, errors.New("fail")
+}
+
 func mockedResourceFetcherFunc(context.Context, string, string) (model.Entity, error) {
 	return &model.Application{
 		BaseEntity: &model.BaseEntity{
@@ -1438,6 +1779,14 @@ func mockedResourceFetcherFunc(context.Context, string, string) (model.Entity, e
 	}, nil
 }
 
+func mockedRuntimeFetcherFunc(context.Context, string, string) (model.Entity, error) {
+	return &model.Runtime{
+		ID:                resourceID,
+		Ready:             true,
+		CreationTimestamp: time.Now(),
+	}, nil
+}
+
 func mockedTenantLoaderFunc(_ context.Context) (string, error) {
 	return tenantID, nil
 }
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+// Details describes the progress of a scheduled Operation as reported by the Operations Controller
+type Details struct {
+	Operation
+	Generation    int64
+	TenantID      string
+	Status        OperationStatus
+	Error         *string
+	InitializedAt *time.Time
+	CancelledBy   string
+	RetriedBy     string
+	Webhooks      []WebhookDetails
+}
+
+// WebhookDetails describes the progress of one of the webhooks executed as part of an Operation
+type WebhookDetails struct {
+	WebhookID          string
+	Status             OperationStatus
+	RetriesCount       int
+	LastPollTimestamp  string
+	FailedAttempts     int
+	LastError          string
+	NextRetryTimestamp string
+}
+
+// Filter narrows down the operations returned by a fetcher. Empty fields are not taken into account.
+type Filter struct {
+	TenantID     string
+	ResourceID   string
+	ResourceType resource.Type
+	Status       OperationStatus
+}
+
+// Matches checks whether the given operation satisfies all the conditions of the filter
+func (f Filter) Matches(details *Details) bool {
+	if f.TenantID != "" && f.TenantID != details.TenantID {
+		return false
+	}
+	if f.ResourceID != "" && f.ResourceID != details.ResourceID {
+		return false
+	}
+	if f.ResourceType != "" && f.ResourceType != details.ResourceType {
+		return false
+	}
+	if f.Status != "" && f.Status != details.Status {
+		return false
+	}
+
+	return true
+}
+
+// DisabledFetcher defines an operations fetcher implementation that can be used when asynchronous operations are disabled
+type DisabledFetcher struct{}
+
+// GetByID returns a not found error as no operations can be scheduled
+func (d *DisabledFetcher) GetByID(_ context.Context, id string) (*Details, error) {
+	return nil, apperrors.NewNotFoundError(resource.Operation, id)
+}
+
+// List returns no operations as no operations can be scheduled
+func (d *DisabledFetcher) List(_ context.Context, _ Filter) ([]*Details, error) {
+	return []*Details{}, nil
+}
@@ -63,6 +63,29 @@ func (_m *K8SClient) Get(ctx context.Context, name string, options v1.GetOptions
 	return r0, r1
 }
 
+// List provides a mock function with given fields: ctx, opts
+func (_m *K8SClient) List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.OperationList, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 *v1alpha1.OperationList
+	if rf, ok := ret.Get(0).(func(context.Context, v1.ListOptions) *v1alpha1.OperationList); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.OperationList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, v1.ListOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Update provides a mock function with given fields: ctx, operation
 func (_m *K8SClient) Update(ctx context.Context, operation *v1alpha1.Operation) (*v1alpha1.Operation, error) {
 	ret := _m.Called(ctx, operation)
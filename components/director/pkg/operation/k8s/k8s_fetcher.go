@@ -0,0 +1,169 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Fetcher fetches asynchronous operations from their Operation custom resources
+type Fetcher struct {
+	kcli K8SClient
+}
+
+// NewFetcher creates a Fetcher which uses the given k8s client
+func NewFetcher(kcli K8SClient) *Fetcher {
+	return &Fetcher{
+		kcli: kcli,
+	}
+}
+
+// GetByID returns the operation with the given ID. The ID of an operation is the name of its custom resource.
+func (f *Fetcher) GetByID(ctx context.Context, id string) (*operation.Details, error) {
+	k8sOp, err := f.kcli.Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, apperrors.NewNotFoundError(resource.Operation, id)
+		}
+		return nil, err
+	}
+
+	return toOperationDetails(ctx, k8sOp), nil
+}
+
+// List returns the operations which match the given filter. The resource and tenant conditions of the filter
+// are turned into a label selector, so that only the matching custom resources are fetched.
+func (f *Fetcher) List(ctx context.Context, filter operation.Filter) ([]*operation.Details, error) {
+	operations, err := f.kcli.List(ctx, metav1.ListOptions{LabelSelector: labelSelector(filter)})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*operation.Details, 0, len(operations.Items))
+	for i := range operations.Items {
+		details := toOperationDetails(ctx, &operations.Items[i])
+		if filter.Matches(details) {
+			result = append(result, details)
+		}
+	}
+
+	return result, nil
+}
+
+func toOperationDetails(ctx context.Context, k8sOp *v1alpha1.Operation) *operation.Details {
+	details := &operation.Details{
+		Operation: operation.Operation{
+			OperationID:       k8sOp.Name,
+			OperationType:     operation.OperationType(k8sOp.Spec.OperationType),
+			OperationCategory: k8sOp.Spec.OperationCategory,
+			ResourceID:        k8sOp.Spec.ResourceID,
+			ResourceType:      resource.Type(k8sOp.Spec.ResourceType),
+			CreationTime:      k8sOp.CreationTimestamp.Time,
+			CorrelationID:     k8sOp.Spec.CorrelationID,
+			WebhookIDs:        k8sOp.Spec.WebhookIDs,
+			RequestObject:     k8sOp.Spec.RequestObject,
+		},
+		Generation:  k8sOp.Generation,
+		TenantID:    tenantOf(ctx, k8sOp),
+		Status:      toOperationStatus(k8sOp.Status.Phase, operation.OperationStatusInProgress),
+		CancelledBy: k8sOp.Annotations[operation.CancelledByAnnotation],
+		RetriedBy:   k8sOp.Annotations[operation.RetriedByAnnotation],
+		Webhooks:    make([]operation.WebhookDetails, 0, len(k8sOp.Spec.WebhookIDs)),
+	}
+
+	if !k8sOp.Status.InitializedAt.IsZero() {
+		initializedAt := k8sOp.Status.InitializedAt.Time
+		details.InitializedAt = &initializedAt
+	}
+
+	for _, cond := range k8sOp.Status.Conditions {
+		if cond.Type == v1alpha1.ConditionTypeError && cond.Status == v1.ConditionTrue {
+			details.Error = str.Ptr(cond.Message)
+		}
+	}
+
+	for _, webhookID := range k8sOp.Spec.WebhookIDs {
+		webhookDetails := operation.WebhookDetails{
+			WebhookID: webhookID,
+			Status:    operation.OperationStatusPending,
+		}
+
+		for _, webhookStatus := range k8sOp.Status.Webhooks {
+			if webhookStatus.WebhookID == webhookID {
+				webhookDetails.Status = toOperationStatus(webhookStatus.State, operation.OperationStatusPending)
+				webhookDetails.RetriesCount = webhookStatus.RetriesCount
+				webhookDetails.LastPollTimestamp = webhookStatus.LastPollTimestamp
+				webhookDetails.FailedAttempts = webhookStatus.FailedAttempts
+				webhookDetails.LastError = webhookStatus.LastError
+				webhookDetails.NextRetryTimestamp = webhookStatus.NextRetryTimestamp
+			}
+		}
+
+		details.Webhooks = append(details.Webhooks, webhookDetails)
+	}
+
+	return details
+}
+
+// toOperationStatus maps the state of an Operation custom resource, or of one of its webhooks, to an operation status.
+// States which are not yet known to the Director, as well as the empty state, are mapped to the provided default status.
+func toOperationStatus(state v1alpha1.State, defaultStatus operation.OperationStatus) operation.OperationStatus {
+	switch state {
+	case v1alpha1.StateSuccess:
+		return operation.OperationStatusSucceeded
+	case v1alpha1.StateFailed:
+		return operation.OperationStatusFailed
+	case v1alpha1.StateInProgress:
+		return operation.OperationStatusInProgress
+	default:
+		return defaultStatus
+	}
+}
+
+func labelSelector(filter operation.Filter) string {
+	set := labels.Set{}
+	if filter.ResourceType != "" {
+		set[ResourceTypeLabel] = string(filter.ResourceType)
+	}
+	if filter.ResourceID != "" {
+		set[ResourceIDLabel] = filter.ResourceID
+	}
+	if filter.TenantID != "" {
+		set[TenantLabel] = filter.TenantID
+	}
+
+	return labels.SelectorFromSet(set).String()
+}
+
+// tenantOf returns the tenant label of the Operation custom resource. Operations scheduled before the label
+// was introduced fall back to the tenant of their request object.
+func tenantOf(ctx context.Context, k8sOp *v1alpha1.Operation) string {
+	if tenantID, ok := k8sOp.Labels[TenantLabel]; ok {
+		return tenantID
+	}
+
+	return tenantFromRequestObject(ctx, k8sOp.Name, k8sOp.Spec.RequestObject)
+}
+
+func tenantFromRequestObject(ctx context.Context, operationName, reqObject string) string {
+	requestObject := struct {
+		TenantID string
+	}{}
+
+	if err := json.Unmarshal([]byte(reqObject), &requestObject); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while parsing the request object of operation %s: %v", operationName, err)
+		return ""
+	}
+
+	return requestObject.TenantID
+}
@@ -0,0 +1,245 @@
+package k8s_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	tenantID      = "4b7aa2e1-e060-4633-a795-1be0d207c3e2"
+	firstWebhook  = "d09731af-bc0a-4abf-9b09-f3c9d25d064b"
+	secondWebhook = "2b1c8e1e-6f3b-4a9e-8a55-58c70e1e4a3b"
+)
+
+func TestFetcher_GetByID(t *testing.T) {
+	t.Run("when the k8s client fails to get the operation it should fail", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		expErr := errors.New("error")
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(nil, expErr).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		_, err := f.GetByID(ctx, operationID)
+		// THEN
+		require.Equal(t, expErr, err)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when an operation with the given ID does not exist it should return not found error", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(nil, k8s_errors.NewNotFound(schema.GroupResource{}, operationID)).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		_, err := f.GetByID(ctx, operationID)
+		// THEN
+		require.Error(t, err)
+		require.True(t, apperrors.IsNotFoundError(err))
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when an operation with the given ID exists it should return its details", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		k8sOp := fixK8SOperation(resourceID, tenantID)
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(k8sOp, nil).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		details, err := f.GetByID(ctx, operationID)
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, fixOperationDetails(k8sOp), details)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when the operation has no tenant label it should take the tenant from the request object", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		k8sOp := fixK8SOperation(resourceID, tenantID)
+		delete(k8sOp.Labels, k8s.TenantLabel)
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(k8sOp, nil).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		details, err := f.GetByID(ctx, operationID)
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, tenantID, details.TenantID)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+}
+
+func TestFetcher_List(t *testing.T) {
+	t.Run("when the k8s client fails to list the operations it should fail", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		expErr := errors.New("error")
+
+		cli := &automock.K8SClient{}
+		cli.On("List", ctx, metav1.ListOptions{LabelSelector: k8s.TenantLabel + "=" + tenantID}).Return(nil, expErr).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		_, err := f.List(ctx, operation.Filter{TenantID: tenantID})
+		// THEN
+		require.Equal(t, expErr, err)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("it should select the operations by the labels of the resource and the tenant and filter them by status", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		failedOp := fixK8SOperation(resourceID, tenantID)
+		inProgressOp := fixK8SOperation(resourceID, tenantID)
+		inProgressOp.Status.Phase = v1alpha1.StateInProgress
+		expectedSelector := fmt.Sprintf("%s=%s,%s=%s,%s=%s", k8s.ResourceIDLabel, resourceID, k8s.ResourceTypeLabel, resource.Application, k8s.TenantLabel, tenantID)
+
+		cli := &automock.K8SClient{}
+		cli.On("List", ctx, metav1.ListOptions{LabelSelector: expectedSelector}).Return(&v1alpha1.OperationList{Items: []v1alpha1.Operation{*failedOp, *inProgressOp}}, nil).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		result, err := f.List(ctx, operation.Filter{
+			TenantID:     tenantID,
+			ResourceID:   resourceID,
+			ResourceType: resource.Application,
+			Status:       operation.OperationStatusFailed,
+		})
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []*operation.Details{fixOperationDetails(failedOp)}, result)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when no operation matches the filter it should return empty list", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+
+		cli := &automock.K8SClient{}
+		cli.On("List", ctx, metav1.ListOptions{LabelSelector: k8s.TenantLabel + "=" + tenantID}).Return(&v1alpha1.OperationList{Items: []v1alpha1.Operation{*fixK8SOperation(resourceID, tenantID)}}, nil).Once()
+		f := k8s.NewFetcher(cli)
+
+		// WHEN
+		result, err := f.List(ctx, operation.Filter{TenantID: tenantID, Status: operation.OperationStatusSucceeded})
+		// THEN
+		require.NoError(t, err)
+		require.Empty(t, result)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+}
+
+func fixK8SOperation(resourceID, tenant string) *v1alpha1.Operation {
+	return &v1alpha1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "application-" + resourceID,
+			Generation: 3,
+			Annotations: map[string]string{
+				operation.CancelledByAnnotation: "admin",
+				operation.RetriedByAnnotation:   "operator",
+			},
+			Labels: map[string]string{
+				k8s.ResourceTypeLabel: string(resource.Application),
+				k8s.ResourceIDLabel:   resourceID,
+				k8s.TenantLabel:       tenant,
+			},
+			CreationTimestamp: metav1.NewTime(time.Date(2022, 1, 27, 9, 0, 0, 0, time.UTC)),
+		},
+		Spec: v1alpha1.OperationSpec{
+			OperationType:     v1alpha1.OperationTypeCreate,
+			OperationCategory: "registerApplication",
+			ResourceType:      string(resource.Application),
+			ResourceID:        resourceID,
+			CorrelationID:     "dea327aa-c173-46d5-9431-497e4057a83a",
+			WebhookIDs:        []string{firstWebhook, secondWebhook},
+			RequestObject:     `{"TenantID":"` + tenant + `"}`,
+		},
+		Status: v1alpha1.OperationStatus{
+			Webhooks: []v1alpha1.Webhook{
+				{
+					WebhookID:          firstWebhook,
+					RetriesCount:       3,
+					WebhookPollURL:     "https://test-domain.com/operation",
+					LastPollTimestamp:  "2022-01-27T10:00:00Z",
+					State:              v1alpha1.StateFailed,
+					FailedAttempts:     2,
+					LastError:          "connection refused",
+					NextRetryTimestamp: "2022-01-27T10:00:30Z",
+				},
+			},
+			Conditions: []v1alpha1.Condition{
+				{Type: v1alpha1.ConditionTypeReady, Status: v1.ConditionTrue},
+				{Type: v1alpha1.ConditionTypeError, Status: v1.ConditionTrue, Message: "webhook failed"},
+			},
+			Phase:         v1alpha1.StateFailed,
+			InitializedAt: metav1.NewTime(time.Date(2022, 1, 27, 9, 0, 5, 0, time.UTC)),
+		},
+	}
+}
+
+func fixOperationDetails(k8sOp *v1alpha1.Operation) *operation.Details {
+	initializedAt := k8sOp.Status.InitializedAt.Time
+
+	return &operation.Details{
+		Operation: operation.Operation{
+			OperationID:       k8sOp.Name,
+			OperationType:     operation.OperationTypeCreate,
+			OperationCategory: k8sOp.Spec.OperationCategory,
+			ResourceID:        k8sOp.Spec.ResourceID,
+			ResourceType:      resource.Application,
+			CreationTime:      k8sOp.CreationTimestamp.Time,
+			CorrelationID:     k8sOp.Spec.CorrelationID,
+			WebhookIDs:        k8sOp.Spec.WebhookIDs,
+			RequestObject:     k8sOp.Spec.RequestObject,
+		},
+		Generation:    k8sOp.Generation,
+		TenantID:      tenantID,
+		Status:        operation.OperationStatusFailed,
+		Error:         str.Ptr("webhook failed"),
+		InitializedAt: &initializedAt,
+		CancelledBy:   "admin",
+		RetriedBy:     "operator",
+		Webhooks: []operation.WebhookDetails{
+			{
+				WebhookID:          firstWebhook,
+				Status:             operation.OperationStatusFailed,
+				RetriesCount:       3,
+				LastPollTimestamp:  "2022-01-27T10:00:00Z",
+				FailedAttempts:     2,
+				LastError:          "connection refused",
+				NextRetryTimestamp: "2022-01-27T10:00:30Z",
+			},
+			{
+				WebhookID: secondWebhook,
+				Status:    operation.OperationStatusPending,
+			},
+		},
+	}
+}
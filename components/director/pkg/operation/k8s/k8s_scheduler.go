@@ -12,11 +12,21 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// ResourceTypeLabel is the Operation label holding the type of the resource targeted by the operation
+	ResourceTypeLabel = "operations.compass/resource-type"
+	// ResourceIDLabel is the Operation label holding the ID of the resource targeted by the operation
+	ResourceIDLabel = "operations.compass/resource-id"
+	// TenantLabel is the Operation label holding the tenant of the resource targeted by the operation
+	TenantLabel = "operations.compass/tenant"
+)
+
 // K8SClient missing godoc
 //go:generate mockery --name=K8SClient --output=automock --outpkg=automock --case=underscore
 type K8SClient interface {
 	Create(ctx context.Context, operation *v1alpha1.Operation) (*v1alpha1.Operation, error)
 	Get(ctx context.Context, name string, options metav1.GetOptions) (*v1alpha1.Operation, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1alpha1.OperationList, error)
 	Update(ctx context.Context, operation *v1alpha1.Operation) (*v1alpha1.Operation, error)
 }
 
@@ -32,24 +42,26 @@ func NewScheduler(kcli K8SClient) *Scheduler {
 	}
 }
 
-// Schedule missing godoc
+// Schedule creates or updates the Operation custom resource of the resource targeted by the operation.
+// The name of the custom resource is returned as the ID of the operation.
 func (s *Scheduler) Schedule(ctx context.Context, op *operation.Operation) (string, error) {
 	operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
 	getOp, err := s.kcli.Get(ctx, operationName, metav1.GetOptions{})
 	if err != nil {
 		if errors.IsNotFound(err) {
-			k8sOp := toK8SOperation(op)
+			k8sOp := toK8SOperation(ctx, op)
 			createdOperation, err := s.kcli.Create(ctx, k8sOp)
 			if err != nil {
 				return "", err
 			}
-			return string(createdOperation.UID), nil
+			return createdOperation.Name, nil
 		}
 		return "", err
 	}
 	if isOpInProgress(getOp) {
 		return "", fmt.Errorf("another operation is in progress for resource with ID %q", op.ResourceID)
 	}
+	getOp = setOperationLabels(ctx, op, getOp)
 	getOp = updateOperationSpec(op, getOp)
 	updatedOperation, err := s.kcli.Update(ctx, getOp)
 	if err != nil {
@@ -58,7 +70,7 @@ func (s *Scheduler) Schedule(ctx context.Context, op *operation.Operation) (stri
 		}
 		return "", err
 	}
-	return string(updatedOperation.UID), err
+	return updatedOperation.Name, err
 }
 
 func isOpInProgress(op *v1alpha1.Operation) bool {
@@ -70,25 +82,41 @@ func isOpInProgress(op *v1alpha1.Operation) bool {
 	return true
 }
 
-func toK8SOperation(op *operation.Operation) *v1alpha1.Operation {
+func toK8SOperation(ctx context.Context, op *operation.Operation) *v1alpha1.Operation {
 	operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
 	result := &v1alpha1.Operation{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: operationName,
 		},
 	}
+	result = setOperationLabels(ctx, op, result)
 	return updateOperationSpec(op, result)
 }
 
+// setOperationLabels labels the Operation custom resource with the resource and the tenant it belongs to,
+// so that the operations can be listed with a label selector
+func setOperationLabels(ctx context.Context, op *operation.Operation, k8sOp *v1alpha1.Operation) *v1alpha1.Operation {
+	if k8sOp.Labels == nil {
+		k8sOp.Labels = make(map[string]string, 3)
+	}
+	k8sOp.Labels[ResourceTypeLabel] = string(op.ResourceType)
+	k8sOp.Labels[ResourceIDLabel] = op.ResourceID
+	if tenantID := tenantFromRequestObject(ctx, k8sOp.Name, op.RequestObject); tenantID != "" {
+		k8sOp.Labels[TenantLabel] = tenantID
+	}
+	return k8sOp
+}
+
 func updateOperationSpec(op *operation.Operation, k8sOp *v1alpha1.Operation) *v1alpha1.Operation {
 	k8sOp.Spec = v1alpha1.OperationSpec{
-		OperationCategory: op.OperationCategory,
-		OperationType:     v1alpha1.OperationType(str.Title(string(op.OperationType))),
-		ResourceType:      string(op.ResourceType),
-		ResourceID:        op.ResourceID,
-		CorrelationID:     op.CorrelationID,
-		WebhookIDs:        op.WebhookIDs,
-		RequestObject:     op.RequestObject,
+		OperationCategory:      op.OperationCategory,
+		OperationType:          v1alpha1.OperationType(str.Title(string(op.OperationType))),
+		ResourceType:           string(op.ResourceType),
+		ResourceID:             op.ResourceID,
+		CorrelationID:          op.CorrelationID,
+		WebhookIDs:             op.WebhookIDs,
+		WebhookExecutionPolicy: v1alpha1.WebhookExecutionPolicy(op.WebhookExecutionPolicy),
+		RequestObject:          op.RequestObject,
 	}
 	return k8sOp
 }
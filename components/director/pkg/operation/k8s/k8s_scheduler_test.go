@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 	"github.com/kyma-incubator/compass/components/director/pkg/str"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/pkg/errors"
@@ -21,7 +22,7 @@ import (
 
 const (
 	resourceID  = "c7092c57-7a5c-4ebe-8c58-03c0f85ade6c"
-	operationID = "0b4fc816-da70-4505-961e-db346388fdb7"
+	operationID = "application-" + resourceID
 )
 
 func TestScheduler_Schedule(t *testing.T) {
@@ -67,18 +68,22 @@ func TestScheduler_Schedule(t *testing.T) {
 	t.Run("when no previous operation exists it should return the ID of a newly created operation", func(t *testing.T) {
 		// GIVEN
 		ctx := context.TODO()
-		op := &operation.Operation{OperationType: operation.OperationTypeCreate, ResourceID: resourceID}
-		operationName := fmt.Sprintf("%s-%s", op.ResourceType, op.ResourceID)
+		op := &operation.Operation{
+			OperationType:          operation.OperationTypeCreate,
+			ResourceType:           resource.Application,
+			ResourceID:             resourceID,
+			WebhookExecutionPolicy: operation.WebhookExecutionPolicyParallel,
+			RequestObject:          `{"TenantID":"` + tenantID + `"}`,
+		}
 
 		cli := &automock.K8SClient{}
-		notFoundErr := k8s_errors.NewNotFound(schema.GroupResource{}, operationName)
-		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(nil, notFoundErr).Once()
+		notFoundErr := k8s_errors.NewNotFound(schema.GroupResource{}, operationID)
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(nil, notFoundErr).Once()
 
 		k8sOp := toK8SOperation(op)
-		k8sOp.UID = operationID
-		k8sOpWithoutID := toK8SOperation(op)
+		k8sOp.Labels[k8s.TenantLabel] = tenantID
 
-		cli.On("Create", ctx, k8sOpWithoutID).Return(k8sOp, nil).Once()
+		cli.On("Create", ctx, k8sOp).Return(k8sOp, nil).Once()
 
 		s := k8s.NewScheduler(cli)
 
@@ -86,7 +91,8 @@ func TestScheduler_Schedule(t *testing.T) {
 		opID, err := s.Schedule(ctx, op)
 		// THEN
 		require.NoError(t, err)
-		require.Equal(t, string(k8sOp.UID), opID)
+		require.Equal(t, operationID, opID)
+		cli.AssertExpectations(t)
 	})
 
 	t.Run("when a previous operation is in progress it should return an error", func(t *testing.T) {
@@ -98,7 +104,6 @@ func TestScheduler_Schedule(t *testing.T) {
 		cli := &automock.K8SClient{}
 
 		k8sOp := toK8SOperation(op)
-		k8sOp.UID = operationID
 
 		cli.On("Get", ctx, operationName, metav1.GetOptions{}).Return(k8sOp, nil).Once()
 
@@ -120,7 +125,6 @@ func TestScheduler_Schedule(t *testing.T) {
 		cli := &automock.K8SClient{}
 
 		k8sOp := toK8SOperation(op)
-		k8sOp.UID = operationID
 		k8sOp.Status.Conditions = []v1alpha1.Condition{{
 			Status: v1.ConditionTrue,
 		}}
@@ -148,7 +152,6 @@ func TestScheduler_Schedule(t *testing.T) {
 		cli := &automock.K8SClient{}
 
 		k8sOp := toK8SOperation(op)
-		k8sOp.UID = operationID
 		k8sOp.Status.Conditions = []v1alpha1.Condition{{
 			Status: v1.ConditionTrue,
 		}}
@@ -175,14 +178,12 @@ func TestScheduler_Schedule(t *testing.T) {
 		completedOp := &operation.Operation{OperationType: operation.OperationTypeCreate, ResourceID: resourceID}
 		completedOpName := fmt.Sprintf("%s-%s", completedOp.ResourceType, completedOp.ResourceID)
 		completedk8sOp := toK8SOperation(completedOp)
-		completedk8sOp.UID = operationID
 		completedk8sOp.Status.Conditions = []v1alpha1.Condition{{
 			Status: v1.ConditionTrue,
 		}}
 
 		newOp := &operation.Operation{OperationType: operation.OperationTypeUpdate, ResourceID: resourceID}
 		newK8sOp := toK8SOperation(newOp)
-		newK8sOp.UID = operationID
 
 		cli.On("Get", ctx, completedOpName, metav1.GetOptions{}).Return(completedk8sOp, nil).Once()
 		cli.On("Update", ctx, completedk8sOp).Return(newK8sOp, nil).Once()
@@ -193,7 +194,7 @@ func TestScheduler_Schedule(t *testing.T) {
 		opID, err := s.Schedule(ctx, newOp)
 		// THEN
 		require.NoError(t, err)
-		require.Equal(t, string(newK8sOp.UID), opID)
+		require.Equal(t, completedOpName, opID)
 	})
 }
 
@@ -203,10 +204,17 @@ func toK8SOperation(op *operation.Operation) *v1alpha1.Operation {
 		TypeMeta: metav1.TypeMeta{},
 		ObjectMeta: metav1.ObjectMeta{
 			Name: operationName,
+			Labels: map[string]string{
+				k8s.ResourceTypeLabel: string(op.ResourceType),
+				k8s.ResourceIDLabel:   op.ResourceID,
+			},
 		},
 		Spec: v1alpha1.OperationSpec{
-			OperationType: v1alpha1.OperationType(str.Title(string(op.OperationType))),
-			ResourceID:    op.ResourceID,
+			OperationType:          v1alpha1.OperationType(str.Title(string(op.OperationType))),
+			ResourceType:           string(op.ResourceType),
+			ResourceID:             op.ResourceID,
+			WebhookExecutionPolicy: v1alpha1.WebhookExecutionPolicy(op.WebhookExecutionPolicy),
+			RequestObject:          op.RequestObject,
 		},
 		Status: v1alpha1.OperationStatus{},
 	}
@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Updater updates the Operation custom resources of already scheduled operations
+type Updater struct {
+	kcli K8SClient
+}
+
+// NewUpdater creates an Updater which uses the given k8s client
+func NewUpdater(kcli K8SClient) *Updater {
+	return &Updater{
+		kcli: kcli,
+	}
+}
+
+// Update replaces the spec of the Operation custom resource with the given ID and adds the provided annotations to it.
+// The change of the spec makes the Operations Controller reinitialize the operation status and process the operation again.
+func (u *Updater) Update(ctx context.Context, op *operation.Operation, annotations map[string]string) error {
+	k8sOp, err := u.kcli.Get(ctx, op.OperationID, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return apperrors.NewNotFoundError(resource.Operation, op.OperationID)
+		}
+		return err
+	}
+
+	if k8sOp.Annotations == nil {
+		k8sOp.Annotations = make(map[string]string, len(annotations))
+	}
+	for key, value := range annotations {
+		k8sOp.Annotations[key] = value
+	}
+
+	k8sOp = updateOperationSpec(op, k8sOp)
+	if _, err := u.kcli.Update(ctx, k8sOp); err != nil {
+		if errors.IsConflict(err) {
+			return apperrors.NewConcurrentOperationInProgressError(fmt.Sprintf("operation %s has been modified in the meantime", op.OperationID))
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package k8s_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/k8s/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestUpdater_Update(t *testing.T) {
+	annotations := map[string]string{operation.CancelledByAnnotation: "admin"}
+
+	t.Run("when the operation does not exist it should return not found error", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		op := fixUpdatedOperation()
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(nil, k8s_errors.NewNotFound(schema.GroupResource{}, operationID)).Once()
+		u := k8s.NewUpdater(cli)
+
+		// WHEN
+		err := u.Update(ctx, op, annotations)
+		// THEN
+		require.Error(t, err)
+		require.True(t, apperrors.IsNotFoundError(err))
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when the k8s client fails to retrieve the operation it should fail", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		op := fixUpdatedOperation()
+		expErr := errors.New("error")
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(nil, expErr).Once()
+		u := k8s.NewUpdater(cli)
+
+		// WHEN
+		err := u.Update(ctx, op, annotations)
+		// THEN
+		require.Equal(t, expErr, err)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("when the operation has been modified in the meantime it should fail", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		op := fixUpdatedOperation()
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(fixK8SOperation(resourceID, tenantID), nil).Once()
+		cli.On("Update", ctx, mock.Anything).Return(nil, k8s_errors.NewConflict(schema.GroupResource{}, operationID, errors.New("conflict"))).Once()
+		u := k8s.NewUpdater(cli)
+
+		// WHEN
+		err := u.Update(ctx, op, annotations)
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "has been modified in the meantime")
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+
+	t.Run("it should replace the spec of the operation and add the annotations to it", func(t *testing.T) {
+		// GIVEN
+		ctx := context.TODO()
+		op := fixUpdatedOperation()
+		k8sOp := fixK8SOperation(resourceID, tenantID)
+		k8sOp.Annotations = map[string]string{operation.RetriedByAnnotation: "operator"}
+
+		expectedOp := fixK8SOperation(resourceID, tenantID)
+		expectedOp.Annotations = map[string]string{operation.RetriedByAnnotation: "operator", operation.CancelledByAnnotation: "admin"}
+		expectedOp.Spec = v1alpha1.OperationSpec{
+			OperationType:     v1alpha1.OperationTypeUpdate,
+			OperationCategory: operation.OperationCategoryCancelOperation,
+			ResourceType:      string(resource.Application),
+			ResourceID:        resourceID,
+			CorrelationID:     op.CorrelationID,
+			WebhookIDs:        op.WebhookIDs,
+			RequestObject:     op.RequestObject,
+		}
+
+		cli := &automock.K8SClient{}
+		cli.On("Get", ctx, operationID, metav1.GetOptions{}).Return(k8sOp, nil).Once()
+		cli.On("Update", ctx, expectedOp).Return(expectedOp, nil).Once()
+		u := k8s.NewUpdater(cli)
+
+		// WHEN
+		err := u.Update(ctx, op, annotations)
+		// THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, cli)
+	})
+}
+
+func fixUpdatedOperation() *operation.Operation {
+	return &operation.Operation{
+		OperationID:       operationID,
+		OperationType:     operation.OperationTypeUpdate,
+		OperationCategory: operation.OperationCategoryCancelOperation,
+		ResourceID:        resourceID,
+		ResourceType:      resource.Application,
+		CorrelationID:     "a7c7f3b8-21c5-4a1c-a6bc-3c50b0b0e9a1",
+		WebhookIDs:        []string{firstWebhook},
+		RequestObject:     `{"TenantID":"` + tenantID + `"}`,
+	}
+}
@@ -36,6 +36,8 @@ const (
 	OpCtxKey contextKey = "OperationCtx"
 	// OpModeKey missing godoc
 	OpModeKey contextKey = "OperationModeCtx"
+	// OpHooksKey is the context key of the hooks executed when the transaction of an operation ends
+	OpHooksKey contextKey = "OperationHooksCtx"
 )
 
 // OperationStatus denotes the different statuses that an Operation can be in
@@ -48,6 +50,8 @@ const (
 	OperationStatusFailed OperationStatus = "FAILED"
 	// OperationStatusInProgress missing godoc
 	OperationStatusInProgress OperationStatus = "IN_PROGRESS"
+	// OperationStatusPending is used only for webhooks which wait for the preceding webhooks of an operation to finish
+	OperationStatusPending OperationStatus = "PENDING"
 )
 
 // OperationType missing godoc
@@ -62,6 +66,16 @@ const (
 	OperationTypeDelete OperationType = "Delete"
 )
 
+// WebhookExecutionPolicy defines how the webhooks of an Operation are executed
+type WebhookExecutionPolicy string
+
+const (
+	// WebhookExecutionPolicySequential executes the webhooks one after another in the order of their IDs
+	WebhookExecutionPolicySequential WebhookExecutionPolicy = "Sequential"
+	// WebhookExecutionPolicyParallel executes all webhooks at the same time
+	WebhookExecutionPolicyParallel WebhookExecutionPolicy = "Parallel"
+)
+
 // OperationResponse defines the expected response format for the Operations API
 type OperationResponse struct {
 	*Operation
@@ -74,22 +88,23 @@ type OperationResponse struct {
 // the flow of the original mutation with information such as ResourceID and ResourceType and finally scheduled through
 // a dedicated Scheduler implementation.
 type Operation struct {
-	OperationID       string        `json:"operation_id,omitempty"`
-	OperationType     OperationType `json:"operation_type,omitempty"`
-	OperationCategory string        `json:"operation_category,omitempty"`
-	ResourceID        string        `json:"resource_id,omitempty"`
-	ResourceType      resource.Type `json:"resource_type,omitempty"`
-	CreationTime      time.Time     `json:"creation_time,omitempty"`
-	CorrelationID     string        `json:"correlation_id,omitempty"`
-	WebhookIDs        []string      `json:"webhook_ids,omitempty"`
-	RequestObject     string        `json:"request_object,omitempty"`
+	OperationID            string                 `json:"operation_id,omitempty"`
+	OperationType          OperationType          `json:"operation_type,omitempty"`
+	OperationCategory      string                 `json:"operation_category,omitempty"`
+	ResourceID             string                 `json:"resource_id,omitempty"`
+	ResourceType           resource.Type          `json:"resource_type,omitempty"`
+	CreationTime           time.Time              `json:"creation_time,omitempty"`
+	CorrelationID          string                 `json:"correlation_id,omitempty"`
+	WebhookIDs             []string               `json:"webhook_ids,omitempty"`
+	WebhookExecutionPolicy WebhookExecutionPolicy `json:"webhook_execution_policy,omitempty"`
+	RequestObject          string                 `json:"request_object,omitempty"`
 }
 
 // Validate ensures that the constructed Operation has valid properties
 func (op *Operation) Validate() error {
 	return validation.ValidateStruct(op,
 		validation.Field(&op.ResourceID, is.UUID),
-		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application)))
+		validation.Field(&op.ResourceType, validation.Required, validation.In(resource.Application, resource.Runtime, resource.BundleInstanceAuth)))
 }
 
 // SaveToContext saves Operation to the context
@@ -134,6 +149,55 @@ func ModeFromCtx(ctx context.Context) graphql.OperationMode {
 	return graphql.OperationModeSync
 }
 
+// Hook is a side effect of an operation, e.g. a call to an external system, which is executed only when the transaction of the operation ends
+type Hook func(ctx context.Context)
+
+// Hooks holds the hooks registered during an operation
+type Hooks struct {
+	onCommit   []Hook
+	onRollback []Hook
+}
+
+// SaveHooksToContext saves empty Hooks to the context, so that OnCommit and OnRollback can register hooks in it
+func SaveHooksToContext(ctx context.Context) (context.Context, *Hooks) {
+	hooks := &Hooks{}
+	return context.WithValue(ctx, OpHooksKey, hooks), hooks
+}
+
+// OnCommit registers a hook which is executed after the transaction of the operation is committed.
+// When there are no Hooks in the context, the hook is executed immediately.
+func OnCommit(ctx context.Context, hook Hook) {
+	hooks, ok := ctx.Value(OpHooksKey).(*Hooks)
+	if !ok {
+		hook(ctx)
+		return
+	}
+
+	hooks.onCommit = append(hooks.onCommit, hook)
+}
+
+// OnRollback registers a hook which is executed after the transaction of the operation is rolled back.
+// When there are no Hooks in the context, the hook is ignored.
+func OnRollback(ctx context.Context, hook Hook) {
+	if hooks, ok := ctx.Value(OpHooksKey).(*Hooks); ok {
+		hooks.onRollback = append(hooks.onRollback, hook)
+	}
+}
+
+// Committed executes the hooks registered with OnCommit
+func (h *Hooks) Committed(ctx context.Context) {
+	for _, hook := range h.onCommit {
+		hook(ctx)
+	}
+}
+
+// RolledBack executes the hooks registered with OnRollback
+func (h *Hooks) RolledBack(ctx context.Context) {
+	for _, hook := range h.onRollback {
+		hook(ctx)
+	}
+}
+
 func (opResponse *OperationResponse) initializeOperationType(resource model.Entity) {
 	if !resource.GetDeletedAt().IsZero() {
 		opResponse.OperationType = OperationTypeDelete
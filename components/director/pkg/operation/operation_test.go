@@ -145,3 +145,48 @@ func TestSaveModeToContext(t *testing.T) {
 	// then
 	assert.Equal(t, graphql.OperationModeAsync, result.Value(operation.OpModeKey))
 }
+
+func TestHooks(t *testing.T) {
+	t.Run("Executes commit hooks only when committed", func(t *testing.T) {
+		// given
+		ctx, hooks := operation.SaveHooksToContext(context.TODO())
+		var committed, rolledBack int
+		operation.OnCommit(ctx, func(context.Context) { committed++ })
+		operation.OnRollback(ctx, func(context.Context) { rolledBack++ })
+
+		// when
+		hooks.Committed(ctx)
+
+		// then
+		assert.Equal(t, 1, committed)
+		assert.Equal(t, 0, rolledBack)
+	})
+
+	t.Run("Executes rollback hooks only when rolled back", func(t *testing.T) {
+		// given
+		ctx, hooks := operation.SaveHooksToContext(context.TODO())
+		var committed, rolledBack int
+		operation.OnCommit(ctx, func(context.Context) { committed++ })
+		operation.OnRollback(ctx, func(context.Context) { rolledBack++ })
+
+		// when
+		hooks.RolledBack(ctx)
+
+		// then
+		assert.Equal(t, 0, committed)
+		assert.Equal(t, 1, rolledBack)
+	})
+
+	t.Run("Executes commit hooks immediately and ignores rollback hooks when there are no hooks in the context", func(t *testing.T) {
+		// given
+		var committed, rolledBack int
+
+		// when
+		operation.OnCommit(context.TODO(), func(context.Context) { committed++ })
+		operation.OnRollback(context.TODO(), func(context.Context) { rolledBack++ })
+
+		// then
+		assert.Equal(t, 1, committed)
+		assert.Equal(t, 0, rolledBack)
+	})
+}
@@ -29,3 +29,11 @@ type DisabledScheduler struct{}
 func (d *DisabledScheduler) Schedule(ctx context.Context, _ *Operation) (string, error) {
 	return "", apperrors.NewInvalidOperationError("operation scheduling is currently disabled")
 }
+
+// DisabledUpdater defines an Updater implementation that can be used when asynchronous operations are disabled
+type DisabledUpdater struct{}
+
+// Update returns an error when called
+func (d *DisabledUpdater) Update(_ context.Context, _ *Operation, _ map[string]string) error {
+	return apperrors.NewInvalidOperationError("operation updates are currently disabled")
+}
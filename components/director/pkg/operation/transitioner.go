@@ -0,0 +1,206 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+)
+
+const (
+	// OperationCategoryCancelOperation is the category of operations cancelled while they were in progress.
+	// The Operations Controller finalizes such operations as failed without executing any of their webhooks.
+	OperationCategoryCancelOperation = "cancelOperation"
+	// OperationCategoryRollbackOperation is the category of the delete operations which clean up the resources of cancelled create operations
+	OperationCategoryRollbackOperation = "rollbackOperation"
+
+	// CancelledByAnnotation is the Operation annotation holding the ID of the consumer who cancelled the operation
+	CancelledByAnnotation = "operations.compass/cancelled-by"
+	// RetriedByAnnotation is the Operation annotation holding the ID of the consumer who retried the operation
+	RetriedByAnnotation = "operations.compass/retried-by"
+)
+
+// rollbackWebhookTypes maps the resource types supporting async operations to the webhooks which delete them
+var rollbackWebhookTypes = map[resource.Type]graphql.WebhookType{
+	resource.Application:        graphql.WebhookTypeUnregisterApplication,
+	resource.Runtime:            graphql.WebhookTypeUnregisterRuntime,
+	resource.BundleInstanceAuth: graphql.WebhookTypeBundleInstanceAuthDeletion,
+}
+
+type transitioner struct {
+	webhookFetcherFuncs  map[resource.Type]WebhookFetcherFunc
+	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
+	updater              Updater
+}
+
+// NewTransitioner creates a new object responsible for cancelling and retrying scheduled operations.
+// The status of the resource targeted by an operation is updated together with the operation itself,
+// so the transitioner expects a database transaction to be present in the context.
+func NewTransitioner(webhookFetcherFuncs map[resource.Type]WebhookFetcherFunc, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, updater Updater) *transitioner {
+	return &transitioner{
+		webhookFetcherFuncs:  webhookFetcherFuncs,
+		resourceUpdaterFuncs: resourceUpdaterFuncs,
+		updater:              updater,
+	}
+}
+
+// Cancel stops an operation which is in progress. The resource of a cancelled update or delete operation is marked as failed,
+// while a cancelled create operation is turned into a delete operation which executes the webhooks deleting the resource.
+func (t *transitioner) Cancel(ctx context.Context, details *Details, triggeredBy string) (*Details, error) {
+	if details.Status != OperationStatusInProgress {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s is not in progress", details.OperationID))
+	}
+	if details.OperationCategory == OperationCategoryCancelOperation {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s has already been cancelled", details.OperationID))
+	}
+
+	resourceUpdaterFunc, ok := t.resourceUpdaterFuncs[details.ResourceType]
+	if !ok {
+		return nil, apperrors.NewInternalError("no resource updater is registered for resource type %s", details.ResourceType)
+	}
+
+	op := details.Operation
+	op.CorrelationID = log.C(ctx).Data[log.FieldRequestID].(string)
+
+	if op.OperationType == OperationTypeCreate {
+		webhookIDs, err := t.rollbackWebhookIDs(ctx, &op)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("An error occurred while retrieving webhooks: %v", err)
+			return nil, apperrors.NewInternalError("Unable to retrieve webhooks")
+		}
+
+		op.OperationType = OperationTypeDelete
+		op.OperationCategory = OperationCategoryRollbackOperation
+		op.WebhookIDs = webhookIDs
+
+		if err := resourceUpdaterFunc(ctx, op.ResourceID, false, nil, model.ApplicationStatusConditionDeleting); err != nil {
+			log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s: %v", op.ResourceType, op.ResourceID, err)
+			return nil, apperrors.NewInternalError("Unable to update resource %s with id %s", op.ResourceType, op.ResourceID)
+		}
+	} else {
+		opError, err := stringifiedJSONError(fmt.Sprintf("operation cancelled by %s", triggeredBy))
+		if err != nil {
+			return nil, err
+		}
+
+		appConditionStatus := determineApplicationFinalStatus(&OperationRequest{OperationType: op.OperationType, OperationCategory: op.OperationCategory}, opError)
+		op.OperationCategory = OperationCategoryCancelOperation
+
+		if err := resourceUpdaterFunc(ctx, op.ResourceID, true, opError, appConditionStatus); err != nil {
+			log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s: %v", op.ResourceType, op.ResourceID, err)
+			return nil, apperrors.NewInternalError("Unable to update resource %s with id %s", op.ResourceType, op.ResourceID)
+		}
+	}
+
+	if err := t.updater.Update(ctx, &op, map[string]string{CancelledByAnnotation: triggeredBy}); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while cancelling operation %s: %v", op.OperationID, err)
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Operation %s for %s with id %s has been cancelled by %s", op.OperationID, op.ResourceType, op.ResourceID, triggeredBy)
+	return restartedDetails(op, details.TenantID), nil
+}
+
+// Retry executes the webhooks of a failed operation again and marks the resource targeted by the operation as in progress.
+// Cancelled operations can not be retried as their webhooks have not been executed until completion, the original mutation should be executed again instead.
+func (t *transitioner) Retry(ctx context.Context, details *Details, triggeredBy string) (*Details, error) {
+	if details.Status != OperationStatusFailed {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s has not failed", details.OperationID))
+	}
+	if details.OperationCategory == OperationCategoryCancelOperation {
+		return nil, apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s has been cancelled and can not be retried", details.OperationID))
+	}
+
+	resourceUpdaterFunc, ok := t.resourceUpdaterFuncs[details.ResourceType]
+	if !ok {
+		return nil, apperrors.NewInternalError("no resource updater is registered for resource type %s", details.ResourceType)
+	}
+
+	op := details.Operation
+	op.CorrelationID = log.C(ctx).Data[log.FieldRequestID].(string)
+
+	appConditionStatus, err := determineApplicationInProgressStatus(&op)
+	if err != nil {
+		log.C(ctx).WithError(err).Errorf("While determining the application status condition: %v", err)
+		return nil, err
+	}
+
+	if err := resourceUpdaterFunc(ctx, op.ResourceID, false, nil, *appConditionStatus); err != nil {
+		log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s and status condition %v: %v", op.ResourceType, op.ResourceID, appConditionStatus, err)
+		return nil, apperrors.NewInternalError("Unable to update resource %s with id %s", op.ResourceType, op.ResourceID)
+	}
+
+	if err := t.updater.Update(ctx, &op, map[string]string{RetriedByAnnotation: triggeredBy}); err != nil {
+		log.C(ctx).WithError(err).Errorf("An error occurred while retrying operation %s: %v", op.OperationID, err)
+		return nil, err
+	}
+
+	log.C(ctx).Infof("Operation %s for %s with id %s has been retried by %s", op.OperationID, op.ResourceType, op.ResourceID, triggeredBy)
+	return restartedDetails(op, details.TenantID), nil
+}
+
+func (t *transitioner) rollbackWebhookIDs(ctx context.Context, op *Operation) ([]string, error) {
+	webhookType, ok := rollbackWebhookTypes[op.ResourceType]
+	if !ok {
+		return nil, fmt.Errorf("no rollback webhook type is defined for resource type %s", op.ResourceType)
+	}
+
+	webhookFetcherFunc, ok := t.webhookFetcherFuncs[op.ResourceType]
+	if !ok {
+		return nil, fmt.Errorf("no webhook fetcher is registered for resource type %s", op.ResourceType)
+	}
+
+	webhooks, err := webhookFetcherFunc(ctx, op.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhookIDsOfType(webhooks, webhookType), nil
+}
+
+// restartedDetails describes an operation which has just been updated and is yet to be processed again by the Operations Controller
+func restartedDetails(op Operation, tenantID string) *Details {
+	details := &Details{
+		Operation: op,
+		TenantID:  tenantID,
+		Status:    OperationStatusInProgress,
+		Webhooks:  make([]WebhookDetails, 0, len(op.WebhookIDs)),
+	}
+
+	for _, webhookID := range op.WebhookIDs {
+		details.Webhooks = append(details.Webhooks, WebhookDetails{WebhookID: webhookID, Status: OperationStatusPending})
+	}
+
+	return details
+}
+
+func webhookIDsOfType(webhooks []*model.Webhook, webhookType graphql.WebhookType) []string {
+	webhookIDs := make([]string, 0)
+	for _, currWebhook := range webhooks {
+		if graphql.WebhookType(currWebhook.Type) == webhookType {
+			webhookIDs = append(webhookIDs, currWebhook.ID)
+		}
+	}
+
+	return webhookIDs
+}
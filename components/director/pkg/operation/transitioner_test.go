@@ -0,0 +1,337 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operation_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/director/internal/model"
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
+	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	"github.com/kyma-incubator/compass/components/director/pkg/str"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	triggeredBy      = "admin"
+	newCorrelationID = "a7c7f3b8-21c5-4a1c-a6bc-3c50b0b0e9a1"
+)
+
+type resourceUpdate struct {
+	id              string
+	ready           bool
+	errorMsg        *string
+	statusCondition model.ApplicationStatusCondition
+}
+
+func TestTransitioner_Cancel(t *testing.T) {
+	ctx := log.ContextWithLogger(context.TODO(), logrus.WithField(log.FieldRequestID, newCorrelationID))
+
+	t.Run("when the operation is not in progress it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeUpdate, "updateApplication", operation.OperationStatusFailed)
+		transitioner := operation.NewTransitioner(nil, nil, &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not in progress")
+	})
+
+	t.Run("when the operation has already been cancelled it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeUpdate, operation.OperationCategoryCancelOperation, operation.OperationStatusInProgress)
+		transitioner := operation.NewTransitioner(nil, nil, &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "has already been cancelled")
+	})
+
+	t.Run("when a create operation is cancelled it should be turned into a delete operation", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeCreate, "registerApplication", operation.OperationStatusInProgress)
+		updates := make([]resourceUpdate, 0)
+
+		expectedOperation := details.Operation
+		expectedOperation.OperationType = operation.OperationTypeDelete
+		expectedOperation.OperationCategory = operation.OperationCategoryRollbackOperation
+		expectedOperation.CorrelationID = newCorrelationID
+		expectedOperation.WebhookIDs = []string{webhookID2}
+
+		updater := &automock.Updater{}
+		updater.On("Update", ctx, &expectedOperation, map[string]string{operation.CancelledByAnnotation: triggeredBy}).Return(nil).Once()
+
+		transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, nil), updater)
+
+		// WHEN
+		result, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []resourceUpdate{{id: resourceID, ready: false, statusCondition: model.ApplicationStatusConditionDeleting}}, updates)
+		require.Equal(t, &operation.Details{
+			Operation: expectedOperation,
+			TenantID:  tenantID,
+			Status:    operation.OperationStatusInProgress,
+			Webhooks:  []operation.WebhookDetails{{WebhookID: webhookID2, Status: operation.OperationStatusPending}},
+		}, result)
+		mock.AssertExpectationsForObjects(t, updater)
+	})
+
+	t.Run("when the webhooks of a cancelled create operation can not be fetched it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeCreate, "registerApplication", operation.OperationStatusInProgress)
+		updates := make([]resourceUpdate, 0)
+		webhookFetcherFuncs := map[resource.Type]operation.WebhookFetcherFunc{resource.Application: errorWebhooksResponse}
+
+		transitioner := operation.NewTransitioner(webhookFetcherFuncs, fixResourceUpdaterFuncs(&updates, nil), &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unable to retrieve webhooks")
+		require.Empty(t, updates)
+	})
+
+	testCases := []struct {
+		Name                    string
+		OperationType           operation.OperationType
+		OperationCategory       string
+		ExpectedStatusCondition model.ApplicationStatusCondition
+	}{
+		{
+			Name:                    "when an update operation is cancelled the resource should be marked as failed",
+			OperationType:           operation.OperationTypeUpdate,
+			OperationCategory:       "updateApplication",
+			ExpectedStatusCondition: model.ApplicationStatusConditionUpdateFailed,
+		},
+		{
+			Name:                    "when an unpair operation is cancelled the resource should be marked as failed",
+			OperationType:           operation.OperationTypeUpdate,
+			OperationCategory:       operation.OperationCategoryUnpairApplication,
+			ExpectedStatusCondition: model.ApplicationStatusConditionUnpairFailed,
+		},
+		{
+			Name:                    "when a delete operation is cancelled the resource should be marked as failed",
+			OperationType:           operation.OperationTypeDelete,
+			OperationCategory:       "unregisterApplication",
+			ExpectedStatusCondition: model.ApplicationStatusConditionDeleteFailed,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			// GIVEN
+			details := fixOperationDetails(testCase.OperationType, testCase.OperationCategory, operation.OperationStatusInProgress)
+			updates := make([]resourceUpdate, 0)
+
+			expectedOperation := details.Operation
+			expectedOperation.OperationCategory = operation.OperationCategoryCancelOperation
+			expectedOperation.CorrelationID = newCorrelationID
+
+			updater := &automock.Updater{}
+			updater.On("Update", ctx, &expectedOperation, map[string]string{operation.CancelledByAnnotation: triggeredBy}).Return(nil).Once()
+
+			transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, nil), updater)
+
+			// WHEN
+			result, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+			// THEN
+			require.NoError(t, err)
+			require.Equal(t, []resourceUpdate{{
+				id:              resourceID,
+				ready:           true,
+				errorMsg:        str.Ptr(`{"error":"operation cancelled by admin"}`),
+				statusCondition: testCase.ExpectedStatusCondition,
+			}}, updates)
+			require.Equal(t, expectedOperation, result.Operation)
+			require.Equal(t, operation.OperationStatusInProgress, result.Status)
+			mock.AssertExpectationsForObjects(t, updater)
+		})
+	}
+
+	t.Run("when the resource can not be updated it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeUpdate, "updateApplication", operation.OperationStatusInProgress)
+		updates := make([]resourceUpdate, 0)
+
+		transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, mockedError()), &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Unable to update resource application")
+	})
+
+	t.Run("when the operation can not be updated it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeUpdate, "updateApplication", operation.OperationStatusInProgress)
+		updates := make([]resourceUpdate, 0)
+		expErr := apperrors.NewConcurrentOperationInProgressError("operation has been modified in the meantime")
+
+		updater := &automock.Updater{}
+		updater.On("Update", ctx, mock.Anything, mock.Anything).Return(expErr).Once()
+
+		transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, nil), updater)
+
+		// WHEN
+		_, err := transitioner.Cancel(ctx, details, triggeredBy)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		mock.AssertExpectationsForObjects(t, updater)
+	})
+}
+
+func TestTransitioner_Retry(t *testing.T) {
+	ctx := log.ContextWithLogger(context.TODO(), logrus.WithField(log.FieldRequestID, newCorrelationID))
+
+	t.Run("when the operation has not failed it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeDelete, "unregisterApplication", operation.OperationStatusInProgress)
+		transitioner := operation.NewTransitioner(nil, nil, &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Retry(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "has not failed")
+	})
+
+	t.Run("when the operation has been cancelled it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeUpdate, operation.OperationCategoryCancelOperation, operation.OperationStatusFailed)
+		transitioner := operation.NewTransitioner(nil, nil, &automock.Updater{})
+
+		// WHEN
+		_, err := transitioner.Retry(ctx, details, triggeredBy)
+
+		// THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "can not be retried")
+	})
+
+	t.Run("when a failed operation is retried the resource should be marked as in progress", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeDelete, "unregisterApplication", operation.OperationStatusFailed)
+		updates := make([]resourceUpdate, 0)
+
+		expectedOperation := details.Operation
+		expectedOperation.CorrelationID = newCorrelationID
+
+		updater := &automock.Updater{}
+		updater.On("Update", ctx, &expectedOperation, map[string]string{operation.RetriedByAnnotation: triggeredBy}).Return(nil).Once()
+
+		transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, nil), updater)
+
+		// WHEN
+		result, err := transitioner.Retry(ctx, details, triggeredBy)
+
+		// THEN
+		require.NoError(t, err)
+		require.Equal(t, []resourceUpdate{{id: resourceID, ready: false, statusCondition: model.ApplicationStatusConditionDeleting}}, updates)
+		require.Equal(t, &operation.Details{
+			Operation: expectedOperation,
+			TenantID:  tenantID,
+			Status:    operation.OperationStatusInProgress,
+			Webhooks:  []operation.WebhookDetails{{WebhookID: webhookID2, Status: operation.OperationStatusPending}},
+		}, result)
+		mock.AssertExpectationsForObjects(t, updater)
+	})
+
+	t.Run("when the operation can not be updated it should fail", func(t *testing.T) {
+		// GIVEN
+		details := fixOperationDetails(operation.OperationTypeCreate, "registerApplication", operation.OperationStatusFailed)
+		updates := make([]resourceUpdate, 0)
+		expErr := errors.New("error")
+
+		updater := &automock.Updater{}
+		updater.On("Update", ctx, mock.Anything, mock.Anything).Return(expErr).Once()
+
+		transitioner := operation.NewTransitioner(fixWebhookFetcherFuncs(), fixResourceUpdaterFuncs(&updates, nil), updater)
+
+		// WHEN
+		_, err := transitioner.Retry(ctx, details, triggeredBy)
+
+		// THEN
+		require.Equal(t, expErr, err)
+		require.Equal(t, []resourceUpdate{{id: resourceID, ready: false, statusCondition: model.ApplicationStatusConditionCreating}}, updates)
+		mock.AssertExpectationsForObjects(t, updater)
+	})
+}
+
+func fixOperationDetails(operationType operation.OperationType, operationCategory string, status operation.OperationStatus) *operation.Details {
+	return &operation.Details{
+		Operation: operation.Operation{
+			OperationID:       operationID,
+			OperationType:     operationType,
+			OperationCategory: operationCategory,
+			ResourceID:        resourceID,
+			ResourceType:      resource.Application,
+			CorrelationID:     "3bfc0a8c-07b0-4bd5-9ab5-3e4b2a9b4f86",
+			WebhookIDs:        []string{webhookID2},
+			RequestObject:     `{"TenantID":"` + tenantID + `"}`,
+		},
+		TenantID: tenantID,
+		Status:   status,
+		Error:    str.Ptr("webhook failed"),
+		Webhooks: []operation.WebhookDetails{{WebhookID: webhookID2, Status: status}},
+	}
+}
+
+func fixWebhookFetcherFuncs() map[resource.Type]operation.WebhookFetcherFunc {
+	return map[resource.Type]operation.WebhookFetcherFunc{
+		resource.Application: func(_ context.Context, _ string) ([]*model.Webhook, error) {
+			return []*model.Webhook{
+				{ID: webhookID1, Type: model.WebhookTypeRegisterApplication},
+				{ID: webhookID2, Type: model.WebhookTypeDeleteApplication},
+			}, nil
+		},
+	}
+}
+
+func fixResourceUpdaterFuncs(updates *[]resourceUpdate, err error) map[resource.Type]operation.ResourceUpdaterFunc {
+	return map[resource.Type]operation.ResourceUpdaterFunc{
+		resource.Application: func(_ context.Context, id string, ready bool, errorMsg *string, appStatusCondition model.ApplicationStatusCondition) error {
+			if err != nil {
+				return err
+			}
+
+			*updates = append(*updates, resourceUpdate{id: id, ready: ready, errorMsg: errorMsg, statusCondition: appStatusCondition})
+			return nil
+		},
+	}
+}
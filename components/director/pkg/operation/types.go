@@ -9,3 +9,16 @@ import (
 type Scheduler interface {
 	Schedule(ctx context.Context, op *Operation) (string, error)
 }
+
+// Updater is responsible for updating the specification of an already scheduled Operation entity,
+// which makes the Operation be processed again from the beginning
+//go:generate mockery --name=Updater --output=automock --outpkg=automock --case=underscore
+type Updater interface {
+	Update(ctx context.Context, op *Operation, annotations map[string]string) error
+}
+
+// Fetcher is responsible for fetching the progress of an already scheduled Operation entity
+//go:generate mockery --name=Fetcher --output=automock --outpkg=automock --case=underscore
+type Fetcher interface {
+	GetByID(ctx context.Context, id string) (*Details, error)
+}
@@ -19,6 +19,7 @@ package operation
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -40,11 +41,13 @@ import (
 
 // OperationRequest is the expected request body when updating certain operation status
 type OperationRequest struct {
-	OperationType     OperationType `json:"operation_type,omitempty"`
-	ResourceType      resource.Type `json:"resource_type"`
-	ResourceID        string        `json:"resource_id"`
-	Error             string        `json:"error"`
-	OperationCategory string        `json:"operation_category,omitempty"`
+	OperationID         string        `json:"operation_id"`
+	OperationGeneration int64         `json:"operation_generation"`
+	OperationType       OperationType `json:"operation_type,omitempty"`
+	ResourceType        resource.Type `json:"resource_type"`
+	ResourceID          string        `json:"resource_id"`
+	Error               string        `json:"error"`
+	OperationCategory   string        `json:"operation_category,omitempty"`
 }
 
 // ResourceUpdaterFunc defines a function which updates a particular resource ready and error status
@@ -57,6 +60,7 @@ type updateOperationHandler struct {
 	transact             persistence.Transactioner
 	resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc
 	resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc
+	fetcher              Fetcher
 }
 
 type errResponse struct {
@@ -71,12 +75,14 @@ type operationError struct {
 // OperationCategoryUnpairApplication Operation category for unpair application mutation. It's used determine the operation status
 const OperationCategoryUnpairApplication = "unpairApplication"
 
-// NewUpdateOperationHandler creates a new handler struct to update resource by operation
-func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc) *updateOperationHandler {
+// NewUpdateOperationHandler creates a new handler struct to update resource by operation.
+// The fetcher is used to reject the results of operations which have been cancelled or retried in the meantime.
+func NewUpdateOperationHandler(transact persistence.Transactioner, resourceUpdaterFuncs map[resource.Type]ResourceUpdaterFunc, resourceDeleterFuncs map[resource.Type]ResourceDeleterFunc, fetcher Fetcher) *updateOperationHandler {
 	return &updateOperationHandler{
 		transact:             transact,
 		resourceUpdaterFuncs: resourceUpdaterFuncs,
 		resourceDeleterFuncs: resourceDeleterFuncs,
+		fetcher:              fetcher,
 	}
 }
 
@@ -96,24 +102,42 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 	}
 
 	if err := validation.ValidateStruct(operation,
+		validation.Field(&operation.OperationID, validation.Required),
+		validation.Field(&operation.OperationGeneration, validation.Required),
 		validation.Field(&operation.ResourceID, is.UUID),
 		validation.Field(&operation.OperationType, validation.Required, validation.In(OperationTypeCreate, OperationTypeUpdate, OperationTypeDelete)),
-		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application))); err != nil {
+		validation.Field(&operation.ResourceType, validation.Required, validation.In(resource.Application, resource.Runtime, resource.BundleInstanceAuth))); err != nil {
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Invalid operation properties: %s", err), http.StatusBadRequest)
 		return
 	}
 
+	resourceUpdaterFunc := h.resourceUpdaterFuncs[operation.ResourceType]
+	resourceDeleterFunc := h.resourceDeleterFuncs[operation.ResourceType]
+	if resourceUpdaterFunc == nil && resourceDeleterFunc == nil {
+		apperrors.WriteAppError(ctx, writer, apperrors.NewInvalidDataError("Unsupported resource type %s", operation.ResourceType), http.StatusBadRequest)
+		return
+	}
+
+	if errResp := h.ensureOperationIsCurrent(ctx, operation); errResp != nil {
+		apperrors.WriteAppError(ctx, writer, errResp.err, errResp.statusCode)
+		return
+	}
+
 	tx, err := h.transact.Begin()
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while opening db transaction: %s", err.Error())
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to establish connection with database"), http.StatusInternalServerError)
 		return
 	}
-	defer h.transact.RollbackUnlessCommitted(ctx, tx)
+	ctx, hooks := SaveHooksToContext(ctx)
+	defer func() {
+		if didRollback := h.transact.RollbackUnlessCommitted(ctx, tx); didRollback {
+			hooks.RolledBack(ctx)
+		}
+	}()
 
 	ctx = persistence.SaveToContext(ctx, tx)
 
-	resourceUpdaterFunc := h.resourceUpdaterFuncs[operation.ResourceType]
 	opError, err := stringifiedJSONError(operation.Error)
 	if err != nil {
 		log.C(ctx).WithError(err).Errorf("An error occurred while marshalling operation error: %s", err.Error())
@@ -132,7 +156,6 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 			return
 		}
 	case OperationTypeDelete:
-		resourceDeleterFunc := h.resourceDeleterFuncs[operation.ResourceType]
 		if operation.Error != "" {
 			if err := resourceUpdaterFunc(ctx, operation.ResourceID, true, opError, appConditionStatus); err != nil {
 				log.C(ctx).WithError(err).Errorf("While updating resource %s with id %s: %v", operation.ResourceType, operation.ResourceID, err)
@@ -153,6 +176,7 @@ func (h *updateOperationHandler) ServeHTTP(writer http.ResponseWriter, request *
 		apperrors.WriteAppError(ctx, writer, apperrors.NewInternalError("Unable to finalize database operation"), http.StatusInternalServerError)
 		return
 	}
+	hooks.Committed(ctx)
 
 	writer.WriteHeader(http.StatusOK)
 }
@@ -178,6 +202,27 @@ func operationRequestFromBody(ctx context.Context, request *http.Request) (*Oper
 	return &operation, nil
 }
 
+// ensureOperationIsCurrent checks that the operation has not been changed since the Operations Controller processed it.
+// Cancelling or retrying an operation changes its category or generation, so the results of a stale reconciliation are rejected.
+func (h *updateOperationHandler) ensureOperationIsCurrent(ctx context.Context, operation *OperationRequest) *errResponse {
+	details, err := h.fetcher.GetByID(ctx, operation.OperationID)
+	if err != nil {
+		if apperrors.IsNotFoundError(err) {
+			return &errResponse{apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s does not exist", operation.OperationID)), http.StatusConflict}
+		}
+		log.C(ctx).WithError(err).Errorf("An error occurred while fetching operation %s: %v", operation.OperationID, err)
+		return &errResponse{apperrors.NewInternalError("Unable to fetch operation %s", operation.OperationID), http.StatusInternalServerError}
+	}
+
+	if details.OperationCategory != operation.OperationCategory || details.Generation != operation.OperationGeneration {
+		log.C(ctx).Infof("Rejecting the result of operation %s with category %q and generation %d, as the operation has category %q and generation %d",
+			operation.OperationID, operation.OperationCategory, operation.OperationGeneration, details.OperationCategory, details.Generation)
+		return &errResponse{apperrors.NewInvalidOperationError(fmt.Sprintf("operation %s has been changed in the meantime", operation.OperationID)), http.StatusConflict}
+	}
+
+	return nil
+}
+
 func stringifiedJSONError(errorMsg string) (*string, error) {
 	if len(errorMsg) == 0 {
 		return nil, nil
@@ -27,20 +27,25 @@ import (
 
 	"github.com/kyma-incubator/compass/components/director/internal/model"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/kyma-incubator/compass/components/director/pkg/apperrors"
 	"github.com/kyma-incubator/compass/components/director/pkg/operation"
+	"github.com/kyma-incubator/compass/components/director/pkg/operation/automock"
 	"github.com/kyma-incubator/compass/components/director/pkg/persistence/txtest"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
 )
 
+const operationGeneration = 2
+
 func TestUpdateOperationHandler(t *testing.T) {
 	t.Run("when request method is not PUT it should return method not allowed", func(t *testing.T) {
 		writer := httptest.NewRecorder()
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "/", nil)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Method not allowed")
@@ -53,7 +58,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "/", reader)
 		require.NoError(t, err)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Unable to decode body to JSON")
@@ -64,7 +69,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 		writer := httptest.NewRecorder()
 		req := fixPostRequestWithBody(t, context.Background(), `{}`)
 
-		handler := operation.NewUpdateOperationHandler(nil, nil, nil)
+		handler := operation.NewUpdateOperationHandler(nil, nil, nil, nil)
 		handler.ServeHTTP(writer, req)
 
 		require.Contains(t, writer.Body.String(), "Invalid operation properties")
@@ -73,7 +78,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 
 	t.Run("when transaction fails to begin it should return internal server error", func(t *testing.T) {
 		writer := httptest.NewRecorder()
-		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeCreate))
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, operation.OperationTypeCreate))
 
 		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnBegin()
 		defer mockedTx.AssertExpectations(t)
@@ -83,7 +88,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, fixCurrentOperationFetcher())
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -92,7 +97,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 
 	t.Run("when transaction fails to commit it should return internal server error", func(t *testing.T) {
 		writer := httptest.NewRecorder()
-		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeCreate))
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, operation.OperationTypeCreate))
 
 		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnCommit()
 		defer mockedTx.AssertExpectations(t)
@@ -102,7 +107,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return nil
 			},
-		}, nil)
+		}, nil, fixCurrentOperationFetcher())
 		handler.ServeHTTP(writer, req)
 
 		require.Equal(t, http.StatusInternalServerError, writer.Code)
@@ -111,7 +116,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 
 	t.Run("when update handler fails on CREATE/UPDATE operation", func(t *testing.T) {
 		writer := httptest.NewRecorder()
-		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeCreate))
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, operation.OperationTypeCreate))
 
 		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
 		defer mockedTx.AssertExpectations(t)
@@ -121,7 +126,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
 				return errors.New("failed to update")
 			},
-		}, nil)
+		}, nil, fixCurrentOperationFetcher())
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -131,7 +136,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 
 	t.Run("when delete handler fails on DELETE operation", func(t *testing.T) {
 		writer := httptest.NewRecorder()
-		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, resourceID, resource.Application, operation.OperationTypeDelete))
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, operation.OperationTypeDelete))
 
 		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatDoesntExpectCommit()
 		defer mockedTx.AssertExpectations(t)
@@ -141,7 +146,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			resource.Application: func(ctx context.Context, id string) error {
 				return errors.New("failed to delete")
 			},
-		})
+		}, fixCurrentOperationFetcher())
 		handler.ServeHTTP(writer, req)
 
 		mockedTx.AssertNotCalled(t, "Commit")
@@ -213,7 +218,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 			t.Run(testCase.Name, func(t *testing.T) {
 				writer := httptest.NewRecorder()
 				expectedErrorMsg := testCase.ExpectedError
-				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "error": "%s"}`, resourceID, resource.Application, testCase.OperationType, expectedErrorMsg))
+				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s", "error": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, testCase.OperationType, expectedErrorMsg))
 
 				updateCalled := 0
 				deleteCalled := 0
@@ -236,7 +241,7 @@ func TestUpdateOperationHandler(t *testing.T) {
 						deleteCalled++
 						return nil
 					},
-				})
+				}, fixCurrentOperationFetcher())
 
 				handler.ServeHTTP(writer, req)
 				require.Equal(t, testCase.UpdateCalled, updateCalled)
@@ -245,6 +250,118 @@ func TestUpdateOperationHandler(t *testing.T) {
 			})
 		}
 	})
+	t.Run("when the resource is deleted the commit hooks should be executed after commit", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Runtime, operation.OperationTypeDelete))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(nil).ThatSucceeds()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		committed := false
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, nil, map[resource.Type]operation.ResourceDeleterFunc{
+			resource.Runtime: func(ctx context.Context, id string) error {
+				operation.OnCommit(ctx, func(context.Context) {
+					mockedTx.AssertCalled(t, "Commit")
+					committed = true
+				})
+				return nil
+			},
+		}, fixCurrentOperationFetcher())
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusOK, writer.Code)
+		require.True(t, committed)
+	})
+
+	t.Run("when transaction fails to commit the commit hooks should not be executed", func(t *testing.T) {
+		writer := httptest.NewRecorder()
+		req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Runtime, operation.OperationTypeDelete))
+
+		mockedTx, mockedTransactioner := txtest.NewTransactionContextGenerator(mockedError()).ThatFailsOnCommit()
+		defer mockedTx.AssertExpectations(t)
+		defer mockedTransactioner.AssertExpectations(t)
+
+		committed := false
+		handler := operation.NewUpdateOperationHandler(mockedTransactioner, nil, map[resource.Type]operation.ResourceDeleterFunc{
+			resource.Runtime: func(ctx context.Context, id string) error {
+				operation.OnCommit(ctx, func(context.Context) { committed = true })
+				return nil
+			},
+		}, fixCurrentOperationFetcher())
+		handler.ServeHTTP(writer, req)
+
+		require.Equal(t, http.StatusInternalServerError, writer.Code)
+		require.False(t, committed)
+	})
+	t.Run("when the operation has been changed in the meantime it should reject the result", func(t *testing.T) {
+		type testCase struct {
+			Name           string
+			Details        *operation.Details
+			FetcherErr     error
+			ExpectedStatus int
+			ExpectedError  string
+		}
+		cases := []testCase{
+			{
+				Name:           "with a newer generation",
+				Details:        &operation.Details{Operation: operation.Operation{OperationID: operationID}, Generation: operationGeneration + 1},
+				ExpectedStatus: http.StatusConflict,
+				ExpectedError:  "has been changed in the meantime",
+			},
+			{
+				Name:           "with a different category",
+				Details:        &operation.Details{Operation: operation.Operation{OperationID: operationID, OperationCategory: operation.OperationCategoryCancelOperation}, Generation: operationGeneration},
+				ExpectedStatus: http.StatusConflict,
+				ExpectedError:  "has been changed in the meantime",
+			},
+			{
+				Name:           "which does not exist",
+				FetcherErr:     apperrors.NewNotFoundError(resource.Operation, operationID),
+				ExpectedStatus: http.StatusConflict,
+				ExpectedError:  "does not exist",
+			},
+			{
+				Name:           "which cannot be fetched",
+				FetcherErr:     errors.New("failed to fetch"),
+				ExpectedStatus: http.StatusInternalServerError,
+				ExpectedError:  "Unable to fetch operation",
+			},
+		}
+
+		for _, testCase := range cases {
+			t.Run(testCase.Name, func(t *testing.T) {
+				writer := httptest.NewRecorder()
+				req := fixPostRequestWithBody(t, context.Background(), fmt.Sprintf(`{"operation_id": "%s", "operation_generation": %d, "resource_id": "%s", "resource_type": "%s", "operation_type": "%s"}`, operationID, operationGeneration, resourceID, resource.Application, operation.OperationTypeCreate))
+
+				fetcher := fixOperationFetcher(testCase.Details, testCase.FetcherErr)
+				handler := operation.NewUpdateOperationHandler(nil, map[resource.Type]operation.ResourceUpdaterFunc{
+					resource.Application: func(ctx context.Context, id string, ready bool, errorMsg *string, appConditionStatus model.ApplicationStatusCondition) error {
+						require.FailNow(t, "the resource of a changed operation must not be updated")
+						return nil
+					},
+				}, nil, fetcher)
+				handler.ServeHTTP(writer, req)
+
+				require.Equal(t, testCase.ExpectedStatus, writer.Code)
+				require.Contains(t, writer.Body.String(), testCase.ExpectedError)
+				fetcher.AssertExpectations(t)
+			})
+		}
+	})
+}
+
+func fixCurrentOperationFetcher() *automock.Fetcher {
+	return fixOperationFetcher(&operation.Details{
+		Operation:  operation.Operation{OperationID: operationID},
+		Generation: operationGeneration,
+	}, nil)
+}
+
+func fixOperationFetcher(details *operation.Details, err error) *automock.Fetcher {
+	fetcher := &automock.Fetcher{}
+	fetcher.On("GetByID", mock.Anything, operationID).Return(details, err)
+	return fetcher
 }
 
 func fixPostRequestWithBody(t *testing.T, ctx context.Context, body string) *http.Request {
@@ -36,6 +36,10 @@ const (
 	Vendor Type = "vendor"
 	// Tombstone type represents tombstone resource.
 	Tombstone Type = "tombstone"
+	// ORDAggregationStatus type represents ORD aggregation status resource.
+	ORDAggregationStatus Type = "ordAggregationStatus"
+	// ORDAggregationRequest type represents on-demand ORD aggregation request resource.
+	ORDAggregationRequest Type = "ordAggregationRequest"
 	// IntegrationSystem type represents integration system resource.
 	IntegrationSystem Type = "integrationSystem"
 	// SystemAuth type represents system auth resource.
@@ -54,6 +58,8 @@ const (
 	APISpecification Type = "apiSpecification"
 	// EventSpecification type represents Event specification resource.
 	EventSpecification Type = "eventSpecification"
+	// SpecRevision type represents API and Event specification revision resource.
+	SpecRevision Type = "specRevision"
 	// Document type represents document resource.
 	Document Type = "document"
 	// BundleInstanceAuth type represents bundle instance auth resource.
@@ -74,6 +80,8 @@ const (
 	Tenant Type = "tenant"
 	// TenantAccess type represents tenant access resource.
 	TenantAccess Type = "tenantAccess"
+	// Operation type represents asynchronous operation resource.
+	Operation Type = "operation"
 	// Schema type represents schema resource.
 	Schema Type = "schemaMigration"
 )
@@ -96,11 +104,13 @@ var tenantAccessTable = map[Type]string{
 	Product:               "products_tenants",
 	Vendor:                "vendors_tenants",
 	Tombstone:             "tombstones_tenants",
+	ORDAggregationStatus:  "ord_aggregation_statuses_tenants",
 	DocFetchRequest:       "document_fetch_requests_tenants",
 	APISpecFetchRequest:   "api_specifications_fetch_requests_tenants",
 	EventSpecFetchRequest: "event_specifications_fetch_requests_tenants",
 	APISpecification:      "api_specifications_tenants",
 	EventSpecification:    "event_specifications_tenants",
+	SpecRevision:          "specification_revisions_tenants",
 	Document:              "documents_tenants",
 	BundleInstanceAuth:    "bundle_instance_auths_tenants",
 	API:                   "api_definitions_tenants",
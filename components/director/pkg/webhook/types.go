@@ -41,9 +41,11 @@ type Resource interface {
 
 // RequestObject struct contains parts of request that might be needed for later processing of a Webhook request
 type RequestObject struct {
-	Application Resource
-	TenantID    string
-	Headers     map[string]string
+	Application        Resource
+	Runtime            Resource
+	BundleInstanceAuth Resource
+	TenantID           string
+	Headers            map[string]string
 }
 
 // ResponseObject struct contains parts of response that might be needed for later processing of Webhook response
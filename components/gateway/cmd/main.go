@@ -12,11 +12,15 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
+	directorgraphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
 	timeouthandler "github.com/kyma-incubator/compass/components/director/pkg/handler"
 	httputil "github.com/kyma-incubator/compass/components/director/pkg/http"
 	"github.com/kyma-incubator/compass/components/director/pkg/log"
 	"github.com/kyma-incubator/compass/components/director/pkg/signal"
 	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/dataaccess"
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/gateway/internal/redaction"
 	timeservices "github.com/kyma-incubator/compass/components/gateway/internal/time"
 	"github.com/kyma-incubator/compass/components/gateway/internal/uuid"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
@@ -28,6 +32,8 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 )
 
+const spoolMetricsInterval = 15 * time.Second
+
 type config struct {
 	Address string `envconfig:"default=127.0.0.1:3000"`
 
@@ -39,6 +45,8 @@ type config struct {
 	ConnectorOrigin string `envconfig:"default=http://127.0.0.1:3002"`
 	MetricsAddress  string `envconfig:"default=127.0.0.1:3003"`
 	AuditlogEnabled bool   `envconfig:"default=false"`
+
+	RateLimitEnabled bool `envconfig:"default=false"`
 }
 
 func main() {
@@ -63,23 +71,43 @@ func main() {
 
 	var auditlogSink proxy.AuditlogService
 	var auditlogSvc proxy.PreAuditlogService
+	var redactor proxy.Redactor
+	var detector proxy.SensitiveFieldDetector
 	if cfg.AuditlogEnabled {
 		logger.Infoln("Auditlog is enabled")
 		auditlogSink, auditlogSvc, err = initAuditLogs(ctx, metricsCollector)
 		exitOnError(err, "Error while initializing auditlog service")
+
+		redactor, err = initRedactor()
+		exitOnError(err, "Error while initializing auditlog redaction")
+
+		detector, err = initDetector()
+		exitOnError(err, "Error while initializing auditlog sensitive field detection")
 	} else {
 		logger.Infoln("Auditlog is disabled")
 		auditlogSink = &auditlog.NoOpService{}
 		auditlogSvc = &auditlog.NoOpService{}
+		redactor = &redaction.NoOpRedactor{}
+		detector = &dataaccess.NoOpDetector{}
 	}
 
 	correlationTr := httputil.NewCorrelationIDTransport(http.DefaultTransport)
-	tr := proxy.NewTransport(auditlogSink, auditlogSvc, correlationTr)
+	tr := proxy.NewTransport(auditlogSink, auditlogSvc, redactor, detector, correlationTr)
+
+	var middleware []mux.MiddlewareFunc
+	if cfg.RateLimitEnabled {
+		logger.Infoln("Rate limiting is enabled")
+		rateLimitMiddleware, err := initRateLimits()
+		exitOnError(err, "Error while initializing rate limits")
+		middleware = append(middleware, rateLimitMiddleware)
+	} else {
+		logger.Infoln("Rate limiting is disabled")
+	}
 
-	err = proxyRequestsForComponent(ctx, router, "/connector", cfg.ConnectorOrigin, tr)
+	err = proxyRequestsForComponent(ctx, router, "/connector", cfg.ConnectorOrigin, tr, middleware...)
 	exitOnError(err, "Error while initializing proxy for Connector")
 
-	err = proxyRequestsForComponent(ctx, router, "/director", cfg.DirectorOrigin, tr)
+	err = proxyRequestsForComponent(ctx, router, "/director", cfg.DirectorOrigin, tr, middleware...)
 	exitOnError(err, "Error while initializing proxy for Director")
 
 	router.HandleFunc("/healthz", func(writer http.ResponseWriter, request *http.Request) {
@@ -241,14 +269,72 @@ func initAuditLogs(ctx context.Context, collector *metrics.AuditlogCollector) (p
 	}
 
 	auditlogSvc := auditlog.NewService(auditlogClient, msgFactory)
-	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
 	workers := make(chan bool, cfg.WriteWorkers)
+
+	if cfg.SpoolDir != "" {
+		spool, err := auditlog.OpenSpool(cfg.SpoolDir, cfg.SpoolSegmentSize, cfg.SpoolMaxSize, cfg.SpoolRetryInterval, collector)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while opening auditlog spool")
+		}
+		size, age := spool.Backlog()
+		log.C(ctx).Infof("Auditlog spool opened in %s, replaying %d undelivered messages (oldest is %s old)", cfg.SpoolDir, size, age)
+
+		initSpoolWorkers(ctx, workers, auditlogSvc, spool, cfg.SpoolRetryInterval)
+		go spool.ReportBacklog(ctx, spoolMetricsInterval)
+
+		log.C(ctx).Infof("Auditlog configured successfully, auth mode: %s", cfg.AuthMode)
+		return spool, auditlogSvc, nil
+	}
+
+	msgChannel := make(chan proxy.AuditlogMessage, cfg.MsgChannelSize)
 	initWorkers(ctx, workers, auditlogSvc, msgChannel, collector)
 
 	log.C(ctx).Infof("Auditlog configured successfully, auth mode: %s", cfg.AuthMode)
 	return auditlog.NewSink(msgChannel, cfg.MsgChannelTimeout, collector), auditlogSvc, nil
 }
 
+// initRedactor creates a redactor which removes credentials from the audit log messages based on the Director GraphQL schema.
+func initRedactor() (*redaction.Redactor, error) {
+	cfg := redaction.Config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading auditlog redaction cfg")
+	}
+
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+	return redaction.NewRedactor(schema, cfg)
+}
+
+// initDetector creates a detector which finds the sensitive fields read by GraphQL queries based on the Director GraphQL schema.
+func initDetector() (*dataaccess.Detector, error) {
+	cfg := dataaccess.Config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading auditlog sensitive fields cfg")
+	}
+
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+	return dataaccess.NewDetector(schema, cfg)
+}
+
+func initRateLimits() (mux.MiddlewareFunc, error) {
+	cfg := ratelimit.Config{}
+	err := envconfig.InitWithPrefix(&cfg, "APP")
+	if err != nil {
+		return nil, errors.Wrap(err, "while loading rate limit cfg")
+	}
+
+	limiter, err := ratelimit.NewLimiter(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	collector := metrics.NewRateLimitMetricCollector()
+	prometheus.MustRegister(collector)
+
+	return ratelimit.Middleware(limiter, collector, &timeservices.TimeService{}), nil
+}
+
 func fillJWTCredentials(cfg auditlog.OAuthConfig) clientcredentials.Config {
 	return clientcredentials.Config{
 		ClientID:     cfg.ClientID,
@@ -278,3 +364,33 @@ func initWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.Audit
 		}
 	}()
 }
+
+// initSpoolWorkers keeps the workers delivering the spooled auditlog messages running.
+// A worker which stopped because the spool could not be read is restarted after the retry interval, so that a persistent error does not keep the worker restarting.
+func initSpoolWorkers(ctx context.Context, workers chan bool, auditlogSvc proxy.AuditlogService, spool *auditlog.Spool, retryInterval time.Duration) {
+	logger := log.C(ctx)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infoln("Spool worker starter goroutine finished")
+				return
+			case workers <- true:
+			}
+			worker := auditlog.NewSpoolWorker(auditlogSvc, spool)
+			go func() {
+				logger.Infoln("Starting worker for spooled auditlog message processing")
+				worker.Start(ctx)
+				if ctx.Err() == nil {
+					logger.Infof("Restarting worker for spooled auditlog message processing in %s", retryInterval)
+					select {
+					case <-ctx.Done():
+					case <-time.After(retryInterval):
+					}
+				}
+				<-workers
+			}()
+		}
+	}()
+}
@@ -0,0 +1,19 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package automock
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SpoolMetricCollector is an autogenerated mock type for the SpoolMetricCollector type
+type SpoolMetricCollector struct {
+	mock.Mock
+}
+
+// SetSpoolBacklog provides a mock function with given fields: size, age
+func (_m *SpoolMetricCollector) SetSpoolBacklog(size int, age time.Duration) {
+	_m.Called(size, age)
+}
@@ -11,6 +11,11 @@ type Config struct {
 	MsgChannelSize    int           `envconfig:"APP_AUDITLOG_CHANNEL_SIZE,default=100"`
 	MsgChannelTimeout time.Duration `envconfig:"APP_AUDITLOG_CHANNEL_TIMEOUT,default=5s"`
 	WriteWorkers      int           `envconfig:"APP_AUDITLOG_WRITE_WORKERS,default=5"`
+
+	SpoolDir           string        `envconfig:"APP_AUDITLOG_SPOOL_DIR,optional"`
+	SpoolSegmentSize   int64         `envconfig:"APP_AUDITLOG_SPOOL_SEGMENT_SIZE,default=8388608"`
+	SpoolMaxSize       int64         `envconfig:"APP_AUDITLOG_SPOOL_MAX_SIZE,default=1073741824"`
+	SpoolRetryInterval time.Duration `envconfig:"APP_AUDITLOG_SPOOL_RETRY_INTERVAL,default=10s"`
 }
 
 type BasicAuthConfig struct {
@@ -2,6 +2,7 @@ package auditlog_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/correlation"
@@ -32,13 +33,16 @@ func fixFabricatedSecurityEventMsg() model.SecurityEvent {
 	}}
 }
 
-func fixSuccessConfigChangeMsg(claims proxy.Claims, request, response string, auditlogType string) model.ConfigurationChange {
+func fixSuccessConfigChangeMsg(claims proxy.Claims, request, response string, auditlogType string, operation proxy.Operation) model.ConfigurationChange {
 	msg := fixFabricatedConfigChangeMsg()
 	msg.Object = model.Object{ID: fillID(claims, "Config Change")}
 	msg.Attributes = []model.Attribute{
 		{Name: "auditlog_type", Old: "", New: auditlogType},
 		{Name: "request", Old: "", New: request},
 		{Name: "correlation_id", Old: "", New: fixCorrelationID()[correlation.RequestIDHeaderKey]},
+		{Name: "operation_name", Old: "", New: operation.Name},
+		{Name: "operation_type", Old: "", New: operation.Type},
+		{Name: "operation_fields", Old: "", New: strings.Join(operation.Fields, ",")},
 		{Name: "response", Old: "", New: response},
 	}
 
@@ -60,6 +64,21 @@ func fixSecurityEventMsg(t *testing.T, errors []model.ErrorMessage, claims proxy
 
 }
 
+func fixDataAccessEventMsg(t *testing.T, fields []string, claims proxy.Claims, correlationID string) model.SecurityEvent {
+	msgData := model.DataAccessEventData{
+		ID:            fillID(claims, "Data Access"),
+		CorrelationID: correlationID,
+		Operation:     "applicationAuths",
+		Fields:        fields,
+	}
+	data, err := json.Marshal(&msgData)
+	require.NoError(t, err)
+
+	msg := fixFabricatedSecurityEventMsg()
+	msg.Data = string(data)
+	return msg
+}
+
 func fillID(claims proxy.Claims, name string) map[string]string {
 	return map[string]string{
 		"name":           name,
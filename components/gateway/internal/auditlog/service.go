@@ -84,12 +84,16 @@ func NewService(client AuditlogClient, msgFactory AuditlogMessageFactory) *Servi
 
 func (svc *Service) PreLog(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PreAuditlogOperation)
+	configChangeMsg := svc.createConfigChangeMsg(msg, correlationID, PreAuditlogOperation)
 	err := svc.client.LogConfigurationChange(ctx, configChangeMsg)
 	return errors.Wrap(err, "while sending configuration pre-change")
 }
 
 func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
+	if msg.Operation.Type == proxy.QueryOperation && len(msg.SensitiveFields) > 0 {
+		return svc.logDataAccess(ctx, msg)
+	}
+
 	graphqlResponse, err := svc.parseResponse(msg.Response)
 	if err != nil {
 		return errors.Wrap(err, "while parsing response")
@@ -98,7 +102,7 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 	correlationID := msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey]
 
 	if len(graphqlResponse.Errors) == 0 {
-		configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+		configChangeMsg := svc.createConfigChangeMsg(msg, correlationID, PostAuditlogOperation)
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
 				Name: "response",
@@ -127,12 +131,8 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 		return errors.Wrap(err, "while sending security event to auditlog")
 	}
 
-	isReadErr, err := isReadError(graphqlResponse, msg.Request)
-	if err != nil {
-		return errors.Wrap(err, "while checking if error is read error")
-	}
-
-	configChangeMsg := svc.createConfigChangeMsg(msg.Claims, msg.Request, correlationID, PostAuditlogOperation)
+	isReadErr := isReadError(graphqlResponse, msg.Operation)
+	configChangeMsg := svc.createConfigChangeMsg(msg, correlationID, PostAuditlogOperation)
 	if isReadErr {
 		configChangeMsg.Attributes = append(configChangeMsg.Attributes,
 			model.Attribute{
@@ -155,6 +155,24 @@ func (svc *Service) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
 	return errors.Wrap(err, "while sending configuration change")
 }
 
+func (svc *Service) logDataAccess(ctx context.Context, msg proxy.AuditlogMessage) error {
+	securityEventMsg := svc.msgFactory.CreateSecurityEvent()
+	eventData := model.DataAccessEventData{
+		ID:            fillID(msg.Claims, "Data Access"),
+		CorrelationID: msg.CorrelationIDHeaders[correlation.RequestIDHeaderKey],
+		Operation:     msg.Operation.Name,
+		Fields:        msg.SensitiveFields,
+	}
+	data, err := json.Marshal(&eventData)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling data access event data")
+	}
+
+	securityEventMsg.Data = string(data)
+	err = svc.client.LogSecurityEvent(ctx, securityEventMsg)
+	return errors.Wrap(err, "while sending data access event to auditlog")
+}
+
 func (svc *Service) parseResponse(response string) (model.GraphqlResponse, error) {
 	var graphqlResponse model.GraphqlResponse
 	err := json.Unmarshal([]byte(response), &graphqlResponse)
@@ -164,9 +182,9 @@ func (svc *Service) parseResponse(response string) (model.GraphqlResponse, error
 	return graphqlResponse, nil
 }
 
-func (svc *Service) createConfigChangeMsg(claims proxy.Claims, request string, correlationID string, auditlogOperationType string) model.ConfigurationChange {
+func (svc *Service) createConfigChangeMsg(auditlogMsg proxy.AuditlogMessage, correlationID string, auditlogOperationType string) model.ConfigurationChange {
 	msg := svc.msgFactory.CreateConfigurationChange()
-	msg.Object = model.Object{ID: fillID(claims, "Config Change")}
+	msg.Object = model.Object{ID: fillID(auditlogMsg.Claims, "Config Change")}
 
 	msg.Attributes = []model.Attribute{
 		{
@@ -177,13 +195,28 @@ func (svc *Service) createConfigChangeMsg(claims proxy.Claims, request string, c
 		{
 			Name: "request",
 			Old:  "",
-			New:  request,
+			New:  auditlogMsg.Request,
 		},
 		{
 			Name: "correlation_id",
 			Old:  "",
 			New:  correlationID,
 		},
+		{
+			Name: "operation_name",
+			Old:  "",
+			New:  auditlogMsg.Operation.Name,
+		},
+		{
+			Name: "operation_type",
+			Old:  "",
+			New:  auditlogMsg.Operation.Type,
+		},
+		{
+			Name: "operation_fields",
+			Old:  "",
+			New:  strings.Join(auditlogMsg.Operation.Fields, ","),
+		},
 	}
 
 	return msg
@@ -198,36 +231,13 @@ func (svc *Service) hasInsufficientScopeError(errors []model.ErrorMessage) bool
 	return false
 }
 
-type graphqQuery struct {
-	Query string `json:"query"`
-}
-
-//We assume that if request payload start with `mutation` and
+//We assume that if the executed operation is a mutation and
 //if any of response errors has path array length equal 1, that means that mutation failed
-func isReadError(response model.GraphqlResponse, request string) (bool, error) {
-	req := strings.TrimSpace(request)
-	isMutation := strings.HasPrefix(req, "mutation")
-	if isMutation {
-		return searchForMutationErr(response), nil
-	}
-
-	isQuery := strings.HasPrefix(req, "query")
-	if isQuery {
-		return true, nil
+func isReadError(response model.GraphqlResponse, operation proxy.Operation) bool {
+	if operation.Type == proxy.MutationOperation {
+		return searchForMutationErr(response)
 	}
-
-	var graphqlQuery graphqQuery
-	err := json.Unmarshal([]byte(request), &graphqlQuery)
-	if err != nil {
-		return false, errors.Wrap(err, "while marshalling graphql named query")
-	}
-
-	graphqlRequestPayload := strings.TrimSpace(graphqlQuery.Query)
-	if strings.HasPrefix(graphqlRequestPayload, "mutation") {
-		return searchForMutationErr(response), nil
-	}
-
-	return true, nil
+	return true
 }
 
 func searchForMutationErr(response model.GraphqlResponse) bool {
@@ -25,9 +25,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequest()
+		operation := fixMutationOperation()
 		response := fixNoErrorResponse(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -38,6 +39,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -53,9 +55,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequest()
+		operation := fixMutationOperation()
 		response := fixGraphqlMutationError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -66,6 +69,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -81,9 +85,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequestWithInvalidQuery()
+		operation := fixMutationOperation()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -94,6 +99,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -109,9 +115,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequest()
+		operation := fixMutationOperation()
 		response := fixResponseMultipleError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -122,6 +129,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -137,9 +145,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequest()
+		operation := fixMutationOperation()
 		response := fixGraphqlMultiErrorWithMutation(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, response, auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -150,6 +159,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -165,9 +175,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixRequestWithQuery()
+		operation := fixQueryOperation()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -178,6 +189,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -193,9 +205,10 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateConfigurationChange").Return(fixFabricatedConfigChangeMsg())
 
 		request := fixJsonRequest()
+		operation := fixNamedMutationOperation()
 		response := fixResponseReadError(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(nil)
@@ -206,6 +219,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -221,6 +235,7 @@ func TestAuditlogService_Log(t *testing.T) {
 		factory.On("CreateSecurityEvent").Return(fixFabricatedSecurityEventMsg())
 
 		request := fixRequest()
+		operation := fixMutationOperation()
 		graphqlResponse := fixResponseUnsufficientScopes()
 		response, err := json.Marshal(&graphqlResponse)
 		require.NoError(t, err)
@@ -237,6 +252,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             string(response),
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err = auditlogSvc.Log(context.TODO(), msg)
@@ -246,6 +262,65 @@ func TestAuditlogService_Log(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, client, factory)
 	})
 
+	t.Run("Data access event - query with sensitive fields", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateSecurityEvent").Return(fixFabricatedSecurityEventMsg())
+
+		fields := []string{"Application.auths", "Webhook.auth"}
+		claims := fixClaims()
+		dataAccessMsg := fixDataAccessEventMsg(t, fields, claims, fixCorrelationID()[correlation.RequestIDHeaderKey])
+
+		client := &automock.AuditlogClient{}
+		client.On("LogSecurityEvent", context.TODO(), dataAccessMsg).Return(nil)
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		//WHEN
+		msg := proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              fixRequestWithSensitiveQuery(),
+			Operation:            proxy.Operation{Name: "applicationAuths", Type: proxy.QueryOperation, Fields: []string{"application"}},
+			SensitiveFields:      fields,
+			Claims:               claims,
+		}
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, client, factory)
+		client.AssertNotCalled(t, "LogConfigurationChange", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Data access event - auditlog client returns error", func(t *testing.T) {
+		//GIVEN
+		factory := &automock.AuditlogMessageFactory{}
+		factory.On("CreateSecurityEvent").Return(fixFabricatedSecurityEventMsg())
+
+		testError := errors.New("test-error")
+		fields := []string{"Application.auths"}
+		claims := fixClaims()
+		dataAccessMsg := fixDataAccessEventMsg(t, fields, claims, fixCorrelationID()[correlation.RequestIDHeaderKey])
+
+		client := &automock.AuditlogClient{}
+		client.On("LogSecurityEvent", context.TODO(), dataAccessMsg).Return(testError)
+		auditlogSvc := auditlog.NewService(client, factory)
+
+		//WHEN
+		msg := proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              fixRequestWithSensitiveQuery(),
+			Operation:            proxy.Operation{Name: "applicationAuths", Type: proxy.QueryOperation, Fields: []string{"application"}},
+			SensitiveFields:      fields,
+			Claims:               claims,
+		}
+		err := auditlogSvc.Log(context.TODO(), msg)
+
+		//THEN
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while sending data access event to auditlog")
+		mock.AssertExpectationsForObjects(t, client, factory)
+	})
+
 	t.Run("Auditlog client return error", func(t *testing.T) {
 		//GIVEN
 		factory := &automock.AuditlogMessageFactory{}
@@ -253,9 +328,10 @@ func TestAuditlogService_Log(t *testing.T) {
 
 		testError := errors.New("test-error")
 		request := fixRequest()
+		operation := fixMutationOperation()
 		response := fixNoErrorResponse(t)
 		claims := fixClaims()
-		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation)
+		log := fixSuccessConfigChangeMsg(claims, request, "success", auditlog.PostAuditlogOperation, operation)
 
 		client := &automock.AuditlogClient{}
 		client.On("LogConfigurationChange", context.TODO(), log).Return(testError)
@@ -266,6 +342,7 @@ func TestAuditlogService_Log(t *testing.T) {
 			CorrelationIDHeaders: fixCorrelationID(),
 			Request:              request,
 			Response:             response,
+			Operation:            operation,
 			Claims:               claims,
 		}
 		err := auditlogSvc.Log(context.TODO(), msg)
@@ -355,6 +432,27 @@ func fixJsonRequest() string {
 	return `{"query":"mutation a{\n   registerApplication(in : {name:\"test123\"}) {\n  id\n  name\n  labels\n  apiDefinition(id:\"\") {\n    id\n  }\n  }\n  registerRuntime(in: {name:\"app2\"}) {\n      id\n  name\n  labels\n  }\n}\n","operationName":"a"}`
 }
 
+func fixMutationOperation() proxy.Operation {
+	return proxy.Operation{
+		Type:   proxy.MutationOperation,
+		Fields: []string{"registerApplication", "registerRuntime"},
+	}
+}
+
+func fixNamedMutationOperation() proxy.Operation {
+	operation := fixMutationOperation()
+	operation.Name = "a"
+	return operation
+}
+
+func fixQueryOperation() proxy.Operation {
+	return proxy.Operation{
+		Name:   "wiever",
+		Type:   proxy.QueryOperation,
+		Fields: []string{"viewer"},
+	}
+}
+
 func fixRequestWithQuery() string {
 	return `query wiever {
 			  result: viewer {
@@ -364,6 +462,16 @@ func fixRequestWithQuery() string {
 			}`
 }
 
+func fixRequestWithSensitiveQuery() string {
+	return `query applicationAuths {
+			  application(id: "id") {
+				auths {
+				  id
+				}
+			  }
+			}`
+}
+
 func fixRequestWithInvalidQuery() string {
 	return `mutation {
 			   registerApplication(in : {name:"test1"}) {
@@ -0,0 +1,630 @@
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+)
+
+const (
+	segmentPrefix      = "segment-"
+	segmentExtension   = ".log"
+	cursorFileName     = "cursor"
+	deadLetterFileName = "dead-letter"
+)
+
+//go:generate mockery --name=SpoolMetricCollector --output=automock --outpkg=automock --case=underscore
+type SpoolMetricCollector interface {
+	SetSpoolBacklog(size int, age time.Duration)
+}
+
+// SpoolRecord is an audit log message stored in the spool, together with its sequence number and the time it was spooled at.
+type SpoolRecord struct {
+	Seq        uint64                `json:"seq"`
+	EnqueuedAt time.Time             `json:"enqueuedAt"`
+	Message    proxy.AuditlogMessage `json:"message"`
+}
+
+type segment struct {
+	path     string
+	firstSeq uint64
+	lastSeq  uint64
+	size     int64
+}
+
+type scheduledRetry struct {
+	record SpoolRecord
+	after  time.Time
+}
+
+// Spool is a persistent queue of audit log messages, which guarantees their at-least-once delivery.
+// The messages are appended to segment files, and the sequence number up to which all messages are delivered is stored in a cursor file.
+// Messages which are not acknowledged before a restart are delivered again once the spool is reopened.
+// Corrupt messages, which can not be read from the segments, are moved to a dead-letter file and are not delivered.
+type Spool struct {
+	mu sync.Mutex
+
+	dir           string
+	segmentSize   int64
+	maxSize       int64
+	retryInterval time.Duration
+	collector     SpoolMetricCollector
+
+	segments []*segment
+	active   *os.File
+	nextSeq  uint64
+
+	cursor      uint64
+	inFlight    map[uint64]time.Time
+	acked       map[uint64]bool
+	retries     []scheduledRetry
+	pausedUntil time.Time
+
+	reader    *bufio.Reader
+	readFile  *os.File
+	readIndex int
+	readSeq   uint64
+	head      *SpoolRecord
+	// unreadSince is the time the next message, which was not read from the segments yet, was spooled at, or zero if it is not known.
+	// It is tracked so that the age of the backlog is reported without reading from the segments.
+	unreadSince time.Time
+
+	notify chan struct{}
+	now    func() time.Time
+}
+
+// OpenSpool opens the spool in the given directory, and recovers the messages which were not delivered before the last shutdown.
+// The spool accepts new messages only while its segments take up to maxSize bytes, unless maxSize is 0.
+func OpenSpool(dir string, segmentSize, maxSize int64, retryInterval time.Duration, collector SpoolMetricCollector) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "while creating spool directory %s", dir)
+	}
+
+	s := &Spool{
+		dir:           dir,
+		segmentSize:   segmentSize,
+		maxSize:       maxSize,
+		retryInterval: retryInterval,
+		collector:     collector,
+		inFlight:      make(map[uint64]time.Time),
+		acked:         make(map[uint64]bool),
+		notify:        make(chan struct{}, 1),
+		now:           time.Now,
+	}
+
+	cursor, err := s.readCursor()
+	if err != nil {
+		return nil, err
+	}
+	s.cursor = cursor
+	s.nextSeq = cursor
+	s.readSeq = cursor
+
+	if err := s.loadSegments(); err != nil {
+		return nil, err
+	}
+	if err := s.removeDeliveredSegments(); err != nil {
+		return nil, err
+	}
+
+	s.reportBacklog()
+	return s, nil
+}
+
+// Log appends the message to the spool. The message is persisted on disk before Log returns.
+// An error is returned if the spool is full, as the audit log service has been unavailable for too long.
+func (s *Spool) Log(ctx context.Context, msg proxy.AuditlogMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := SpoolRecord{
+		Seq:        s.nextSeq,
+		EnqueuedAt: s.now().UTC(),
+		Message:    msg,
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling auditlog message")
+	}
+	line = append(line, '\n')
+
+	if s.maxSize > 0 && s.size()+int64(len(line)) > s.maxSize {
+		return errors.Errorf("auditlog spool is full, its segments exceed the limit of %d bytes", s.maxSize)
+	}
+
+	if err := s.ensureActiveSegment(int64(len(line))); err != nil {
+		return err
+	}
+
+	if _, err := s.active.Write(line); err != nil {
+		return errors.Wrap(err, "while writing auditlog message to spool")
+	}
+	if err := s.active.Sync(); err != nil {
+		return errors.Wrap(err, "while syncing auditlog spool")
+	}
+
+	current := s.segments[len(s.segments)-1]
+	current.lastSeq = record.Seq
+	current.size += int64(len(line))
+	s.nextSeq++
+	if record.Seq == s.readSeq && s.head == nil && s.unreadSince.IsZero() {
+		s.unreadSince = record.EnqueuedAt
+	}
+
+	log.C(ctx).Debugf("Successfully spooled auditlog message with sequence number %d", record.Seq)
+	s.reportBacklog()
+	s.signal()
+	return nil
+}
+
+// Next returns the next message to be delivered. It blocks until there is such a message, or until the context is done.
+// Messages, which were not acknowledged, are returned again after the retry interval.
+func (s *Spool) Next(ctx context.Context) (SpoolRecord, error) {
+	for {
+		s.mu.Lock()
+		record, ok, err := s.takeNext()
+		wait := s.nextRetryIn()
+		s.mu.Unlock()
+
+		if err != nil {
+			return SpoolRecord{}, err
+		}
+		if ok {
+			return record, nil
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case <-s.notify:
+		case <-timeout:
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return SpoolRecord{}, err
+		}
+	}
+}
+
+// Ack marks the message with the given sequence number as delivered.
+// Segments, whose messages are all delivered, are removed.
+func (s *Spool) Ack(seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.inFlight, seq)
+	s.acked[seq] = true
+	if !s.pausedUntil.IsZero() {
+		// The delivery succeeded, so the messages which were held back by a Nack can be read again.
+		s.pausedUntil = time.Time{}
+		s.signal()
+	}
+
+	return s.advanceCursor()
+}
+
+// Nack schedules the message for another delivery after the retry interval.
+// No further messages are read from the segments until then, so that a prolonged outage of the audit log service does not load the whole backlog in memory.
+func (s *Spool) Nack(record SpoolRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	after := s.now().Add(s.retryInterval)
+	s.retries = append(s.retries, scheduledRetry{record: record, after: after})
+	s.pausedUntil = after
+	s.signal()
+}
+
+// Backlog returns the number of messages which are not delivered yet, and the age of the oldest of them.
+func (s *Spool) Backlog() (int, time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.backlog()
+}
+
+// ReportBacklog periodically updates the backlog metrics, as the age of the backlog grows even if no messages are spooled.
+func (s *Spool) ReportBacklog(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.mu.Lock()
+			s.reportBacklog()
+			s.mu.Unlock()
+		}
+	}
+}
+
+// Close closes the files of the spool.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result error
+	if s.readFile != nil {
+		result = s.readFile.Close()
+		s.readFile, s.reader = nil, nil
+	}
+	if s.active != nil {
+		if err := s.active.Close(); err != nil {
+			result = err
+		}
+		s.active = nil
+	}
+	return result
+}
+
+// advanceCursor moves the cursor past the delivered messages and removes the segments, whose messages are all delivered.
+func (s *Spool) advanceCursor() error {
+	previous := s.cursor
+	for s.acked[s.cursor] {
+		delete(s.acked, s.cursor)
+		s.cursor++
+	}
+	s.reportBacklog()
+
+	if s.cursor == previous {
+		return nil
+	}
+	if err := s.writeCursor(); err != nil {
+		return err
+	}
+	return s.removeDeliveredSegments()
+}
+
+func (s *Spool) takeNext() (SpoolRecord, bool, error) {
+	now := s.now()
+	for i, r := range s.retries {
+		if !r.after.After(now) {
+			s.retries = append(s.retries[:i], s.retries[i+1:]...)
+			return r.record, true, nil
+		}
+	}
+
+	if now.Before(s.pausedUntil) {
+		return SpoolRecord{}, false, nil
+	}
+
+	record, err := s.peek()
+	if err != nil || record == nil {
+		return SpoolRecord{}, false, err
+	}
+
+	s.head = nil
+	s.unreadSince = time.Time{}
+	s.inFlight[record.Seq] = record.EnqueuedAt
+	return *record, true, nil
+}
+
+// peek reads the next message, which was not delivered yet, from the segments without taking it.
+// Corrupt messages are moved to the dead-letter file, and their sequence numbers are skipped.
+func (s *Spool) peek() (*SpoolRecord, error) {
+	for s.head == nil {
+		if s.reader == nil {
+			if s.readIndex >= len(s.segments) {
+				return nil, nil
+			}
+			file, err := os.Open(s.segments[s.readIndex].path)
+			if err != nil {
+				return nil, errors.Wrapf(err, "while opening spool segment %s", s.segments[s.readIndex].path)
+			}
+			s.readFile, s.reader = file, bufio.NewReader(file)
+		}
+
+		line, err := s.reader.ReadBytes('\n')
+		if err == io.EOF {
+			// The messages are appended only to the last segment, so the rest of the segments are read completely.
+			if s.readIndex == len(s.segments)-1 {
+				return nil, nil
+			}
+			if err := s.readFile.Close(); err != nil {
+				return nil, errors.Wrap(err, "while closing spool segment")
+			}
+			s.readFile, s.reader = nil, nil
+			s.readIndex++
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "while reading spool segment")
+		}
+
+		var record SpoolRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			if err := s.deadLetter(line); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if record.Seq < s.cursor || s.acked[record.Seq] {
+			continue
+		}
+
+		lostFrom := s.readSeq
+		s.readSeq = record.Seq + 1
+		s.head = &record
+		if record.Seq > lostFrom {
+			if err := s.skipLost(lostFrom, record.Seq); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.head, nil
+}
+
+// deadLetter appends a corrupt message to the dead-letter file, so that it can be inspected, as it can not be delivered.
+func (s *Spool) deadLetter(line []byte) error {
+	path := filepath.Join(s.dir, deadLetterFileName)
+	log.D().Warnf("Moving corrupt auditlog message from spool segment %s to %s", s.segments[s.readIndex].path, path)
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while opening spool dead-letter file")
+	}
+	if len(line) == 0 || line[len(line)-1] != '\n' {
+		line = append(line, '\n')
+	}
+	if _, err := file.Write(line); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "while writing to spool dead-letter file")
+	}
+	return errors.Wrap(file.Close(), "while closing spool dead-letter file")
+}
+
+// skipLost marks the messages with sequence numbers in the given range as delivered,
+// as they are corrupt or missing from the segments, and would otherwise prevent the cursor from advancing.
+func (s *Spool) skipLost(from, to uint64) error {
+	log.D().Warnf("Auditlog messages with sequence numbers from %d to %d are missing from the spool and will not be delivered", from, to-1)
+	for lost := from; lost < to; lost++ {
+		if lost >= s.cursor {
+			s.acked[lost] = true
+		}
+	}
+	return s.advanceCursor()
+}
+
+func (s *Spool) nextRetryIn() time.Duration {
+	var wait time.Duration
+	now := s.now()
+	for _, r := range s.retries {
+		if d := r.after.Sub(now); wait == 0 || d < wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// backlog returns the number of messages which are not delivered yet, and the age of the oldest of them.
+// The messages are read in order, so the messages in flight are older than the ones which were not read yet.
+func (s *Spool) backlog() (int, time.Duration) {
+	size := int(s.nextSeq-s.cursor) - len(s.acked)
+
+	var oldest time.Time
+	for _, enqueuedAt := range s.inFlight {
+		if oldest.IsZero() || enqueuedAt.Before(oldest) {
+			oldest = enqueuedAt
+		}
+	}
+	if oldest.IsZero() {
+		if s.head != nil {
+			oldest = s.head.EnqueuedAt
+		} else {
+			oldest = s.unreadSince
+		}
+	}
+
+	if oldest.IsZero() {
+		return size, 0
+	}
+	return size, s.now().Sub(oldest)
+}
+
+// size returns the number of bytes taken by the segments.
+func (s *Spool) size() int64 {
+	var size int64
+	for _, seg := range s.segments {
+		size += seg.size
+	}
+	return size
+}
+
+func (s *Spool) reportBacklog() {
+	if s.collector == nil {
+		return
+	}
+	s.collector.SetSpoolBacklog(s.backlog())
+}
+
+func (s *Spool) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// ensureActiveSegment opens a segment for appending, and starts a new one when the current one would exceed the segment size.
+func (s *Spool) ensureActiveSegment(lineSize int64) error {
+	if s.active != nil {
+		current := s.segments[len(s.segments)-1]
+		if current.size == 0 || current.size+lineSize <= s.segmentSize {
+			return nil
+		}
+		if err := s.active.Close(); err != nil {
+			return errors.Wrap(err, "while closing spool segment")
+		}
+		s.active = nil
+	}
+
+	var current *segment
+	if len(s.segments) > 0 && s.segments[len(s.segments)-1].size+lineSize <= s.segmentSize {
+		current = s.segments[len(s.segments)-1]
+	} else {
+		current = &segment{
+			path:     filepath.Join(s.dir, fmt.Sprintf("%s%020d%s", segmentPrefix, s.nextSeq, segmentExtension)),
+			firstSeq: s.nextSeq,
+		}
+		s.segments = append(s.segments, current)
+	}
+
+	file, err := os.OpenFile(current.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "while opening spool segment %s", current.path)
+	}
+	s.active = file
+	return nil
+}
+
+// loadSegments reads the existing segments. A message, which was partially written because of a crash, is truncated.
+// Corrupt messages are kept in the segments, and are moved to the dead-letter file once they are read for delivery.
+func (s *Spool) loadSegments() error {
+	paths, err := filepath.Glob(filepath.Join(s.dir, segmentPrefix+"*"+segmentExtension))
+	if err != nil {
+		return errors.Wrap(err, "while listing spool segments")
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		seg, corrupt, err := s.loadSegment(path)
+		if err != nil {
+			return err
+		}
+		if corrupt > 0 {
+			log.D().Warnf("Found %d corrupt auditlog messages in spool segment %s", corrupt, path)
+		}
+		if seg.size == 0 {
+			if err := os.Remove(path); err != nil {
+				return errors.Wrapf(err, "while removing empty spool segment %s", path)
+			}
+			continue
+		}
+		s.segments = append(s.segments, seg)
+		if seg.lastSeq+1 > s.nextSeq {
+			s.nextSeq = seg.lastSeq + 1
+		}
+	}
+	return nil
+}
+
+// loadSegment reads the sequence numbers of the messages in the segment, and returns the number of corrupt messages, which are skipped.
+// It records the time the first message, which was not delivered yet, was spooled at.
+func (s *Spool) loadSegment(path string) (*segment, int, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, 0, errors.Wrapf(err, "while reading spool segment %s", path)
+	}
+
+	seg := &segment{path: path}
+	var complete int64
+	var records, corrupt int
+	for len(data) > 0 {
+		end := bytes.IndexByte(data, '\n')
+		if end < 0 {
+			break
+		}
+
+		var record SpoolRecord
+		if err := json.Unmarshal(data[:end], &record); err != nil {
+			corrupt++
+		} else {
+			if records == 0 {
+				seg.firstSeq = record.Seq
+			}
+			seg.lastSeq = record.Seq
+			records++
+			if record.Seq >= s.cursor && s.unreadSince.IsZero() {
+				s.unreadSince = record.EnqueuedAt
+			}
+		}
+		complete += int64(end + 1)
+		data = data[end+1:]
+	}
+
+	if len(data) > 0 {
+		if err := os.Truncate(path, complete); err != nil {
+			return nil, 0, errors.Wrapf(err, "while truncating partially written spool segment %s", path)
+		}
+	}
+	seg.size = complete
+	return seg, corrupt, nil
+}
+
+// removeDeliveredSegments removes the segments, whose messages are all delivered, except for the one which is appended to.
+// The segment, which is being read, is not removed either.
+func (s *Spool) removeDeliveredSegments() error {
+	for len(s.segments) > 1 && s.segments[0].lastSeq < s.cursor && (s.readIndex > 0 || s.reader == nil) {
+		if err := os.Remove(s.segments[0].path); err != nil {
+			return errors.Wrapf(err, "while removing delivered spool segment %s", s.segments[0].path)
+		}
+		s.segments = s.segments[1:]
+		if s.readIndex > 0 {
+			s.readIndex--
+		}
+	}
+	return nil
+}
+
+func (s *Spool) readCursor() (uint64, error) {
+	data, err := ioutil.ReadFile(filepath.Join(s.dir, cursorFileName))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "while reading spool cursor")
+	}
+
+	cursor, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "while parsing spool cursor")
+	}
+	return cursor, nil
+}
+
+// writeCursor replaces the cursor file atomically, so that it is never partially written.
+func (s *Spool) writeCursor() error {
+	tmp := filepath.Join(s.dir, cursorFileName+".tmp")
+	file, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while creating spool cursor")
+	}
+	if _, err := file.WriteString(strconv.FormatUint(s.cursor, 10)); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "while writing spool cursor")
+	}
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "while syncing spool cursor")
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrap(err, "while closing spool cursor")
+	}
+	return errors.Wrap(os.Rename(tmp, filepath.Join(s.dir, cursorFileName)), "while replacing spool cursor")
+}
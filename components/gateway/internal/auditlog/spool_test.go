@@ -0,0 +1,343 @@
+package auditlog_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog"
+	"github.com/kyma-incubator/compass/components/gateway/internal/auditlog/automock"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSegmentSize   = 1024 * 1024
+	testRetryInterval = 50 * time.Millisecond
+)
+
+func TestSpool_DeliversMessagesInOrder(t *testing.T) {
+	// GIVEN
+	spool := openTestSpool(t, t.TempDir(), testSegmentSize)
+	logMessages(t, spool, "first", "second")
+
+	// WHEN
+	first := next(t, spool)
+	second := next(t, spool)
+
+	// THEN
+	assert.Equal(t, uint64(0), first.Seq)
+	assert.Equal(t, "first", first.Message.Request)
+	assert.Equal(t, fixClaims(), first.Message.Claims)
+	assert.Equal(t, uint64(1), second.Seq)
+	assert.Equal(t, "second", second.Message.Request)
+	assertNoMessage(t, spool)
+}
+
+func TestSpool_ReplaysUndeliveredMessagesAfterRestart(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first", "second", "third")
+
+	require.NoError(t, spool.Ack(next(t, spool).Seq))
+	next(t, spool)
+	require.NoError(t, spool.Close())
+
+	// WHEN
+	reopened := openTestSpool(t, dir, testSegmentSize)
+
+	// THEN
+	size, _ := reopened.Backlog()
+	assert.Equal(t, 2, size)
+	assert.Equal(t, "second", next(t, reopened).Message.Request)
+	assert.Equal(t, "third", next(t, reopened).Message.Request)
+	assertNoMessage(t, reopened)
+}
+
+func TestSpool_DoesNotReplayDeliveredMessages(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first", "second")
+
+	first, second := next(t, spool), next(t, spool)
+	require.NoError(t, spool.Ack(second.Seq))
+	require.NoError(t, spool.Ack(first.Seq))
+	require.NoError(t, spool.Close())
+
+	// WHEN
+	reopened := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, reopened, "third")
+
+	// THEN
+	size, age := reopened.Backlog()
+	assert.Equal(t, 1, size)
+	assert.True(t, age >= 0)
+	record := next(t, reopened)
+	assert.Equal(t, uint64(2), record.Seq)
+	assert.Equal(t, "third", record.Message.Request)
+}
+
+func TestSpool_ReplaysMessagesAcknowledgedOutOfOrder(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first", "second")
+
+	next(t, spool)
+	require.NoError(t, spool.Ack(next(t, spool).Seq))
+	require.NoError(t, spool.Close())
+
+	// WHEN
+	reopened := openTestSpool(t, dir, testSegmentSize)
+
+	// THEN
+	assert.Equal(t, "first", next(t, reopened).Message.Request)
+	assert.Equal(t, "second", next(t, reopened).Message.Request)
+}
+
+func TestSpool_RetriesNotAcknowledgedMessages(t *testing.T) {
+	// GIVEN
+	spool := openTestSpool(t, t.TempDir(), testSegmentSize)
+	logMessages(t, spool, "first", "second")
+
+	// WHEN
+	spool.Nack(next(t, spool))
+
+	// THEN
+	assertNoMessage(t, spool)
+
+	time.Sleep(testRetryInterval)
+	retried := next(t, spool)
+	assert.Equal(t, "first", retried.Message.Request)
+	require.NoError(t, spool.Ack(retried.Seq))
+	assert.Equal(t, "second", next(t, spool).Message.Request)
+}
+
+func TestSpool_RecoversFromPartiallyWrittenMessage(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first")
+	require.NoError(t, spool.Close())
+
+	segments := listSegments(t, dir)
+	require.Len(t, segments, 1)
+	file, err := os.OpenFile(segments[0], os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"seq":1,"enqueuedAt":`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// WHEN
+	reopened := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, reopened, "second")
+
+	// THEN
+	assert.Equal(t, "first", next(t, reopened).Message.Request)
+	record := next(t, reopened)
+	assert.Equal(t, uint64(1), record.Seq)
+	assert.Equal(t, "second", record.Message.Request)
+}
+
+func TestSpool_MovesCorruptMessagesToDeadLetterFile(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first", "second", "third")
+	require.NoError(t, spool.Close())
+
+	segments := listSegments(t, dir)
+	require.Len(t, segments, 1)
+	data, err := ioutil.ReadFile(segments[0])
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(data), "\n")
+	require.Len(t, lines, 4)
+	corrupt := "{corrupt}\n"
+	require.NoError(t, ioutil.WriteFile(segments[0], []byte(lines[0]+corrupt+lines[2]), 0600))
+
+	// WHEN
+	reopened := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, reopened, "fourth")
+
+	// THEN
+	first := next(t, reopened)
+	assert.Equal(t, "first", first.Message.Request)
+	require.NoError(t, reopened.Ack(first.Seq))
+	third := next(t, reopened)
+	assert.Equal(t, "third", third.Message.Request)
+	require.NoError(t, reopened.Ack(third.Seq))
+	assert.Equal(t, "fourth", next(t, reopened).Message.Request)
+
+	size, _ := reopened.Backlog()
+	assert.Equal(t, 1, size)
+	deadLetters, err := ioutil.ReadFile(filepath.Join(dir, "dead-letter"))
+	require.NoError(t, err)
+	assert.Equal(t, corrupt, string(deadLetters))
+}
+
+func TestSpool_ReportsBacklogWithoutReadingMessages(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, testSegmentSize)
+	logMessages(t, spool, "first", "second")
+	require.NoError(t, spool.Close())
+
+	segments := listSegments(t, dir)
+	require.Len(t, segments, 1)
+	data, err := ioutil.ReadFile(segments[0])
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(data), "\n")
+	require.Len(t, lines, 3)
+	require.NoError(t, ioutil.WriteFile(segments[0], []byte("{corrupt}\n"+lines[1]), 0600))
+
+	reopened := openTestSpool(t, dir, testSegmentSize)
+
+	// WHEN
+	size, age := reopened.Backlog()
+
+	// THEN
+	assert.Equal(t, 2, size)
+	assert.True(t, age > 0)
+	_, err = os.Stat(filepath.Join(dir, "dead-letter"))
+	assert.True(t, os.IsNotExist(err))
+	assert.Equal(t, "second", next(t, reopened).Message.Request)
+}
+
+func TestSpool_ResumesDeliveryWhenAnotherMessageIsAcknowledged(t *testing.T) {
+	// GIVEN
+	spool, err := auditlog.OpenSpool(t.TempDir(), testSegmentSize, 0, time.Hour, nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, spool.Close()) }()
+	logMessages(t, spool, "first", "second", "third")
+
+	first, second := next(t, spool), next(t, spool)
+	spool.Nack(first)
+
+	third := make(chan auditlog.SpoolRecord)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		record, _ := spool.Next(ctx)
+		third <- record
+	}()
+	time.Sleep(testRetryInterval)
+
+	// WHEN
+	require.NoError(t, spool.Ack(second.Seq))
+
+	// THEN
+	record := <-third
+	assert.Equal(t, "third", record.Message.Request)
+}
+
+func TestSpool_RejectsMessagesWhenFull(t *testing.T) {
+	// GIVEN
+	spool, err := auditlog.OpenSpool(t.TempDir(), testSegmentSize, 512, testRetryInterval, nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, spool.Close()) }()
+	logMessages(t, spool, "first")
+
+	// WHEN
+	err = spool.Log(context.TODO(), proxy.AuditlogMessage{Request: strings.Repeat("a", 512)})
+
+	// THEN
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "auditlog spool is full")
+	size, _ := spool.Backlog()
+	assert.Equal(t, 1, size)
+}
+
+func TestSpool_RemovesDeliveredSegments(t *testing.T) {
+	// GIVEN
+	dir := t.TempDir()
+	spool := openTestSpool(t, dir, 1)
+	logMessages(t, spool, "first", "second", "third")
+	require.Len(t, listSegments(t, dir), 3)
+
+	// WHEN
+	for i := 0; i < 3; i++ {
+		require.NoError(t, spool.Ack(next(t, spool).Seq))
+	}
+
+	// THEN
+	assert.Len(t, listSegments(t, dir), 1)
+	size, age := spool.Backlog()
+	assert.Equal(t, 0, size)
+	assert.Equal(t, time.Duration(0), age)
+}
+
+func TestSpool_ReportsBacklog(t *testing.T) {
+	// GIVEN
+	collector := &automock.SpoolMetricCollector{}
+	collector.On("SetSpoolBacklog", 0, time.Duration(0)).Once()
+	collector.On("SetSpoolBacklog", 1, mock.AnythingOfType("time.Duration")).Once()
+	collector.On("SetSpoolBacklog", 0, time.Duration(0)).Once()
+
+	spool, err := auditlog.OpenSpool(t.TempDir(), testSegmentSize, 0, testRetryInterval, collector)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, spool.Close()) }()
+
+	// WHEN
+	logMessages(t, spool, "first")
+	require.NoError(t, spool.Ack(next(t, spool).Seq))
+
+	// THEN
+	collector.AssertExpectations(t)
+}
+
+func openTestSpool(t *testing.T, dir string, segmentSize int64) *auditlog.Spool {
+	spool, err := auditlog.OpenSpool(dir, segmentSize, 0, testRetryInterval, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = spool.Close() })
+	return spool
+}
+
+func logMessages(t *testing.T, spool *auditlog.Spool, requests ...string) {
+	for _, request := range requests {
+		err := spool.Log(context.TODO(), proxy.AuditlogMessage{
+			CorrelationIDHeaders: fixCorrelationID(),
+			Request:              request,
+			Response:             "response",
+			Claims:               fixClaims(),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func next(t *testing.T, spool *auditlog.Spool) auditlog.SpoolRecord {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	record, err := spool.Next(ctx)
+	require.NoError(t, err)
+	return record
+}
+
+func assertNoMessage(t *testing.T, spool *auditlog.Spool) {
+	ctx, cancel := context.WithTimeout(context.Background(), testRetryInterval/5)
+	defer cancel()
+
+	_, err := spool.Next(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func listSegments(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+
+	var segments []string
+	for _, file := range files {
+		if filepath.Ext(file.Name()) == ".log" {
+			segments = append(segments, filepath.Join(dir, file.Name()))
+		}
+	}
+	return segments
+}
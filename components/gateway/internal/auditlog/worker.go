@@ -43,3 +43,42 @@ func (w *Worker) Start(ctx context.Context) {
 		}
 	}
 }
+
+// SpoolWorker delivers the messages from the spool to the audit log service. Messages which cannot be delivered are retried.
+type SpoolWorker struct {
+	svc   proxy.AuditlogService
+	spool *Spool
+}
+
+func NewSpoolWorker(svc proxy.AuditlogService, spool *Spool) *SpoolWorker {
+	return &SpoolWorker{
+		svc:   svc,
+		spool: spool,
+	}
+}
+
+func (w *SpoolWorker) Start(ctx context.Context) {
+	logger := log.C(ctx)
+	for {
+		record, err := w.spool.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				logger.Infoln("Worker for spooled auditlog message processing has finished")
+				return
+			}
+			logger.WithError(err).Errorf("while reading auditlog message from spool: %v", err)
+			return
+		}
+
+		msgCtx := context.WithValue(ctx, correlation.HeadersContextKey, record.Message.CorrelationIDHeaders)
+		if err := w.svc.Log(msgCtx, record.Message); err != nil {
+			logger.WithError(err).Errorf("while saving spooled auditlog message with sequence number %d, it will be retried: %v", record.Seq, err)
+			w.spool.Nack(record)
+			continue
+		}
+
+		if err := w.spool.Ack(record.Seq); err != nil {
+			logger.WithError(err).Errorf("while acknowledging spooled auditlog message with sequence number %d: %v", record.Seq, err)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+package dataaccess
+
+// Config lists the sensitive fields, reading of which is audited. Fields are GraphQL schema coordinates in the form Type.field.
+type Config struct {
+	Fields []string `envconfig:"APP_AUDITLOG_SENSITIVE_FIELDS,default=Query.bundleInstanceAuth;Bundle.instanceAuth;Bundle.instanceAuths;Bundle.defaultInstanceAuth;Application.auths;IntegrationSystem.auths;Runtime.auths;Webhook.auth;FetchRequest.auth"`
+}
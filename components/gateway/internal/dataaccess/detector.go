@@ -0,0 +1,105 @@
+package dataaccess
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+var coordinateRegex = regexp.MustCompile(`^([_A-Za-z][_0-9A-Za-z]*)\.([_A-Za-z][_0-9A-Za-z]*)$`)
+
+// Detector finds the sensitive fields selected by GraphQL queries.
+type Detector struct {
+	schema *ast.Schema
+	fields map[string]bool
+}
+
+func NewDetector(schema *ast.Schema, cfg Config) (*Detector, error) {
+	d := &Detector{
+		schema: schema,
+		fields: make(map[string]bool),
+	}
+
+	for _, coordinate := range cfg.Fields {
+		matches := coordinateRegex.FindStringSubmatch(coordinate)
+		if matches == nil {
+			return nil, errors.Errorf("invalid sensitive field %q, expected Type.field", coordinate)
+		}
+		typeName, fieldName := matches[1], matches[2]
+
+		d.fields[fieldCoordinate(typeName, fieldName)] = true
+		if def := schema.Types[typeName]; def != nil {
+			for _, possibleType := range schema.GetPossibleTypes(def) {
+				d.fields[fieldCoordinate(possibleType.Name, fieldName)] = true
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// SensitiveFields returns the schema coordinates of the sensitive fields selected by the executed operation of the GraphQL request.
+func (d *Detector) SensitiveFields(request []byte) ([]string, error) {
+	var payload struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+	}
+	if err := json.Unmarshal(request, &payload); err != nil {
+		return nil, errors.Wrap(err, "while decoding GraphQL request")
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: payload.Query})
+	if gqlErr != nil {
+		return nil, errors.Wrap(gqlErr, "while parsing GraphQL query")
+	}
+	validator.Walk(d.schema, doc, &validator.Events{})
+
+	operation := doc.Operations.ForName(payload.OperationName)
+	if operation == nil {
+		return nil, nil
+	}
+
+	var fields []string
+	d.collect(operation.SelectionSet, make(map[string]bool), make(map[string]bool), &fields)
+	return fields, nil
+}
+
+func (d *Detector) collect(selectionSet ast.SelectionSet, found, visitedFragments map[string]bool, fields *[]string) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if selection.ObjectDefinition != nil {
+				coordinate := fieldCoordinate(selection.ObjectDefinition.Name, selection.Name)
+				if d.fields[coordinate] && !found[coordinate] {
+					found[coordinate] = true
+					*fields = append(*fields, coordinate)
+				}
+			}
+			d.collect(selection.SelectionSet, found, visitedFragments, fields)
+		case *ast.InlineFragment:
+			d.collect(selection.SelectionSet, found, visitedFragments, fields)
+		case *ast.FragmentSpread:
+			if selection.Definition == nil || visitedFragments[selection.Name] {
+				continue
+			}
+			visitedFragments[selection.Name] = true
+			d.collect(selection.Definition.SelectionSet, found, visitedFragments, fields)
+		}
+	}
+}
+
+type NoOpDetector struct {
+}
+
+func (d *NoOpDetector) SensitiveFields([]byte) ([]string, error) {
+	return nil, nil
+}
+
+func fieldCoordinate(typeName, fieldName string) string {
+	return fmt.Sprintf("%s.%s", typeName, fieldName)
+}
@@ -0,0 +1,134 @@
+package dataaccess_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	directorgraphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/gateway/internal/dataaccess"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vrischmann/envconfig"
+)
+
+func TestDetector_SensitiveFields(t *testing.T) {
+	testCases := []struct {
+		Name           string
+		Query          string
+		OperationName  string
+		ExpectedFields []string
+	}{
+		{
+			Name:           "bundleInstanceAuth",
+			Query:          `query { bundleInstanceAuth(id: "id") { id auth { credential { ... on BasicCredentialData { password } } } } }`,
+			ExpectedFields: []string{"Query.bundleInstanceAuth"},
+		},
+		{
+			Name:           "Application auths",
+			Query:          `query { application(id: "id") { id auths { id } } }`,
+			ExpectedFields: []string{"Application.auths"},
+		},
+		{
+			Name:           "Integration system auths",
+			Query:          `query { integrationSystems(first: 10) { data { auths { id } } } }`,
+			ExpectedFields: []string{"IntegrationSystem.auths"},
+		},
+		{
+			Name:           "Webhook and fetch request auth",
+			Query:          `query { application(id: "id") { webhooks { auth { additionalHeaders } } bundles { data { apiDefinitions { data { spec { fetchRequest { auth { additionalHeaders } } } } } } } } }`,
+			ExpectedFields: []string{"Webhook.auth", "FetchRequest.auth"},
+		},
+		{
+			Name:           "Bundle instance auths",
+			Query:          `query { application(id: "id") { bundles { data { instanceAuth(id: "id") { id } instanceAuths { id } defaultInstanceAuth { additionalHeaders } } } } }`,
+			ExpectedFields: []string{"Bundle.instanceAuth", "Bundle.instanceAuths", "Bundle.defaultInstanceAuth"},
+		},
+		{
+			Name:           "Fields selected through fragments",
+			Query:          `fragment App on Application { auths { id } } query { application(id: "id") { ...App ... on Application { auths { id } } } }`,
+			ExpectedFields: []string{"Application.auths"},
+		},
+		{
+			Name:           "Aliased field",
+			Query:          `query { app: application(id: "id") { credentials: auths { id } } }`,
+			ExpectedFields: []string{"Application.auths"},
+		},
+		{
+			Name:           "Operation selected by name",
+			Query:          `query Read { viewer { id } } query Auths { runtime(id: "id") { auths { id } } }`,
+			OperationName:  "Auths",
+			ExpectedFields: []string{"Runtime.auths"},
+		},
+		{
+			Name:           "Other operation than the executed one",
+			Query:          `query Read { viewer { id } } query Auths { runtime(id: "id") { auths { id } } }`,
+			OperationName:  "Read",
+			ExpectedFields: nil,
+		},
+		{
+			Name:           "Query without sensitive fields",
+			Query:          `query { applications { data { id name } } }`,
+			ExpectedFields: nil,
+		},
+	}
+
+	detector := fixDetector(t)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			fields, err := detector.SensitiveFields(fixRequest(t, testCase.Query, testCase.OperationName))
+
+			//THEN
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ExpectedFields, fields)
+		})
+	}
+}
+
+func TestDetector_InvalidPayloads(t *testing.T) {
+	detector := fixDetector(t)
+
+	t.Run("Fails for request which is not a JSON object", func(t *testing.T) {
+		_, err := detector.SensitiveFields([]byte(`query { }`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding GraphQL request")
+	})
+
+	t.Run("Fails for invalid query", func(t *testing.T) {
+		_, err := detector.SensitiveFields(fixRequest(t, `query { application(`, ""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while parsing GraphQL query")
+	})
+}
+
+func TestNewDetector(t *testing.T) {
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+
+	t.Run("Fails for invalid field coordinate", func(t *testing.T) {
+		_, err := dataaccess.NewDetector(schema, dataaccess.Config{Fields: []string{"Application"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid sensitive field")
+	})
+}
+
+func fixDetector(t *testing.T) *dataaccess.Detector {
+	cfg := dataaccess.Config{}
+	require.NoError(t, envconfig.InitWithPrefix(&cfg, "APP"))
+
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+	detector, err := dataaccess.NewDetector(schema, cfg)
+	require.NoError(t, err)
+	return detector
+}
+
+func fixRequest(t *testing.T, query, operationName string) []byte {
+	payload := map[string]interface{}{"query": query}
+	if operationName != "" {
+		payload["operationName"] = operationName
+	}
+
+	request, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return request
+}
@@ -2,6 +2,7 @@ package metrics
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -9,6 +10,8 @@ import (
 
 type AuditlogCollector struct {
 	channelLength           prometheus.Gauge
+	spoolBacklogSize        prometheus.Gauge
+	spoolBacklogAge         prometheus.Gauge
 	auditlogRequestDuration *prometheus.HistogramVec
 }
 
@@ -20,6 +23,18 @@ func NewAuditlogMetricCollector() *AuditlogCollector {
 			Name:      "auditlog_channel_length",
 			Help:      "current audit log async channel size",
 		}),
+		spoolBacklogSize: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_spool_backlog_size",
+			Help:      "number of spooled audit log messages which are not delivered yet",
+		}),
+		spoolBacklogAge: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "auditlog_spool_backlog_age_seconds",
+			Help:      "age of the oldest spooled audit log message which is not delivered yet",
+		}),
 		auditlogRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
 			Namespace: "compass",
 			Subsystem: "gateway",
@@ -31,11 +46,15 @@ func NewAuditlogMetricCollector() *AuditlogCollector {
 
 func (c *AuditlogCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.channelLength.Describe(ch)
+	c.spoolBacklogSize.Describe(ch)
+	c.spoolBacklogAge.Describe(ch)
 	c.auditlogRequestDuration.Describe(ch)
 }
 
 func (c *AuditlogCollector) Collect(ch chan<- prometheus.Metric) {
 	c.channelLength.Collect(ch)
+	c.spoolBacklogSize.Collect(ch)
+	c.spoolBacklogAge.Collect(ch)
 	c.auditlogRequestDuration.Collect(ch)
 }
 
@@ -43,6 +62,38 @@ func (c *AuditlogCollector) SetChannelSize(size int) {
 	c.channelLength.Set(float64(size))
 }
 
+func (c *AuditlogCollector) SetSpoolBacklog(size int, age time.Duration) {
+	c.spoolBacklogSize.Set(float64(size))
+	c.spoolBacklogAge.Set(age.Seconds())
+}
+
 func (c *AuditlogCollector) InstrumentAuditlogHTTPClient(client *http.Client) {
 	client.Transport = promhttp.InstrumentRoundTripperDuration(c.auditlogRequestDuration, client.Transport)
 }
+
+type RateLimitCollector struct {
+	throttledRequests *prometheus.CounterVec
+}
+
+func NewRateLimitMetricCollector() *RateLimitCollector {
+	return &RateLimitCollector{
+		throttledRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "compass",
+			Subsystem: "gateway",
+			Name:      "rate_limit_throttled_requests_total",
+			Help:      "number of requests rejected because of exceeded rate limits",
+		}, []string{"consumer_type", "limit"}),
+	}
+}
+
+func (c *RateLimitCollector) Describe(ch chan<- *prometheus.Desc) {
+	c.throttledRequests.Describe(ch)
+}
+
+func (c *RateLimitCollector) Collect(ch chan<- prometheus.Metric) {
+	c.throttledRequests.Collect(ch)
+}
+
+func (c *RateLimitCollector) IncThrottledRequests(consumerType, limit string) {
+	c.throttledRequests.WithLabelValues(consumerType, limit).Inc()
+}
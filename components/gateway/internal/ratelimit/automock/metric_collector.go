@@ -0,0 +1,15 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package automock
+
+import mock "github.com/stretchr/testify/mock"
+
+// MetricCollector is an autogenerated mock type for the MetricCollector type
+type MetricCollector struct {
+	mock.Mock
+}
+
+// IncThrottledRequests provides a mock function with given fields: consumerType, limit
+func (_m *MetricCollector) IncThrottledRequests(consumerType string, limit string) {
+	_m.Called(consumerType, limit)
+}
@@ -0,0 +1,14 @@
+package ratelimit
+
+import "time"
+
+// Config lists the rate limits applied to the consumers. Limits are given in the form rate:burst, where rate is the number
+// of requests per second and burst is the number of requests which can be sent at once.
+// ConsumerTypes override the default limit in the form ConsumerType=rate:burst.
+// Operations limit the GraphQL operations in the form field=rate:burst, where field is a top-level field of the operation.
+type Config struct {
+	Default       string        `envconfig:"APP_RATE_LIMIT_DEFAULT,default=50:100"`
+	ConsumerTypes []string      `envconfig:"APP_RATE_LIMIT_CONSUMER_TYPES,optional"`
+	Operations    []string      `envconfig:"APP_RATE_LIMIT_OPERATIONS,optional"`
+	IdleTimeout   time.Duration `envconfig:"APP_RATE_LIMIT_IDLE_TIMEOUT,default=10m"`
+}
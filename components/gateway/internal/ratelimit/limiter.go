@@ -0,0 +1,205 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+)
+
+// ConsumerLimit is the name of the limit applied to all requests of a consumer.
+const ConsumerLimit = "consumer"
+
+type limit struct {
+	rate  rate.Limit
+	burst int
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Decision is the result of checking a request against the rate limits.
+// A request which exceeds the burst of a limit is never allowed, so ExceedsBurst is set instead of RetryAfter.
+type Decision struct {
+	Allowed      bool
+	ExceedsBurst bool
+	RetryAfter   time.Duration
+	// Limit is ConsumerLimit or the name of the operation field, limit of which is exceeded.
+	Limit string
+}
+
+// Limiter applies token bucket rate limits to the requests of each consumer, identified by its tenant, type and ID.
+type Limiter struct {
+	defaultLimit  limit
+	consumerTypes map[string]limit
+	operations    map[string]limit
+	idleTimeout   time.Duration
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func NewLimiter(cfg Config) (*Limiter, error) {
+	defaultLimit, err := parseLimit(cfg.Default)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing default rate limit")
+	}
+
+	consumerTypes, err := parseNamedLimits(cfg.ConsumerTypes)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing consumer type rate limits")
+	}
+
+	operations, err := parseNamedLimits(cfg.Operations)
+	if err != nil {
+		return nil, errors.Wrap(err, "while parsing operation rate limits")
+	}
+
+	return &Limiter{
+		defaultLimit:  defaultLimit,
+		consumerTypes: consumerTypes,
+		operations:    operations,
+		idleTimeout:   cfg.IdleTimeout,
+		buckets:       make(map[string]*bucket),
+	}, nil
+}
+
+// Allow checks the operations executed by a single request of the consumer. Each operation takes one token from the bucket
+// of the consumer, and from the bucket of each of its limited top-level fields. Tokens are taken only if the request is allowed.
+func (l *Limiter) Allow(now time.Time, claims proxy.Claims, operations []proxy.Operation) Decision {
+	consumerKey := fmt.Sprintf("%s/%s/%s", claims.Tenant, claims.ConsumerType, claims.ConsumerID)
+
+	consumerLimit, ok := l.consumerTypes[claims.ConsumerType]
+	if !ok {
+		consumerLimit = l.defaultLimit
+	}
+
+	names := []string{ConsumerLimit}
+	requested := map[string]int{ConsumerLimit: len(operations)}
+	if requested[ConsumerLimit] == 0 {
+		requested[ConsumerLimit] = 1
+	}
+	for _, operation := range operations {
+		for _, field := range operation.Fields {
+			if _, ok := l.operations[field]; !ok {
+				continue
+			}
+			if requested[field] == 0 {
+				names = append(names, field)
+			}
+			requested[field]++
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	reservations := make([]*rate.Reservation, 0, len(names))
+	decision := Decision{Allowed: true}
+	for _, name := range names {
+		count := requested[name]
+		key, lim := consumerKey, consumerLimit
+		if name != ConsumerLimit {
+			key, lim = consumerKey+"/"+name, l.operations[name]
+		}
+
+		reservation := l.bucket(key, lim, now).ReserveN(now, count)
+		if !reservation.OK() {
+			decision.Allowed = false
+			if !decision.ExceedsBurst {
+				decision.ExceedsBurst = true
+				decision.Limit = name
+				decision.RetryAfter = 0
+			}
+			continue
+		}
+
+		reservations = append(reservations, reservation)
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			decision.Allowed = false
+			if !decision.ExceedsBurst && delay > decision.RetryAfter {
+				decision.Limit = name
+				decision.RetryAfter = delay
+			}
+		}
+	}
+
+	if !decision.Allowed {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+
+	return decision
+}
+
+func (l *Limiter) bucket(key string, lim limit, now time.Time) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(lim.rate, lim.burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter
+}
+
+// sweep removes the buckets of consumers which did not send any request for the idle timeout. Such buckets are full,
+// so removing them does not change the limits.
+func (l *Limiter) sweep(now time.Time) {
+	if l.idleTimeout <= 0 || now.Sub(l.lastSweep) < l.idleTimeout {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= l.idleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func parseNamedLimits(values []string) (map[string]limit, error) {
+	limits := make(map[string]limit, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, errors.Errorf("invalid rate limit %q, expected name=rate:burst", value)
+		}
+
+		lim, err := parseLimit(parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "for %q", parts[0])
+		}
+		limits[strings.TrimSpace(parts[0])] = lim
+	}
+	return limits, nil
+}
+
+func parseLimit(value string) (limit, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return limit{}, errors.Errorf("invalid rate limit %q, expected rate:burst", value)
+	}
+
+	r, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || r <= 0 {
+		return limit{}, errors.Errorf("invalid rate %q, expected a positive number", parts[0])
+	}
+
+	burst, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil || burst <= 0 {
+		return limit{}, errors.Errorf("invalid burst %q, expected a positive integer", parts[1])
+	}
+
+	return limit{rate: rate.Limit(r), burst: burst}, nil
+}
@@ -0,0 +1,190 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	t.Run("Throttles consumer after burst is used and allows it again after refill", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:2"})
+		now := fixNow()
+
+		//WHEN
+		first := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+		second := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+		third := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+		afterRefill := limiter.Allow(now.Add(time.Second), fixRuntimeClaims("runtime"), nil)
+
+		//THEN
+		assert.True(t, first.Allowed)
+		assert.True(t, second.Allowed)
+		assert.Equal(t, ratelimit.Decision{Allowed: false, RetryAfter: time.Second, Limit: ratelimit.ConsumerLimit}, third)
+		assert.True(t, afterRefill.Allowed)
+	})
+
+	t.Run("Keeps separate buckets for each consumer and tenant", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1"})
+		now := fixNow()
+		otherTenant := fixRuntimeClaims("runtime")
+		otherTenant.Tenant = "other-tenant"
+
+		//WHEN
+		first := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+		otherConsumer := limiter.Allow(now, fixRuntimeClaims("other-runtime"), nil)
+		otherTenantDecision := limiter.Allow(now, otherTenant, nil)
+		again := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+
+		//THEN
+		assert.True(t, first.Allowed)
+		assert.True(t, otherConsumer.Allowed)
+		assert.True(t, otherTenantDecision.Allowed)
+		assert.False(t, again.Allowed)
+	})
+
+	t.Run("Applies limit of consumer type", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1", ConsumerTypes: []string{"Integration System=1:3"}})
+		now := fixNow()
+		claims := proxy.Claims{Tenant: "tenant", ConsumerID: "system", ConsumerType: "Integration System"}
+
+		//WHEN
+		decisions := []ratelimit.Decision{
+			limiter.Allow(now, claims, nil),
+			limiter.Allow(now, claims, nil),
+			limiter.Allow(now, claims, nil),
+			limiter.Allow(now, claims, nil),
+		}
+
+		//THEN
+		assert.True(t, decisions[0].Allowed)
+		assert.True(t, decisions[1].Allowed)
+		assert.True(t, decisions[2].Allowed)
+		assert.False(t, decisions[3].Allowed)
+	})
+
+	t.Run("Applies limit of operation field without taking consumer tokens of rejected request", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:2", Operations: []string{"registerApplication=0.5:1"}})
+		now := fixNow()
+		register := []proxy.Operation{{Type: proxy.MutationOperation, Fields: []string{"registerApplication"}}}
+		query := []proxy.Operation{{Type: proxy.QueryOperation, Fields: []string{"applications"}}}
+
+		//WHEN
+		first := limiter.Allow(now, fixRuntimeClaims("runtime"), register)
+		second := limiter.Allow(now, fixRuntimeClaims("runtime"), register)
+		otherOperation := limiter.Allow(now, fixRuntimeClaims("runtime"), query)
+
+		//THEN
+		assert.True(t, first.Allowed)
+		assert.Equal(t, ratelimit.Decision{Allowed: false, RetryAfter: 2 * time.Second, Limit: "registerApplication"}, second)
+		assert.True(t, otherOperation.Allowed)
+	})
+
+	t.Run("Takes a token for each operation in batched request", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:3"})
+		now := fixNow()
+		batch := []proxy.Operation{{}, {}}
+
+		//WHEN
+		first := limiter.Allow(now, fixRuntimeClaims("runtime"), batch)
+		second := limiter.Allow(now, fixRuntimeClaims("runtime"), batch)
+
+		//THEN
+		assert.True(t, first.Allowed)
+		assert.Equal(t, ratelimit.Decision{Allowed: false, RetryAfter: time.Second, Limit: ratelimit.ConsumerLimit}, second)
+	})
+
+	t.Run("Throttles batched request which exceeds the burst", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1"})
+
+		//WHEN
+		decision := limiter.Allow(fixNow(), fixRuntimeClaims("runtime"), []proxy.Operation{{}, {}})
+
+		//THEN
+		assert.Equal(t, ratelimit.Decision{Allowed: false, ExceedsBurst: true, Limit: ratelimit.ConsumerLimit}, decision)
+	})
+
+	t.Run("Removes idle buckets", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "0.001:1", IdleTimeout: time.Minute})
+		now := fixNow()
+
+		//WHEN
+		first := limiter.Allow(now, fixRuntimeClaims("runtime"), nil)
+		afterIdle := limiter.Allow(now.Add(2*time.Minute), fixRuntimeClaims("runtime"), nil)
+
+		//THEN
+		assert.True(t, first.Allowed)
+		assert.True(t, afterIdle.Allowed)
+	})
+}
+
+func TestNewLimiter(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Config        ratelimit.Config
+		ExpectedError string
+	}{
+		{
+			Name:          "Invalid default limit",
+			Config:        ratelimit.Config{Default: "10"},
+			ExpectedError: "while parsing default rate limit",
+		},
+		{
+			Name:          "Invalid rate",
+			Config:        ratelimit.Config{Default: "-1:10"},
+			ExpectedError: "invalid rate",
+		},
+		{
+			Name:          "Invalid burst",
+			Config:        ratelimit.Config{Default: "1:0"},
+			ExpectedError: "invalid burst",
+		},
+		{
+			Name:          "Consumer type limit without name",
+			Config:        ratelimit.Config{Default: "1:1", ConsumerTypes: []string{"1:1"}},
+			ExpectedError: "while parsing consumer type rate limits",
+		},
+		{
+			Name:          "Invalid operation limit",
+			Config:        ratelimit.Config{Default: "1:1", Operations: []string{"registerApplication=fast"}},
+			ExpectedError: "while parsing operation rate limits",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			_, err := ratelimit.NewLimiter(testCase.Config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.ExpectedError)
+		})
+	}
+}
+
+func fixLimiter(t *testing.T, cfg ratelimit.Config) *ratelimit.Limiter {
+	limiter, err := ratelimit.NewLimiter(cfg)
+	require.NoError(t, err)
+	return limiter
+}
+
+func fixRuntimeClaims(consumerID string) proxy.Claims {
+	return proxy.Claims{
+		Tenant:       "tenant",
+		ConsumerID:   consumerID,
+		ConsumerType: "Runtime",
+	}
+}
+
+func fixNow() time.Time {
+	return time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-incubator/compass/components/director/pkg/log"
+	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
+)
+
+const (
+	throttledResponse    = `{"errors":[{"message":"rate limit exceeded"}]}`
+	exceedsBurstResponse = `{"errors":[{"message":"request exceeds the rate limit burst, split it into smaller requests"}]}`
+)
+
+//go:generate mockery --name=MetricCollector --output=automock --outpkg=automock --case=underscore
+type MetricCollector interface {
+	IncThrottledRequests(consumerType, limit string)
+}
+
+//go:generate mockery --name=TimeService --output=automock --outpkg=automock --case=underscore
+type TimeService interface {
+	Now() time.Time
+}
+
+// Middleware rejects the requests of consumers which exceed their rate limits with 429 Too Many Requests.
+// Batched requests, which exceed the burst of a limit, can never be allowed, so they are rejected with 413 Request Entity Too Large and without Retry-After.
+// Requests without valid consumer claims are not limited.
+func Middleware(limiter *Limiter, collector MetricCollector, timeSvc TimeService) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			claims, err := proxy.ParseClaims(req.Header)
+			if err != nil {
+				log.C(req.Context()).WithError(err).Debugf("Will not apply rate limits to request without consumer claims: %v", err)
+				next.ServeHTTP(writer, req)
+				return
+			}
+
+			var operations []proxy.Operation
+			if req.Body != nil && req.Method != http.MethodGet {
+				body, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					http.Error(writer, "could not read request body", http.StatusBadRequest)
+					return
+				}
+				req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+				if operations, err = proxy.ParseOperations(body); err != nil {
+					log.C(req.Context()).WithError(err).Debugf("Will apply consumer rate limit only to request with unknown operations: %v", err)
+				}
+			}
+
+			decision := limiter.Allow(timeSvc.Now(), claims, operations)
+			if decision.Allowed {
+				next.ServeHTTP(writer, req)
+				return
+			}
+
+			log.C(req.Context()).Infof("Throttling request of consumer %s of type %s on %s limit", claims.ConsumerID, claims.ConsumerType, decision.Limit)
+			collector.IncThrottledRequests(claims.ConsumerType, decision.Limit)
+
+			writer.Header().Set("Content-Type", "application/json")
+			response := throttledResponse
+			if decision.ExceedsBurst {
+				response = exceedsBurstResponse
+				writer.WriteHeader(http.StatusRequestEntityTooLarge)
+			} else {
+				writer.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(decision.RetryAfter.Seconds()))))
+				writer.WriteHeader(http.StatusTooManyRequests)
+			}
+			if _, err := writer.Write([]byte(response)); err != nil {
+				log.C(req.Context()).WithError(err).Error("An error has occurred while writing to response body")
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package ratelimit_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit"
+	"github.com/kyma-incubator/compass/components/gateway/internal/ratelimit/automock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Run("Passes requests within the limit to the next handler with unchanged body", func(t *testing.T) {
+		//GIVEN
+		body := `{"query":"mutation { registerApplication(in: {name: \"app\"}) { id } }"}`
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1"})
+
+		collector := &automock.MetricCollector{}
+		timeSvc := &automock.TimeService{}
+		timeSvc.On("Now").Return(fixNow()).Once()
+
+		var receivedBody []byte
+		handler := ratelimit.Middleware(limiter, collector, timeSvc)(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			var err error
+			receivedBody, err = ioutil.ReadAll(req.Body)
+			require.NoError(t, err)
+			writer.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodPost, "http://localhost/director/graphql", bytes.NewBufferString(body))
+		req.Header.Set("Authorization", fixBearerHeader(t, "runtime"))
+		recorder := httptest.NewRecorder()
+
+		//WHEN
+		handler.ServeHTTP(recorder, req)
+
+		//THEN
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, body, string(receivedBody))
+		mock.AssertExpectationsForObjects(t, collector, timeSvc)
+	})
+
+	t.Run("Rejects requests over the operation limit with Retry-After", func(t *testing.T) {
+		//GIVEN
+		body := `{"query":"mutation { registerApplication(in: {name: \"app\"}) { id } }"}`
+		limiter := fixLimiter(t, ratelimit.Config{Default: "10:10", Operations: []string{"registerApplication=0.25:1"}})
+
+		collector := &automock.MetricCollector{}
+		collector.On("IncThrottledRequests", "Runtime", "registerApplication").Once()
+		timeSvc := &automock.TimeService{}
+		timeSvc.On("Now").Return(fixNow()).Twice()
+
+		calls := 0
+		handler := ratelimit.Middleware(limiter, collector, timeSvc)(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			calls++
+			writer.WriteHeader(http.StatusOK)
+		}))
+
+		//WHEN
+		recorders := make([]*httptest.ResponseRecorder, 0, 2)
+		for i := 0; i < 2; i++ {
+			req := httptest.NewRequest(http.MethodPost, "http://localhost/director/graphql", bytes.NewBufferString(body))
+			req.Header.Set("Authorization", fixBearerHeader(t, "runtime"))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			recorders = append(recorders, recorder)
+		}
+
+		//THEN
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, http.StatusOK, recorders[0].Code)
+		assert.Equal(t, http.StatusTooManyRequests, recorders[1].Code)
+		assert.Equal(t, "4", recorders[1].Header().Get("Retry-After"))
+		assert.JSONEq(t, `{"errors":[{"message":"rate limit exceeded"}]}`, recorders[1].Body.String())
+		mock.AssertExpectationsForObjects(t, collector, timeSvc)
+	})
+
+	t.Run("Rejects batched requests which exceed the burst without Retry-After", func(t *testing.T) {
+		//GIVEN
+		body := `[{"query":"{ viewer { id } }"},{"query":"{ viewer { id } }"}]`
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1"})
+
+		collector := &automock.MetricCollector{}
+		collector.On("IncThrottledRequests", "Runtime", ratelimit.ConsumerLimit).Once()
+		timeSvc := &automock.TimeService{}
+		timeSvc.On("Now").Return(fixNow()).Once()
+
+		calls := 0
+		handler := ratelimit.Middleware(limiter, collector, timeSvc)(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			calls++
+			writer.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodPost, "http://localhost/director/graphql", bytes.NewBufferString(body))
+		req.Header.Set("Authorization", fixBearerHeader(t, "runtime"))
+		recorder := httptest.NewRecorder()
+
+		//WHEN
+		handler.ServeHTTP(recorder, req)
+
+		//THEN
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Empty(t, recorder.Header().Get("Retry-After"))
+		assert.JSONEq(t, `{"errors":[{"message":"request exceeds the rate limit burst, split it into smaller requests"}]}`, recorder.Body.String())
+		mock.AssertExpectationsForObjects(t, collector, timeSvc)
+	})
+
+	t.Run("Does not limit requests without consumer claims", func(t *testing.T) {
+		//GIVEN
+		limiter := fixLimiter(t, ratelimit.Config{Default: "1:1"})
+		collector := &automock.MetricCollector{}
+		timeSvc := &automock.TimeService{}
+
+		calls := 0
+		handler := ratelimit.Middleware(limiter, collector, timeSvc)(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+			calls++
+			writer.WriteHeader(http.StatusOK)
+		}))
+
+		//WHEN
+		for i := 0; i < 3; i++ {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "http://localhost/director/graphql", bytes.NewBufferString(`{"query":"{ viewer { id } }"}`)))
+		}
+
+		//THEN
+		assert.Equal(t, 3, calls)
+		timeSvc.AssertNotCalled(t, "Now")
+		collector.AssertNotCalled(t, "IncThrottledRequests", mock.Anything, mock.Anything)
+	})
+}
+
+func fixBearerHeader(t *testing.T, consumerID string) string {
+	tenantJSON, err := json.Marshal(map[string]string{"consumerTenant": "tenant"})
+	require.NoError(t, err)
+
+	claims, err := json.Marshal(map[string]string{
+		"tenant":       string(tenantJSON),
+		"consumerID":   consumerID,
+		"consumerType": "Runtime",
+	})
+	require.NoError(t, err)
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg": "HS256","typ": "JWT"}`))
+	return fmt.Sprintf("Bearer %s.%s.", header, base64.RawURLEncoding.EncodeToString(claims))
+}
@@ -0,0 +1,9 @@
+package redaction
+
+// Config lists what is redacted from the audit log messages.
+// Types are GraphQL type names, values of which are redacted wherever they are used in arguments, variables or responses.
+// Fields are GraphQL schema coordinates in the form Type.field or Type.field(argument:).
+type Config struct {
+	Types  []string `envconfig:"APP_AUDITLOG_REDACTED_TYPES,default=CredentialData;CredentialDataInput;HttpHeaders;HttpHeadersSerialized;QueryParams;QueryParamsSerialized"`
+	Fields []string `envconfig:"APP_AUDITLOG_REDACTED_FIELDS,default=OneTimeToken.token;OneTimeToken.raw;OneTimeToken.rawEncoded;Token.token"`
+}
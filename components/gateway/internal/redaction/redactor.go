@@ -0,0 +1,408 @@
+package redaction
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// RedactedValue replaces the redacted values in the audit log messages.
+const RedactedValue = "[REDACTED]"
+
+var (
+	typeNameRegex   = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+	coordinateRegex = regexp.MustCompile(`^([_A-Za-z][_0-9A-Za-z]*)\.([_A-Za-z][_0-9A-Za-z]*)(?:\(([_A-Za-z][_0-9A-Za-z]*):\))?$`)
+)
+
+// Redactor removes credentials from GraphQL requests and responses before they are sent to the audit log.
+// Arguments, input fields and response fields are matched against the schema. Fields which are not part of the schema,
+// such as the ones served by the Connector, are matched only by the field names of the configured coordinates.
+type Redactor struct {
+	schema     *ast.Schema
+	types      map[string]bool
+	fields     map[string]bool
+	arguments  map[string]bool
+	fieldNames map[string]bool
+}
+
+func NewRedactor(schema *ast.Schema, cfg Config) (*Redactor, error) {
+	r := &Redactor{
+		schema:     schema,
+		types:      make(map[string]bool),
+		fields:     make(map[string]bool),
+		arguments:  make(map[string]bool),
+		fieldNames: make(map[string]bool),
+	}
+
+	for _, typeName := range cfg.Types {
+		if !typeNameRegex.MatchString(typeName) {
+			return nil, errors.Errorf("invalid redacted type %q", typeName)
+		}
+		for _, name := range r.withPossibleTypes(typeName) {
+			r.types[name] = true
+		}
+	}
+
+	for _, coordinate := range cfg.Fields {
+		matches := coordinateRegex.FindStringSubmatch(coordinate)
+		if matches == nil {
+			return nil, errors.Errorf("invalid redacted field %q, expected Type.field or Type.field(argument:)", coordinate)
+		}
+		typeName, fieldName, argumentName := matches[1], matches[2], matches[3]
+
+		for _, name := range r.withPossibleTypes(typeName) {
+			if argumentName != "" {
+				r.arguments[argumentCoordinate(name, fieldName, argumentName)] = true
+				continue
+			}
+			r.fields[fieldCoordinate(name, fieldName)] = true
+		}
+		if argumentName == "" {
+			r.fieldNames[fieldName] = true
+		}
+	}
+
+	return r, nil
+}
+
+// RedactRequest redacts the literal arguments in the query and the variables of the GraphQL request.
+func (r *Redactor) RedactRequest(request []byte) ([]byte, error) {
+	payload, err := decodeObject(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding GraphQL request")
+	}
+
+	red := &redaction{Redactor: r, variablesToRedact: make(map[string]bool)}
+
+	query, ok := payload["query"].(string)
+	if !ok {
+		red.redactPayloadField(payload, "variables")
+		return red.encode(request, payload)
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: query})
+	if gqlErr != nil {
+		red.redactPayloadField(payload, "query")
+		red.redactPayloadField(payload, "variables")
+		return red.encode(request, payload)
+	}
+	validator.Walk(r.schema, doc, &validator.Events{})
+
+	for _, operation := range doc.Operations {
+		red.arguments(operation.SelectionSet)
+	}
+	for _, fragment := range doc.Fragments {
+		red.arguments(fragment.SelectionSet)
+	}
+
+	if red.queryChanged {
+		var buf bytes.Buffer
+		formatter.NewFormatter(&buf).FormatQueryDocument(doc)
+		payload["query"] = buf.String()
+	}
+
+	if variables, ok := payload["variables"].(map[string]interface{}); ok {
+		for _, operation := range doc.Operations {
+			red.variables(operation.VariableDefinitions, variables)
+		}
+	}
+
+	return red.encode(request, payload)
+}
+
+// RedactResponse redacts the fields selected by the GraphQL request from the data of the GraphQL response.
+func (r *Redactor) RedactResponse(request, response []byte) ([]byte, error) {
+	payload, err := decodeObject(response)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding GraphQL response")
+	}
+
+	data, ok := payload["data"]
+	if !ok || data == nil {
+		return response, nil
+	}
+
+	red := &redaction{Redactor: r}
+
+	doc := r.parseRequest(request)
+	if doc == nil {
+		payload["data"] = red.outputByName(data)
+		return red.encode(response, payload)
+	}
+
+	for _, operation := range doc.Operations {
+		red.output(data, operation.SelectionSet)
+	}
+
+	return red.encode(response, payload)
+}
+
+func (r *Redactor) parseRequest(request []byte) *ast.QueryDocument {
+	payload, err := decodeObject(request)
+	if err != nil {
+		return nil
+	}
+	query, ok := payload["query"].(string)
+	if !ok {
+		return nil
+	}
+
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: query})
+	if gqlErr != nil {
+		return nil
+	}
+	validator.Walk(r.schema, doc, &validator.Events{})
+
+	return doc
+}
+
+func (r *Redactor) withPossibleTypes(typeName string) []string {
+	names := []string{typeName}
+	if def := r.schema.Types[typeName]; def != nil {
+		for _, possibleType := range r.schema.GetPossibleTypes(def) {
+			names = append(names, possibleType.Name)
+		}
+	}
+	return names
+}
+
+func (r *Redactor) isSensitiveType(typ *ast.Type) bool {
+	return typ != nil && r.types[typ.Name()]
+}
+
+func (r *Redactor) isSensitiveField(parent *ast.Definition, name string) bool {
+	if parent == nil {
+		return r.fieldNames[name]
+	}
+
+	fieldDef := parent.Fields.ForName(name)
+	if fieldDef == nil {
+		return r.fieldNames[name]
+	}
+
+	return r.fields[fieldCoordinate(parent.Name, name)] || r.isSensitiveType(fieldDef.Type)
+}
+
+func (r *Redactor) isSensitiveArgument(field *ast.Field, argument *ast.Argument) bool {
+	if field.ObjectDefinition != nil && r.arguments[argumentCoordinate(field.ObjectDefinition.Name, field.Name, argument.Name)] {
+		return true
+	}
+	return r.isSensitiveType(argument.Value.ExpectedType)
+}
+
+type redaction struct {
+	*Redactor
+	variablesToRedact map[string]bool
+	queryChanged      bool
+	changed           bool
+}
+
+func (red *redaction) arguments(selectionSet ast.SelectionSet) {
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			for _, argument := range selection.Arguments {
+				if red.isSensitiveArgument(selection, argument) {
+					argument.Value = red.redactLiteral(argument.Value)
+					continue
+				}
+				red.literal(argument.Value)
+			}
+			red.arguments(selection.SelectionSet)
+		case *ast.InlineFragment:
+			red.arguments(selection.SelectionSet)
+		}
+	}
+}
+
+func (red *redaction) literal(value *ast.Value) {
+	switch value.Kind {
+	case ast.ObjectValue:
+		for _, child := range value.Children {
+			if red.isSensitiveField(value.Definition, child.Name) {
+				child.Value = red.redactLiteral(child.Value)
+				continue
+			}
+			red.literal(child.Value)
+		}
+	case ast.ListValue:
+		for _, child := range value.Children {
+			red.literal(child.Value)
+		}
+	}
+}
+
+func (red *redaction) redactLiteral(value *ast.Value) *ast.Value {
+	red.collectVariables(value)
+
+	if value.Kind == ast.Variable || value.Kind == ast.NullValue {
+		return value
+	}
+
+	red.queryChanged = true
+	red.changed = true
+	return &ast.Value{Kind: ast.StringValue, Raw: RedactedValue, Position: value.Position}
+}
+
+func (red *redaction) collectVariables(value *ast.Value) {
+	if value.Kind == ast.Variable {
+		red.variablesToRedact[value.Raw] = true
+	}
+	for _, child := range value.Children {
+		red.collectVariables(child.Value)
+	}
+}
+
+func (red *redaction) variables(definitions ast.VariableDefinitionList, variables map[string]interface{}) {
+	for _, definition := range definitions {
+		value, ok := variables[definition.Variable]
+		if !ok {
+			continue
+		}
+
+		if red.variablesToRedact[definition.Variable] || red.isSensitiveType(definition.Type) {
+			variables[definition.Variable] = red.redact(value)
+			continue
+		}
+		red.input(definition.Definition, value)
+	}
+}
+
+func (red *redaction) input(def *ast.Definition, value interface{}) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for name, child := range value {
+			if red.isSensitiveField(def, name) {
+				value[name] = red.redact(child)
+				continue
+			}
+			red.input(red.fieldType(def, name), child)
+		}
+	case []interface{}:
+		for _, child := range value {
+			red.input(def, child)
+		}
+	}
+}
+
+func (red *redaction) output(value interface{}, selectionSet ast.SelectionSet) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, field := range collectFields(selectionSet, make(map[string]bool)) {
+			child, ok := value[field.Alias]
+			if !ok {
+				continue
+			}
+			if red.isSensitiveField(field.ObjectDefinition, field.Name) {
+				value[field.Alias] = red.redact(child)
+				continue
+			}
+			red.output(child, field.SelectionSet)
+		}
+	case []interface{}:
+		for _, child := range value {
+			red.output(child, selectionSet)
+		}
+	}
+}
+
+func (red *redaction) outputByName(value interface{}) interface{} {
+	red.input(nil, value)
+	return value
+}
+
+func (red *redaction) redact(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	red.changed = true
+	return RedactedValue
+}
+
+func (red *redaction) redactPayloadField(payload map[string]interface{}, name string) {
+	if value, ok := payload[name]; ok {
+		payload[name] = red.redact(value)
+	}
+}
+
+func (red *redaction) fieldType(parent *ast.Definition, name string) *ast.Definition {
+	if parent == nil {
+		return nil
+	}
+	fieldDef := parent.Fields.ForName(name)
+	if fieldDef == nil {
+		return nil
+	}
+	return red.schema.Types[fieldDef.Type.Name()]
+}
+
+func (red *redaction) encode(original []byte, payload map[string]interface{}) ([]byte, error) {
+	if !red.changed {
+		return original, nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(payload); err != nil {
+		return nil, errors.Wrap(err, "while encoding redacted payload")
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func collectFields(selectionSet ast.SelectionSet, visitedFragments map[string]bool) []*ast.Field {
+	var fields []*ast.Field
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, selection)
+		case *ast.InlineFragment:
+			fields = append(fields, collectFields(selection.SelectionSet, visitedFragments)...)
+		case *ast.FragmentSpread:
+			if selection.Definition == nil || visitedFragments[selection.Name] {
+				continue
+			}
+			visitedFragments[selection.Name] = true
+			fields = append(fields, collectFields(selection.Definition.SelectionSet, visitedFragments)...)
+		}
+	}
+	return fields
+}
+
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var object map[string]interface{}
+	if err := decoder.Decode(&object); err != nil {
+		return nil, err
+	}
+	return object, nil
+}
+
+func fieldCoordinate(typeName, fieldName string) string {
+	return fmt.Sprintf("%s.%s", typeName, fieldName)
+}
+
+func argumentCoordinate(typeName, fieldName, argumentName string) string {
+	return fmt.Sprintf("%s.%s(%s:)", typeName, fieldName, argumentName)
+}
+
+type NoOpRedactor struct {
+}
+
+func (r *NoOpRedactor) RedactRequest(request []byte) ([]byte, error) {
+	return request, nil
+}
+
+func (r *NoOpRedactor) RedactResponse(_, response []byte) ([]byte, error) {
+	return response, nil
+}
@@ -0,0 +1,439 @@
+package redaction_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	directorgraphql "github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/gateway/internal/redaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vrischmann/envconfig"
+)
+
+const (
+	secret = "s3cr3t"
+
+	authInput     = `{credential: {basic: {username: "admin", password: "s3cr3t"}}, additionalHeaders: {Authorization: ["Bearer s3cr3t"]}, requestAuth: {csrf: {tokenEndpointURL: "http://csrf.local", credential: {oauth: {clientId: "client", clientSecret: "s3cr3t", url: "http://token.local"}}}}}`
+	authOutput    = `{"credential": {"username": "admin", "password": "s3cr3t"}, "additionalHeaders": {"Authorization": ["Bearer s3cr3t"]}, "requestAuth": {"csrf": {"tokenEndpointURL": "http://csrf.local", "credential": {"clientId": "client", "clientSecret": "s3cr3t", "url": "http://token.local"}}}}`
+	authSelection = `auth { credential { ... on BasicCredentialData { username password } ... on OAuthCredentialData { clientId clientSecret url } } additionalHeaders requestAuth { csrf { tokenEndpointURL credential { ... on OAuthCredentialData { clientId clientSecret url } } } } }`
+)
+
+func TestRedactor_RedactRequest(t *testing.T) {
+	testCases := []struct {
+		Name  string
+		Query string
+	}{
+		{
+			Name:  "registerApplication",
+			Query: `mutation { registerApplication(in: {name: "app", webhooks: [{type: CONFIGURATION_CHANGED, url: "http://webhook.local", auth: ` + authInput + `}], bundles: [{name: "bundle", defaultInstanceAuth: ` + authInput + `, apiDefinitions: [{name: "api", targetURL: "http://api.local", spec: {type: OPEN_API, format: JSON, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}}]}]}) { id } }`,
+		},
+		{
+			Name:  "createApplicationTemplate",
+			Query: `mutation { createApplicationTemplate(in: {name: "template", accessLevel: GLOBAL, webhooks: [{type: CONFIGURATION_CHANGED, url: "http://webhook.local", auth: ` + authInput + `}], applicationInput: {name: "app", webhooks: [{type: CONFIGURATION_CHANGED, url: "http://webhook.local", auth: ` + authInput + `}]}}) { id } }`,
+		},
+		{
+			Name:  "updateApplicationTemplate",
+			Query: `mutation { updateApplicationTemplate(id: "id", in: {name: "template", accessLevel: GLOBAL, applicationInput: {name: "app", bundles: [{name: "bundle", defaultInstanceAuth: ` + authInput + `}]}}) { id } }`,
+		},
+		{
+			Name:  "addWebhook",
+			Query: `mutation { addWebhook(applicationID: "id", in: {type: CONFIGURATION_CHANGED, url: "http://webhook.local", auth: ` + authInput + `}) { id } }`,
+		},
+		{
+			Name:  "updateWebhook",
+			Query: `mutation { updateWebhook(webhookID: "id", in: {type: CONFIGURATION_CHANGED, url: "http://webhook.local", auth: ` + authInput + `}) { id } }`,
+		},
+		{
+			Name:  "addAPIDefinitionToBundle",
+			Query: `mutation { addAPIDefinitionToBundle(bundleID: "id", in: {name: "api", targetURL: "http://api.local", spec: {type: OPEN_API, format: JSON, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}}) { id } }`,
+		},
+		{
+			Name:  "updateAPIDefinition",
+			Query: `mutation { updateAPIDefinition(id: "id", in: {name: "api", targetURL: "http://api.local", spec: {type: OPEN_API, format: JSON, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}}) { id } }`,
+		},
+		{
+			Name:  "addEventDefinitionToBundle",
+			Query: `mutation { addEventDefinitionToBundle(bundleID: "id", in: {name: "event", spec: {type: ASYNC_API, format: YAML, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}}) { id } }`,
+		},
+		{
+			Name:  "updateEventDefinition",
+			Query: `mutation { updateEventDefinition(id: "id", in: {name: "event", spec: {type: ASYNC_API, format: YAML, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}}) { id } }`,
+		},
+		{
+			Name:  "addDocumentToBundle",
+			Query: `mutation { addDocumentToBundle(bundleID: "id", in: {title: "doc", displayName: "doc", description: "doc", format: MARKDOWN, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}) { id } }`,
+		},
+		{
+			Name:  "setBundleInstanceAuth",
+			Query: `mutation { setBundleInstanceAuth(authID: "id", in: {auth: ` + authInput + `, status: {condition: SUCCEEDED}}) { id } }`,
+		},
+		{
+			Name:  "addBundle",
+			Query: `mutation { addBundle(applicationID: "id", in: {name: "bundle", defaultInstanceAuth: ` + authInput + `, documents: [{title: "doc", displayName: "doc", description: "doc", format: MARKDOWN, fetchRequest: {url: "http://spec.local", auth: ` + authInput + `}}]}) { id } }`,
+		},
+		{
+			Name:  "updateBundle",
+			Query: `mutation { updateBundle(id: "id", in: {name: "bundle", defaultInstanceAuth: ` + authInput + `}) { id } }`,
+		},
+	}
+
+	redactor := fixRedactor(t)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			redacted, err := redactor.RedactRequest(fixRequest(t, testCase.Query, nil))
+
+			//THEN
+			require.NoError(t, err)
+			query := fixQuery(t, redacted)
+			assert.NotContains(t, query, secret)
+			assert.NotContains(t, query, "admin")
+			assert.Contains(t, query, redaction.RedactedValue)
+			assert.Contains(t, query, "http://")
+			assert.Contains(t, query, testCase.Name)
+		})
+	}
+}
+
+func TestRedactor_RedactRequestVariables(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("Redacts credentials in input object variables", func(t *testing.T) {
+		//GIVEN
+		query := `mutation ($in: WebhookInput!) { addWebhook(applicationID: "id", in: $in) { id } }`
+		variables := map[string]interface{}{
+			"in": map[string]interface{}{
+				"type": "CONFIGURATION_CHANGED",
+				"url":  "http://webhook.local",
+				"auth": map[string]interface{}{
+					"credential":        map[string]interface{}{"basic": map[string]interface{}{"username": "admin", "password": secret}},
+					"additionalHeaders": map[string]interface{}{"Authorization": []interface{}{"Bearer " + secret}},
+					"accessStrategy":    "strategy",
+				},
+			},
+		}
+
+		//WHEN
+		redacted, err := redactor.RedactRequest(fixRequest(t, query, variables))
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"in": map[string]interface{}{
+				"type": "CONFIGURATION_CHANGED",
+				"url":  "http://webhook.local",
+				"auth": map[string]interface{}{
+					"credential":        redaction.RedactedValue,
+					"additionalHeaders": redaction.RedactedValue,
+					"accessStrategy":    "strategy",
+				},
+			},
+		}, fixVariables(t, redacted))
+		assert.Equal(t, query, fixQuery(t, redacted))
+	})
+
+	t.Run("Redacts variables of redacted type", func(t *testing.T) {
+		//GIVEN
+		query := `mutation ($credential: CredentialDataInput) { setBundleInstanceAuth(authID: "id", in: {auth: {credential: $credential}}) { id } }`
+		variables := map[string]interface{}{
+			"credential": map[string]interface{}{"oauth": map[string]interface{}{"clientId": "client", "clientSecret": secret}},
+		}
+
+		//WHEN
+		redacted, err := redactor.RedactRequest(fixRequest(t, query, variables))
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"credential": redaction.RedactedValue}, fixVariables(t, redacted))
+	})
+
+	t.Run("Redacts variables used in redacted literals", func(t *testing.T) {
+		//GIVEN
+		query := `mutation ($password: String!, $url: String!) { setBundleInstanceAuth(authID: "id", in: {auth: {credential: {basic: {username: "admin", password: $password}}, requestAuth: {csrf: {tokenEndpointURL: $url}}}}) { id } }`
+		variables := map[string]interface{}{
+			"password": secret,
+			"url":      "http://csrf.local",
+		}
+
+		//WHEN
+		redacted, err := redactor.RedactRequest(fixRequest(t, query, variables))
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"password": redaction.RedactedValue,
+			"url":      "http://csrf.local",
+		}, fixVariables(t, redacted))
+		assert.NotContains(t, fixQuery(t, redacted), "admin")
+	})
+}
+
+func TestRedactor_RedactResponse(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Query    string
+		Response string
+		Kept     []string
+	}{
+		{
+			Name:     "requestOneTimeTokenForRuntime",
+			Query:    `mutation { requestOneTimeTokenForRuntime(id: "id") { token connectorURL raw rawEncoded used } }`,
+			Response: `{"data": {"requestOneTimeTokenForRuntime": {"token": "s3cr3t", "connectorURL": "http://connector.local", "raw": "s3cr3t", "rawEncoded": "s3cr3t", "used": false}}}`,
+			Kept:     []string{"http://connector.local"},
+		},
+		{
+			Name:     "requestOneTimeTokenForApplication",
+			Query:    `mutation { requestOneTimeTokenForApplication(id: "id") { token connectorURL legacyConnectorURL raw rawEncoded } }`,
+			Response: `{"data": {"requestOneTimeTokenForApplication": {"token": "s3cr3t", "connectorURL": "http://connector.local", "legacyConnectorURL": "http://legacy.local", "raw": "s3cr3t", "rawEncoded": "s3cr3t"}}}`,
+			Kept:     []string{"http://connector.local", "http://legacy.local"},
+		},
+		{
+			Name:     "requestClientCredentialsForRuntime",
+			Query:    `mutation { requestClientCredentialsForRuntime(id: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"requestClientCredentialsForRuntime": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "requestClientCredentialsForApplication",
+			Query:    `mutation { requestClientCredentialsForApplication(id: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"requestClientCredentialsForApplication": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "requestClientCredentialsForIntegrationSystem",
+			Query:    `mutation { requestClientCredentialsForIntegrationSystem(id: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"requestClientCredentialsForIntegrationSystem": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "deleteSystemAuthForRuntime",
+			Query:    `mutation { deleteSystemAuthForRuntime(authID: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"deleteSystemAuthForRuntime": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "deleteSystemAuthForApplication",
+			Query:    `mutation { deleteSystemAuthForApplication(authID: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"deleteSystemAuthForApplication": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "deleteSystemAuthForIntegrationSystem",
+			Query:    `mutation { deleteSystemAuthForIntegrationSystem(authID: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"deleteSystemAuthForIntegrationSystem": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "setBundleInstanceAuth",
+			Query:    `mutation { setBundleInstanceAuth(authID: "id", in: {status: {condition: SUCCEEDED}}) { id ` + authSelection + ` } }`,
+			Response: `{"data": {"setBundleInstanceAuth": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "deleteBundleInstanceAuth",
+			Query:    `mutation { deleteBundleInstanceAuth(authID: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"deleteBundleInstanceAuth": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "requestBundleInstanceAuthCreation",
+			Query:    `mutation { requestBundleInstanceAuthCreation(bundleID: "id", in: {}) { id ` + authSelection + ` } }`,
+			Response: `{"data": {"requestBundleInstanceAuthCreation": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "requestBundleInstanceAuthDeletion",
+			Query:    `mutation { requestBundleInstanceAuthDeletion(authID: "id") { id ` + authSelection + ` } }`,
+			Response: `{"data": {"requestBundleInstanceAuthDeletion": {"id": "auth-id", "auth": ` + authOutput + `}}}`,
+			Kept:     []string{"auth-id", "http://csrf.local"},
+		},
+		{
+			Name:     "registerApplication",
+			Query:    `mutation { app: registerApplication(in: {name: "app"}) { id ...Webhooks } } fragment Webhooks on Application { hooks: webhooks { url ` + authSelection + ` } }`,
+			Response: `{"data": {"app": {"id": "app-id", "hooks": [{"url": "http://webhook.local", "auth": ` + authOutput + `}]}}}`,
+			Kept:     []string{"app-id", "http://webhook.local", "http://csrf.local"},
+		},
+	}
+
+	redactor := fixRedactor(t)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//WHEN
+			redacted, err := redactor.RedactResponse(fixRequest(t, testCase.Query, nil), []byte(testCase.Response))
+
+			//THEN
+			require.NoError(t, err)
+			assert.NotContains(t, string(redacted), secret)
+			assert.Contains(t, string(redacted), redaction.RedactedValue)
+			for _, kept := range testCase.Kept {
+				assert.Contains(t, string(redacted), kept)
+			}
+		})
+	}
+}
+
+func TestRedactor_FieldsOutsideOfSchema(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("Redacts response fields by name", func(t *testing.T) {
+		//GIVEN
+		request := fixRequest(t, `mutation { generateApplicationToken(appID: "id") { token } }`, nil)
+		response := []byte(`{"data":{"generateApplicationToken":{"token":"s3cr3t"}}}`)
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"generateApplicationToken":{"token":"[REDACTED]"}}}`, string(redacted))
+	})
+
+	t.Run("Redacts response fields by name when request cannot be parsed", func(t *testing.T) {
+		//GIVEN
+		request := fixRequest(t, `mutation { generateApplicationToken(`, nil)
+		response := []byte(`{"data":{"generateApplicationToken":{"token":"s3cr3t"}}}`)
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"generateApplicationToken":{"token":"[REDACTED]"}}}`, string(redacted))
+	})
+
+	t.Run("Redacts query and variables when query cannot be parsed", func(t *testing.T) {
+		//GIVEN
+		request := fixRequest(t, `mutation { addWebhook(in: {auth: {credential: {basic: {password: "s3cr3t"`, map[string]interface{}{"password": secret})
+
+		//WHEN
+		redacted, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"query":"[REDACTED]","variables":"[REDACTED]"}`, string(redacted))
+	})
+}
+
+func TestRedactor_ConfiguredArguments(t *testing.T) {
+	//GIVEN
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+	redactor, err := redaction.NewRedactor(schema, redaction.Config{
+		Fields: []string{"Mutation.requestBundleInstanceAuthCreation(in:)", "Application.name"},
+	})
+	require.NoError(t, err)
+
+	query := `mutation ($params: JSON) { requestBundleInstanceAuthCreation(bundleID: "id", in: {id: "auth-id", inputParams: $params}) { id } registerApplication(in: {name: "app", description: "s3cr3t"}) { id name } }`
+
+	//WHEN
+	redactedRequest, err := redactor.RedactRequest(fixRequest(t, query, map[string]interface{}{"params": secret}))
+	require.NoError(t, err)
+	redactedResponse, err := redactor.RedactResponse(fixRequest(t, query, nil), []byte(`{"data":{"registerApplication":{"id":"app-id","name":"app"}}}`))
+	require.NoError(t, err)
+
+	//THEN
+	assert.Equal(t, map[string]interface{}{"params": redaction.RedactedValue}, fixVariables(t, redactedRequest))
+	assert.NotContains(t, fixQuery(t, redactedRequest), "auth-id")
+	assert.Contains(t, fixQuery(t, redactedRequest), secret)
+	assert.JSONEq(t, `{"data":{"registerApplication":{"id":"app-id","name":"[REDACTED]"}}}`, string(redactedResponse))
+}
+
+func TestRedactor_PayloadsWithoutCredentials(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("Returns request unchanged", func(t *testing.T) {
+		//GIVEN
+		request := fixRequest(t, `mutation ($id: ID!) { unregisterApplication(id: $id) { id name } }`, map[string]interface{}{"id": "app-id"})
+
+		//WHEN
+		redacted, err := redactor.RedactRequest(request)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, request, redacted)
+	})
+
+	t.Run("Returns response unchanged", func(t *testing.T) {
+		//GIVEN
+		request := fixRequest(t, `mutation { unregisterApplication(id: "app-id") { id name } }`, nil)
+		response := []byte(`{"data":{"unregisterApplication":{"id":"app-id","name":"app"}},"errors":[{"message":"<error>"}]}`)
+
+		//WHEN
+		redacted, err := redactor.RedactResponse(request, response)
+
+		//THEN
+		require.NoError(t, err)
+		assert.Equal(t, response, redacted)
+	})
+}
+
+func TestRedactor_InvalidPayloads(t *testing.T) {
+	redactor := fixRedactor(t)
+
+	t.Run("Fails for request which is not a JSON object", func(t *testing.T) {
+		_, err := redactor.RedactRequest([]byte(`mutation { }`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding GraphQL request")
+	})
+
+	t.Run("Fails for response which is not a JSON object", func(t *testing.T) {
+		_, err := redactor.RedactResponse(fixRequest(t, `mutation { }`, nil), []byte(`<html></html>`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while decoding GraphQL response")
+	})
+}
+
+func TestNewRedactor(t *testing.T) {
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+
+	t.Run("Fails for invalid type", func(t *testing.T) {
+		_, err := redaction.NewRedactor(schema, redaction.Config{Types: []string{"Credential Data"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid redacted type")
+	})
+
+	t.Run("Fails for invalid field coordinate", func(t *testing.T) {
+		_, err := redaction.NewRedactor(schema, redaction.Config{Fields: []string{"OneTimeToken"}})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid redacted field")
+	})
+}
+
+func fixRedactor(t *testing.T) *redaction.Redactor {
+	cfg := redaction.Config{}
+	require.NoError(t, envconfig.InitWithPrefix(&cfg, "APP"))
+
+	schema := directorgraphql.NewExecutableSchema(directorgraphql.Config{}).Schema()
+	redactor, err := redaction.NewRedactor(schema, cfg)
+	require.NoError(t, err)
+	return redactor
+}
+
+func fixRequest(t *testing.T, query string, variables map[string]interface{}) []byte {
+	payload := map[string]interface{}{"query": query}
+	if variables != nil {
+		payload["variables"] = variables
+	}
+
+	request, err := json.Marshal(payload)
+	require.NoError(t, err)
+	return request
+}
+
+func fixQuery(t *testing.T, request []byte) string {
+	var payload struct {
+		Query string `json:"query"`
+	}
+	require.NoError(t, json.Unmarshal(request, &payload))
+	return payload.Query
+}
+
+func fixVariables(t *testing.T, request []byte) map[string]interface{} {
+	var payload struct {
+		Variables map[string]interface{} `json:"variables"`
+	}
+	require.NoError(t, json.Unmarshal(request, &payload))
+	return payload.Variables
+}
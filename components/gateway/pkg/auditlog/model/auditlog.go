@@ -39,6 +39,13 @@ type SecurityEventData struct {
 	Reason        []ErrorMessage    `json:"reason"`
 }
 
+type DataAccessEventData struct {
+	ID            map[string]string `json:"id"`
+	CorrelationID string            `json:"correlation_id"`
+	Operation     string            `json:"operation"`
+	Fields        []string          `json:"fields"`
+}
+
 type Object struct {
 	ID   map[string]string `json:"id"`
 	Type string            `json:"type"`
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package automock
+
+import mock "github.com/stretchr/testify/mock"
+
+// Redactor is an autogenerated mock type for the Redactor type
+type Redactor struct {
+	mock.Mock
+}
+
+// RedactRequest provides a mock function with given fields: request
+func (_m *Redactor) RedactRequest(request []byte) ([]byte, error) {
+	ret := _m.Called(request)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte) []byte); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactResponse provides a mock function with given fields: request, response
+func (_m *Redactor) RedactResponse(request []byte, response []byte) ([]byte, error) {
+	ret := _m.Called(request, response)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, []byte) []byte); ok {
+		r0 = rf(request, response)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, []byte) error); ok {
+		r1 = rf(request, response)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.5.1. DO NOT EDIT.
+
+package automock
+
+import mock "github.com/stretchr/testify/mock"
+
+// SensitiveFieldDetector is an autogenerated mock type for the SensitiveFieldDetector type
+type SensitiveFieldDetector struct {
+	mock.Mock
+}
+
+// SensitiveFields provides a mock function with given fields: request
+func (_m *SensitiveFieldDetector) SensitiveFields(request []byte) ([]string, error) {
+	ret := _m.Called(request)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func([]byte) []string); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,175 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const (
+	QueryOperation    = string(ast.Query)
+	MutationOperation = string(ast.Mutation)
+)
+
+// Operation describes the GraphQL operation executed by a request. Fields are the top-level fields selected by the operation.
+type Operation struct {
+	Name   string
+	Type   string
+	Fields []string
+}
+
+type graphqlRequest struct {
+	body      []byte
+	operation Operation
+}
+
+// audited reports whether the request has to be audited. Requests for which the executed operation
+// cannot be determined are audited as well, as it is not known whether they modify any data.
+func (r graphqlRequest) audited() bool {
+	return r.operation.Type != QueryOperation
+}
+
+// ParseOperations returns the operations executed by a GraphQL request, one for each request in a batch.
+// Operations which cannot be determined are returned empty.
+func ParseOperations(body []byte) ([]Operation, error) {
+	requests, _, err := parseRequests(body)
+	if err != nil {
+		return nil, err
+	}
+
+	operations := make([]Operation, 0, len(requests))
+	for _, request := range requests {
+		operations = append(operations, request.operation)
+	}
+	return operations, nil
+}
+
+// parseRequests parses the body of a GraphQL request. Batched requests are sent as a JSON array of requests.
+func parseRequests(body []byte) ([]graphqlRequest, bool, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		request, err := parseRequest(body)
+		if err != nil {
+			return nil, false, err
+		}
+		return []graphqlRequest{request}, false, nil
+	}
+
+	var batch []json.RawMessage
+	if err := json.Unmarshal(trimmed, &batch); err != nil {
+		return nil, false, errors.Wrap(err, "could not unmarshal batched query")
+	}
+
+	requests := make([]graphqlRequest, 0, len(batch))
+	for i, body := range batch {
+		request, err := parseRequest(body)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "in batched query with index %d", i)
+		}
+		requests = append(requests, request)
+	}
+
+	return requests, true, nil
+}
+
+func parseRequest(body []byte) (graphqlRequest, error) {
+	var payload struct {
+		Query         *string `json:"query"`
+		OperationName string  `json:"operationName"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return graphqlRequest{}, errors.Wrap(err, "could not unmarshal query")
+	}
+
+	request := graphqlRequest{body: body}
+	if payload.Query == nil {
+		return request, nil
+	}
+
+	operation, err := parseOperation(*payload.Query, payload.OperationName)
+	if err != nil {
+		return request, nil
+	}
+	request.operation = operation
+
+	return request, nil
+}
+
+func parseOperation(query, operationName string) (Operation, error) {
+	doc, gqlErr := parser.ParseQuery(&ast.Source{Input: query})
+	if gqlErr != nil {
+		return Operation{}, gqlErr
+	}
+
+	operation, err := selectOperation(doc.Operations, operationName)
+	if err != nil {
+		return Operation{}, err
+	}
+
+	return Operation{
+		Name:   operation.Name,
+		Type:   string(operation.Operation),
+		Fields: topLevelFields(doc, operation.SelectionSet, make(map[string]bool), make(map[string]bool)),
+	}, nil
+}
+
+func selectOperation(operations ast.OperationList, operationName string) (*ast.OperationDefinition, error) {
+	if operationName != "" {
+		operation := operations.ForName(operationName)
+		if operation == nil {
+			return nil, errors.Errorf("operation %q not found", operationName)
+		}
+		return operation, nil
+	}
+
+	if len(operations) != 1 {
+		return nil, errors.New("operation name is required for documents with multiple operations")
+	}
+	return operations[0], nil
+}
+
+func topLevelFields(doc *ast.QueryDocument, selectionSet ast.SelectionSet, visitedFields, visitedFragments map[string]bool) []string {
+	var fields []string
+	for _, selection := range selectionSet {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if visitedFields[selection.Name] {
+				continue
+			}
+			visitedFields[selection.Name] = true
+			fields = append(fields, selection.Name)
+		case *ast.InlineFragment:
+			fields = append(fields, topLevelFields(doc, selection.SelectionSet, visitedFields, visitedFragments)...)
+		case *ast.FragmentSpread:
+			fragment := doc.Fragments.ForName(selection.Name)
+			if fragment == nil || visitedFragments[selection.Name] {
+				continue
+			}
+			visitedFragments[selection.Name] = true
+			fields = append(fields, topLevelFields(doc, fragment.SelectionSet, visitedFields, visitedFragments)...)
+		}
+	}
+	return fields
+}
+
+// splitResponses returns the response for each of the requests. If the responses of a batched request
+// cannot be matched with the requests, the whole response is returned for each of them.
+func splitResponses(body []byte, batched bool, count int) [][]byte {
+	responses := make([][]byte, count)
+
+	var batch []json.RawMessage
+	if batched && json.Unmarshal(body, &batch) == nil && len(batch) == count {
+		for i := range batch {
+			responses[i] = batch[i]
+		}
+		return responses
+	}
+
+	for i := range responses {
+		responses[i] = body
+	}
+	return responses
+}
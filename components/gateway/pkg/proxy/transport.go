@@ -20,8 +20,6 @@ const (
 	consumerTenant = "consumerTenant"
 )
 
-var emptyQuery error = errors.New("empty graphql query")
-
 //go:generate mockery --name=RoundTrip --output=automock --outpkg=automock --case=underscore
 type RoundTrip interface {
 	RoundTrip(*http.Request) (*http.Response, error)
@@ -38,10 +36,26 @@ type PreAuditlogService interface {
 	PreLog(ctx context.Context, msg AuditlogMessage) error
 }
 
+// RedactedResponse replaces the whole response in the post-change audit log message if the response could not be redacted.
+const RedactedResponse = "[REDACTED]"
+
+//go:generate mockery --name=Redactor --output=automock --outpkg=automock --case=underscore
+type Redactor interface {
+	RedactRequest(request []byte) ([]byte, error)
+	RedactResponse(request, response []byte) ([]byte, error)
+}
+
+//go:generate mockery --name=SensitiveFieldDetector --output=automock --outpkg=automock --case=underscore
+type SensitiveFieldDetector interface {
+	SensitiveFields(request []byte) ([]string, error)
+}
+
 type AuditlogMessage struct {
 	CorrelationIDHeaders correlation.Headers
 	Request              string
 	Response             string
+	Operation            Operation
+	SensitiveFields      []string
 	Claims
 }
 
@@ -49,13 +63,17 @@ type Transport struct {
 	http.RoundTripper
 	auditlogSink AuditlogService
 	auditlogSvc  PreAuditlogService
+	redactor     Redactor
+	detector     SensitiveFieldDetector
 }
 
-func NewTransport(sink AuditlogService, svc PreAuditlogService, trip RoundTrip) *Transport {
+func NewTransport(sink AuditlogService, svc PreAuditlogService, redactor Redactor, detector SensitiveFieldDetector, trip RoundTrip) *Transport {
 	return &Transport{
 		RoundTripper: trip,
 		auditlogSink: sink,
 		auditlogSvc:  svc,
+		redactor:     redactor,
+		detector:     detector,
 	}
 }
 
@@ -73,32 +91,61 @@ func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error
 
 	correlationHeaders := correlation.HeadersForRequest(req)
 
-	isMutation, err := checkQueryType(requestBody, "mutation")
-	if err != nil && err != emptyQuery {
+	requests, batched, err := parseRequests(requestBody)
+	if err != nil {
 		return nil, errors.Wrap(err, "could not check query type")
 	}
 
-	if !isMutation && err != emptyQuery {
+	auditedRequests := make(map[int][]byte)
+	sensitiveFields := make(map[int][]string)
+	for i, request := range requests {
+		if request.audited() {
+			auditedRequests[i] = nil
+			continue
+		}
+
+		fields, err := t.detector.SensitiveFields(request.body)
+		if err != nil {
+			log.C(req.Context()).WithError(err).Errorf("failed to detect sensitive fields in query: %v", err)
+			continue
+		}
+		if len(fields) > 0 {
+			sensitiveFields[i] = fields
+		}
+	}
+
+	if len(auditedRequests) == 0 && len(sensitiveFields) == 0 {
 		log.C(req.Context()).Debugln("Will not send auditlog message for queries")
 		return t.RoundTripper.RoundTrip(req)
 	}
 
-	preAuditLogger := t.auditlogSvc
-
-	claims, err := t.getClaims(req.Header)
+	claims, err := ParseClaims(req.Header)
 	if err != nil {
 		return nil, errors.Wrap(err, "while parsing JWT")
 	}
 
 	ctx := context.WithValue(req.Context(), correlation.RequestIDHeaderKey, correlationHeaders)
-	err = preAuditLogger.PreLog(ctx, AuditlogMessage{
-		CorrelationIDHeaders: correlationHeaders,
-		Request:              string(requestBody),
-		Response:             "",
-		Claims:               claims,
-	})
-	if err != nil {
-		return nil, errors.Wrap(err, "while sending pre-change auditlog message to auditlog service")
+	for i, request := range requests {
+		if _, ok := auditedRequests[i]; !ok {
+			continue
+		}
+
+		redactedRequest, err := t.redactor.RedactRequest(request.body)
+		if err != nil {
+			return nil, errors.Wrap(err, "while redacting request for auditlog")
+		}
+		auditedRequests[i] = redactedRequest
+
+		err = t.auditlogSvc.PreLog(ctx, AuditlogMessage{
+			CorrelationIDHeaders: correlationHeaders,
+			Request:              string(redactedRequest),
+			Response:             "",
+			Operation:            request.operation,
+			Claims:               claims,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "while sending pre-change auditlog message to auditlog service")
+		}
 	}
 
 	resp, err = t.RoundTripper.RoundTrip(req)
@@ -113,37 +160,57 @@ func (t *Transport) RoundTrip(req *http.Request) (resp *http.Response, err error
 	resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
 	defer httpcommon.CloseBody(req.Context(), resp.Body)
 
-	err = t.auditlogSink.Log(req.Context(), AuditlogMessage{
-		CorrelationIDHeaders: correlationHeaders,
-		Request:              string(requestBody),
-		Response:             string(responseBody),
-		Claims:               claims,
-	})
-	if err != nil {
-		log.C(ctx).WithError(err).Errorf("failed to send a post-change auditlog message to auditlog service: %v", err)
+	for i, request := range requests {
+		fields, ok := sensitiveFields[i]
+		if !ok {
+			continue
+		}
+
+		redactedRequest, err := t.redactor.RedactRequest(request.body)
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("failed to redact request for data access auditlog message: %v", err)
+			continue
+		}
+
+		err = t.auditlogSink.Log(req.Context(), AuditlogMessage{
+			CorrelationIDHeaders: correlationHeaders,
+			Request:              string(redactedRequest),
+			Operation:            request.operation,
+			SensitiveFields:      fields,
+			Claims:               claims,
+		})
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("failed to send a data access auditlog message to auditlog service: %v", err)
+		}
 	}
 
-	return resp, nil
-}
-
-func checkQueryType(requestBody []byte, typee string) (bool, error) {
-	var query map[string]interface{}
-	if err := json.Unmarshal(requestBody, &query); err != nil {
-		return false, errors.Wrap(err, "could not unmarshal query")
+	responses := splitResponses(responseBody, batched, len(requests))
+	for i, request := range requests {
+		redactedRequest, ok := auditedRequests[i]
+		if !ok {
+			continue
+		}
+
+		redactedResponse, err := t.redactor.RedactResponse(request.body, responses[i])
+		if err != nil {
+			// The change is audited even if the response can not be redacted, but without any part of the response, as it may contain credentials.
+			log.C(ctx).WithError(err).Errorf("failed to redact response for post-change auditlog message, the whole response is redacted: %v", err)
+			redactedResponse = []byte(RedactedResponse)
+		}
+
+		err = t.auditlogSink.Log(req.Context(), AuditlogMessage{
+			CorrelationIDHeaders: correlationHeaders,
+			Request:              string(redactedRequest),
+			Response:             string(redactedResponse),
+			Operation:            request.operation,
+			Claims:               claims,
+		})
+		if err != nil {
+			log.C(ctx).WithError(err).Errorf("failed to send a post-change auditlog message to auditlog service: %v", err)
+		}
 	}
-	queryObj, ok := query["query"]
-	if !ok {
-		return false, emptyQuery
-	}
-	queryString, ok := queryObj.(string)
-	if !ok {
-		return false, errors.New("query is not a string")
-	}
-	queryString = strings.TrimSpace(queryString)
-	if strings.HasPrefix(queryString, typee) {
-		return true, nil
-	}
-	return false, nil
+
+	return resp, nil
 }
 
 type Claims struct {
@@ -159,7 +226,8 @@ func (c Claims) Valid() error {
 	return nil
 }
 
-func (t *Transport) getClaims(headers http.Header) (Claims, error) {
+// ParseClaims extracts the consumer claims from the bearer token in the Authorization header. The token signature is not verified.
+func ParseClaims(headers http.Header) (Claims, error) {
 	tokenClaims := struct {
 		TenantString string `json:"tenant"`
 		Scopes       string `json:"scopes"`
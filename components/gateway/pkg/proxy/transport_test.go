@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-incubator/compass/components/gateway/pkg/auditlog/model"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy"
 	"github.com/kyma-incubator/compass/components/gateway/pkg/proxy/automock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -34,7 +35,7 @@ func TestTransport(t *testing.T) {
 		roundTripper := &automock.RoundTrip{}
 		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
 
-		transport := proxy.NewTransport(nil, nil, roundTripper)
+		transport := proxy.NewTransport(nil, nil, nil, nil, roundTripper)
 
 		//WHEN
 		_, err := transport.RoundTrip(req)
@@ -69,7 +70,7 @@ func TestTransport(t *testing.T) {
 		postAuditlogSvc := &automock.AuditlogService{}
 		postAuditlogSvc.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool { return msg.Claims == fixClaims() })).Return(nil).Once()
 
-		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, roundTripper)
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
 
 		//WHEN
 		output, err := transport.RoundTrip(req)
@@ -112,7 +113,7 @@ func TestTransport(t *testing.T) {
 		postAuditlogSvc := &automock.AuditlogService{}
 		postAuditlogSvc.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool { return msg.Claims == fixClaims() })).Return(errors.New("auditlog issue")).Once()
 
-		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, roundTripper)
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
 
 		//WHEN
 		output, err := transport.RoundTrip(req)
@@ -143,7 +144,7 @@ func TestTransport(t *testing.T) {
 		preAuditlogSvc := &automock.PreAuditlogService{}
 		preAuditlogSvc.On("PreLog", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool { return msg.Claims == fixClaims() })).Return(errors.New("auditlog issue"))
 
-		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, roundTripper)
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
 
 		//WHEN
 		_, err = transport.RoundTrip(req)
@@ -154,6 +155,436 @@ func TestTransport(t *testing.T) {
 		postAuditlogSvc.AssertNotCalled(t, "Log")
 		roundTripper.AssertNotCalled(t, "RoundTrip")
 	})
+
+	t.Run("Sends redacted request and response to auditlog", func(t *testing.T) {
+		//GIVEN
+		gqlReq := fixGraphQLMutation()
+		gqlReqPayload, err := json.Marshal(&gqlReq)
+		require.NoError(t, err)
+
+		gqlResp := fixGraphQLResponse()
+		gqlRespPayload, err := json.Marshal(&gqlResp)
+		require.NoError(t, err)
+
+		claims := fixBearerHeader(t)
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBuffer(gqlReqPayload))
+		req.Header = http.Header{
+			"Authorization": []string{claims},
+		}
+		resp := http.Response{
+			StatusCode:    http.StatusCreated,
+			Body:          ioutil.NopCloser(bytes.NewBuffer(gqlRespPayload)),
+			ContentLength: (int64)(len(gqlRespPayload)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		redactor := &automock.Redactor{}
+		redactor.On("RedactRequest", gqlReqPayload).Return([]byte("redacted-request"), nil).Once()
+		redactor.On("RedactResponse", gqlReqPayload, gqlRespPayload).Return([]byte("redacted-response"), nil).Once()
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		preAuditlogSvc.On("PreLog", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request" && msg.Response == ""
+		})).Return(nil).Once()
+
+		postAuditlogSvc := &automock.AuditlogService{}
+		postAuditlogSvc.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request" && msg.Response == "redacted-response"
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, redactor, fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, gqlRespPayload, body)
+		roundTripper.AssertExpectations(t)
+		redactor.AssertExpectations(t)
+		preAuditlogSvc.AssertExpectations(t)
+		postAuditlogSvc.AssertExpectations(t)
+	})
+
+	t.Run("Logs fully redacted response when response cannot be redacted", func(t *testing.T) {
+		//GIVEN
+		gqlReq := fixGraphQLMutation()
+		gqlReqPayload, err := json.Marshal(&gqlReq)
+		require.NoError(t, err)
+
+		gqlResp := fixGraphQLResponse()
+		gqlRespPayload, err := json.Marshal(&gqlResp)
+		require.NoError(t, err)
+
+		claims := fixBearerHeader(t)
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBuffer(gqlReqPayload))
+		req.Header = http.Header{
+			"Authorization": []string{claims},
+		}
+		resp := http.Response{
+			StatusCode:    http.StatusCreated,
+			Body:          ioutil.NopCloser(bytes.NewBuffer(gqlRespPayload)),
+			ContentLength: (int64)(len(gqlRespPayload)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		redactor := &automock.Redactor{}
+		redactor.On("RedactRequest", gqlReqPayload).Return([]byte("redacted-request"), nil).Once()
+		redactor.On("RedactResponse", gqlReqPayload, gqlRespPayload).Return(nil, errors.New("redaction issue")).Once()
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		preAuditlogSvc.On("PreLog", mock.Anything, mock.Anything).Return(nil).Once()
+
+		postAuditlogSvc := &automock.AuditlogService{}
+		postAuditlogSvc.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+			return msg.Request == "redacted-request" && msg.Response == proxy.RedactedResponse
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, redactor, fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, gqlRespPayload, body)
+		redactor.AssertExpectations(t)
+		preAuditlogSvc.AssertExpectations(t)
+		postAuditlogSvc.AssertExpectations(t)
+	})
+
+	t.Run("Fails when request cannot be redacted", func(t *testing.T) {
+		//GIVEN
+		gqlReq := fixGraphQLMutation()
+		gqlReqPayload, err := json.Marshal(&gqlReq)
+		require.NoError(t, err)
+
+		claims := fixBearerHeader(t)
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBuffer(gqlReqPayload))
+		req.Header = http.Header{
+			"Authorization": []string{claims},
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		postAuditlogSvc := &automock.AuditlogService{}
+
+		redactor := &automock.Redactor{}
+		redactor.On("RedactRequest", gqlReqPayload).Return(nil, errors.New("redaction issue")).Once()
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, redactor, fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		_, err = transport.RoundTrip(req)
+
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "redaction issue")
+		redactor.AssertExpectations(t)
+		preAuditlogSvc.AssertNotCalled(t, "PreLog")
+		postAuditlogSvc.AssertNotCalled(t, "Log")
+		roundTripper.AssertNotCalled(t, "RoundTrip")
+	})
+}
+
+func TestTransport_AuditedOperations(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		Request           map[string]interface{}
+		ExpectedOperation *proxy.Operation
+	}{
+		{
+			Name:              "Mutation with leading comment",
+			Request:           map[string]interface{}{"query": "# register application\nmutation { registerApplication(in: {name: \"app\"}) { id } }"},
+			ExpectedOperation: &proxy.Operation{Type: proxy.MutationOperation, Fields: []string{"registerApplication"}},
+		},
+		{
+			Name:              "Mutation after fragment definition",
+			Request:           map[string]interface{}{"query": "fragment Runtime on Mutation { registerRuntime(in: {name: \"rt\"}) { id } } mutation Register { ...Runtime rt: registerRuntime(in: {name: \"rt2\"}) { id } ... on Mutation { addWebhook(in: {}) { id } } }"},
+			ExpectedOperation: &proxy.Operation{Name: "Register", Type: proxy.MutationOperation, Fields: []string{"registerRuntime", "addWebhook"}},
+		},
+		{
+			Name:              "Mutation selected by operation name",
+			Request:           map[string]interface{}{"query": "query Read { viewer { id } } mutation Delete { unregisterApplication(id: \"id\") { id } }", "operationName": "Delete"},
+			ExpectedOperation: &proxy.Operation{Name: "Delete", Type: proxy.MutationOperation, Fields: []string{"unregisterApplication"}},
+		},
+		{
+			Name:              "Query selected by operation name",
+			Request:           map[string]interface{}{"query": "query Read { viewer { id } } mutation Delete { unregisterApplication(id: \"id\") { id } }", "operationName": "Read"},
+			ExpectedOperation: nil,
+		},
+		{
+			Name:              "Query",
+			Request:           map[string]interface{}{"query": "query { applications { data { id } } }"},
+			ExpectedOperation: nil,
+		},
+		{
+			Name:              "Query shorthand",
+			Request:           map[string]interface{}{"query": "{ viewer { id } }"},
+			ExpectedOperation: nil,
+		},
+		{
+			Name:              "Multiple operations without operation name",
+			Request:           map[string]interface{}{"query": "query Read { viewer { id } } mutation Delete { unregisterApplication(id: \"id\") { id } }"},
+			ExpectedOperation: &proxy.Operation{},
+		},
+		{
+			Name:              "Invalid query",
+			Request:           map[string]interface{}{"query": "mutation { registerApplication("},
+			ExpectedOperation: &proxy.Operation{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			//GIVEN
+			gqlReqPayload, err := json.Marshal(testCase.Request)
+			require.NoError(t, err)
+
+			gqlResp := fixGraphQLResponse()
+			gqlRespPayload, err := json.Marshal(&gqlResp)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest("POST", "http://localhost", bytes.NewBuffer(gqlReqPayload))
+			req.Header = http.Header{
+				"Authorization": []string{fixBearerHeader(t)},
+			}
+			resp := http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewBuffer(gqlRespPayload)),
+			}
+
+			roundTripper := &automock.RoundTrip{}
+			roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+			preAuditlogSvc := &automock.PreAuditlogService{}
+			postAuditlogSvc := &automock.AuditlogService{}
+			if testCase.ExpectedOperation != nil {
+				preAuditlogSvc.On("PreLog", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+					return assert.Equal(t, *testCase.ExpectedOperation, msg.Operation) && msg.Request == string(gqlReqPayload)
+				})).Return(nil).Once()
+				postAuditlogSvc.On("Log", mock.Anything, mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+					return assert.Equal(t, *testCase.ExpectedOperation, msg.Operation) && msg.Response == string(gqlRespPayload)
+				})).Return(nil).Once()
+			}
+
+			transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
+
+			//WHEN
+			_, err = transport.RoundTrip(req)
+
+			//THEN
+			require.NoError(t, err)
+			roundTripper.AssertExpectations(t)
+			preAuditlogSvc.AssertExpectations(t)
+			postAuditlogSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTransport_BatchedRequests(t *testing.T) {
+	t.Run("Audits mutations in batched request with their responses", func(t *testing.T) {
+		//GIVEN
+		queryPayload := `{"query":"{ viewer { id } }"}`
+		mutationPayload := `{"query":"mutation Delete { unregisterApplication(id: \"id\") { id } }","operationName":"Delete"}`
+		gqlReqPayload := fmt.Sprintf("[%s, %s]", queryPayload, mutationPayload)
+
+		queryResponse := `{"data":{"viewer":{"id":"id"}}}`
+		mutationResponse := `{"data":{"unregisterApplication":{"id":"id"}}}`
+		gqlRespPayload := fmt.Sprintf("[%s,%s]", queryResponse, mutationResponse)
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(gqlReqPayload))
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(gqlRespPayload)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		expectedOperation := proxy.Operation{Name: "Delete", Type: proxy.MutationOperation, Fields: []string{"unregisterApplication"}}
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		preAuditlogSvc.On("PreLog", mock.Anything, matchMessage(t, proxy.AuditlogMessage{
+			Request:   mutationPayload,
+			Operation: expectedOperation,
+			Claims:    fixClaims(),
+		})).Return(nil).Once()
+
+		postAuditlogSvc := &automock.AuditlogService{}
+		postAuditlogSvc.On("Log", mock.Anything, matchMessage(t, proxy.AuditlogMessage{
+			Request:   mutationPayload,
+			Response:  mutationResponse,
+			Operation: expectedOperation,
+			Claims:    fixClaims(),
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		roundTripper.AssertExpectations(t)
+		preAuditlogSvc.AssertExpectations(t)
+		postAuditlogSvc.AssertExpectations(t)
+	})
+
+	t.Run("Does not audit batched request with queries only", func(t *testing.T) {
+		//GIVEN
+		gqlReqPayload := `[{"query":"{ viewer { id } }"}, {"query":"query { applications { data { id } } }"}]`
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(gqlReqPayload))
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("[]")),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		postAuditlogSvc := &automock.AuditlogService{}
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		roundTripper.AssertExpectations(t)
+		preAuditlogSvc.AssertNotCalled(t, "PreLog")
+		postAuditlogSvc.AssertNotCalled(t, "Log")
+	})
+
+	t.Run("Fails for invalid batched request", func(t *testing.T) {
+		//GIVEN
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(`[{"query":"{ viewer { id } }"}, "mutation"]`))
+
+		roundTripper := &automock.RoundTrip{}
+		transport := proxy.NewTransport(nil, nil, fixNoOpRedactor(), fixNoOpDetector(), roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "in batched query with index 1")
+		roundTripper.AssertNotCalled(t, "RoundTrip")
+	})
+}
+
+func TestTransport_SensitiveQueries(t *testing.T) {
+	t.Run("Audits query with sensitive fields after the response", func(t *testing.T) {
+		//GIVEN
+		gqlReqPayload := `{"query":"query Auths { application(id: \"id\") { auths { id } } }","operationName":"Auths"}`
+		gqlRespPayload := `{"data":{"application":{"auths":[{"id":"id"}]}}}`
+		fields := []string{"Application.auths"}
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(gqlReqPayload))
+		req.Header = http.Header{
+			"Authorization": []string{fixBearerHeader(t)},
+		}
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(gqlRespPayload)),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		detector := &automock.SensitiveFieldDetector{}
+		detector.On("SensitiveFields", []byte(gqlReqPayload)).Return(fields, nil).Once()
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		postAuditlogSvc := &automock.AuditlogService{}
+		postAuditlogSvc.On("Log", mock.Anything, matchMessage(t, proxy.AuditlogMessage{
+			Request:         gqlReqPayload,
+			Operation:       proxy.Operation{Name: "Auths", Type: proxy.QueryOperation, Fields: []string{"application"}},
+			SensitiveFields: fields,
+			Claims:          fixClaims(),
+		})).Return(nil).Once()
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), detector, roundTripper)
+
+		//WHEN
+		output, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		body, err := ioutil.ReadAll(output.Body)
+		require.NoError(t, err)
+		require.Equal(t, gqlRespPayload, string(body))
+		roundTripper.AssertExpectations(t)
+		detector.AssertExpectations(t)
+		preAuditlogSvc.AssertNotCalled(t, "PreLog")
+		postAuditlogSvc.AssertExpectations(t)
+	})
+
+	t.Run("Does not audit query when sensitive fields cannot be detected", func(t *testing.T) {
+		//GIVEN
+		gqlReqPayload := `{"query":"{ viewer { id } }"}`
+
+		req := httptest.NewRequest("POST", "http://localhost", bytes.NewBufferString(gqlReqPayload))
+		resp := http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("{}")),
+		}
+
+		roundTripper := &automock.RoundTrip{}
+		roundTripper.On("RoundTrip", req).Return(&resp, nil).Once()
+
+		detector := &automock.SensitiveFieldDetector{}
+		detector.On("SensitiveFields", []byte(gqlReqPayload)).Return(nil, errors.New("detection issue")).Once()
+
+		preAuditlogSvc := &automock.PreAuditlogService{}
+		postAuditlogSvc := &automock.AuditlogService{}
+
+		transport := proxy.NewTransport(postAuditlogSvc, preAuditlogSvc, fixNoOpRedactor(), detector, roundTripper)
+
+		//WHEN
+		_, err := transport.RoundTrip(req)
+
+		//THEN
+		require.NoError(t, err)
+		roundTripper.AssertExpectations(t)
+		detector.AssertExpectations(t)
+		preAuditlogSvc.AssertNotCalled(t, "PreLog")
+		postAuditlogSvc.AssertNotCalled(t, "Log")
+	})
+}
+
+func matchMessage(t *testing.T, expected proxy.AuditlogMessage) interface{} {
+	return mock.MatchedBy(func(msg proxy.AuditlogMessage) bool {
+		msg.CorrelationIDHeaders = nil
+		return assert.Equal(t, expected, msg)
+	})
+}
+
+func fixNoOpRedactor() *automock.Redactor {
+	redactor := &automock.Redactor{}
+	redactor.On("RedactRequest", mock.Anything).Return(func(request []byte) []byte { return request }, nil)
+	redactor.On("RedactResponse", mock.Anything, mock.Anything).Return(func(_, response []byte) []byte { return response }, nil)
+	return redactor
+}
+
+func fixNoOpDetector() *automock.SensitiveFieldDetector {
+	detector := &automock.SensitiveFieldDetector{}
+	detector.On("SensitiveFields", mock.Anything).Return(nil, nil)
+	return detector
 }
 
 func fixTokenClaims(t *testing.T) proxy.Claims {
@@ -40,25 +40,46 @@ const (
 	OperationTypeDelete OperationType = "Delete"
 )
 
+const (
+	// OperationCategoryCancel is the category of operations which have been cancelled in Director while they were in progress.
+	// Cancelled operations are finalized as failed without executing any of their webhooks.
+	OperationCategoryCancel = "cancelOperation"
+	// AnnotationCancelledBy holds the ID of the Director consumer who cancelled the operation
+	AnnotationCancelledBy = "operations.compass/cancelled-by"
+)
+
+// +kubebuilder:validation:Enum=Sequential;Parallel
+type WebhookExecutionPolicy string
+
+const (
+	// WebhookExecutionPolicySequential executes the webhooks one after another in the order of OperationSpec.WebhookIDs
+	WebhookExecutionPolicySequential WebhookExecutionPolicy = "Sequential"
+	// WebhookExecutionPolicyParallel executes all webhooks at the same time
+	WebhookExecutionPolicyParallel WebhookExecutionPolicy = "Parallel"
+)
+
 // OperationSpec defines the desired state of Operation
 type OperationSpec struct {
-	OperationID       string        `json:"operation_id"`
-	OperationType     OperationType `json:"operation_type"`
-	OperationCategory string        `json:"operation_category"`
-	ResourceType      string        `json:"resource_type"`
-	ResourceID        string        `json:"resource_id"`
-	CorrelationID     string        `json:"correlation_id"`
-	WebhookIDs        []string      `json:"webhook_ids"`
-	RequestObject     string        `json:"request_object"`
+	OperationID            string                 `json:"operation_id"`
+	OperationType          OperationType          `json:"operation_type"`
+	OperationCategory      string                 `json:"operation_category"`
+	ResourceType           string                 `json:"resource_type"`
+	ResourceID             string                 `json:"resource_id"`
+	CorrelationID          string                 `json:"correlation_id"`
+	WebhookIDs             []string               `json:"webhook_ids"`
+	WebhookExecutionPolicy WebhookExecutionPolicy `json:"webhook_execution_policy,omitempty"`
+	RequestObject          string                 `json:"request_object"`
 }
 
-// +kubebuilder:validation:Enum=Success;Failed;In Progress
+// +kubebuilder:validation:Enum=Success;Failed;In Progress;Pending
 type State string
 
 const (
 	StateSuccess    State = "Success"
 	StateFailed     State = "Failed"
 	StateInProgress State = "In Progress"
+	// StatePending is used only for webhooks which wait for the preceding webhooks to finish
+	StatePending State = "Pending"
 )
 
 // Webhook is an entity part of the OperationStatus which holds information
@@ -69,6 +90,12 @@ type Webhook struct {
 	WebhookPollURL    string `json:"webhook_poll_url"`
 	LastPollTimestamp string `json:"last_poll_timestamp"`
 	State             State  `json:"state"`
+	// FailedAttempts is the number of consecutive failed calls to the webhook
+	FailedAttempts int `json:"failed_attempts,omitempty"`
+	// LastError is the error returned by the last failed call to the webhook
+	LastError string `json:"last_error,omitempty"`
+	// NextRetryTimestamp is the time after which the last failed call to the webhook can be retried
+	NextRetryTimestamp string `json:"next_retry_timestamp,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Ready;Error
@@ -132,37 +159,84 @@ func (o *OperationValidationErr) Error() string {
 
 // Validate implements validation logic for the Operation CR
 func (in *Operation) Validate() error {
-	webhookCount := len(in.Spec.WebhookIDs)
-	if webhookCount > 1 {
-		return &OperationValidationErr{Description: fmt.Sprintf("expected 0 or 1 webhook for execution, found: %d", webhookCount)}
+	switch in.Spec.WebhookExecutionPolicy {
+	case "", WebhookExecutionPolicySequential, WebhookExecutionPolicyParallel:
+	default:
+		return &OperationValidationErr{Description: fmt.Sprintf("unsupported webhook execution policy: %s", in.Spec.WebhookExecutionPolicy)}
+	}
+
+	webhookIDs := make(map[string]bool, len(in.Spec.WebhookIDs))
+	for _, webhookID := range in.Spec.WebhookIDs {
+		if webhookIDs[webhookID] {
+			return &OperationValidationErr{Description: fmt.Sprintf("webhook with ID %s is referenced more than once", webhookID)}
+		}
+		webhookIDs[webhookID] = true
+	}
+
+	return nil
+}
+
+// ExecutionPolicy returns the webhook execution policy of the current Operation,
+// defaulting to sequential execution if a policy has not been provided
+func (in *Operation) ExecutionPolicy() WebhookExecutionPolicy {
+	if in.Spec.WebhookExecutionPolicy == "" {
+		return WebhookExecutionPolicySequential
+	}
+
+	return in.Spec.WebhookExecutionPolicy
+}
+
+// Cancelled returns whether the current operation has been cancelled in Director
+func (in *Operation) Cancelled() bool {
+	return in.Spec.OperationCategory == OperationCategoryCancel
+}
+
+// CancellationMessage returns the error message with which a cancelled operation is finalized
+func (in *Operation) CancellationMessage() string {
+	if cancelledBy := in.Annotations[AnnotationCancelledBy]; cancelledBy != "" {
+		return fmt.Sprintf("operation cancelled by %s", cancelledBy)
+	}
+
+	return "operation cancelled"
+}
+
+// WebhookStatus returns the status of the webhook with the given ID
+// and nil if the webhook is not part of the Operation status
+func (in *Operation) WebhookStatus(webhookID string) *Webhook {
+	for i := range in.Status.Webhooks {
+		if in.Status.Webhooks[i].WebhookID == webhookID {
+			return &in.Status.Webhooks[i]
+		}
 	}
 
 	return nil
 }
 
-// HasPollURL checks whether the current Operation has been provided with a Poll URL
-func (in *Operation) HasPollURL() bool {
-	return in.PollURL() != ""
+// HasPollURL checks whether the webhook with the given ID has been provided with a Poll URL
+func (in *Operation) HasPollURL(webhookID string) bool {
+	return in.PollURL(webhookID) != ""
 }
 
-// PollURL returns the Poll URL for the current Operation
+// PollURL returns the Poll URL for the webhook with the given ID
 // and empty string if a URL has not been provided
-func (in *Operation) PollURL() string {
-	if len(in.Status.Webhooks) == 0 {
+func (in *Operation) PollURL(webhookID string) string {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil {
 		return ""
 	}
 
-	return in.Status.Webhooks[0].WebhookPollURL
+	return webhookStatus.WebhookPollURL
 }
 
 // NextPollTime calculates the remaining time until the Poll URL associated with
-// the current Operation can be requested/polled again.
-func (in *Operation) NextPollTime(retryInterval *int, timeLayout string) (time.Duration, error) {
-	if len(in.Status.Webhooks) == 0 || in.Status.Webhooks[0].LastPollTimestamp == "" || retryInterval == nil {
+// the webhook with the given ID can be requested/polled again.
+func (in *Operation) NextPollTime(webhookID string, retryInterval *int, timeLayout string) (time.Duration, error) {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil || webhookStatus.LastPollTimestamp == "" || retryInterval == nil {
 		return 0, nil
 	}
 
-	lastPollTimestamp, err := time.Parse(timeLayout, in.Status.Webhooks[0].LastPollTimestamp)
+	lastPollTimestamp, err := time.Parse(timeLayout, webhookStatus.LastPollTimestamp)
 	if err != nil {
 		return 0, err
 	}
@@ -171,6 +245,22 @@ func (in *Operation) NextPollTime(retryInterval *int, timeLayout string) (time.D
 	return time.Until(nextPollTime), nil
 }
 
+// NextRetryTime calculates the remaining time until the last failed call to the
+// webhook with the given ID can be retried according to the webhook retry policy.
+func (in *Operation) NextRetryTime(webhookID string, timeLayout string) (time.Duration, error) {
+	webhookStatus := in.WebhookStatus(webhookID)
+	if webhookStatus == nil || webhookStatus.NextRetryTimestamp == "" {
+		return 0, nil
+	}
+
+	nextRetryTime, err := time.Parse(timeLayout, webhookStatus.NextRetryTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Until(nextRetryTime), nil
+}
+
 // TimeoutReached returns whether the current operation has timed-out or not
 // based on the InitializedAt status timestamp of the Operation and the provided
 // timeout duration variable.
@@ -185,18 +275,29 @@ func (in *Operation) TimeoutReached(timeout time.Duration) bool {
 // webhook templates as part of the Operation Controller reconcile logic.
 func (in *Operation) RequestObject() (webhook.RequestObject, error) {
 	str := struct {
-		Application graphql.Application
-		TenantID    string
-		Headers     map[string]string
+		Application        graphql.Application
+		Runtime            *graphql.Runtime
+		BundleInstanceAuth *graphql.BundleInstanceAuth
+		TenantID           string
+		Headers            map[string]string
 	}{}
 
 	if err := json.Unmarshal([]byte(in.Spec.RequestObject), &str); err != nil {
 		return webhook.RequestObject{}, err
 	}
 
-	return webhook.RequestObject{
+	requestObject := webhook.RequestObject{
 		Application: &str.Application,
 		TenantID:    str.TenantID,
 		Headers:     str.Headers,
-	}, nil
+	}
+
+	if str.Runtime != nil {
+		requestObject.Runtime = str.Runtime
+	}
+	if str.BundleInstanceAuth != nil {
+		requestObject.BundleInstanceAuth = str.BundleInstanceAuth
+	}
+
+	return requestObject, nil
 }
@@ -95,17 +95,19 @@ func assertStatusManagerSuccessStatusCalledWithOperation(t *testing.T, statusMan
 	require.Equal(t, expectedOperation, actualOperation)
 }
 
-func assertStatusManagerInProgressWithPollURLCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedPollURL string) {
+func assertStatusManagerInProgressWithPollURLCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedWebhookID, expectedPollURL string) {
 	require.Equal(t, 1, statusManagerClient.InProgressWithPollURLCallCount())
-	_, actualOperation, pollURL := statusManagerClient.InProgressWithPollURLArgsForCall(0)
+	_, actualOperation, webhookID, pollURL := statusManagerClient.InProgressWithPollURLArgsForCall(0)
 	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedWebhookID, webhookID)
 	require.Equal(t, expectedPollURL, pollURL)
 }
 
-func assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedPollURL string) {
+func assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedWebhookID, expectedPollURL string) {
 	require.Equal(t, 1, statusManagerClient.InProgressWithPollURLAndLastPollTimestampCallCount())
-	_, actualOperation, pollURL, lastPollTimestamp, retryCount := statusManagerClient.InProgressWithPollURLAndLastPollTimestampArgsForCall(0)
+	_, actualOperation, webhookID, pollURL, lastPollTimestamp, retryCount := statusManagerClient.InProgressWithPollURLAndLastPollTimestampArgsForCall(0)
 	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedWebhookID, webhookID)
 	require.Equal(t, expectedPollURL, pollURL)
 
 	timestamp, err := time.Parse(time.RFC3339Nano, lastPollTimestamp)
@@ -114,6 +116,26 @@ func assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t *testing.T
 	require.Equal(t, expectedOperation.Status.Webhooks[0].RetriesCount+1, retryCount)
 }
 
+func assertStatusManagerInProgressWithFailedAttemptCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedWebhookID, expectedLastError string, expectedFailedAttempts int, expectedRetryDelay time.Duration) {
+	require.Equal(t, 1, statusManagerClient.InProgressWithFailedAttemptCallCount())
+	_, actualOperation, webhookID, lastError, nextRetryTimestamp, failedAttempts := statusManagerClient.InProgressWithFailedAttemptArgsForCall(0)
+	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedWebhookID, webhookID)
+	require.Equal(t, expectedLastError, lastError)
+	require.Equal(t, expectedFailedAttempts, failedAttempts)
+
+	timestamp, err := time.Parse(time.RFC3339Nano, nextRetryTimestamp)
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(expectedRetryDelay), timestamp, 5*time.Second)
+}
+
+func assertStatusManagerWebhookSuccessStatusCalled(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedWebhookID string) {
+	require.Equal(t, 1, statusManagerClient.WebhookSuccessStatusCallCount())
+	_, actualOperation, webhookID := statusManagerClient.WebhookSuccessStatusArgsForCall(0)
+	require.Equal(t, expectedOperation, actualOperation)
+	require.Equal(t, expectedWebhookID, webhookID)
+}
+
 func assertStatusManagerFailedStatusCalledWithOperation(t *testing.T, statusManagerClient *controllersfakes.FakeStatusManager, expectedOperation *v1alpha1.Operation, expectedErrorMsg string) {
 	require.Equal(t, 1, statusManagerClient.FailedStatusCallCount())
 	_, actualOperation, errorMsg := statusManagerClient.FailedStatusArgsForCall(0)
@@ -136,6 +158,8 @@ func assertDirectorUpdateOperationWithErrorCalled(t *testing.T, directorClient *
 
 func assertDirectorUpdateOperationWithErrorInvocation(t *testing.T, directorClient *controllersfakes.FakeDirectorClient, operation *v1alpha1.Operation, errMsg string, invocation int) {
 	_, actualRequest := directorClient.UpdateOperationArgsForCall(invocation)
+	require.Equal(t, operation.Name, actualRequest.OperationID)
+	require.Equal(t, operation.Generation, actualRequest.OperationGeneration)
 	require.Equal(t, graphql.OperationType(operation.Spec.OperationType), actualRequest.OperationType)
 	require.Equal(t, resource.Type(operation.Spec.ResourceType), actualRequest.ResourceType)
 	require.Equal(t, operation.Spec.ResourceID, actualRequest.ResourceID)
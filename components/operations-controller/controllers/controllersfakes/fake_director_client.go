@@ -25,6 +25,34 @@ type FakeDirectorClient struct {
 		result1 *director.ApplicationOutput
 		result2 error
 	}
+	FetchBundleInstanceAuthStub        func(context.Context, string) (*directora.BundleInstanceAuthOutput, error)
+	fetchBundleInstanceAuthMutex       sync.RWMutex
+	fetchBundleInstanceAuthArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	fetchBundleInstanceAuthReturns struct {
+		result1 *directora.BundleInstanceAuthOutput
+		result2 error
+	}
+	fetchBundleInstanceAuthReturnsOnCall map[int]struct {
+		result1 *directora.BundleInstanceAuthOutput
+		result2 error
+	}
+	FetchRuntimeStub        func(context.Context, string) (*directora.RuntimeOutput, error)
+	fetchRuntimeMutex       sync.RWMutex
+	fetchRuntimeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	fetchRuntimeReturns struct {
+		result1 *directora.RuntimeOutput
+		result2 error
+	}
+	fetchRuntimeReturnsOnCall map[int]struct {
+		result1 *directora.RuntimeOutput
+		result2 error
+	}
 	UpdateOperationStub        func(context.Context, *directora.Request) error
 	updateOperationMutex       sync.RWMutex
 	updateOperationArgsForCall []struct {
@@ -106,6 +134,136 @@ func (fake *FakeDirectorClient) FetchApplicationReturnsOnCall(i int, result1 *di
 	}{result1, result2}
 }
 
+func (fake *FakeDirectorClient) FetchBundleInstanceAuth(arg1 context.Context, arg2 string) (*directora.BundleInstanceAuthOutput, error) {
+	fake.fetchBundleInstanceAuthMutex.Lock()
+	ret, specificReturn := fake.fetchBundleInstanceAuthReturnsOnCall[len(fake.fetchBundleInstanceAuthArgsForCall)]
+	fake.fetchBundleInstanceAuthArgsForCall = append(fake.fetchBundleInstanceAuthArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FetchBundleInstanceAuthStub
+	fakeReturns := fake.fetchBundleInstanceAuthReturns
+	fake.recordInvocation("FetchBundleInstanceAuth", []interface{}{arg1, arg2})
+	fake.fetchBundleInstanceAuthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDirectorClient) FetchBundleInstanceAuthCallCount() int {
+	fake.fetchBundleInstanceAuthMutex.RLock()
+	defer fake.fetchBundleInstanceAuthMutex.RUnlock()
+	return len(fake.fetchBundleInstanceAuthArgsForCall)
+}
+
+func (fake *FakeDirectorClient) FetchBundleInstanceAuthCalls(stub func(context.Context, string) (*directora.BundleInstanceAuthOutput, error)) {
+	fake.fetchBundleInstanceAuthMutex.Lock()
+	defer fake.fetchBundleInstanceAuthMutex.Unlock()
+	fake.FetchBundleInstanceAuthStub = stub
+}
+
+func (fake *FakeDirectorClient) FetchBundleInstanceAuthArgsForCall(i int) (context.Context, string) {
+	fake.fetchBundleInstanceAuthMutex.RLock()
+	defer fake.fetchBundleInstanceAuthMutex.RUnlock()
+	argsForCall := fake.fetchBundleInstanceAuthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDirectorClient) FetchBundleInstanceAuthReturns(result1 *directora.BundleInstanceAuthOutput, result2 error) {
+	fake.fetchBundleInstanceAuthMutex.Lock()
+	defer fake.fetchBundleInstanceAuthMutex.Unlock()
+	fake.FetchBundleInstanceAuthStub = nil
+	fake.fetchBundleInstanceAuthReturns = struct {
+		result1 *directora.BundleInstanceAuthOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDirectorClient) FetchBundleInstanceAuthReturnsOnCall(i int, result1 *directora.BundleInstanceAuthOutput, result2 error) {
+	fake.fetchBundleInstanceAuthMutex.Lock()
+	defer fake.fetchBundleInstanceAuthMutex.Unlock()
+	fake.FetchBundleInstanceAuthStub = nil
+	if fake.fetchBundleInstanceAuthReturnsOnCall == nil {
+		fake.fetchBundleInstanceAuthReturnsOnCall = make(map[int]struct {
+			result1 *directora.BundleInstanceAuthOutput
+			result2 error
+		})
+	}
+	fake.fetchBundleInstanceAuthReturnsOnCall[i] = struct {
+		result1 *directora.BundleInstanceAuthOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDirectorClient) FetchRuntime(arg1 context.Context, arg2 string) (*directora.RuntimeOutput, error) {
+	fake.fetchRuntimeMutex.Lock()
+	ret, specificReturn := fake.fetchRuntimeReturnsOnCall[len(fake.fetchRuntimeArgsForCall)]
+	fake.fetchRuntimeArgsForCall = append(fake.fetchRuntimeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.FetchRuntimeStub
+	fakeReturns := fake.fetchRuntimeReturns
+	fake.recordInvocation("FetchRuntime", []interface{}{arg1, arg2})
+	fake.fetchRuntimeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDirectorClient) FetchRuntimeCallCount() int {
+	fake.fetchRuntimeMutex.RLock()
+	defer fake.fetchRuntimeMutex.RUnlock()
+	return len(fake.fetchRuntimeArgsForCall)
+}
+
+func (fake *FakeDirectorClient) FetchRuntimeCalls(stub func(context.Context, string) (*directora.RuntimeOutput, error)) {
+	fake.fetchRuntimeMutex.Lock()
+	defer fake.fetchRuntimeMutex.Unlock()
+	fake.FetchRuntimeStub = stub
+}
+
+func (fake *FakeDirectorClient) FetchRuntimeArgsForCall(i int) (context.Context, string) {
+	fake.fetchRuntimeMutex.RLock()
+	defer fake.fetchRuntimeMutex.RUnlock()
+	argsForCall := fake.fetchRuntimeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDirectorClient) FetchRuntimeReturns(result1 *directora.RuntimeOutput, result2 error) {
+	fake.fetchRuntimeMutex.Lock()
+	defer fake.fetchRuntimeMutex.Unlock()
+	fake.FetchRuntimeStub = nil
+	fake.fetchRuntimeReturns = struct {
+		result1 *directora.RuntimeOutput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDirectorClient) FetchRuntimeReturnsOnCall(i int, result1 *directora.RuntimeOutput, result2 error) {
+	fake.fetchRuntimeMutex.Lock()
+	defer fake.fetchRuntimeMutex.Unlock()
+	fake.FetchRuntimeStub = nil
+	if fake.fetchRuntimeReturnsOnCall == nil {
+		fake.fetchRuntimeReturnsOnCall = make(map[int]struct {
+			result1 *directora.RuntimeOutput
+			result2 error
+		})
+	}
+	fake.fetchRuntimeReturnsOnCall[i] = struct {
+		result1 *directora.RuntimeOutput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDirectorClient) UpdateOperation(arg1 context.Context, arg2 *directora.Request) error {
 	fake.updateOperationMutex.Lock()
 	ret, specificReturn := fake.updateOperationReturnsOnCall[len(fake.updateOperationArgsForCall)]
@@ -173,6 +331,10 @@ func (fake *FakeDirectorClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.fetchApplicationMutex.RLock()
 	defer fake.fetchApplicationMutex.RUnlock()
+	fake.fetchBundleInstanceAuthMutex.RLock()
+	defer fake.fetchBundleInstanceAuthMutex.RUnlock()
+	fake.fetchRuntimeMutex.RLock()
+	defer fake.fetchRuntimeMutex.RUnlock()
 	fake.updateOperationMutex.RLock()
 	defer fake.updateOperationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -23,12 +23,29 @@ type FakeStatusManager struct {
 	failedStatusReturnsOnCall map[int]struct {
 		result1 error
 	}
-	InProgressWithPollURLStub        func(context.Context, *v1alpha1.Operation, string) error
+	InProgressWithFailedAttemptStub        func(context.Context, *v1alpha1.Operation, string, string, string, int) error
+	inProgressWithFailedAttemptMutex       sync.RWMutex
+	inProgressWithFailedAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 int
+	}
+	inProgressWithFailedAttemptReturns struct {
+		result1 error
+	}
+	inProgressWithFailedAttemptReturnsOnCall map[int]struct {
+		result1 error
+	}
+	InProgressWithPollURLStub        func(context.Context, *v1alpha1.Operation, string, string) error
 	inProgressWithPollURLMutex       sync.RWMutex
 	inProgressWithPollURLArgsForCall []struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
+		arg4 string
 	}
 	inProgressWithPollURLReturns struct {
 		result1 error
@@ -36,14 +53,15 @@ type FakeStatusManager struct {
 	inProgressWithPollURLReturnsOnCall map[int]struct {
 		result1 error
 	}
-	InProgressWithPollURLAndLastPollTimestampStub        func(context.Context, *v1alpha1.Operation, string, string, int) error
+	InProgressWithPollURLAndLastPollTimestampStub        func(context.Context, *v1alpha1.Operation, string, string, string, int) error
 	inProgressWithPollURLAndLastPollTimestampMutex       sync.RWMutex
 	inProgressWithPollURLAndLastPollTimestampArgsForCall []struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
 		arg4 string
-		arg5 int
+		arg5 string
+		arg6 int
 	}
 	inProgressWithPollURLAndLastPollTimestampReturns struct {
 		result1 error
@@ -75,6 +93,19 @@ type FakeStatusManager struct {
 	successStatusReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WebhookSuccessStatusStub        func(context.Context, *v1alpha1.Operation, string) error
+	webhookSuccessStatusMutex       sync.RWMutex
+	webhookSuccessStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}
+	webhookSuccessStatusReturns struct {
+		result1 error
+	}
+	webhookSuccessStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -142,20 +173,87 @@ func (fake *FakeStatusManager) FailedStatusReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURL(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string) error {
+func (fake *FakeStatusManager) InProgressWithFailedAttempt(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string, arg5 string, arg6 int) error {
+	fake.inProgressWithFailedAttemptMutex.Lock()
+	ret, specificReturn := fake.inProgressWithFailedAttemptReturnsOnCall[len(fake.inProgressWithFailedAttemptArgsForCall)]
+	fake.inProgressWithFailedAttemptArgsForCall = append(fake.inProgressWithFailedAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.InProgressWithFailedAttemptStub
+	fakeReturns := fake.inProgressWithFailedAttemptReturns
+	fake.recordInvocation("InProgressWithFailedAttempt", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.inProgressWithFailedAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusManager) InProgressWithFailedAttemptCallCount() int {
+	fake.inProgressWithFailedAttemptMutex.RLock()
+	defer fake.inProgressWithFailedAttemptMutex.RUnlock()
+	return len(fake.inProgressWithFailedAttemptArgsForCall)
+}
+
+func (fake *FakeStatusManager) InProgressWithFailedAttemptCalls(stub func(context.Context, *v1alpha1.Operation, string, string, string, int) error) {
+	fake.inProgressWithFailedAttemptMutex.Lock()
+	defer fake.inProgressWithFailedAttemptMutex.Unlock()
+	fake.InProgressWithFailedAttemptStub = stub
+}
+
+func (fake *FakeStatusManager) InProgressWithFailedAttemptArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string, string, int) {
+	fake.inProgressWithFailedAttemptMutex.RLock()
+	defer fake.inProgressWithFailedAttemptMutex.RUnlock()
+	argsForCall := fake.inProgressWithFailedAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeStatusManager) InProgressWithFailedAttemptReturns(result1 error) {
+	fake.inProgressWithFailedAttemptMutex.Lock()
+	defer fake.inProgressWithFailedAttemptMutex.Unlock()
+	fake.InProgressWithFailedAttemptStub = nil
+	fake.inProgressWithFailedAttemptReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) InProgressWithFailedAttemptReturnsOnCall(i int, result1 error) {
+	fake.inProgressWithFailedAttemptMutex.Lock()
+	defer fake.inProgressWithFailedAttemptMutex.Unlock()
+	fake.InProgressWithFailedAttemptStub = nil
+	if fake.inProgressWithFailedAttemptReturnsOnCall == nil {
+		fake.inProgressWithFailedAttemptReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.inProgressWithFailedAttemptReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) InProgressWithPollURL(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string) error {
 	fake.inProgressWithPollURLMutex.Lock()
 	ret, specificReturn := fake.inProgressWithPollURLReturnsOnCall[len(fake.inProgressWithPollURLArgsForCall)]
 	fake.inProgressWithPollURLArgsForCall = append(fake.inProgressWithPollURLArgsForCall, struct {
 		arg1 context.Context
 		arg2 *v1alpha1.Operation
 		arg3 string
-	}{arg1, arg2, arg3})
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
 	stub := fake.InProgressWithPollURLStub
 	fakeReturns := fake.inProgressWithPollURLReturns
-	fake.recordInvocation("InProgressWithPollURL", []interface{}{arg1, arg2, arg3})
+	fake.recordInvocation("InProgressWithPollURL", []interface{}{arg1, arg2, arg3, arg4})
 	fake.inProgressWithPollURLMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -169,17 +267,17 @@ func (fake *FakeStatusManager) InProgressWithPollURLCallCount() int {
 	return len(fake.inProgressWithPollURLArgsForCall)
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLCalls(stub func(context.Context, *v1alpha1.Operation, string) error) {
+func (fake *FakeStatusManager) InProgressWithPollURLCalls(stub func(context.Context, *v1alpha1.Operation, string, string) error) {
 	fake.inProgressWithPollURLMutex.Lock()
 	defer fake.inProgressWithPollURLMutex.Unlock()
 	fake.InProgressWithPollURLStub = stub
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLArgsForCall(i int) (context.Context, *v1alpha1.Operation, string) {
+func (fake *FakeStatusManager) InProgressWithPollURLArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string) {
 	fake.inProgressWithPollURLMutex.RLock()
 	defer fake.inProgressWithPollURLMutex.RUnlock()
 	argsForCall := fake.inProgressWithPollURLArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeStatusManager) InProgressWithPollURLReturns(result1 error) {
@@ -205,7 +303,7 @@ func (fake *FakeStatusManager) InProgressWithPollURLReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string, arg5 int) error {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string, arg4 string, arg5 string, arg6 int) error {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Lock()
 	ret, specificReturn := fake.inProgressWithPollURLAndLastPollTimestampReturnsOnCall[len(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall)]
 	fake.inProgressWithPollURLAndLastPollTimestampArgsForCall = append(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall, struct {
@@ -213,14 +311,15 @@ func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestamp(arg1 co
 		arg2 *v1alpha1.Operation
 		arg3 string
 		arg4 string
-		arg5 int
-	}{arg1, arg2, arg3, arg4, arg5})
+		arg5 string
+		arg6 int
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
 	stub := fake.InProgressWithPollURLAndLastPollTimestampStub
 	fakeReturns := fake.inProgressWithPollURLAndLastPollTimestampReturns
-	fake.recordInvocation("InProgressWithPollURLAndLastPollTimestamp", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("InProgressWithPollURLAndLastPollTimestamp", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -234,17 +333,17 @@ func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCallCoun
 	return len(fake.inProgressWithPollURLAndLastPollTimestampArgsForCall)
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCalls(stub func(context.Context, *v1alpha1.Operation, string, string, int) error) {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampCalls(stub func(context.Context, *v1alpha1.Operation, string, string, string, int) error) {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.Lock()
 	defer fake.inProgressWithPollURLAndLastPollTimestampMutex.Unlock()
 	fake.InProgressWithPollURLAndLastPollTimestampStub = stub
 }
 
-func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string, int) {
+func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampArgsForCall(i int) (context.Context, *v1alpha1.Operation, string, string, string, int) {
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.RLock()
 	defer fake.inProgressWithPollURLAndLastPollTimestampMutex.RUnlock()
 	argsForCall := fake.inProgressWithPollURLAndLastPollTimestampArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeStatusManager) InProgressWithPollURLAndLastPollTimestampReturns(result1 error) {
@@ -394,11 +493,76 @@ func (fake *FakeStatusManager) SuccessStatusReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeStatusManager) WebhookSuccessStatus(arg1 context.Context, arg2 *v1alpha1.Operation, arg3 string) error {
+	fake.webhookSuccessStatusMutex.Lock()
+	ret, specificReturn := fake.webhookSuccessStatusReturnsOnCall[len(fake.webhookSuccessStatusArgsForCall)]
+	fake.webhookSuccessStatusArgsForCall = append(fake.webhookSuccessStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 *v1alpha1.Operation
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.WebhookSuccessStatusStub
+	fakeReturns := fake.webhookSuccessStatusReturns
+	fake.recordInvocation("WebhookSuccessStatus", []interface{}{arg1, arg2, arg3})
+	fake.webhookSuccessStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusCallCount() int {
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
+	return len(fake.webhookSuccessStatusArgsForCall)
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusCalls(stub func(context.Context, *v1alpha1.Operation, string) error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = stub
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusArgsForCall(i int) (context.Context, *v1alpha1.Operation, string) {
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
+	argsForCall := fake.webhookSuccessStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusReturns(result1 error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = nil
+	fake.webhookSuccessStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusManager) WebhookSuccessStatusReturnsOnCall(i int, result1 error) {
+	fake.webhookSuccessStatusMutex.Lock()
+	defer fake.webhookSuccessStatusMutex.Unlock()
+	fake.WebhookSuccessStatusStub = nil
+	if fake.webhookSuccessStatusReturnsOnCall == nil {
+		fake.webhookSuccessStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.webhookSuccessStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStatusManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.failedStatusMutex.RLock()
 	defer fake.failedStatusMutex.RUnlock()
+	fake.inProgressWithFailedAttemptMutex.RLock()
+	defer fake.inProgressWithFailedAttemptMutex.RUnlock()
 	fake.inProgressWithPollURLMutex.RLock()
 	defer fake.inProgressWithPollURLMutex.RUnlock()
 	fake.inProgressWithPollURLAndLastPollTimestampMutex.RLock()
@@ -407,6 +571,8 @@ func (fake *FakeStatusManager) Invocations() map[string][][]interface{} {
 	defer fake.initializeMutex.RUnlock()
 	fake.successStatusMutex.RLock()
 	defer fake.successStatusMutex.RUnlock()
+	fake.webhookSuccessStatusMutex.RLock()
+	defer fake.webhookSuccessStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -82,76 +82,189 @@ func (r *OperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
 		return r.handleInitializationError(ctx, err, operation)
 	}
 
+	if operation.Cancelled() {
+		log.C(ctx).Info("Operation has been cancelled. Its webhooks will not be executed")
+		return r.finalizeStatus(ctx, operation, str.Ptr(operation.CancellationMessage()), r.config.WebhookTimeout)
+	}
+
 	requestObject, err := operation.RequestObject()
 	if err != nil {
 		log.C(ctx).Error(err, "Unable to parse request object")
-		return r.finalizeStatusWithError(ctx, operation, err, nil)
+		return r.finalizeStatusWithError(ctx, operation, err, r.config.WebhookTimeout)
 	}
 
 	ctx = tenant.SaveToContext(ctx, requestObject.TenantID)
-	app, err := r.directorClient.FetchApplication(ctx, operation.Spec.ResourceID)
+	res, err := r.fetchResource(ctx, operation, requestObject)
 	if err != nil {
-		return r.handleFetchApplicationError(ctx, operation, err)
+		return r.handleFetchResourceError(ctx, operation, err)
 	}
 
-	if app.Result.Ready {
-		return r.finalizeStatus(ctx, operation, app.Result.Error, nil)
+	if res.ready {
+		return r.finalizeStatus(ctx, operation, res.error, r.config.WebhookTimeout)
 	}
 
 	if len(operation.Spec.WebhookIDs) == 0 {
 		log.C(ctx).Info("No webhook defined. Operation executed successfully")
-		return r.finalizeStatusSuccess(ctx, operation, nil)
+		return r.finalizeStatusSuccess(ctx, operation, r.config.WebhookTimeout)
 	}
 
-	webhookEntity, err := extractWebhook(app.Result.Webhooks, operation.Spec.WebhookIDs[0])
+	webhookEntities, err := extractWebhooks(res.webhooks, operation.Spec.WebhookIDs)
 	if err != nil {
-		log.C(ctx).Error(err, "Unable to retrieve webhook")
-		return r.finalizeStatusWithError(ctx, operation, err, nil)
+		log.C(ctx).Error(err, "Unable to retrieve webhooks")
+		return r.finalizeStatusWithError(ctx, operation, err, r.config.WebhookTimeout)
+	}
+
+	timeouts := r.determineTimeouts(operation.ExecutionPolicy(), webhookEntities)
+	pendingWebhooksCount := countPendingWebhooks(operation)
+	if pendingWebhooksCount == 0 {
+		log.C(ctx).Info("All webhooks have already succeeded")
+		return r.finalizeStatusSuccess(ctx, operation, timeouts[len(timeouts)-1])
+	}
+
+	var result ctrl.Result
+	for _, i := range webhooksToExecute(operation) {
+		webhookEntity, timeout := webhookEntities[i], timeouts[i]
+		ctx := log.ContextWithLogger(ctx, log.C(ctx).WithValues("webhook", webhookEntity.ID))
+
+		webhookResult, err := r.executeWebhook(ctx, operation, requestObject, webhookEntity, timeout)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if webhookResult.err != nil {
+			return r.finalizeStatusWithError(ctx, operation, webhookResult.err, timeout)
+		}
+
+		if !webhookResult.succeeded {
+			result = mergeResults(result, webhookResult.result)
+			continue
+		}
+
+		pendingWebhooksCount--
+		if pendingWebhooksCount == 0 {
+			return r.finalizeStatusSuccess(ctx, operation, timeout)
+		}
+
+		if err := r.statusManager.WebhookSuccessStatus(ctx, operation, webhookEntity.ID); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.C(ctx).Info("Successfully updated webhook status to succeeded")
+		result = mergeResults(result, ctrl.Result{Requeue: true})
+	}
+
+	return result, nil
+}
+
+// operationResource holds the Director state of the resource targeted by an operation
+// together with the webhooks which can be executed as part of the operation
+type operationResource struct {
+	ready    bool
+	error    *string
+	webhooks []graphql.Webhook
+}
+
+// fetchResource fetches the resource targeted by the given operation from Director based on the operation resource type.
+// Bundle instance auths have no webhooks of their own, so the webhooks of the application owning the auth are used instead.
+func (r *OperationReconciler) fetchResource(ctx context.Context, operation *v1alpha1.Operation, requestObject webhookdir.RequestObject) (*operationResource, error) {
+	switch resource.Type(operation.Spec.ResourceType) {
+	case resource.Runtime:
+		runtime, err := r.directorClient.FetchRuntime(ctx, operation.Spec.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &operationResource{error: runtime.Result.Error, webhooks: runtime.Result.Webhooks}, nil
+	case resource.BundleInstanceAuth:
+		bundleInstanceAuth, err := r.directorClient.FetchBundleInstanceAuth(ctx, operation.Spec.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		owner, ok := requestObject.Application.(*graphql.Application)
+		if !ok || owner.BaseEntity == nil {
+			return nil, fmt.Errorf("missing owning application of bundle instance auth with ID %s", operation.Spec.ResourceID)
+		}
+
+		app, err := r.directorClient.FetchApplication(ctx, owner.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &operationResource{error: bundleInstanceAuth.Result.Error, webhooks: app.Result.Webhooks}, nil
+	default:
+		app, err := r.directorClient.FetchApplication(ctx, operation.Spec.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &operationResource{ready: app.Result.Ready, error: app.Result.Error, webhooks: app.Result.Webhooks}, nil
 	}
+}
 
-	if operation.TimeoutReached(r.determineTimeout(webhookEntity)) {
+// webhookResult holds the outcome of a single reconciliation of one of the operation webhooks.
+// The err field contains the error which fails the whole operation, while result describes
+// when the webhook should be reconciled again if it has neither succeeded nor failed.
+type webhookResult struct {
+	succeeded bool
+	err       error
+	result    ctrl.Result
+}
+
+// executeWebhook executes or polls the given webhook depending on whether a Poll URL has already been provided for it
+func (r *OperationReconciler) executeWebhook(ctx context.Context, operation *v1alpha1.Operation, requestObject webhookdir.RequestObject, webhookEntity *graphql.Webhook, timeout time.Duration) (webhookResult, error) {
+	if operation.TimeoutReached(timeout) {
 		log.C(ctx).Info("Reconciliation timeout reached")
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrWebhookTimeoutReached, webhookEntity)
+		return webhookResult{err: errors.ErrWebhookTimeoutReached}, nil
+	}
+
+	retryAfter, err := operation.NextRetryTime(webhookEntity.ID, r.config.TimeLayout)
+	if err != nil {
+		log.C(ctx).Error(err, "Unable to calculate next retry time")
+		return webhookResult{err: err}, nil
 	}
 
-	if !operation.HasPollURL() {
+	if retryAfter > 0 {
+		log.C(ctx).Info(fmt.Sprintf("Retry interval has not passed. Will requeue after: %s", retryAfter))
+		return webhookResult{result: ctrl.Result{RequeueAfter: retryAfter}}, nil
+	}
+
+	if !operation.HasPollURL(webhookEntity.ID) {
 		log.C(ctx).Info("Webhook Poll URL is not found. Will attempt to execute the webhook")
 		request := webhook.NewRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID)
 
 		response, err := r.webhookClient.Do(ctx, request)
 		if errors.IsWebhookStatusGoneErr(err) && operation.Spec.OperationType == v1alpha1.OperationTypeDelete {
 			log.C(ctx).Info(fmt.Sprintf("%s webhook initial request returned gone status %d", *(webhookEntity.Mode), *response.GoneStatusCode))
-			return r.finalizeStatusSuccess(ctx, operation, webhookEntity)
+			return webhookResult{succeeded: true}, nil
 		}
 		if err != nil {
 			log.C(ctx).Error(err, "Unable to execute Webhook request")
-			return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+			return r.retryUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, timeout, err)
 		}
 
-		return r.handleWebhookResponse(ctx, operation, webhookEntity.Mode, response)
+		return r.handleWebhookResponse(ctx, operation, webhookEntity, response)
 	}
 
 	log.C(ctx).Info("Webhook Poll URL is found. Will calculate next poll time")
-	requeueAfter, err := operation.NextPollTime(webhookEntity.RetryInterval, r.config.TimeLayout)
+	requeueAfter, err := operation.NextPollTime(webhookEntity.ID, webhookEntity.RetryInterval, r.config.TimeLayout)
 	if err != nil {
 		log.C(ctx).Error(err, "Unable to calculate next poll time")
-		return r.finalizeStatusWithError(ctx, operation, err, webhookEntity)
+		return webhookResult{err: err}, nil
 	}
 
 	if requeueAfter > 0 {
 		log.C(ctx).Info(fmt.Sprintf("Poll interval has not passed. Will requeue after: %d seconds", requeueAfter*time.Second))
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		return webhookResult{result: ctrl.Result{RequeueAfter: requeueAfter}}, nil
 	}
 
-	request := webhook.NewPollRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID, operation.PollURL())
+	request := webhook.NewPollRequest(*webhookEntity, requestObject, operation.Spec.CorrelationID, operation.PollURL(webhookEntity.ID))
 	response, err := r.webhookClient.Poll(ctx, request)
 	if err != nil {
 		log.C(ctx).Error(err, "Unable to execute Webhook Poll request")
-		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, err)
+		return r.retryUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, timeout, err)
 	}
 
-	return r.handleWebhookPollResponse(ctx, operation, webhookEntity, response)
-
+	return r.handleWebhookPollResponse(ctx, operation, webhookEntity, timeout, response)
 }
 
 func (r *OperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -170,7 +283,7 @@ func (r *OperationReconciler) handleInitializationError(ctx context.Context, err
 	log.C(ctx).Error(err, "Failed to initialize operation status")
 	if _, ok := err.(*v1alpha1.OperationValidationErr); ok {
 		log.C(ctx).Error(err, "Validation error occurred during operation status initialization")
-		return r.finalizeStatusWithError(ctx, operation, err, nil)
+		return r.finalizeStatusWithError(ctx, operation, err, r.config.WebhookTimeout)
 	}
 
 	return ctrl.Result{}, err
@@ -186,8 +299,8 @@ func (r *OperationReconciler) handleGetOperationError(ctx context.Context, req *
 	return ctrl.Result{}, err
 }
 
-func (r *OperationReconciler) handleFetchApplicationError(ctx context.Context, operation *v1alpha1.Operation, err error) (ctrl.Result, error) {
-	log.C(ctx).Error(err, fmt.Sprintf("Unable to fetch application with ID %s", operation.Spec.ResourceID))
+func (r *OperationReconciler) handleFetchResourceError(ctx context.Context, operation *v1alpha1.Operation, err error) (ctrl.Result, error) {
+	log.C(ctx).Error(err, fmt.Sprintf("Unable to fetch %s with ID %s", operation.Spec.ResourceType, operation.Spec.ResourceID))
 	if operation.TimeoutReached(time.Duration(r.config.TimeoutFactor) * r.config.WebhookTimeout) {
 		if err := r.k8sClient.Delete(ctx, operation); err != nil {
 			return ctrl.Result{}, err
@@ -199,86 +312,113 @@ func (r *OperationReconciler) handleFetchApplicationError(ctx context.Context, o
 
 	if isNotFoundError(err) {
 		if operation.Status.Phase == v1alpha1.StateSuccess || operation.Status.Phase == v1alpha1.StateFailed {
-			log.C(ctx).Info(fmt.Sprintf("Last state of operation for application with ID %s is %s, will not requeue", operation.Spec.ResourceID, operation.Status.Phase))
+			log.C(ctx).Info(fmt.Sprintf("Last state of operation for %s with ID %s is %s, will not requeue", operation.Spec.ResourceType, operation.Spec.ResourceID, operation.Status.Phase))
 			return ctrl.Result{}, nil
 		}
 
 		if operation.Spec.OperationType == v1alpha1.OperationTypeDelete && operation.Status.Phase == v1alpha1.StateInProgress {
-			return r.finalizeStatus(ctx, operation, nil, nil)
+			return r.finalizeStatus(ctx, operation, nil, r.config.WebhookTimeout)
 		}
 		if operation.Spec.OperationType == v1alpha1.OperationTypeUpdate && operation.Status.Phase == v1alpha1.StateInProgress {
-			return r.finalizeStatus(ctx, operation, str.Ptr("Application not found in director"), nil)
+			return r.finalizeStatus(ctx, operation, str.Ptr(fmt.Sprintf("%s not found in director", operation.Spec.ResourceType)), r.config.WebhookTimeout)
 		}
 	}
 
-	// requeue in case of async create (app is not created in director yet), or a glitch in controller<->director communication
+	// requeue in case of async create (resource is not created in director yet), or a glitch in controller<->director communication
 	return ctrl.Result{}, err
 }
 
-func (r *OperationReconciler) handleWebhookResponse(ctx context.Context, operation *v1alpha1.Operation, webhookMode *graphql.WebhookMode, response *webhookdir.Response) (ctrl.Result, error) {
+func (r *OperationReconciler) handleWebhookResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.Response) (webhookResult, error) {
 	mode := graphql.WebhookModeSync
-	if webhookMode != nil {
-		mode = *webhookMode
+	if webhookEntity.Mode != nil {
+		mode = *webhookEntity.Mode
 	}
 
 	switch mode {
 	case graphql.WebhookModeAsync:
 		log.C(ctx).Info("Asynchronous webhook initial request has been executed successfully")
-		if err := r.statusManager.InProgressWithPollURL(ctx, operation, *response.Location); err != nil {
-			return ctrl.Result{}, err
+		if err := r.statusManager.InProgressWithPollURL(ctx, operation, webhookEntity.ID, *response.Location); err != nil {
+			return webhookResult{}, err
 		}
 		log.C(ctx).Info("Successfully updated operation status with poll URL: " + *response.Location)
-		return ctrl.Result{Requeue: true}, nil
+		return webhookResult{result: ctrl.Result{Requeue: true}}, nil
 	case graphql.WebhookModeSync:
 		log.C(ctx).Info("Synchronous webhook has been executed successfully")
-		return r.finalizeStatusSuccess(ctx, operation, nil)
+		return webhookResult{succeeded: true}, nil
 	default:
 		log.C(ctx).Error(errors.ErrUnsupportedWebhookMode, "Unable to post-process Webhook response")
-		return ctrl.Result{}, nil
+		return webhookResult{}, nil
 	}
 }
 
-func (r *OperationReconciler) handleWebhookPollResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, response *webhookdir.ResponseStatus) (ctrl.Result, error) {
+func (r *OperationReconciler) handleWebhookPollResponse(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, timeout time.Duration, response *webhookdir.ResponseStatus) (webhookResult, error) {
 	log.C(ctx).Info(fmt.Sprintf("Asynchronous webhook polling request has been executed successfully with response status: %s", *response.Status))
 	switch *response.Status {
 	case *response.InProgressStatusIdentifier:
 		lastPollTimestamp := time.Now().Format(r.config.TimeLayout)
-		retryCount := operation.Status.Webhooks[0].RetriesCount + 1
-		if err := r.statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, operation.PollURL(), lastPollTimestamp, retryCount); err != nil {
-			return ctrl.Result{}, err
+		retryCount := operation.WebhookStatus(webhookEntity.ID).RetriesCount + 1
+		if err := r.statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, webhookEntity.ID, operation.PollURL(webhookEntity.ID), lastPollTimestamp, retryCount); err != nil {
+			return webhookResult{}, err
 		}
 		log.C(ctx).Info(fmt.Sprintf("Successfully updated operation status last poll timestamp to %s", lastPollTimestamp), "status", operation.Status)
-		return r.requeueUnlessTimeoutOrFatalError(ctx, operation, webhookEntity, errors.ErrWebhookPollTimeExpired)
+		return r.requeueUnlessTimeoutOrFatalError(operation, webhookEntity, timeout, errors.ErrWebhookPollTimeExpired), nil
 	case *response.SuccessStatusIdentifier:
-		return r.finalizeStatusSuccess(ctx, operation, webhookEntity)
+		return webhookResult{succeeded: true}, nil
 	case *response.FailedStatusIdentifier:
-		return r.finalizeStatusWithError(ctx, operation, errors.ErrFailedWebhookStatus, webhookEntity)
+		return webhookResult{err: errors.ErrFailedWebhookStatus}, nil
 	default:
 		log.C(ctx).Error(fmt.Errorf("unexpected poll status response: %s", *response.Status), "Polling will be stopped due to an unknown status code received")
-		return ctrl.Result{}, nil
+		return webhookResult{}, nil
 	}
 }
 
-func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, webhookErr error) (ctrl.Result, error) {
+func (r *OperationReconciler) requeueUnlessTimeoutOrFatalError(operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, timeout time.Duration, webhookErr error) webhookResult {
 	_, isFatalErr := webhookErr.(*errors.FatalReconcileErr)
-	if !operation.TimeoutReached(r.determineTimeout(webhookEntity)) && !isFatalErr {
+	if !operation.TimeoutReached(timeout) && !isFatalErr {
 		requeueAfter := r.config.RequeueInterval
 		if webhookEntity.RetryInterval != nil {
 			requeueAfter = time.Duration(*webhookEntity.RetryInterval)
 		}
 
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		return webhookResult{result: ctrl.Result{RequeueAfter: requeueAfter}}
 	}
 
 	if !isFatalErr {
 		webhookErr = fmt.Errorf("%s: %s", errors.ErrWebhookTimeoutReached, webhookErr)
 	}
 
-	return r.finalizeStatusWithError(ctx, operation, webhookErr, webhookEntity)
+	return webhookResult{err: webhookErr}
 }
 
-func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg *string, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+// retryUnlessTimeoutOrFatalError records a failed call to the given webhook in the operation status and schedules its retry
+// according to the webhook retry policy, unless the error is fatal, the timeout is reached or the webhook has no attempts left
+func (r *OperationReconciler) retryUnlessTimeoutOrFatalError(ctx context.Context, operation *v1alpha1.Operation, webhookEntity *graphql.Webhook, timeout time.Duration, webhookErr error) (webhookResult, error) {
+	if result := r.requeueUnlessTimeoutOrFatalError(operation, webhookEntity, timeout, webhookErr); result.err != nil {
+		return result, nil
+	}
+
+	failedAttempts := 1
+	if webhookStatus := operation.WebhookStatus(webhookEntity.ID); webhookStatus != nil {
+		failedAttempts += webhookStatus.FailedAttempts
+	}
+
+	if webhook.MaxAttemptsReached(*webhookEntity, failedAttempts) {
+		log.C(ctx).Info(fmt.Sprintf("Webhook has failed %d times. No attempts left", failedAttempts))
+		return webhookResult{err: fmt.Errorf("%s: %s", errors.ErrWebhookMaxAttemptsReached, webhookErr)}, nil
+	}
+
+	retryDelay := webhook.RetryDelay(*webhookEntity, r.config.RequeueInterval, failedAttempts)
+	nextRetryTimestamp := time.Now().Add(retryDelay).Format(r.config.TimeLayout)
+	if err := r.statusManager.InProgressWithFailedAttempt(ctx, operation, webhookEntity.ID, webhookErr.Error(), nextRetryTimestamp, failedAttempts); err != nil {
+		return webhookResult{}, err
+	}
+
+	log.C(ctx).Info(fmt.Sprintf("Successfully updated operation status with failed attempt %d. Will retry after: %s", failedAttempts, retryDelay))
+	return webhookResult{result: ctrl.Result{RequeueAfter: retryDelay}}, nil
+}
+
+func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg *string, timeout time.Duration) (ctrl.Result, error) {
+	if isCloseToTimeout(operation.Status.InitializedAt.Time, timeout) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -307,8 +447,8 @@ func (r *OperationReconciler) finalizeStatus(ctx context.Context, operation *v1a
 	return ctrl.Result{}, nil
 }
 
-func (r *OperationReconciler) finalizeStatusSuccess(ctx context.Context, operation *v1alpha1.Operation, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+func (r *OperationReconciler) finalizeStatusSuccess(ctx context.Context, operation *v1alpha1.Operation, timeout time.Duration) (ctrl.Result, error) {
+	if isCloseToTimeout(operation.Status.InitializedAt.Time, timeout) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -328,8 +468,8 @@ func (r *OperationReconciler) finalizeStatusSuccess(ctx context.Context, operati
 	return ctrl.Result{}, nil
 }
 
-func (r *OperationReconciler) finalizeStatusWithError(ctx context.Context, operation *v1alpha1.Operation, opErr error, webhook *graphql.Webhook) (ctrl.Result, error) {
-	if operation != nil && isCloseToTimeout(operation.Status.InitializedAt.Time, r.determineTimeout(webhook)) {
+func (r *OperationReconciler) finalizeStatusWithError(ctx context.Context, operation *v1alpha1.Operation, opErr error, timeout time.Duration) (ctrl.Result, error) {
+	if operation != nil && isCloseToTimeout(operation.Status.InitializedAt.Time, timeout) {
 		r.metricsCollector.RecordOperationInProgressNearTimeout(string(operation.Spec.OperationType), operation.ObjectMeta.Name)
 	}
 
@@ -364,16 +504,35 @@ func (r *OperationReconciler) determineTimeout(webhook *graphql.Webhook) time.Du
 	return time.Duration(*webhook.Timeout) * time.Second
 }
 
+// determineTimeouts returns the timeouts of the given webhooks measured from the initialization of the operation.
+// When the webhooks are executed sequentially, each webhook is given the time left by the preceding ones in addition to its own timeout.
+func (r *OperationReconciler) determineTimeouts(policy v1alpha1.WebhookExecutionPolicy, webhooks []*graphql.Webhook) []time.Duration {
+	timeouts := make([]time.Duration, 0, len(webhooks))
+	var elapsed time.Duration
+	for _, webhook := range webhooks {
+		timeout := r.determineTimeout(webhook)
+		if policy == v1alpha1.WebhookExecutionPolicySequential {
+			elapsed += timeout
+			timeout = elapsed
+		}
+		timeouts = append(timeouts, timeout)
+	}
+
+	return timeouts
+}
+
 func prepareDirectorRequest(operation *v1alpha1.Operation) *director.Request {
 	return prepareDirectorRequestWithError(operation, nil)
 }
 
 func prepareDirectorRequestWithError(operation *v1alpha1.Operation, err error) *director.Request {
 	request := &director.Request{
-		OperationType:     graphql.OperationType(operation.Spec.OperationType),
-		ResourceType:      resource.Type(operation.Spec.ResourceType),
-		ResourceID:        operation.Spec.ResourceID,
-		OperationCategory: operation.Spec.OperationCategory,
+		OperationID:         operation.Name,
+		OperationGeneration: operation.Generation,
+		OperationType:       graphql.OperationType(operation.Spec.OperationType),
+		ResourceType:        resource.Type(operation.Spec.ResourceType),
+		ResourceID:          operation.Spec.ResourceID,
+		OperationCategory:   operation.Spec.OperationCategory,
 	}
 
 	if err != nil {
@@ -383,6 +542,19 @@ func prepareDirectorRequestWithError(operation *v1alpha1.Operation, err error) *
 	return request
 }
 
+func extractWebhooks(appWebhooks []graphql.Webhook, operationWebhookIDs []string) ([]*graphql.Webhook, error) {
+	webhooks := make([]*graphql.Webhook, 0, len(operationWebhookIDs))
+	for _, operationWebhookID := range operationWebhookIDs {
+		webhook, err := extractWebhook(appWebhooks, operationWebhookID)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
 func extractWebhook(appWebhooks []graphql.Webhook, operationWebhookID string) (*graphql.Webhook, error) {
 	for _, appWebhook := range appWebhooks {
 		if appWebhook.ID == operationWebhookID {
@@ -393,6 +565,50 @@ func extractWebhook(appWebhooks []graphql.Webhook, operationWebhookID string) (*
 	return nil, fmt.Errorf("missing webhook with ID: %s", operationWebhookID)
 }
 
+// webhooksToExecute returns the indexes of the operation webhooks which have to be reconciled, in the order of the operation spec.
+// When the webhooks are executed sequentially, only the first webhook which has not succeeded yet is returned.
+func webhooksToExecute(operation *v1alpha1.Operation) []int {
+	indexes := make([]int, 0, len(operation.Spec.WebhookIDs))
+	for i, webhookID := range operation.Spec.WebhookIDs {
+		if webhookStatus := operation.WebhookStatus(webhookID); webhookStatus != nil && webhookStatus.State == v1alpha1.StateSuccess {
+			continue
+		}
+
+		indexes = append(indexes, i)
+		if operation.ExecutionPolicy() == v1alpha1.WebhookExecutionPolicySequential {
+			break
+		}
+	}
+
+	return indexes
+}
+
+// countPendingWebhooks returns the number of operation webhooks which have not succeeded yet
+func countPendingWebhooks(operation *v1alpha1.Operation) int {
+	count := 0
+	for _, webhookID := range operation.Spec.WebhookIDs {
+		if webhookStatus := operation.WebhookStatus(webhookID); webhookStatus == nil || webhookStatus.State != v1alpha1.StateSuccess {
+			count++
+		}
+	}
+
+	return count
+}
+
+// mergeResults combines the results of the webhooks reconciled in parallel so that
+// an immediate requeue takes precedence over the shortest requeue delay
+func mergeResults(current, next ctrl.Result) ctrl.Result {
+	if current.Requeue || next.Requeue {
+		return ctrl.Result{Requeue: true}
+	}
+
+	if current.RequeueAfter == 0 || (next.RequeueAfter > 0 && next.RequeueAfter < current.RequeueAfter) {
+		return next
+	}
+
+	return current
+}
+
 func trimRequestObject(operation *v1alpha1.Operation) string {
 	index := strings.Index(operation.Spec.RequestObject, ",\"Headers\"")
 	if index != -1 {
@@ -31,10 +31,12 @@ import (
 	recerr "github.com/kyma-incubator/compass/components/operations-controller/internal/errors"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
 	web_hook "github.com/kyma-incubator/compass/components/director/pkg/webhook"
 	"github.com/kyma-incubator/compass/components/operations-controller/api/v1alpha1"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers"
 	"github.com/kyma-incubator/compass/components/operations-controller/controllers/controllersfakes"
+	internaldirector "github.com/kyma-incubator/compass/components/operations-controller/internal/director"
 	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/director"
 	"github.com/pkg/errors"
@@ -48,11 +50,14 @@ import (
 
 const (
 	appGUID                = "f92f1fce-631a-4231-b43a-8f9fccebb22c"
+	runtimeGUID            = "7d1a2c3e-8b44-4f2e-9d3c-52e1f0a6b7c8"
+	bundleInstanceAuthGUID = "b3e8f1d2-6c4a-4e9b-a1f7-0d2c5e8a9b14"
 	correlationGUID        = "dea327aa-c173-46d5-9431-497e4057a83a"
 	correlationGUID2       = "ce887479-bccf-4bf1-96a9-05b731196b1e"
 	anotherCorrelationGUID = "575b8042-8bb1-4ffa-9464-8ec633eae0d3"
 	tenantGUID             = "4b7aa2e1-e060-4633-a795-1be0d207c3e2"
 	webhookGUID            = "d09731af-bc0a-4abf-9b09-f3c9d25d064b"
+	webhookGUID2           = "0e7ba9a2-5c1e-4a0b-8e43-6b3c1a8f2d19"
 	opName                 = "application-f92f1fce-631a-4231-b43a-8f9fccebb22c"
 	opNamespace            = "compass-system"
 )
@@ -386,6 +391,79 @@ func TestReconcile_FailureToParseRequestObject_When_DirectorAndStatusManagerUpda
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount)
 }
 
+func TestReconcile_OperationIsCancelled_When_StatusManagerFailedStatusFails_ShouldResultNoRequeueError(t *testing.T) {
+	// GIVEN:
+	operation := *initializedMockedOperation
+	operation.ObjectMeta.Annotations = map[string]string{v1alpha1.AnnotationCancelledBy: "admin"}
+	operation.Spec.OperationType = v1alpha1.OperationTypeUpdate
+	operation.Spec.OperationCategory = v1alpha1.OperationCategoryCancel
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(mockedErr)
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), mockedErr.Error())
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, "operation cancelled by admin")
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount, directorClient.UpdateOperationCallCount,
+		webhookClient.DoCallCount, webhookClient.PollCallCount, statusMgrClient.SuccessStatusCallCount)
+}
+
+func TestReconcile_OperationIsCancelled_And_StatusManagerFailedStatusSucceeds_ShouldNotExecuteWebhooksAndResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := *initializedMockedOperation
+	operation.ObjectMeta.Annotations = map[string]string{v1alpha1.AnnotationCancelledBy: "admin"}
+	operation.Spec.OperationType = v1alpha1.OperationTypeUpdate
+	operation.Spec.OperationCategory = v1alpha1.OperationCategoryCancel
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, "operation cancelled by admin")
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount, directorClient.UpdateOperationCallCount,
+		webhookClient.DoCallCount, webhookClient.PollCallCount, statusMgrClient.SuccessStatusCallCount)
+}
+
 func TestReconcile_FailureToFetchApplication_And_ReconciliationTimeoutReached_When_K8sDeleteFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
 	stubLoggerAssertion(t, mockedErr.Error(), "Unable to fetch application")
@@ -565,7 +643,7 @@ func TestReconcile_FetchApplicationReturnsNilApplication_And_OperationIsUpdateAn
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
-	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, "Application not found in director")
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, "application not found in director")
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount)
 }
@@ -1042,11 +1120,188 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
 	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
+	assertStatusManagerInProgressWithFailedAttemptCalled(t, statusMgrClient, initializedMockedOperation, webhookGUID, mockedErr.Error(), 1, webhook.DefaultConfig().RequeueInterval)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+		webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_WebhookHasRetryPolicy_ShouldResultRequeueAfterBackoffNoError(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	operation := *initializedMockedOperation
+	operation.Status.Webhooks = []v1alpha1.Webhook{{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, FailedAttempts: 2, LastError: mockedErr.Error()}}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.InProgressWithFailedAttemptReturns(nil)
+
+	retryPolicy := &graphql.WebhookRetryPolicy{MaxAttempts: intToIntPtr(5), BackoffMultiplier: float64ToFloat64Ptr(2)}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, RetryInterval: intToIntPtr(10), RetryPolicy: retryPolicy})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Equal(t, 40*time.Second, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertStatusManagerInProgressWithFailedAttemptCalled(t, statusMgrClient, &operation, webhookGUID, mockedErr.Error(), 3, 40*time.Second)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+		webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_When_StatusManagerInProgressWithFailedAttemptFails_ShouldResultNoRequeueError(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(initializedMockedOperation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.InProgressWithFailedAttemptReturns(mockedErr)
+
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.Error(t, err)
+	require.Equal(t, mockedErr, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
+	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
+	assertStatusManagerInProgressWithFailedAttemptCalled(t, statusMgrClient, initializedMockedOperation, webhookGUID, mockedErr.Error(), 1, webhook.DefaultConfig().RequeueInterval)
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
 		webhookClient.PollCallCount)
 }
 
+func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_MaxAttemptsReached_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	stubLoggerAssertion(t, mockedErr.Error(), "Unable to execute Webhook request")
+	defer func() { ctrl.Log = &originalLogger }()
+
+	operation := *initializedMockedOperation
+	operation.Status.Webhooks = []v1alpha1.Webhook{{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, FailedAttempts: 2, LastError: mockedErr.Error()}}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	retryPolicy := &graphql.WebhookRetryPolicy{MaxAttempts: intToIntPtr(3)}
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, RetryPolicy: retryPolicy})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, mockedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	expectedErrMsg := fmt.Sprintf("%s: %s", recerr.ErrWebhookMaxAttemptsReached, mockedErr)
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, expectedErrMsg)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, expectedErrMsg)
+	assertWebhookDoCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount, statusMgrClient.InProgressWithFailedAttemptCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_OperationWithoutWebhookPollURL_And_NextRetryTimeNotReached_ShouldResultRequeueAfterNoError(t *testing.T) {
+	// GIVEN:
+	operation := *initializedMockedOperation
+	nextRetryTimestamp := time.Now().Add(time.Minute).Format(time.RFC3339Nano)
+	operation.Status.Webhooks = []v1alpha1.Webhook{{WebhookID: webhookGUID, State: v1alpha1.StateInProgress, FailedAttempts: 1, LastError: mockedErr.Error(), NextRetryTimestamp: nextRetryTimestamp}}
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(&operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.True(t, res.RequeueAfter > 0 && res.RequeueAfter <= time.Minute)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.InProgressWithFailedAttemptCallCount,
+		statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount, webhookClient.DoCallCount, webhookClient.PollCallCount)
+}
+
 func TestReconcile_OperationWithoutWebhookPollURL_And_WebhookExecutionFails_And_FatalErrorReturned_When_DirectorUpdateOperationFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
 	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
@@ -1432,7 +1687,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_AsyncWebhookExecutionSucce
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
-	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, mockedLocationURL)
+	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, webhookGUID, mockedLocationURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
 	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
@@ -1472,7 +1727,7 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_AsyncWebhookExecutionSucce
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, initializedMockedOperation)
-	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, mockedLocationURL)
+	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, initializedMockedOperation, webhookGUID, mockedLocationURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, initializedMockedOperation.Spec.ResourceID, tenantGUID)
 	assertWebhookDoCalled(t, webhookClient, initializedMockedOperation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
@@ -1606,6 +1861,139 @@ func TestReconcile_OperationWithoutWebhookPollURL_And_SyncWebhookExecutionSuccee
 		webhookClient.PollCallCount)
 }
 
+func TestReconcile_RuntimeOperationWithoutWebhookPollURL_And_SyncWebhookExecutionSucceeds_ShouldUseRuntimeWebhooksAndResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := initializedMockedOperation.DeepCopy()
+	operation.Spec.ResourceType = "runtime"
+	operation.Spec.ResourceID = runtimeGUID
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	runtime := &internaldirector.RuntimeOutput{Result: &graphql.RuntimeExt{
+		Runtime:  graphql.Runtime{ID: runtimeGUID},
+		Webhooks: []graphql.Webhook{{ID: webhookGUID, Mode: &mode}},
+	}}
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchRuntimeReturns(runtime, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	require.Equal(t, 1, directorClient.FetchRuntimeCallCount())
+	ctx, resourceID := directorClient.FetchRuntimeArgsForCall(0)
+	require.Equal(t, runtimeGUID, resourceID)
+	require.Equal(t, tenantGUID, ctx.Value(tenant.ContextKey))
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, operation)
+	assertDirectorUpdateOperationCalled(t, directorClient, operation)
+	assertWebhookDoCalled(t, webhookClient, operation, &runtime.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount, directorClient.FetchBundleInstanceAuthCallCount,
+		statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_BundleInstanceAuthOperationWithoutWebhookPollURL_And_SyncWebhookExecutionSucceeds_ShouldUseOwningApplicationWebhooksAndResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := initializedMockedOperation.DeepCopy()
+	operation.Spec.ResourceType = "bundleInstanceAuth"
+	operation.Spec.ResourceID = bundleInstanceAuthGUID
+	operation.Spec.RequestObject = fmt.Sprintf(`{"TenantID":"%s","Application":{"id":"%s"}}`, tenantGUID, appGUID)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{ID: appGUID}}, graphql.Webhook{ID: webhookGUID, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchBundleInstanceAuthReturns(&internaldirector.BundleInstanceAuthOutput{Result: &graphql.BundleInstanceAuth{ID: bundleInstanceAuthGUID}}, nil)
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	require.Equal(t, 1, directorClient.FetchBundleInstanceAuthCallCount())
+	_, resourceID := directorClient.FetchBundleInstanceAuthArgsForCall(0)
+	require.Equal(t, bundleInstanceAuthGUID, resourceID)
+	assertDirectorFetchApplicationCalled(t, directorClient, appGUID, tenantGUID)
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, operation)
+	assertDirectorUpdateOperationCalled(t, directorClient, operation)
+	assertWebhookDoCalled(t, webhookClient, operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchRuntimeCallCount,
+		statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_BundleInstanceAuthOperation_FetchBundleInstanceAuthReturnsNotFoundErr_And_OperationIsDeleteAndInProgress_ShouldResultSuccessOperationNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := initializedMockedOperation.DeepCopy()
+	operation.Spec.ResourceType = "bundleInstanceAuth"
+	operation.Spec.ResourceID = bundleInstanceAuthGUID
+	operation.Spec.OperationType = v1alpha1.OperationTypeDelete
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchBundleInstanceAuthReturns(nil, notFoundErr)
+
+	stubLoggerAssertion(t, notFoundErr.Error(), fmt.Sprintf("Unable to fetch bundleInstanceAuth with ID %s", bundleInstanceAuthGUID))
+	defer func() { ctrl.Log = &originalLogger }()
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, nil, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, operation)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.FetchApplicationCallCount, directorClient.UpdateOperationCallCount,
+		statusMgrClient.FailedStatusCallCount)
+}
+
 func TestReconcile_OperationHasWebhookPollURL_And_TimeLayoutParsingFails_When_DirectorUpdateOperationFails_ShouldResultNoRequeueError(t *testing.T) {
 	// GIVEN:
 	expectedErr := "cannot parse"
@@ -1835,6 +2223,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionFails_And_Webhook
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
+	assertStatusManagerInProgressWithFailedAttemptCalled(t, statusMgrClient, &operation, webhookGUID, mockedErr.Error(), 1, 30*time.Second)
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
 		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
 		webhookClient.DoCallCount)
@@ -2192,7 +2581,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
+	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, webhookGUID, operation.Status.Webhooks[0].WebhookPollURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
@@ -2236,7 +2625,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
+	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, webhookGUID, operation.Status.Webhooks[0].WebhookPollURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
 	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
@@ -2286,7 +2675,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
+	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, webhookGUID, operation.Status.Webhooks[0].WebhookPollURL)
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
 	assertWebhookPollCalled(t, webhookClient, &operation, &application.Result.Webhooks[0])
@@ -2338,7 +2727,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
+	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, webhookGUID, operation.Status.Webhooks[0].WebhookPollURL)
 	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
@@ -2389,7 +2778,7 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 	// SPECIFIC CLIENT ASSERTIONS:
 	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
 	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, &operation)
-	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, operation.Status.Webhooks[0].WebhookPollURL)
+	assertStatusManagerInProgressWithPollURLAndLastTimestampCalled(t, statusMgrClient, &operation, webhookGUID, operation.Status.Webhooks[0].WebhookPollURL)
 	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
 	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
 	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, &operation, recerr.ErrWebhookTimeoutReached.Error())
@@ -2766,6 +3155,284 @@ func TestReconcile_OperationHasWebhookPollURL_And_PollExecutionSucceeds_And_Stat
 		webhookClient.DoCallCount)
 }
 
+func TestReconcile_MultipleWebhooks_And_SequentialExecution_When_FirstSyncWebhookSucceeds_ShouldResultWebhookSuccessRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicySequential, v1alpha1.StateInProgress, v1alpha1.StatePending)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: webhookGUID2, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.True(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerWebhookSuccessStatusCalled(t, statusMgrClient, operation, webhookGUID)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertWebhookDoCalled(t, webhookClient, operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount,
+		webhookClient.PollCallCount)
+}
+
+func TestReconcile_MultipleWebhooks_And_SequentialExecution_When_LastSyncWebhookSucceeds_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicySequential, v1alpha1.StateSuccess, v1alpha1.StateInProgress)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: webhookGUID2, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationCalled(t, directorClient, operation)
+	assertWebhookDoCalled(t, webhookClient, operation, &application.Result.Webhooks[1])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.WebhookSuccessStatusCallCount, statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_MultipleWebhooks_And_SequentialExecution_When_FirstWebhookFailsWithFatalError_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicySequential, v1alpha1.StateInProgress, v1alpha1.StatePending)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: webhookGUID2, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(nil, expectedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, operation, expectedErr.Error())
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, operation, expectedErr.Error())
+	assertWebhookDoCalled(t, webhookClient, operation, &application.Result.Webhooks[0])
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.WebhookSuccessStatusCallCount, statusMgrClient.SuccessStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_MultipleWebhooks_And_ParallelExecution_When_SyncAndAsyncWebhooksSucceed_ShouldResultRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicyParallel, v1alpha1.StateInProgress, v1alpha1.StateInProgress)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+	statusMgrClient.InProgressWithPollURLReturns(nil)
+
+	syncMode, asyncMode := graphql.WebhookModeSync, graphql.WebhookModeAsync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &syncMode}, graphql.Webhook{ID: webhookGUID2, Mode: &asyncMode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{Location: &mockedLocationURL}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.True(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerWebhookSuccessStatusCalled(t, statusMgrClient, operation, webhookGUID)
+	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, operation, webhookGUID2, mockedLocationURL)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	require.Equal(t, 2, webhookClient.DoCallCount())
+	assertWebhookDoInvocation(t, webhookClient, operation, &application.Result.Webhooks[0], 0)
+	assertWebhookDoInvocation(t, webhookClient, operation, &application.Result.Webhooks[1], 1)
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, directorClient.UpdateOperationCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.SuccessStatusCallCount, statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_MultipleWebhooks_And_ParallelExecution_When_AllSyncWebhooksSucceed_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicyParallel, v1alpha1.StateInProgress, v1alpha1.StateInProgress)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.WebhookSuccessStatusReturns(nil)
+	statusMgrClient.SuccessStatusReturns(nil)
+
+	mode := graphql.WebhookModeSync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: webhookGUID2, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturns(&web_hook.Response{}, nil)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerWebhookSuccessStatusCalled(t, statusMgrClient, operation, webhookGUID)
+	assertStatusManagerSuccessStatusCalledWithOperation(t, statusMgrClient, operation)
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationCalled(t, directorClient, operation)
+	require.Equal(t, 2, webhookClient.DoCallCount())
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLCallCount,
+		statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount, statusMgrClient.FailedStatusCallCount, webhookClient.PollCallCount)
+}
+
+func TestReconcile_MultipleWebhooks_And_ParallelExecution_When_SecondWebhookFailsWithFatalError_ShouldResultNoRequeueNoError(t *testing.T) {
+	// GIVEN:
+	expectedErr := recerr.NewFatalReconcileError("unable to parse output template")
+	operation := prepareMultiWebhookOperation(v1alpha1.WebhookExecutionPolicyParallel, v1alpha1.StateInProgress, v1alpha1.StateInProgress)
+
+	k8sClient := &controllersfakes.FakeKubernetesClient{}
+	k8sClient.GetReturns(operation, nil)
+
+	statusMgrClient := &controllersfakes.FakeStatusManager{}
+	statusMgrClient.InitializeReturns(nil)
+	statusMgrClient.InProgressWithPollURLReturns(nil)
+	statusMgrClient.FailedStatusReturns(nil)
+
+	mode := graphql.WebhookModeAsync
+	application := prepareApplicationOutput(&graphql.Application{BaseEntity: &graphql.BaseEntity{}}, graphql.Webhook{ID: webhookGUID, Mode: &mode}, graphql.Webhook{ID: webhookGUID2, Mode: &mode})
+
+	directorClient := &controllersfakes.FakeDirectorClient{}
+	directorClient.FetchApplicationReturns(application, nil)
+	directorClient.UpdateOperationReturns(nil)
+
+	webhookClient := &controllersfakes.FakeWebhookClient{}
+	webhookClient.DoReturnsOnCall(0, &web_hook.Response{Location: &mockedLocationURL}, nil)
+	webhookClient.DoReturnsOnCall(1, nil, expectedErr)
+
+	// WHEN:
+	controller := controllers.NewOperationReconciler(webhook.DefaultConfig(), statusMgrClient, k8sClient, directorClient, webhookClient, collector.NewCollector())
+	res, err := controller.Reconcile(context.Background(), ctrlRequest)
+
+	// THEN:
+	// GENERAL ASSERTIONS:
+	require.False(t, res.Requeue)
+	require.Zero(t, res.RequeueAfter)
+
+	require.NoError(t, err)
+
+	// SPECIFIC CLIENT ASSERTIONS:
+	assertK8sGetCalledWithName(t, k8sClient, ctrlRequest.NamespacedName)
+	assertStatusManagerInitializeCalledWithOperation(t, statusMgrClient, operation)
+	assertStatusManagerInProgressWithPollURLCalled(t, statusMgrClient, operation, webhookGUID, mockedLocationURL)
+	assertStatusManagerFailedStatusCalledWithOperation(t, statusMgrClient, operation, expectedErr.Error())
+	assertDirectorFetchApplicationCalled(t, directorClient, operation.Spec.ResourceID, tenantGUID)
+	assertDirectorUpdateOperationWithErrorCalled(t, directorClient, operation, expectedErr.Error())
+	require.Equal(t, 2, webhookClient.DoCallCount())
+	assertZeroInvocations(t, k8sClient.DeleteCallCount, statusMgrClient.InProgressWithPollURLAndLastPollTimestampCallCount,
+		statusMgrClient.WebhookSuccessStatusCallCount, statusMgrClient.SuccessStatusCallCount, webhookClient.PollCallCount)
+}
+
+func prepareMultiWebhookOperation(policy v1alpha1.WebhookExecutionPolicy, firstWebhookState, secondWebhookState v1alpha1.State) *v1alpha1.Operation {
+	operation := initializedMockedOperation.DeepCopy()
+	operation.Spec.WebhookIDs = []string{webhookGUID, webhookGUID2}
+	operation.Spec.WebhookExecutionPolicy = policy
+	operation.Status.Webhooks = []v1alpha1.Webhook{
+		{WebhookID: webhookGUID, State: firstWebhookState},
+		{WebhookID: webhookGUID2, State: secondWebhookState},
+	}
+
+	return operation
+}
+
 func prepareApplicationOutput(app *graphql.Application, webhooks ...graphql.Webhook) *director.ApplicationOutput {
 	return &director.ApplicationOutput{Result: &graphql.ApplicationExt{
 		Application: *app,
@@ -2790,6 +3457,10 @@ func intToIntPtr(i int) *int {
 	return &i
 }
 
+func float64ToFloat64Ptr(f float64) *float64 {
+	return &f
+}
+
 func int64ToInt64Ptr(i int64) *int64 {
 	return &i
 }
@@ -34,8 +34,10 @@ import (
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . StatusManager
 type StatusManager interface {
 	Initialize(ctx context.Context, operation *v1alpha1.Operation) error
-	InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, pollURL string) error
-	InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, pollURL, lastPollTimestamp string, retryCount int) error
+	InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL string) error
+	InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL, lastPollTimestamp string, retryCount int) error
+	InProgressWithFailedAttempt(ctx context.Context, operation *v1alpha1.Operation, webhookID, lastError, nextRetryTimestamp string, failedAttempts int) error
+	WebhookSuccessStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error
 	SuccessStatus(ctx context.Context, operation *v1alpha1.Operation) error
 	FailedStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg string) error
 }
@@ -47,11 +49,13 @@ type KubernetesClient interface {
 	Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error
 }
 
-// DirectorClient defines a Director client which is capable of fetching an application
-// and notifying Director for operation state changes
+// DirectorClient defines a Director client which is capable of fetching the resources targeted by operations
+// (applications, runtimes and bundle instance auths) and notifying Director for operation state changes
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . DirectorClient
 type DirectorClient interface {
 	typesbroker.ApplicationLister
+	FetchRuntime(ctx context.Context, id string) (*director.RuntimeOutput, error)
+	FetchBundleInstanceAuth(ctx context.Context, id string) (*director.BundleInstanceAuthOutput, error)
 	UpdateOperation(ctx context.Context, request *director.Request) error
 }
 
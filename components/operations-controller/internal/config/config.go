@@ -38,12 +38,14 @@ type Validatable interface {
 // Config comprises of all the configurations that are necessary
 // for successful bootstrap and execution of the Operations Controller
 type Config struct {
-	Server                   *server.Config   `mapstructure:"server"`
-	HttpClient               *http.Config     `mapstructure:"http_client"`
-	GraphQLClient            *graphql.Config  `mapstructure:"graphql_client"`
-	Director                 *director.Config `mapstructure:"director"`
-	Webhook                  *webhook.Config  `mapstructure:"webhook"`
-	ExternalClientCertSecret string           `mapstructure:"external_client_cert_secret"`
+	Server                    *server.Config   `mapstructure:"server"`
+	HttpClient                *http.Config     `mapstructure:"http_client"`
+	GraphQLClient             *graphql.Config  `mapstructure:"graphql_client"`
+	Director                  *director.Config `mapstructure:"director"`
+	Webhook                   *webhook.Config  `mapstructure:"webhook"`
+	ExternalClientCertSecret  string           `mapstructure:"external_client_cert_secret"`
+	ExternalClientCertCertKey string           `mapstructure:"external_client_cert_cert_key"`
+	ExternalClientCertKeyKey  string           `mapstructure:"external_client_cert_key_key"`
 }
 
 // AppPFlags adds pflags for the Config structure and adds them in the provided set
@@ -60,6 +62,9 @@ func DefaultConfig() *Config {
 		GraphQLClient: graphql.DefaultConfig(),
 		Director:      director.DefaultConfig(),
 		Webhook:       webhook.DefaultConfig(),
+
+		ExternalClientCertCertKey: "tls.crt",
+		ExternalClientCertKeyKey:  "tls.key",
 	}
 }
 
@@ -26,25 +26,43 @@ import (
 	"github.com/kyma-incubator/compass/components/director/pkg/tenant"
 
 	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql/graphqlizer"
 	"github.com/kyma-incubator/compass/components/director/pkg/resource"
+	directorclient "github.com/kyma-incubator/compass/components/system-broker/pkg/director"
 	graphqlbroker "github.com/kyma-incubator/compass/components/system-broker/pkg/graphql"
 	"github.com/kyma-incubator/compass/components/system-broker/pkg/types"
+	gcli "github.com/machinebox/graphql"
+	"github.com/pkg/errors"
 )
 
 // client implements the DirectorClient interface
 type client struct {
 	types.ApplicationLister
+	gqlClient         directorclient.Client
+	fieldsProvider    *graphqlizer.GqlFieldsProvider
 	httpClient        *http.Client
 	directorURL       string
 	operationEndpoint string
 }
 
 type Request struct {
-	OperationType     graphql.OperationType `json:"operation_type"`
-	ResourceType      resource.Type         `json:"resource_type"`
-	ResourceID        string                `json:"resource_id"`
-	OperationCategory string                `json:"operation_category"`
-	Error             string                `json:"error,omitempty"`
+	OperationID         string                `json:"operation_id"`
+	OperationGeneration int64                 `json:"operation_generation"`
+	OperationType       graphql.OperationType `json:"operation_type"`
+	ResourceType        resource.Type         `json:"resource_type"`
+	ResourceID          string                `json:"resource_id"`
+	OperationCategory   string                `json:"operation_category"`
+	Error               string                `json:"error,omitempty"`
+}
+
+// RuntimeOutput holds the result of a runtime query
+type RuntimeOutput struct {
+	Result *graphql.RuntimeExt `json:"result"`
+}
+
+// BundleInstanceAuthOutput holds the result of a bundle instance auth query
+type BundleInstanceAuthOutput struct {
+	Result *graphql.BundleInstanceAuth `json:"result"`
 }
 
 // NewClient constructs a default implementation of the Client interface
@@ -61,13 +79,62 @@ func NewClient(operationEndpoint string, cfg *graphqlbroker.Config, httpClient *
 		return nil, err
 	}
 
+	gqlClient := graphqlbroker.NewClient(cfg, gcli.NewClient(cfg.GraphqlEndpoint, gcli.WithHTTPClient(tenantForwardingHTTPClient)))
+
 	return &client{
 		ApplicationLister: graphqlClient,
+		gqlClient:         gqlClient,
+		fieldsProvider:    &graphqlizer.GqlFieldsProvider{},
 		httpClient:        tenantForwardingHTTPClient,
 		operationEndpoint: operationEndpoint,
 	}, nil
 }
 
+// FetchRuntime fetches the runtime with the given ID together with its webhooks
+func (c *client) FetchRuntime(ctx context.Context, id string) (*RuntimeOutput, error) {
+	query := fmt.Sprintf(`query {
+			result: runtime(id: "%s") {
+					id
+					updatedAt
+					deletedAt
+					error
+					webhooks {%s}
+			}
+	}`, id, c.fieldsProvider.ForWebhooks())
+
+	runtime := RuntimeOutput{}
+	if err := c.gqlClient.Do(ctx, gcli.NewRequest(query), &runtime); err != nil {
+		return nil, errors.Wrap(err, "while fetching runtime in gqlclient")
+	}
+	if runtime.Result == nil {
+		return nil, &directorclient.NotFoundError{}
+	}
+
+	return &runtime, nil
+}
+
+// FetchBundleInstanceAuth fetches the bundle instance auth with the given ID
+func (c *client) FetchBundleInstanceAuth(ctx context.Context, id string) (*BundleInstanceAuthOutput, error) {
+	query := fmt.Sprintf(`query {
+			result: bundleInstanceAuth(id: "%s") {
+					id
+					status {condition}
+					deletedAt
+					error
+			}
+	}`, id)
+
+	bundleInstanceAuth := BundleInstanceAuthOutput{}
+	if err := c.gqlClient.Do(ctx, gcli.NewRequest(query), &bundleInstanceAuth); err != nil {
+		return nil, errors.Wrap(err, "while fetching bundle instance auth in gqlclient")
+	}
+	if bundleInstanceAuth.Result == nil {
+		return nil, &directorclient.NotFoundError{}
+	}
+
+	return &bundleInstanceAuth, nil
+}
+
 // UpdateOperation makes an http request to the Director to notify about any operation state changes
 func (c *client) UpdateOperation(ctx context.Context, request *Request) error {
 	body, err := json.Marshal(request)
@@ -26,6 +26,7 @@ var (
 	ErrReconciliationTimeoutReached = errors.New("reconciliation timeout reached")
 	ErrWebhookTimeoutReached        = errors.New("webhook timeout reached")
 	ErrWebhookPollTimeExpired       = errors.New("polling time has expired")
+	ErrWebhookMaxAttemptsReached    = errors.New("webhook maximum attempts reached")
 	ErrFailedWebhookStatus          = errors.New("webhook operation has finished with failed status")
 	ErrUnsupportedWebhookMode       = errors.New("unsupported webhook mode")
 )
@@ -64,10 +64,9 @@ func (m *manager) Initialize(ctx context.Context, operation *v1alpha1.Operation)
 			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
 		}
 
-		if len(operation.Spec.WebhookIDs) > 0 {
-			status.Webhooks = []v1alpha1.Webhook{
-				{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateInProgress},
-			}
+		status.Webhooks = nil
+		for i, webhookID := range operation.Spec.WebhookIDs {
+			status.Webhooks = append(status.Webhooks, v1alpha1.Webhook{WebhookID: webhookID, State: initialWebhookState(operation, i)})
 		}
 
 		status.InitializedAt = metav1.Now()
@@ -75,13 +74,64 @@ func (m *manager) Initialize(ctx context.Context, operation *v1alpha1.Operation)
 }
 
 // InProgressWithPollURL sets the status of an Operation CR to In Progress, ensures that none of the conditions are set to True,
-// and also initializes the slice of webhooks in the status with a single webhook with the provided pollURL.
-func (m *manager) InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, pollURL string) error {
-	return m.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, pollURL, "", 0)
+// and also sets the provided pollURL to the webhook with the given ID in the slice of webhooks in the status.
+func (m *manager) InProgressWithPollURL(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL string) error {
+	return m.InProgressWithPollURLAndLastPollTimestamp(ctx, operation, webhookID, pollURL, "", 0)
 }
 
 // InProgressWithPollURLAndLastPollTimestamp builds on what InProgressWithPollURL does, but also sets the last poll timestamp and retry count for the given webhook.
-func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, pollURL, lastPollTimestamp string, retryCount int) error {
+func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context, operation *v1alpha1.Operation, webhookID, pollURL, lastPollTimestamp string, retryCount int) error {
+	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
+		status := &operation.Status
+
+		status.Phase = v1alpha1.StateInProgress
+		status.Conditions = []v1alpha1.Condition{
+			{Type: v1alpha1.ConditionTypeReady, Status: corev1.ConditionFalse},
+			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
+		}
+
+		if len(operation.Spec.WebhookIDs) > 0 {
+			webhooks := currentWebhooks(operation)
+			for i := range webhooks {
+				if webhooks[i].WebhookID == webhookID {
+					webhooks[i] = v1alpha1.Webhook{WebhookID: webhookID, State: v1alpha1.StateInProgress, WebhookPollURL: pollURL, LastPollTimestamp: lastPollTimestamp, RetriesCount: retryCount}
+				}
+			}
+			status.Webhooks = webhooks
+		}
+	})
+}
+
+// InProgressWithFailedAttempt keeps the status of an Operation CR In Progress and records a failed call to the webhook with the given ID,
+// together with the error which caused it and the time after which the call can be retried. The poll URL of the webhook is preserved.
+func (m *manager) InProgressWithFailedAttempt(ctx context.Context, operation *v1alpha1.Operation, webhookID, lastError, nextRetryTimestamp string, failedAttempts int) error {
+	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
+		status := &operation.Status
+
+		status.Phase = v1alpha1.StateInProgress
+		status.Conditions = []v1alpha1.Condition{
+			{Type: v1alpha1.ConditionTypeReady, Status: corev1.ConditionFalse},
+			{Type: v1alpha1.ConditionTypeError, Status: corev1.ConditionFalse},
+		}
+
+		if len(operation.Spec.WebhookIDs) > 0 {
+			webhooks := currentWebhooks(operation)
+			for i := range webhooks {
+				if webhooks[i].WebhookID == webhookID {
+					webhooks[i].State = v1alpha1.StateInProgress
+					webhooks[i].FailedAttempts = failedAttempts
+					webhooks[i].LastError = lastError
+					webhooks[i].NextRetryTimestamp = nextRetryTimestamp
+				}
+			}
+			status.Webhooks = webhooks
+		}
+	})
+}
+
+// WebhookSuccessStatus keeps the status of an Operation CR In Progress and marks the webhook with the given ID with Success.
+// When the webhooks are executed sequentially, the next pending webhook in the status is marked with In Progress.
+func (m *manager) WebhookSuccessStatus(ctx context.Context, operation *v1alpha1.Operation, webhookID string) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
 
@@ -92,15 +142,28 @@ func (m *manager) InProgressWithPollURLAndLastPollTimestamp(ctx context.Context,
 		}
 
 		if len(operation.Spec.WebhookIDs) > 0 {
-			status.Webhooks = []v1alpha1.Webhook{
-				{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateInProgress, WebhookPollURL: pollURL, LastPollTimestamp: lastPollTimestamp, RetriesCount: retryCount},
+			webhooks := currentWebhooks(operation)
+			for i := range webhooks {
+				if webhooks[i].WebhookID == webhookID {
+					webhooks[i] = succeededWebhook(webhooks[i])
+				}
 			}
+
+			if operation.ExecutionPolicy() == v1alpha1.WebhookExecutionPolicySequential {
+				for i := range webhooks {
+					if webhooks[i].State == v1alpha1.StatePending {
+						webhooks[i].State = v1alpha1.StateInProgress
+						break
+					}
+				}
+			}
+			status.Webhooks = webhooks
 		}
 	})
 }
 
 // SuccessStatus sets the status of an Operation CR to Success, ensures that the Ready condition is True, the Error condition is False,
-// and that the webhook part of the webhooks slice in the status is marked with Success.
+// and that all webhooks part of the webhooks slice in the status are marked with Success.
 func (m *manager) SuccessStatus(ctx context.Context, operation *v1alpha1.Operation) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
@@ -112,19 +175,18 @@ func (m *manager) SuccessStatus(ctx context.Context, operation *v1alpha1.Operati
 		}
 
 		if len(operation.Spec.WebhookIDs) > 0 {
-			webhook := v1alpha1.Webhook{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateSuccess}
-			if len(operation.Status.Webhooks) > 0 {
-				webhook.RetriesCount = operation.Status.Webhooks[0].RetriesCount
-				webhook.WebhookPollURL = operation.Status.Webhooks[0].WebhookPollURL
-				webhook.LastPollTimestamp = operation.Status.Webhooks[0].LastPollTimestamp
+			webhooks := currentWebhooks(operation)
+			for i := range webhooks {
+				webhooks[i] = succeededWebhook(webhooks[i])
 			}
-			status.Webhooks = []v1alpha1.Webhook{webhook}
+			status.Webhooks = webhooks
 		}
 	})
 }
 
 // FailedStatus sets the status of an Operation CR to Failed, ensures that the Ready condition is False, the Error condition is True,
-// and that the webhook part of the webhooks slice in the status is marked with Failed.
+// and that the webhooks part of the webhooks slice in the status which are In Progress are marked with Failed.
+// Webhooks which have already succeeded or have not been started yet keep their state.
 func (m *manager) FailedStatus(ctx context.Context, operation *v1alpha1.Operation, errorMsg string) error {
 	return m.updateStatusFunc(ctx, operation, func(operation *v1alpha1.Operation) {
 		status := &operation.Status
@@ -136,14 +198,13 @@ func (m *manager) FailedStatus(ctx context.Context, operation *v1alpha1.Operatio
 		}
 
 		if len(operation.Spec.WebhookIDs) > 0 {
-			webhook := v1alpha1.Webhook{WebhookID: operation.Spec.WebhookIDs[0], State: v1alpha1.StateFailed}
-			if len(operation.Status.Webhooks) > 0 {
-				webhook.RetriesCount = operation.Status.Webhooks[0].RetriesCount
-				webhook.WebhookPollURL = operation.Status.Webhooks[0].WebhookPollURL
-				webhook.LastPollTimestamp = operation.Status.Webhooks[0].LastPollTimestamp
+			webhooks := currentWebhooks(operation)
+			for i := range webhooks {
+				if webhooks[i].State == v1alpha1.StateInProgress {
+					webhooks[i].State = v1alpha1.StateFailed
+				}
 			}
-
-			status.Webhooks = []v1alpha1.Webhook{webhook}
+			status.Webhooks = webhooks
 		}
 	})
 }
@@ -162,3 +223,37 @@ func (m *manager) updateStatusFunc(ctx context.Context, operation *v1alpha1.Oper
 		return m.k8sClient.Status().Update(ctx, operation)
 	})
 }
+
+// currentWebhooks returns a copy of the webhooks in the status of the operation ordered as in the operation spec.
+// Webhooks which are missing from the status are added with their initial state.
+func currentWebhooks(operation *v1alpha1.Operation) []v1alpha1.Webhook {
+	webhooks := make([]v1alpha1.Webhook, 0, len(operation.Spec.WebhookIDs))
+	for i, webhookID := range operation.Spec.WebhookIDs {
+		webhook := v1alpha1.Webhook{WebhookID: webhookID, State: initialWebhookState(operation, i)}
+		if webhookStatus := operation.WebhookStatus(webhookID); webhookStatus != nil {
+			webhook = *webhookStatus
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks
+}
+
+// succeededWebhook marks the given webhook with Success and clears the details of its previously failed attempts
+func succeededWebhook(webhook v1alpha1.Webhook) v1alpha1.Webhook {
+	webhook.State = v1alpha1.StateSuccess
+	webhook.FailedAttempts = 0
+	webhook.LastError = ""
+	webhook.NextRetryTimestamp = ""
+	return webhook
+}
+
+// initialWebhookState returns In Progress for the webhooks which are executed as soon as the operation is initialized
+// and Pending for the ones waiting for the preceding webhooks to finish
+func initialWebhookState(operation *v1alpha1.Operation, webhookIndex int) v1alpha1.State {
+	if webhookIndex > 0 && operation.ExecutionPolicy() == v1alpha1.WebhookExecutionPolicySequential {
+		return v1alpha1.StatePending
+	}
+
+	return v1alpha1.StateInProgress
+}
@@ -18,6 +18,7 @@ package status_test
 
 import (
 	"context"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -35,6 +36,7 @@ import (
 
 const (
 	webhookID     = "866e6b9c-f03b-442b-a6a5-4b90e21e503a"
+	webhookID2    = "a9bd1c3e-6f2b-4b83-9ad2-3c3b0e6f1d57"
 	mockedPollURL = "https://test-domain.com/operation"
 )
 
@@ -92,7 +94,7 @@ func TestStatusManager(t *testing.T) {
 		invalidOperation := operation.DeepCopy()
 		invalidOperation.ResourceVersion = ""
 		invalidOperation.ObjectMeta.Name = "invalid-operation"
-		invalidOperation.Spec.WebhookIDs = make([]string, 2)
+		invalidOperation.Spec.WebhookIDs = []string{webhookID, webhookID}
 
 		err = k8sClient.Create(ctx, invalidOperation)
 		require.NoError(t, err)
@@ -107,7 +109,7 @@ func TestStatusManager(t *testing.T) {
 
 		_, isValErr := err.(*v1alpha1.OperationValidationErr)
 		require.True(t, isValErr)
-		require.Contains(t, err.Error(), "expected 0 or 1 webhook for execution, found: 2")
+		require.Contains(t, err.Error(), fmt.Sprintf("webhook with ID %s is referenced more than once", webhookID))
 	})
 
 	t.Run("Test Initialize when generation and observed generation mismatch should initialize status with initial values", func(t *testing.T) {
@@ -196,7 +198,7 @@ func TestStatusManager(t *testing.T) {
 		err = k8sClient.Get(ctx, namespacedName, originOperation)
 		require.NoError(t, err)
 
-		err = statusManager.InProgressWithPollURL(ctx, originOperation, mockedPollURL)
+		err = statusManager.InProgressWithPollURL(ctx, originOperation, webhookID, mockedPollURL)
 		require.NoError(t, err)
 
 		var actualOperation = &v1alpha1.Operation{}
@@ -228,7 +230,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		var actualOperation = &v1alpha1.Operation{}
@@ -253,6 +255,67 @@ func TestStatusManager(t *testing.T) {
 		}
 	}))
 
+	t.Run("Test In Progress with Failed Attempt should record the failed attempt and preserve the Poll URL", initializeOperationBeforeEach(func(t *testing.T) {
+		var originOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, originOperation)
+		require.NoError(t, err)
+
+		err = statusManager.InProgressWithPollURL(ctx, originOperation, webhookID, mockedPollURL)
+		require.NoError(t, err)
+
+		failedAttempts := 2
+		lastError := "test error"
+		nextRetryTimestamp := time.Now().Add(time.Minute).Format(time.RFC3339Nano)
+		err = statusManager.InProgressWithFailedAttempt(ctx, originOperation, webhookID, lastError, nextRetryTimestamp, failedAttempts)
+		require.NoError(t, err)
+
+		var actualOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, actualOperation)
+		require.NoError(t, err)
+
+		for _, op := range []*v1alpha1.Operation{originOperation, actualOperation} {
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Phase)
+
+			require.Len(t, op.Status.Webhooks, 1)
+			require.Equal(t, webhookID, op.Status.Webhooks[0].WebhookID)
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Webhooks[0].State)
+			require.Equal(t, mockedPollURL, op.Status.Webhooks[0].WebhookPollURL)
+			require.Equal(t, failedAttempts, op.Status.Webhooks[0].FailedAttempts)
+			require.Equal(t, lastError, op.Status.Webhooks[0].LastError)
+			require.Equal(t, nextRetryTimestamp, op.Status.Webhooks[0].NextRetryTimestamp)
+
+			require.Len(t, op.Status.Conditions, 2)
+			require.Equal(t, corev1.ConditionFalse, op.Status.Conditions[0].Status)
+			require.Equal(t, corev1.ConditionFalse, op.Status.Conditions[1].Status)
+		}
+	}))
+
+	t.Run("Test In Progress with Poll URL And Last Poll Timestamp after In Progress with Failed Attempt should reset the failed attempts", initializeOperationBeforeEach(func(t *testing.T) {
+		var originOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, originOperation)
+		require.NoError(t, err)
+
+		nextRetryTimestamp := time.Now().Add(time.Minute).Format(time.RFC3339Nano)
+		err = statusManager.InProgressWithFailedAttempt(ctx, originOperation, webhookID, "test error", nextRetryTimestamp, 1)
+		require.NoError(t, err)
+
+		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, 1)
+		require.NoError(t, err)
+
+		var actualOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, namespacedName, actualOperation)
+		require.NoError(t, err)
+
+		for _, op := range []*v1alpha1.Operation{originOperation, actualOperation} {
+			require.Len(t, op.Status.Webhooks, 1)
+			require.Equal(t, lastPollTimestamp, op.Status.Webhooks[0].LastPollTimestamp)
+			require.Zero(t, op.Status.Webhooks[0].FailedAttempts)
+			require.Empty(t, op.Status.Webhooks[0].LastError)
+			require.Empty(t, op.Status.Webhooks[0].NextRetryTimestamp)
+		}
+	}))
+
 	t.Run("Test Success Status should succeed", initializeOperationBeforeEach(func(t *testing.T) {
 		var originOperation = &v1alpha1.Operation{}
 		err = k8sClient.Get(ctx, namespacedName, originOperation)
@@ -294,7 +357,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		err = statusManager.SuccessStatus(ctx, originOperation)
@@ -371,7 +434,7 @@ func TestStatusManager(t *testing.T) {
 
 		retryCount := 1
 		lastPollTimestamp := time.Now().Format(time.RFC3339Nano)
-		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, mockedPollURL, lastPollTimestamp, retryCount)
+		err = statusManager.InProgressWithPollURLAndLastPollTimestamp(ctx, originOperation, webhookID, mockedPollURL, lastPollTimestamp, retryCount)
 		require.NoError(t, err)
 
 		errMsg := "test error"
@@ -406,4 +469,126 @@ func TestStatusManager(t *testing.T) {
 			}
 		}
 	}))
+	multiWebhookOperation := func(t *testing.T, policy v1alpha1.WebhookExecutionPolicy) *v1alpha1.Operation {
+		operation := operation.DeepCopy()
+		operation.ResourceVersion = ""
+		operation.ObjectMeta.Name = "multi-webhook-operation"
+		operation.Spec.WebhookIDs = []string{webhookID, webhookID2}
+		operation.Spec.WebhookExecutionPolicy = policy
+
+		err = k8sClient.Create(ctx, operation)
+		require.NoError(t, err)
+
+		err = statusManager.Initialize(ctx, operation)
+		require.NoError(t, err)
+
+		return operation
+	}
+
+	t.Run("Test Initialize with sequential webhook execution should mark all but the first webhook as Pending", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicySequential)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		require.Len(t, operation.Status.Webhooks, 2)
+		require.Equal(t, webhookID, operation.Status.Webhooks[0].WebhookID)
+		require.Equal(t, v1alpha1.StateInProgress, operation.Status.Webhooks[0].State)
+		require.Equal(t, webhookID2, operation.Status.Webhooks[1].WebhookID)
+		require.Equal(t, v1alpha1.StatePending, operation.Status.Webhooks[1].State)
+	})
+
+	t.Run("Test Initialize with parallel webhook execution should mark all webhooks as In Progress", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicyParallel)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		require.Len(t, operation.Status.Webhooks, 2)
+		require.Equal(t, v1alpha1.StateInProgress, operation.Status.Webhooks[0].State)
+		require.Equal(t, v1alpha1.StateInProgress, operation.Status.Webhooks[1].State)
+	})
+
+	t.Run("Test Webhook Success Status with sequential webhook execution should start the next pending webhook", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicySequential)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		err = statusManager.InProgressWithPollURL(ctx, operation, webhookID, mockedPollURL)
+		require.NoError(t, err)
+
+		err = statusManager.WebhookSuccessStatus(ctx, operation, webhookID)
+		require.NoError(t, err)
+
+		var actualOperation = &v1alpha1.Operation{}
+		err = k8sClient.Get(ctx, types.NamespacedName{Namespace: operation.Namespace, Name: operation.Name}, actualOperation)
+		require.NoError(t, err)
+
+		for _, op := range []*v1alpha1.Operation{operation, actualOperation} {
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Phase)
+
+			require.Len(t, op.Status.Webhooks, 2)
+			require.Equal(t, v1alpha1.StateSuccess, op.Status.Webhooks[0].State)
+			require.Equal(t, mockedPollURL, op.Status.Webhooks[0].WebhookPollURL)
+			require.Equal(t, v1alpha1.StateInProgress, op.Status.Webhooks[1].State)
+			require.Empty(t, op.Status.Webhooks[1].WebhookPollURL)
+		}
+	})
+
+	t.Run("Test Failed Status should fail only the webhooks which are In Progress", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicySequential)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		err = statusManager.FailedStatus(ctx, operation, "test error")
+		require.NoError(t, err)
+
+		require.Equal(t, v1alpha1.StateFailed, operation.Status.Phase)
+		require.Len(t, operation.Status.Webhooks, 2)
+		require.Equal(t, v1alpha1.StateFailed, operation.Status.Webhooks[0].State)
+		require.Equal(t, v1alpha1.StatePending, operation.Status.Webhooks[1].State)
+	})
+
+	t.Run("Test Success Status should mark all webhooks with Success", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicySequential)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		err = statusManager.SuccessStatus(ctx, operation)
+		require.NoError(t, err)
+
+		require.Equal(t, v1alpha1.StateSuccess, operation.Status.Phase)
+		require.Len(t, operation.Status.Webhooks, 2)
+		require.Equal(t, v1alpha1.StateSuccess, operation.Status.Webhooks[0].State)
+		require.Equal(t, v1alpha1.StateSuccess, operation.Status.Webhooks[1].State)
+	})
+
+	t.Run("Test Webhook Success Status should reset the failed attempts of the webhook", func(t *testing.T) {
+		operation := multiWebhookOperation(t, v1alpha1.WebhookExecutionPolicySequential)
+		defer func() {
+			err = k8sClient.Delete(ctx, operation)
+			require.NoError(t, err)
+		}()
+
+		webhookID := operation.Spec.WebhookIDs[0]
+		err = statusManager.InProgressWithFailedAttempt(ctx, operation, webhookID, "test error", time.Now().Format(time.RFC3339Nano), 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, operation.Status.Webhooks[0].FailedAttempts)
+
+		err = statusManager.WebhookSuccessStatus(ctx, operation, webhookID)
+		require.NoError(t, err)
+
+		require.Equal(t, v1alpha1.StateSuccess, operation.Status.Webhooks[0].State)
+		require.Zero(t, operation.Status.Webhooks[0].FailedAttempts)
+		require.Empty(t, operation.Status.Webhooks[0].LastError)
+		require.Empty(t, operation.Status.Webhooks[0].NextRetryTimestamp)
+	})
 }
@@ -135,7 +135,7 @@ func (c *client) Do(ctx context.Context, request *Request) (*web_hook.Response,
 		return nil, errors.New(fmt.Sprintf("missing location url after executing async webhook: HTTP response status %+v with body %s", resp.Status, responseObject.Body))
 	}
 
-	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+	return response, checkForErr(resp, webhook, response.SuccessStatusCode, response.Error)
 }
 
 func (c *client) Poll(ctx context.Context, request *PollRequest) (*web_hook.ResponseStatus, error) {
@@ -186,7 +186,7 @@ func (c *client) Poll(ctx context.Context, request *PollRequest) (*web_hook.Resp
 		return nil, recerr.NewFatalReconcileErrorFromExisting(errors.Wrap(err, "unable to parse response status into status template"))
 	}
 
-	return response, checkForErr(resp, response.SuccessStatusCode, response.Error)
+	return response, checkForErr(resp, webhook, response.SuccessStatusCode, response.Error)
 }
 
 func (c *client) executeRequestWithCorrectClient(ctx context.Context, req *http.Request, webhook graphql.Webhook) (*http.Response, error) {
@@ -237,9 +237,10 @@ func parseResponseObject(resp *http.Response) (*web_hook.ResponseObject, error)
 	}, nil
 }
 
-func checkForErr(resp *http.Response, successStatusCode *int, error *string) error {
+func checkForErr(resp *http.Response, webhook graphql.Webhook, successStatusCode *int, error *string) error {
 	var errMsg string
-	if *successStatusCode != resp.StatusCode {
+	isSuccessStatusCode := *successStatusCode == resp.StatusCode
+	if !isSuccessStatusCode {
 		errMsg += fmt.Sprintf("response success status code was not met - expected %d, got %d; ", *successStatusCode, resp.StatusCode)
 	}
 
@@ -247,11 +248,15 @@ func checkForErr(resp *http.Response, successStatusCode *int, error *string) err
 		errMsg += fmt.Sprintf("received error while polling external system: %s", *error)
 	}
 
-	if errMsg != "" {
-		return errors.New(errMsg)
+	if errMsg == "" {
+		return nil
 	}
 
-	return nil
+	if !isSuccessStatusCode && !IsRetryableStatusCode(webhook, resp.StatusCode) {
+		return recerr.NewFatalReconcileError(fmt.Sprintf("%sstatus code %d is not retryable", errMsg, resp.StatusCode))
+	}
+
+	return errors.New(errMsg)
 }
 
 func checkForGoneStatus(resp *http.Response, goneStatusCode *int) error {
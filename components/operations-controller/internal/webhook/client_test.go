@@ -369,6 +369,69 @@ func TestClient_Do_WhenWebhookResponseStatusCodeIsNotSuccess_ShouldReturnError(t
 	require.Contains(t, err.Error(), "response success status code was not met")
 }
 
+func TestClient_Do_WhenWebhookResponseStatusCodeIsNotSuccessAndNotRetryable_ShouldReturnFatalError(t *testing.T) {
+	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
+	outputTemplate := "{\"location\":\"{{.Headers.Location}}\",\"success_status_code\": 202,\"error\": \"{{.Body.error}}\"}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.Request{
+		Webhook: graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			OutputTemplate: &outputTemplate,
+			Mode:           &webhookAsyncMode,
+			RetryPolicy:    &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}},
+		},
+		Object: web_hook.RequestObject{Application: app},
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				StatusCode: http.StatusBadRequest,
+			},
+		},
+	}, nil)
+
+	_, err := client.Do(context.Background(), webhookReq)
+
+	require.Error(t, err)
+	require.IsType(t, &internal_errors.FatalReconcileErr{}, err)
+	require.Contains(t, err.Error(), "status code 400 is not retryable")
+}
+
+func TestClient_Do_WhenWebhookResponseStatusCodeIsNotSuccessAndRetryable_ShouldReturnError(t *testing.T) {
+	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
+	outputTemplate := "{\"location\":\"{{.Headers.Location}}\",\"success_status_code\": 202,\"error\": \"{{.Body.error}}\"}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.Request{
+		Webhook: graphql.Webhook{
+			URLTemplate:    &URLTemplate,
+			OutputTemplate: &outputTemplate,
+			Mode:           &webhookAsyncMode,
+			RetryPolicy:    &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}},
+		},
+		Object: web_hook.RequestObject{Application: app},
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				Header:     http.Header{"Location": []string{mockedLocationURL}},
+				StatusCode: http.StatusServiceUnavailable,
+			},
+		},
+	}, nil)
+
+	_, err := client.Do(context.Background(), webhookReq)
+
+	require.Error(t, err)
+	_, isFatalErr := err.(*internal_errors.FatalReconcileErr)
+	require.False(t, isFatalErr)
+	require.Contains(t, err.Error(), "response success status code was not met")
+}
+
 func TestClient_Do_WhenSuccessfulBasicAuthWebhook_ShouldBeSuccessful(t *testing.T) {
 	URLTemplate := "{\"method\": \"DELETE\",\"path\":\"https://test-domain.com/api/v1/applicaitons/{{.Application.ID}}\"}"
 	inputTemplate := "{\"application_id\": \"{{.Application.ID}}\",\"name\": \"{{.Application.Name}}\"}"
@@ -732,6 +795,69 @@ func TestClient_Poll_WhenWebhookResponseStatusCodeIsNotSuccess_ShouldReturnError
 	require.Contains(t, err.Error(), "response success status code was not met")
 }
 
+func TestClient_Poll_WhenWebhookResponseStatusCodeIsNotSuccessAndNotRetryable_ShouldReturnFatalError(t *testing.T) {
+	statusTemplate := "{\"status\":\"{{.Body.status}}\",\"success_status_code\": 200,\"success_status_identifier\":\"SUCCEEDED\",\"in_progress_status_identifier\":\"IN_PROGRESS\",\"failed_status_identifier\":\"FAILED\",\"error\": \"{{.Body.error}}\"}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.PollRequest{
+		Request: &webhook.Request{
+			Webhook: graphql.Webhook{
+				StatusTemplate: &statusTemplate,
+				Mode:           &webhookAsyncMode,
+				RetryPolicy:    &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{http.StatusServiceUnavailable}},
+			},
+			Object: web_hook.RequestObject{Application: app},
+		},
+		PollURL: mockedLocationURL,
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				StatusCode: http.StatusNotFound,
+			},
+		},
+	}, nil)
+
+	_, err := client.Poll(context.Background(), webhookReq)
+
+	require.Error(t, err)
+	require.IsType(t, &internal_errors.FatalReconcileErr{}, err)
+	require.Contains(t, err.Error(), "status code 404 is not retryable")
+}
+
+func TestClient_Poll_WhenWebhookResponseStatusCodeIsNotSuccessAndRetryable_ShouldReturnError(t *testing.T) {
+	statusTemplate := "{\"status\":\"{{.Body.status}}\",\"success_status_code\": 200,\"success_status_identifier\":\"SUCCEEDED\",\"in_progress_status_identifier\":\"IN_PROGRESS\",\"failed_status_identifier\":\"FAILED\",\"error\": \"{{.Body.error}}\"}"
+	app := &graphql.Application{BaseEntity: &graphql.BaseEntity{ID: "appID"}}
+	webhookReq := &webhook.PollRequest{
+		Request: &webhook.Request{
+			Webhook: graphql.Webhook{
+				StatusTemplate: &statusTemplate,
+				Mode:           &webhookAsyncMode,
+				RetryPolicy:    &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{http.StatusServiceUnavailable}},
+			},
+			Object: web_hook.RequestObject{Application: app},
+		},
+		PollURL: mockedLocationURL,
+	}
+
+	client := webhook.NewClient(&http.Client{
+		Transport: mockedTransport{
+			resp: &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+				StatusCode: http.StatusServiceUnavailable,
+			},
+		},
+	}, nil)
+
+	_, err := client.Poll(context.Background(), webhookReq)
+
+	require.Error(t, err)
+	_, isFatalErr := err.(*internal_errors.FatalReconcileErr)
+	require.False(t, isFatalErr)
+	require.Contains(t, err.Error(), "response success status code was not met")
+}
+
 func TestClient_Poll_WhenSuccessfulBasicAuthWebhook_ShouldBeSuccessful(t *testing.T) {
 	headersTemplate := "{\"user-identity\":[\"{{.Headers.Client_user}}\"]}"
 	statusTemplate := "{\"status\":\"{{.Body.status}}\",\"success_status_code\": 200,\"success_status_identifier\":\"SUCCEEDED\",\"in_progress_status_identifier\":\"IN_PROGRESS\",\"failed_status_identifier\":\"FAILED\",\"error\": \"{{.Body.error}}\"}"
@@ -0,0 +1,85 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+)
+
+// RetryDelay calculates how long to wait before retrying a webhook call after the given number of consecutive failed attempts.
+// The initial delay is the webhook RetryInterval, or the provided default interval if the webhook does not define one.
+// Without a retry policy the initial delay is used for every retry. Otherwise, the delay is multiplied by the policy
+// BackoffMultiplier after each failed attempt, randomized by up to the policy Jitter fraction in both directions
+// and capped at the policy MaxInterval.
+func RetryDelay(webhook graphql.Webhook, defaultInterval time.Duration, failedAttempts int) time.Duration {
+	delay := defaultInterval
+	if webhook.RetryInterval != nil {
+		delay = time.Duration(*webhook.RetryInterval) * time.Second
+	}
+
+	policy := webhook.RetryPolicy
+	if policy == nil || failedAttempts < 1 {
+		return delay
+	}
+
+	seconds := delay.Seconds()
+	if policy.BackoffMultiplier != nil {
+		seconds *= math.Pow(*policy.BackoffMultiplier, float64(failedAttempts-1))
+	}
+
+	if policy.Jitter != nil && *policy.Jitter > 0 {
+		seconds *= 1 + *policy.Jitter*(2*rand.Float64()-1)
+	}
+
+	if policy.MaxInterval != nil && seconds > float64(*policy.MaxInterval) {
+		seconds = float64(*policy.MaxInterval)
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// MaxAttemptsReached returns whether the webhook should not be called again after the given number of consecutive failed attempts.
+// Webhooks without a retry policy or without a MaxAttempts limit are retried until the webhook timeout is reached.
+func MaxAttemptsReached(webhook graphql.Webhook, failedAttempts int) bool {
+	policy := webhook.RetryPolicy
+	if policy == nil || policy.MaxAttempts == nil {
+		return false
+	}
+
+	return failedAttempts >= *policy.MaxAttempts
+}
+
+// IsRetryableStatusCode returns whether a webhook call which has failed with the given HTTP status code can be retried.
+// All status codes are retryable unless the webhook retry policy restricts them.
+func IsRetryableStatusCode(webhook graphql.Webhook, statusCode int) bool {
+	policy := webhook.RetryPolicy
+	if policy == nil || len(policy.RetryableStatusCodes) == 0 {
+		return true
+	}
+
+	for _, retryableStatusCode := range policy.RetryableStatusCodes {
+		if retryableStatusCode == statusCode {
+			return true
+		}
+	}
+
+	return false
+}
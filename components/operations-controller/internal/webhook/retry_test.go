@@ -0,0 +1,173 @@
+/*
+ * Copyright 2020 The Compass Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package webhook_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-incubator/compass/components/director/pkg/graphql"
+	"github.com/kyma-incubator/compass/components/operations-controller/internal/webhook"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryDelay(t *testing.T) {
+	var tests = []struct {
+		Msg            string
+		Webhook        graphql.Webhook
+		FailedAttempts int
+		ExpectedDelay  time.Duration
+	}{
+		{
+			Msg:            "Default interval should be used when webhook does not define retry interval",
+			Webhook:        graphql.Webhook{},
+			FailedAttempts: 3,
+			ExpectedDelay:  2 * time.Minute,
+		},
+		{
+			Msg:            "Retry interval should be used as seconds when webhook does not define retry policy",
+			Webhook:        graphql.Webhook{RetryInterval: intPtr(30)},
+			FailedAttempts: 3,
+			ExpectedDelay:  30 * time.Second,
+		},
+		{
+			Msg:            "Retry interval should be used for the first retry",
+			Webhook:        graphql.Webhook{RetryInterval: intPtr(30), RetryPolicy: &graphql.WebhookRetryPolicy{BackoffMultiplier: floatPtr(2)}},
+			FailedAttempts: 1,
+			ExpectedDelay:  30 * time.Second,
+		},
+		{
+			Msg:            "Retry interval should be multiplied by the backoff multiplier for each subsequent retry",
+			Webhook:        graphql.Webhook{RetryInterval: intPtr(30), RetryPolicy: &graphql.WebhookRetryPolicy{BackoffMultiplier: floatPtr(2)}},
+			FailedAttempts: 4,
+			ExpectedDelay:  240 * time.Second,
+		},
+		{
+			Msg:            "Delay should be capped at the max interval",
+			Webhook:        graphql.Webhook{RetryInterval: intPtr(30), RetryPolicy: &graphql.WebhookRetryPolicy{BackoffMultiplier: floatPtr(2), MaxInterval: intPtr(100)}},
+			FailedAttempts: 4,
+			ExpectedDelay:  100 * time.Second,
+		},
+		{
+			Msg:            "Delay should not grow when retry policy does not define backoff multiplier",
+			Webhook:        graphql.Webhook{RetryInterval: intPtr(30), RetryPolicy: &graphql.WebhookRetryPolicy{MaxAttempts: intPtr(5)}},
+			FailedAttempts: 4,
+			ExpectedDelay:  30 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Msg, func(t *testing.T) {
+			delay := webhook.RetryDelay(test.Webhook, 2*time.Minute, test.FailedAttempts)
+			require.Equal(t, test.ExpectedDelay, delay)
+		})
+	}
+}
+
+func TestRetryDelay_WithJitter_ShouldRandomizeDelayWithinJitterBounds(t *testing.T) {
+	wh := graphql.Webhook{RetryInterval: intPtr(100), RetryPolicy: &graphql.WebhookRetryPolicy{Jitter: floatPtr(0.2)}}
+
+	for i := 0; i < 100; i++ {
+		delay := webhook.RetryDelay(wh, 2*time.Minute, 1)
+		require.GreaterOrEqual(t, delay, 80*time.Second)
+		require.LessOrEqual(t, delay, 120*time.Second)
+	}
+}
+
+func TestMaxAttemptsReached(t *testing.T) {
+	var tests = []struct {
+		Msg            string
+		Webhook        graphql.Webhook
+		FailedAttempts int
+		ExpectReached  bool
+	}{
+		{
+			Msg:            "Webhook without retry policy should never reach max attempts",
+			Webhook:        graphql.Webhook{},
+			FailedAttempts: 100,
+		},
+		{
+			Msg:            "Webhook without max attempts should never reach max attempts",
+			Webhook:        graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{}},
+			FailedAttempts: 100,
+		},
+		{
+			Msg:            "Webhook with less failed attempts than max attempts should not reach max attempts",
+			Webhook:        graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{MaxAttempts: intPtr(3)}},
+			FailedAttempts: 2,
+		},
+		{
+			Msg:            "Webhook with as many failed attempts as max attempts should reach max attempts",
+			Webhook:        graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{MaxAttempts: intPtr(3)}},
+			FailedAttempts: 3,
+			ExpectReached:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Msg, func(t *testing.T) {
+			require.Equal(t, test.ExpectReached, webhook.MaxAttemptsReached(test.Webhook, test.FailedAttempts))
+		})
+	}
+}
+
+func TestIsRetryableStatusCode(t *testing.T) {
+	var tests = []struct {
+		Msg             string
+		Webhook         graphql.Webhook
+		StatusCode      int
+		ExpectRetryable bool
+	}{
+		{
+			Msg:             "Any status code should be retryable when webhook does not define retry policy",
+			Webhook:         graphql.Webhook{},
+			StatusCode:      400,
+			ExpectRetryable: true,
+		},
+		{
+			Msg:             "Any status code should be retryable when retry policy does not define retryable status codes",
+			Webhook:         graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{}},
+			StatusCode:      400,
+			ExpectRetryable: true,
+		},
+		{
+			Msg:             "Status code from retryable status codes should be retryable",
+			Webhook:         graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{429, 503}}},
+			StatusCode:      503,
+			ExpectRetryable: true,
+		},
+		{
+			Msg:        "Status code missing from retryable status codes should not be retryable",
+			Webhook:    graphql.Webhook{RetryPolicy: &graphql.WebhookRetryPolicy{RetryableStatusCodes: []int{429, 503}}},
+			StatusCode: 400,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Msg, func(t *testing.T) {
+			require.Equal(t, test.ExpectRetryable, webhook.IsRetryableStatusCode(test.Webhook, test.StatusCode))
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP VIEW IF EXISTS ord_aggregation_statuses_tenants;
+DROP TABLE IF EXISTS ord_aggregation_statuses;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE ord_aggregation_statuses
+(
+    id                UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id            UUID      NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    webhook_id        UUID      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    last_attempt_at   TIMESTAMP NOT NULL,
+    last_success_at   TIMESTAMP,
+    documents_count   INTEGER   NOT NULL DEFAULT 0,
+    error             TEXT,
+    validation_errors JSONB,
+    CONSTRAINT ord_aggregation_statuses_app_id_webhook_id_key UNIQUE (app_id, webhook_id)
+);
+
+CREATE INDEX ord_aggregation_statuses_app_id_idx ON ord_aggregation_statuses (app_id);
+
+CREATE OR REPLACE VIEW ord_aggregation_statuses_tenants AS
+SELECT s.*, ta.tenant_id, ta.owner FROM ord_aggregation_statuses AS s
+                                            INNER JOIN tenant_applications AS ta ON ta.id = s.app_id;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+DROP VIEW IF EXISTS ord_aggregation_statuses_tenants;
+
+ALTER TABLE ord_aggregation_statuses
+    DROP COLUMN documents_hash,
+    DROP COLUMN fetch_cache;
+
+CREATE OR REPLACE VIEW ord_aggregation_statuses_tenants AS
+SELECT s.*, ta.tenant_id, ta.owner FROM ord_aggregation_statuses AS s
+                                            INNER JOIN tenant_applications AS ta ON ta.id = s.app_id;
+
+COMMIT;
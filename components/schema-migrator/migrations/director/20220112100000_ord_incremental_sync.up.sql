@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE ord_aggregation_statuses
+    ADD COLUMN documents_hash VARCHAR(256),
+    ADD COLUMN fetch_cache    JSONB;
+
+DROP VIEW IF EXISTS ord_aggregation_statuses_tenants;
+
+CREATE OR REPLACE VIEW ord_aggregation_statuses_tenants AS
+SELECT s.*, ta.tenant_id, ta.owner FROM ord_aggregation_statuses AS s
+                                            INNER JOIN tenant_applications AS ta ON ta.id = s.app_id;
+
+COMMIT;
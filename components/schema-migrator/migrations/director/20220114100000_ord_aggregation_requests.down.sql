@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS ord_aggregation_requests;
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE ord_aggregation_requests
+(
+    id           UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    app_id       UUID      NOT NULL REFERENCES applications (id) ON DELETE CASCADE,
+    requested_at TIMESTAMP NOT NULL,
+    CONSTRAINT ord_aggregation_requests_app_id_key UNIQUE (app_id)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP VIEW IF EXISTS specification_revisions_tenants;
+DROP TABLE IF EXISTS specification_revisions;
+
+COMMIT;
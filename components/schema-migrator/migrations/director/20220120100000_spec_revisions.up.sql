@@ -0,0 +1,29 @@
+BEGIN;
+
+CREATE TABLE specification_revisions
+(
+    id           UUID PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    api_def_id   UUID REFERENCES api_definitions (id) ON DELETE CASCADE,
+    event_def_id UUID REFERENCES event_api_definitions (id) ON DELETE CASCADE,
+    spec_type    VARCHAR(256) NOT NULL,
+    spec_format  VARCHAR(256) NOT NULL,
+    spec_data    TEXT         NOT NULL,
+    content_hash VARCHAR(64)  NOT NULL,
+    source       VARCHAR(256) NOT NULL,
+    created_at   TIMESTAMP    NOT NULL,
+    CONSTRAINT specification_revisions_valid_refs CHECK ((api_def_id IS NOT NULL) <> (event_def_id IS NOT NULL))
+);
+
+CREATE INDEX specification_revisions_api_def_id_idx ON specification_revisions (api_def_id, spec_type, created_at) WHERE api_def_id IS NOT NULL;
+CREATE INDEX specification_revisions_event_def_id_idx ON specification_revisions (event_def_id, spec_type, created_at) WHERE event_def_id IS NOT NULL;
+
+CREATE OR REPLACE VIEW specification_revisions_tenants AS
+SELECT r.*, ta.tenant_id, ta.owner FROM specification_revisions AS r
+                                            INNER JOIN api_definitions AS ad ON ad.id = r.api_def_id
+                                            INNER JOIN tenant_applications AS ta ON ta.id = ad.app_id
+UNION ALL
+SELECT r.*, ta.tenant_id, ta.owner FROM specification_revisions AS r
+                                            INNER JOIN event_api_definitions AS ead ON ead.id = r.event_def_id
+                                            INNER JOIN tenant_applications AS ta ON ta.id = ead.app_id;
+
+COMMIT;
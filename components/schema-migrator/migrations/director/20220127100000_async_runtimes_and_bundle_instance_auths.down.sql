@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP VIEW IF EXISTS bundle_instance_auths_tenants;
+
+ALTER TABLE bundle_instance_auths
+    DROP COLUMN ready,
+    DROP COLUMN created_at,
+    DROP COLUMN updated_at,
+    DROP COLUMN deleted_at,
+    DROP COLUMN error;
+
+ALTER TABLE runtimes
+    DROP COLUMN ready,
+    DROP COLUMN updated_at,
+    DROP COLUMN deleted_at,
+    DROP COLUMN error;
+
+CREATE OR REPLACE VIEW bundle_instance_auths_tenants AS
+SELECT bia.*, ta.tenant_id, ta.owner  FROM bundle_instance_auths AS bia
+                                               INNER JOIN bundles b ON b.id = bia.bundle_id
+                                               INNER JOIN tenant_applications ta ON ta.id = b.app_id;
+
+COMMIT;
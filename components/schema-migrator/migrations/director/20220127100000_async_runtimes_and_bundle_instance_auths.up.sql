@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE runtimes
+    ADD COLUMN ready bool DEFAULT TRUE,
+    ADD COLUMN updated_at timestamp,
+    ADD COLUMN deleted_at timestamp,
+    ADD COLUMN error jsonb;
+
+ALTER TABLE bundle_instance_auths
+    ADD COLUMN ready bool DEFAULT TRUE,
+    ADD COLUMN created_at timestamp,
+    ADD COLUMN updated_at timestamp,
+    ADD COLUMN deleted_at timestamp,
+    ADD COLUMN error jsonb;
+
+DROP VIEW IF EXISTS bundle_instance_auths_tenants;
+
+CREATE OR REPLACE VIEW bundle_instance_auths_tenants AS
+SELECT bia.*, ta.tenant_id, ta.owner  FROM bundle_instance_auths AS bia
+                                               INNER JOIN bundles b ON b.id = bia.bundle_id
+                                               INNER JOIN tenant_applications ta ON ta.id = b.app_id;
+
+COMMIT;
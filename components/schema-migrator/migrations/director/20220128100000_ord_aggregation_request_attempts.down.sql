@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE ord_aggregation_requests
+    DROP COLUMN attempts,
+    DROP COLUMN next_attempt_at;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE ord_aggregation_requests
+    ADD COLUMN attempts        INT NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at TIMESTAMP;
+
+COMMIT;
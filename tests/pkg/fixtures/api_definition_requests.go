@@ -25,7 +25,7 @@ func FixAPIDefinitionInputWithName(name string) graphql.APIDefinitionInput {
 }
 
 func FixEventAPIDefinitionInputWithName(name string) graphql.EventDefinitionInput {
-	data := graphql.CLOB("data")
+	data := graphql.CLOB(asyncAPISpecData)
 	return graphql.EventDefinitionInput{Name: name,
 		Spec: &graphql.EventSpecInput{
 			Data:   &data,
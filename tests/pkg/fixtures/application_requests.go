@@ -342,7 +342,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						  targetURL: "http://mywordpress.com/comments"
 						  group: "comments"
 						  spec: {
-							data: "{\"openapi\":\"3.0.2\"}"
+							data: "{\"openapi\":\"3.0.2\",\"info\":{\"title\":\"Comments API\",\"version\":\"1.0.0\"},\"paths\":{}}"
 							type: OPEN_API
 							format: YAML
 						  }
@@ -376,7 +376,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						{
 						  name: "xml"
 						  targetURL: "http://mywordpress.com/xml"
-						  spec: { data: "odata", type: ODATA, format: XML }
+						  spec: { data: "<edmx:Edmx Version=\"4.0\" xmlns:edmx=\"http://docs.oasis-open.org/odata/ns/edmx\"><edmx:DataServices/></edmx:Edmx>", type: ODATA, format: XML }
 						}
 					  ]
 					  eventDefinitions: [
@@ -384,7 +384,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						  name: "comments-v1"
 						  description: "comments events"
 						  spec: {
-							data: "{\"asyncapi\":\"1.2.0\"}"
+							data: "{\"asyncapi\":\"2.0.0\",\"info\":{\"title\":\"Comments Events\",\"version\":\"1.0.0\"},\"channels\":{}}"
 							type: ASYNC_API
 							format: YAML
 						  }
@@ -457,7 +457,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						  targetURL: "http://mywordpress.com/comments"
 						  group: "comments"
 						  spec: {
-							data: "{\"openapi\":\"3.0.2\"}"
+							data: "{\"openapi\":\"3.0.2\",\"info\":{\"title\":\"Comments API\",\"version\":\"1.0.0\"},\"paths\":{}}"
 							type: OPEN_API
 							format: YAML
 						  }
@@ -491,7 +491,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						{
 						  name: "xml"
 						  targetURL: "http://mywordpress.com/xml"
-						  spec: { data: "odata", type: ODATA, format: XML }
+						  spec: { data: "<edmx:Edmx Version=\"4.0\" xmlns:edmx=\"http://docs.oasis-open.org/odata/ns/edmx\"><edmx:DataServices/></edmx:Edmx>", type: ODATA, format: XML }
 						}
 					  ]
 					  eventDefinitions: [
@@ -499,7 +499,7 @@ func FixRegisterApplicationWithPackagesRequest(name string) *gcli.Request {
 						  name: "comments-v1"
 						  description: "comments events"
 						  spec: {
-							data: "{\"asyncapi\":\"1.2.0\"}"
+							data: "{\"asyncapi\":\"2.0.0\",\"info\":{\"title\":\"Comments Events\",\"version\":\"1.0.0\"},\"channels\":{}}"
 							type: ASYNC_API
 							format: YAML
 						  }
@@ -1737,7 +1737,7 @@ func CreateApp(suffix string) graphql.ApplicationRegisterInput {
 						Spec: &graphql.APISpecInput{
 							Type:   graphql.APISpecTypeOpenAPI,
 							Format: graphql.SpecFormatYaml,
-							Data:   ptr.CLOB(`{"openapi":"3.0.2"}`),
+							Data:   ptr.CLOB(openAPISpecData),
 						},
 					},
 					{
@@ -1748,7 +1748,7 @@ func CreateApp(suffix string) graphql.ApplicationRegisterInput {
 						Spec: &graphql.APISpecInput{
 							Type:   graphql.APISpecTypeOdata,
 							Format: graphql.SpecFormatJSON,
-							Data:   ptr.CLOB(`{"openapi":"3.0.1"}`),
+							Data:   ptr.CLOB(csdlSpecData),
 						},
 					},
 					{
@@ -1759,7 +1759,7 @@ func CreateApp(suffix string) graphql.ApplicationRegisterInput {
 						Spec: &graphql.APISpecInput{
 							Type:   graphql.APISpecTypeOdata,
 							Format: graphql.SpecFormatXML,
-							Data:   ptr.CLOB("odata"),
+							Data:   ptr.CLOB(odataSpecData),
 						},
 					},
 				},
@@ -1772,7 +1772,7 @@ func CreateApp(suffix string) graphql.ApplicationRegisterInput {
 						Spec: &graphql.EventSpecInput{
 							Type:   graphql.EventSpecTypeAsyncAPI,
 							Format: graphql.SpecFormatYaml,
-							Data:   ptr.CLOB(`{"asyncapi":"1.2.0"}`),
+							Data:   ptr.CLOB(asyncAPISpecData),
 						},
 					},
 					{
@@ -1782,7 +1782,7 @@ func CreateApp(suffix string) graphql.ApplicationRegisterInput {
 						Spec: &graphql.EventSpecInput{
 							Type:   graphql.EventSpecTypeAsyncAPI,
 							Format: graphql.SpecFormatYaml,
-							Data:   ptr.CLOB(`{"asyncapi":"1.1.0"}`),
+							Data:   ptr.CLOB(asyncAPISpecData),
 						},
 					},
 				},
@@ -110,7 +110,7 @@ func FixBundleWithOnlyOdataAPIs() *graphql.BundleCreateInput {
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOdata,
 					Format: graphql.SpecFormatJSON,
-					Data:   ptr.CLOB(`{"openapi":"3.0.1"}`),
+					Data:   ptr.CLOB(csdlSpecData),
 				},
 			},
 			{
@@ -121,7 +121,7 @@ func FixBundleWithOnlyOdataAPIs() *graphql.BundleCreateInput {
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOdata,
 					Format: graphql.SpecFormatXML,
-					Data:   ptr.CLOB("odata"),
+					Data:   ptr.CLOB(odataSpecData),
 				},
 			},
 		},
@@ -143,7 +143,7 @@ func FixBundleCreateInputWithRelatedObjects(t require.TestingT, name string) gra
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOpenAPI,
 					Format: graphql.SpecFormatYaml,
-					Data:   ptr.CLOB(`{"openapi":"3.0.2"}`),
+					Data:   ptr.CLOB(openAPISpecData),
 				},
 			},
 			{
@@ -166,7 +166,7 @@ func FixBundleCreateInputWithRelatedObjects(t require.TestingT, name string) gra
 				Spec: &graphql.APISpecInput{
 					Type:   graphql.APISpecTypeOdata,
 					Format: graphql.SpecFormatXML,
-					Data:   ptr.CLOB("odata"),
+					Data:   ptr.CLOB(odataSpecData),
 				},
 			},
 		},
@@ -179,7 +179,7 @@ func FixBundleCreateInputWithRelatedObjects(t require.TestingT, name string) gra
 				Spec: &graphql.EventSpecInput{
 					Type:   graphql.EventSpecTypeAsyncAPI,
 					Format: graphql.SpecFormatYaml,
-					Data:   ptr.CLOB(`{"asyncapi":"1.2.0"}`),
+					Data:   ptr.CLOB(asyncAPISpecData),
 				},
 			},
 			{
@@ -22,6 +22,11 @@ const (
 	auditlogTokenEndpoint        = "secured/oauth/token"
 	auditlogSearchEndpoint       = "audit-log/v2/configuration-changes/search"
 	auditlogDeleteEndpointFormat = "audit-log/v2/configuration-changes/%s"
+
+	openAPISpecData  = `{"openapi":"3.0.2","info":{"title":"Comments API","version":"1.0.0"},"paths":{}}`
+	odataSpecData    = `<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx"><edmx:DataServices/></edmx:Edmx>`
+	csdlSpecData     = `{"$Version":"4.0"}`
+	asyncAPISpecData = `{"asyncapi":"2.0.0","info":{"title":"Comments Events","version":"1.0.0"},"channels":{}}`
 )
 
 type Token struct {
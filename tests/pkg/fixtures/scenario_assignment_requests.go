@@ -87,7 +87,7 @@ func removeDoubleQuotesFromJSONKeys(in string) string {
 }
 
 func FixEventAPIDefinitionInput() graphql.EventDefinitionInput {
-	data := graphql.CLOB("data")
+	data := graphql.CLOB(asyncAPISpecData)
 	return graphql.EventDefinitionInput{Name: "name",
 		Spec: &graphql.EventSpecInput{
 			Data:   &data,